@@ -0,0 +1,95 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"golang_boilerplate_module/internal/bootstrap"
+	"golang_boilerplate_module/internal/config"
+	"golang_boilerplate_module/internal/shared/infra/persistence"
+
+	"go.uber.org/dig"
+	"go.uber.org/fx"
+	"gorm.io/gorm"
+)
+
+// build constructs the same graph the server runs, without starting it, so
+// commands reuse its providers through options such as fx.Populate. The
+// returned func closes the database.
+func build(flags *flagSet, options ...fx.Option) (func(), error) {
+	var db *gorm.DB
+	app := fx.New(
+		bootstrap.App(flags.configOptions()...),
+		fx.Populate(&db),
+		fx.Options(options...),
+		fx.NopLogger,
+	)
+	if err := app.Err(); err != nil {
+		return nil, dig.RootCause(err)
+	}
+	return func() { _ = persistence.CloseDB(db) }, nil
+}
+
+// buildOffline is build for commands that never query the database.
+func buildOffline(flags *flagSet, options ...fx.Option) error {
+	app := fx.New(
+		bootstrap.App(flags.configOptions()...),
+		fx.Decorate(persistence.NewOfflineDB),
+		fx.Options(options...),
+		fx.NopLogger,
+	)
+	if err := app.Err(); err != nil {
+		return dig.RootCause(err)
+	}
+	return nil
+}
+
+// flagSet adds the configuration flags every command accepts: -config, read
+// instead of CONFIG_FILE, and -set key=value, which overrides any other layer.
+type flagSet struct {
+	*flag.FlagSet
+	file      string
+	overrides [][2]string
+}
+
+func newFlagSet(name string) *flagSet {
+	flags := &flagSet{FlagSet: flag.NewFlagSet("api "+name, flag.ExitOnError)}
+	flags.StringVar(&flags.file, "config", "", "config file (YAML or TOML); overrides CONFIG_FILE")
+	flags.Func("set", "override a setting, as key=value (repeatable)", func(value string) error {
+		key, value, ok := strings.Cut(value, "=")
+		if !ok || key == "" {
+			return errors.New("want key=value")
+		}
+		flags.overrides = append(flags.overrides, [2]string{key, value})
+		return nil
+	})
+	return flags
+}
+
+func (f *flagSet) configOptions() []config.Option {
+	var opts []config.Option
+	if f.file != "" {
+		opts = append(opts, config.WithFile(f.file))
+	}
+	for _, override := range f.overrides {
+		opts = append(opts, config.WithOverride(override[0], override[1]))
+	}
+	return opts
+}
+
+func printJSON(v any) error {
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
+
+func subcommand(args []string, usage string) (string, []string, error) {
+	if len(args) == 0 {
+		return "", nil, fmt.Errorf("usage: api %s", usage)
+	}
+	return args[0], args[1:], nil
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"golang_boilerplate_module/internal/bootstrap"
+	"golang_boilerplate_module/internal/config"
+)
+
+const configUsage = "config print|reference"
+
+func runConfig(args []string) error {
+	action, args, err := subcommand(args, configUsage)
+	if err != nil {
+		return err
+	}
+	switch action {
+	case "print":
+		return printConfig(args)
+	case "reference":
+		return writeReference(args)
+	default:
+		return fmt.Errorf("unknown action %q; usage: api %s", action, configUsage)
+	}
+}
+
+// printConfig lists every resolved setting, with secrets masked, and the
+// layer it came from.
+func printConfig(args []string) error {
+	flags := newFlagSet("config print")
+	_ = flags.Parse(args)
+
+	cfg, err := bootstrap.Config(flags.configOptions()...)
+	if err != nil {
+		return err
+	}
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "KEY\tENV\tVALUE\tORIGIN")
+	for _, setting := range cfg.Settings() {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", setting.Key, setting.Env, setting.Value, setting.Origin)
+	}
+	return tw.Flush()
+}
+
+// writeReference documents every setting from the struct tags, without
+// loading the configuration.
+func writeReference(args []string) error {
+	flags := newFlagSet("config reference")
+	output := flags.String("o", "", "write to this file instead of stdout")
+	_ = flags.Parse(args)
+
+	sections := (&config.Config{}).Sections()
+	for _, m := range bootstrap.Modules {
+		if m.Config != nil {
+			sections = append(sections, config.Section{Key: m.Name, Value: m.Config})
+		}
+	}
+
+	settings := config.Describe(sections...)
+	if *output == "" {
+		return config.WriteReference(os.Stdout, settings)
+	}
+	f, err := os.Create(*output)
+	if err != nil {
+		return err
+	}
+	if err := config.WriteReference(f, settings); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"golang_boilerplate_module/internal/bootstrap"
+)
+
+// runHealthcheck probes the server on the loopback interface, so the image
+// needs no curl for its HEALTHCHECK. It reads only the configuration.
+func runHealthcheck(args []string) error {
+	flags := newFlagSet("healthcheck")
+	ready := flags.Bool("ready", false, "probe /readyz instead of /healthz")
+	timeout := flags.Duration("timeout", 3*time.Second, "request timeout")
+	_ = flags.Parse(args)
+
+	cfg, err := bootstrap.Config(flags.configOptions()...)
+	if err != nil {
+		return err
+	}
+
+	path := "/healthz"
+	if *ready {
+		path = "/readyz"
+	}
+
+	client := http.Client{Timeout: *timeout}
+	resp, err := client.Get(fmt.Sprintf("http://127.0.0.1:%d%s", cfg.App.Port, path))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s returned %s", path, resp.Status)
+	}
+	return nil
+}
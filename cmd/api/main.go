@@ -1,16 +1,69 @@
 package main
 
 import (
-	"golang_boilerplate_module/internal/bootstrap"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
 
 	"github.com/joho/godotenv"
-	"go.uber.org/fx"
 )
 
-func main() {
+type command struct {
+	name    string
+	usage   string
+	summary string
+	run     func(args []string) error
+}
 
+var commands = []command{
+	{"serve", "serve", "Start the HTTP and gRPC servers (default)", runServe},
+	{"migrate", "migrate up|down|status", "Apply, revert or list the database migrations", runMigrate},
+	{"seed", "seed [-dir fixtures] [-tenant id]", "Load the fixture files into the database", runSeed},
+	{"users", "users create|get|list", "Manage users from the command line", runUsers},
+	{"routes", "routes", "Print the HTTP route table", runRoutes},
+	{"config", "config print|reference", "Print the resolved configuration or document every setting", runConfig},
+	{"secrets", "secrets keygen|encrypt|decrypt", "Create and edit the encrypted secrets file", runSecrets},
+	{"healthcheck", "healthcheck [-ready]", "Probe the running server; for Docker HEALTHCHECK", runHealthcheck},
+}
+
+func main() {
 	_ = godotenv.Load()
 
-	app := fx.New(bootstrap.App)
-	app.Run()
+	name, args := "serve", os.Args[1:]
+	if len(args) > 0 {
+		name, args = args[0], args[1:]
+	}
+
+	switch name {
+	case "help", "-h", "-help", "--help":
+		usage(os.Stdout)
+		return
+	}
+
+	for _, cmd := range commands {
+		if cmd.name != name {
+			continue
+		}
+		if err := cmd.run(args); err != nil {
+			fmt.Fprintf(os.Stderr, "api %s: %v\n", name, err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	fmt.Fprintf(os.Stderr, "api: unknown command %q\n\n", name)
+	usage(os.Stderr)
+	os.Exit(2)
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "Usage: api <command> [arguments]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Commands:")
+	tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+	for _, cmd := range commands {
+		fmt.Fprintf(tw, "  %s\t%s\n", cmd.usage, cmd.summary)
+	}
+	_ = tw.Flush()
 }
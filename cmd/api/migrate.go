@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"golang_boilerplate_module/internal/shared/infra/persistence"
+	"golang_boilerplate_module/migrations"
+
+	"go.uber.org/fx"
+	"gorm.io/gorm"
+)
+
+const migrateUsage = "migrate up|down [-steps n]|status"
+
+func runMigrate(args []string) error {
+	action, args, err := subcommand(args, migrateUsage)
+	if err != nil {
+		return err
+	}
+
+	flags := newFlagSet("migrate " + action)
+	steps := 1
+	if action == "down" {
+		flags.IntVar(&steps, "steps", 1, "number of migrations to revert")
+	}
+	_ = flags.Parse(args)
+
+	var db *gorm.DB
+	closeDB, err := build(flags, fx.Populate(&db))
+	if err != nil {
+		return err
+	}
+	defer closeDB()
+
+	ctx := context.Background()
+	migrator := persistence.NewMigrator(db, migrations.FS)
+
+	switch action {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		for _, migration := range applied {
+			fmt.Printf("applied %s\n", migration.Script)
+		}
+		if err == nil && len(applied) == 0 {
+			fmt.Println("schema is up to date")
+		}
+		return err
+	case "down":
+		if steps < 1 {
+			return fmt.Errorf("-steps must be at least 1")
+		}
+		reverted, err := migrator.Down(ctx, steps)
+		for _, migration := range reverted {
+			fmt.Printf("reverted %s\n", migration.Script)
+		}
+		if err == nil && len(reverted) == 0 {
+			fmt.Println("no migration to revert")
+		}
+		return err
+	case "status":
+		status, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, "VERSION\tDESCRIPTION\tSTATE\tINSTALLED ON")
+		for _, migration := range status {
+			state, installedOn := "pending", ""
+			if migration.Applied {
+				state, installedOn = "applied", migration.InstalledOn.Format(time.DateTime)
+			}
+			fmt.Fprintf(tw, "%d\t%s\t%s\t%s\n", migration.Version, migration.Description, state, installedOn)
+		}
+		return tw.Flush()
+	default:
+		return fmt.Errorf("unknown action %q; usage: api %s", action, migrateUsage)
+	}
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/fx"
+)
+
+func runRoutes(args []string) error {
+	flags := newFlagSet("routes")
+	_ = flags.Parse(args)
+
+	var app *fiber.App
+	if err := buildOffline(flags, fx.Populate(&app)); err != nil {
+		return err
+	}
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "METHOD\tPATH\tNAME")
+	for _, route := range app.GetRoutes(true) {
+		// Fiber registers a HEAD twin for every GET.
+		if route.Method == fiber.MethodHead {
+			continue
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", route.Method, route.Path, route.Name)
+	}
+	return tw.Flush()
+}
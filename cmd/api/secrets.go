@@ -0,0 +1,72 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"golang_boilerplate_module/internal/config/secrets"
+)
+
+const secretsUsage = "secrets keygen|encrypt|decrypt"
+
+// runSecrets manages the encrypted secrets file of the file backend. It
+// reads no configuration, so it works before any is valid.
+func runSecrets(args []string) error {
+	action, args, err := subcommand(args, secretsUsage)
+	if err != nil {
+		return err
+	}
+
+	if action == "keygen" {
+		key, err := secrets.GenerateKey()
+		if err != nil {
+			return err
+		}
+		fmt.Println(key)
+		return nil
+	}
+
+	var seal func([]byte, *[32]byte) ([]byte, error)
+	switch action {
+	case "encrypt":
+		seal = secrets.Encrypt
+	case "decrypt":
+		seal = secrets.Decrypt
+	default:
+		return fmt.Errorf("unknown action %q; usage: api %s", action, secretsUsage)
+	}
+
+	flags := flag.NewFlagSet("api secrets "+action, flag.ExitOnError)
+	in := flags.String("in", "", "input file (default stdin)")
+	out := flags.String("out", "", "output file (default stdout)")
+	encodedKey := flags.String("key", os.Getenv("SECRETS_KEY"), "base64 key (default $SECRETS_KEY)")
+	_ = flags.Parse(args)
+
+	key, err := secrets.ParseKey(*encodedKey)
+	if err != nil {
+		return fmt.Errorf("key %w", err)
+	}
+
+	input, err := readInput(*in)
+	if err != nil {
+		return err
+	}
+	output, err := seal(input, key)
+	if err != nil {
+		return err
+	}
+	if *out == "" {
+		_, err = os.Stdout.Write(output)
+		return err
+	}
+	return os.WriteFile(*out, output, 0o600)
+}
+
+func readInput(path string) ([]byte, error) {
+	if path == "" {
+		return io.ReadAll(os.Stdin)
+	}
+	return os.ReadFile(path)
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"golang_boilerplate_module/internal/config"
+	"golang_boilerplate_module/internal/shared/domain/tenancy"
+	"golang_boilerplate_module/internal/shared/infra/platform"
+
+	"go.uber.org/fx"
+)
+
+func runSeed(args []string) error {
+	flags := newFlagSet("seed")
+	dir := flags.String("dir", "fixtures", "directory holding the fixture files")
+	tenant := flags.String("tenant", "", "tenant to seed (default TENANCY_DEFAULT_TENANT)")
+	_ = flags.Parse(args)
+
+	var (
+		cfg     *config.Config
+		seeders platform.SeedersParams
+	)
+	closeDB, err := build(flags, fx.Populate(&cfg), fx.Invoke(func(p platform.SeedersParams) { seeders = p }))
+	if err != nil {
+		return err
+	}
+	defer closeDB()
+
+	ctx, err := tenantContext(cfg, *tenant)
+	if err != nil {
+		return err
+	}
+
+	for _, seeder := range seeders.Seeders {
+		fixture, err := os.ReadFile(filepath.Join(*dir, seeder.Fixture))
+		if errors.Is(err, os.ErrNotExist) {
+			fmt.Printf("%s: skipped, no %s\n", seeder.Name, seeder.Fixture)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		created, err := seeder.Seed(ctx, fixture)
+		if err != nil {
+			return fmt.Errorf("%s: %w", seeder.Name, err)
+		}
+		fmt.Printf("%s: %d created\n", seeder.Name, created)
+	}
+	return nil
+}
+
+// tenantContext scopes ops commands to a tenant, as the HTTP middleware does
+// for requests.
+func tenantContext(cfg *config.Config, tenant string) (context.Context, error) {
+	if tenant == "" {
+		tenant = cfg.Tenancy.DefaultTenant
+	}
+	if tenant == "" {
+		return nil, fmt.Errorf("-tenant is required when TENANCY_DEFAULT_TENANT is empty")
+	}
+	return tenancy.WithTenantID(context.Background(), tenant), nil
+}
@@ -0,0 +1,15 @@
+package main
+
+import (
+	"golang_boilerplate_module/internal/bootstrap"
+
+	"go.uber.org/fx"
+)
+
+func runServe(args []string) error {
+	flags := newFlagSet("serve")
+	_ = flags.Parse(args)
+
+	fx.New(bootstrap.App(flags.configOptions()...)).Run()
+	return nil
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+
+	"golang_boilerplate_module/internal/config"
+	"golang_boilerplate_module/internal/modules/users/application/usersusecases"
+	"golang_boilerplate_module/internal/shared/domain/bus"
+
+	"go.uber.org/fx"
+)
+
+const usersUsage = "users create -name n -email e | get <id> | list [-after id] [-limit n]"
+
+func runUsers(args []string) error {
+	action, args, err := subcommand(args, usersUsage)
+	if err != nil {
+		return err
+	}
+
+	flags := newFlagSet("users " + action)
+	tenant := flags.String("tenant", "", "tenant to act on (default TENANCY_DEFAULT_TENANT)")
+	var (
+		name, email string
+		afterID     uint
+		limit       int
+	)
+	switch action {
+	case "create":
+		flags.StringVar(&name, "name", "", "user name")
+		flags.StringVar(&email, "email", "", "user email")
+	case "list":
+		flags.UintVar(&afterID, "after", 0, "list users after this id")
+		flags.IntVar(&limit, "limit", usersusecases.DefaultListUsersLimit, "maximum number of users")
+	case "get":
+	default:
+		return fmt.Errorf("unknown action %q; usage: api %s", action, usersUsage)
+	}
+	_ = flags.Parse(args)
+
+	var (
+		cfg        *config.Config
+		createUser bus.Handler[usersusecases.CreateUserInput, usersusecases.UserOutput]
+		getUser    bus.Handler[uint, usersusecases.UserOutput]
+		listUsers  *usersusecases.ListUsersUseCase
+	)
+	closeDB, err := build(flags, fx.Populate(&cfg, &createUser, &getUser, &listUsers))
+	if err != nil {
+		return err
+	}
+	defer closeDB()
+
+	ctx, err := tenantContext(cfg, *tenant)
+	if err != nil {
+		return err
+	}
+
+	var output any
+	switch action {
+	case "create":
+		output, err = createUser.Handle(ctx, usersusecases.CreateUserInput{Name: name, Email: email})
+	case "get":
+		if flags.NArg() != 1 {
+			return fmt.Errorf("usage: api users get <id>")
+		}
+		id, parseErr := strconv.ParseUint(flags.Arg(0), 10, 64)
+		if parseErr != nil {
+			return fmt.Errorf("invalid user id %q", flags.Arg(0))
+		}
+		output, err = getUser.Handle(ctx, uint(id))
+	case "list":
+		output, err = listUsers.Execute(ctx, usersusecases.ListUsersInput{AfterID: afterID, Limit: limit})
+	}
+	if err != nil {
+		return err
+	}
+	return printJSON(output)
+}
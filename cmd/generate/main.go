@@ -0,0 +1,77 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"golang_boilerplate_module/internal/generator"
+)
+
+const usage = `usage: generate module <name> --fields name:type[,name:type...] [--entity name] [--root dir]
+
+Field types: ` + generator.TypeNames + `.
+`
+
+func main() {
+	if len(os.Args) < 3 || os.Args[1] != "module" {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	name := os.Args[2]
+
+	flags := flag.NewFlagSet("generate module", flag.ExitOnError)
+	flags.Usage = func() { fmt.Fprint(os.Stderr, usage) }
+	fieldSpec := flags.String("fields", "", "comma-separated name:type pairs")
+	entity := flags.String("entity", "", "singular entity name (defaults to the module name without its plural suffix)")
+	root := flags.String("root", ".", "repository root")
+	_ = flags.Parse(os.Args[3:])
+
+	goModule, err := modulePath(filepath.Join(*root, "go.mod"))
+	if err != nil {
+		fail(err)
+	}
+	fields, err := generator.ParseFields(*fieldSpec)
+	if err != nil {
+		fail(err)
+	}
+	module, err := generator.NewModule(goModule, name, *entity, fields)
+	if err != nil {
+		fail(err)
+	}
+
+	paths, err := generator.Generate(*root, module)
+	for _, path := range paths {
+		fmt.Println(path)
+	}
+	if err != nil {
+		fail(err)
+	}
+
+	fmt.Println()
+	fmt.Println("Next steps: make migrate && make openapi")
+}
+
+func modulePath(goMod string) (string, error) {
+	file, err := os.Open(goMod)
+	if err != nil {
+		return "", fmt.Errorf("run from the repository root or pass --root: %w", err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if path, ok := strings.CutPrefix(strings.TrimSpace(scanner.Text()), "module "); ok {
+			return strings.TrimSpace(path), nil
+		}
+	}
+	return "", fmt.Errorf("%s has no module directive", goMod)
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, "generate:", err)
+	os.Exit(1)
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"golang_boilerplate_module/internal/bootstrap"
+	"golang_boilerplate_module/internal/shared/infra/http/openapi"
+	"golang_boilerplate_module/internal/shared/infra/persistence"
+
+	"github.com/joho/godotenv"
+	"go.uber.org/fx"
+)
+
+func main() {
+	output := flag.String("o", "", "write the spec to this file instead of stdout")
+	flag.Parse()
+
+	_ = godotenv.Load()
+
+	// Building the spec only needs route registrations, so the database is
+	// never contacted; a placeholder URL keeps config validation happy.
+	if os.Getenv("DATABASE_URL") == "" {
+		_ = os.Setenv("DATABASE_URL", "postgres://localhost/openapi?sslmode=disable")
+	}
+
+	var spec *openapi.Spec
+	app := fx.New(
+		bootstrap.App(),
+		fx.Decorate(persistence.NewOfflineDB),
+		fx.Populate(&spec),
+		fx.NopLogger,
+	)
+	if err := app.Err(); err != nil {
+		fail(err)
+	}
+
+	body, err := spec.JSON()
+	if err != nil {
+		fail(err)
+	}
+	body = append(body, '\n')
+
+	if *output == "" {
+		_, _ = os.Stdout.Write(body)
+		return
+	}
+	if err := os.WriteFile(*output, body, 0o644); err != nil {
+		fail(err)
+	}
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, "openapi:", err)
+	os.Exit(1)
+}
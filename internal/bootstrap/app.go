@@ -5,29 +5,53 @@ import (
 	"fmt"
 
 	"golang_boilerplate_module/internal/config"
-	"golang_boilerplate_module/internal/modules/health"
-	"golang_boilerplate_module/internal/modules/users"
 	"golang_boilerplate_module/internal/shared/domain/providers"
+	"golang_boilerplate_module/internal/shared/domain/tenancy"
 	sharedfx "golang_boilerplate_module/internal/shared/infra"
+	"golang_boilerplate_module/internal/shared/infra/grpcserver"
+	"golang_boilerplate_module/internal/shared/infra/http/graphqlapi"
 	"golang_boilerplate_module/internal/shared/infra/http/middleware"
+	"golang_boilerplate_module/internal/shared/infra/http/openapi"
 	"golang_boilerplate_module/internal/shared/infra/persistence"
+	"golang_boilerplate_module/internal/shared/infra/platform"
+	"golang_boilerplate_module/internal/shared/infra/streaming"
 
 	otelfiber "github.com/gofiber/contrib/otelfiber/v2"
 	"github.com/gofiber/fiber/v2"
-	fibercors "github.com/gofiber/fiber/v2/middleware/cors"
 	"go.uber.org/fx"
 	"gorm.io/gorm"
 )
 
-func NewFiberApp(logger providers.LoggerProvider) *fiber.App {
+func NewFiberApp(
+	cfg *config.Config,
+	reloader *config.Reloader,
+	tenants tenancy.TenantLookup,
+	sessions providers.SessionAuthenticator,
+	spec *openapi.Spec,
+	validator *openapi.Validator,
+	logger providers.LoggerProvider,
+) *fiber.App {
 	app := fiber.New(fiber.Config{
 		ErrorHandler: middleware.NewErrorHandler(logger),
 	})
 
-	app.Use(fibercors.New())
+	app.Use(middleware.CORS(cfg, reloader))
 	app.Use(otelfiber.Middleware())
 	app.Use(middleware.HTTPMetrics())
 	app.Use(middleware.RequestID(logger))
+	app.Use(middleware.ReadYourWrites())
+	rateLimit := middleware.RateLimit(cfg, reloader)
+	app.Use("/api", rateLimit)
+	app.Use(graphqlapi.Path, rateLimit)
+	app.Use("/api", middleware.APIVersion(cfg, logger))
+	app.Use(openapi.Validation(cfg, validator, logger))
+	app.Use("/api", middleware.Session(cfg, sessions, logger))
+	app.Use("/api", middleware.Tenant(cfg, tenants, logger))
+	app.Use("/api", middleware.CSRF(cfg))
+	app.Use(graphqlapi.Path, middleware.Session(cfg, sessions, logger))
+	app.Use(graphqlapi.Path, middleware.Tenant(cfg, tenants, logger))
+	app.Use(graphqlapi.Path, middleware.CSRF(cfg))
+	spec.DescribeErrors("/api", fiber.StatusBadRequest, fiber.StatusUnauthorized, fiber.StatusForbidden, fiber.StatusNotFound, fiber.StatusTooManyRequests)
 
 	return app
 }
@@ -64,11 +88,79 @@ func StartFiberApp(
 	})
 }
 
-var App = fx.Options(
-	fx.Provide(config.NewConfig),
-	fx.Provide(NewFiberApp),
-	sharedfx.Module,
-	health.Module,
-	users.Module,
-	fx.Invoke(StartFiberApp),
-)
+func StartGRPCServer(
+	lc fx.Lifecycle,
+	server *grpcserver.Server,
+	cfg *config.Config,
+	logger providers.LoggerProvider,
+) {
+	if !cfg.GRPC.Enabled {
+		return
+	}
+
+	lc.Append(fx.Hook{
+		OnStart: func(_ context.Context) error {
+			if err := server.Listen(cfg.GRPC.Port); err != nil {
+				return err
+			}
+			logger.Info("gRPC server starting",
+				"addr", server.Addr().String(),
+				"reflection", cfg.GRPC.ReflectionEnabled,
+			)
+			go func() {
+				if err := server.Serve(); err != nil {
+					logger.Error("gRPC server stopped with error", "error", err)
+				}
+			}()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			logger.Info("Shutting down gRPC server gracefully...")
+			server.Shutdown(ctx)
+			return nil
+		},
+	})
+}
+
+// Config resolves the configuration, including the section of every module
+// that declares one.
+func Config(opts ...config.Option) (*config.Config, error) {
+	var sections []config.Option
+	for _, m := range Modules {
+		if m.Config != nil {
+			sections = append(sections, config.WithSection(m.Name, m.Config))
+		}
+	}
+	return config.NewConfig(append(sections, opts...)...)
+}
+
+// App builds the application from the configuration: the platform services
+// plus every enabled module.
+func App(opts ...config.Option) fx.Option {
+	cfg, err := Config(opts...)
+	if err != nil {
+		return fx.Error(err)
+	}
+
+	registry, err := platform.NewRegistry(cfg, Modules...)
+	if err != nil {
+		return fx.Error(err)
+	}
+
+	return fx.Options(
+		fx.Supply(cfg),
+		fx.Provide(config.NewReloader),
+		fx.Provide(NewFiberApp),
+		fx.Provide(grpcserver.NewServer),
+		fx.Provide(openapi.NewSpec, openapi.NewRouter, openapi.NewValidator),
+		fx.Invoke(openapi.RegisterDocsRoutes),
+		fx.Provide(graphqlapi.NewSchema, graphqlapi.NewPersistedQueries),
+		fx.Invoke(graphqlapi.RegisterRoutes),
+		fx.Provide(streaming.NewManager),
+		sharedfx.Module,
+		registry.Options(),
+		fx.Invoke(StartFiberApp),
+		fx.Invoke(StartGRPCServer),
+		fx.Invoke(streaming.RegisterShutdown),
+	)
+}
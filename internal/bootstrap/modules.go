@@ -0,0 +1,26 @@
+package bootstrap
+
+import (
+	"golang_boilerplate_module/internal/modules/featureflags"
+	"golang_boilerplate_module/internal/modules/health"
+	"golang_boilerplate_module/internal/modules/identity"
+	"golang_boilerplate_module/internal/modules/organizations"
+	"golang_boilerplate_module/internal/modules/privacy"
+	"golang_boilerplate_module/internal/modules/sessions"
+	"golang_boilerplate_module/internal/modules/tenants"
+	"golang_boilerplate_module/internal/modules/users"
+	"golang_boilerplate_module/internal/shared/infra/platform"
+)
+
+// Modules lists every module the application can run; MODULES_DISABLED
+// switches optional ones off.
+var Modules = []platform.Module{
+	health.Module,
+	tenants.Module,
+	sessions.Module,
+	identity.Module,
+	users.Module,
+	organizations.Module,
+	privacy.Module,
+	featureflags.Module,
+}
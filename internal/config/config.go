@@ -1,79 +1,507 @@
 package config
 
 import (
-	"fmt"
-	"os"
+	"context"
+	"net/url"
+	"reflect"
+	"regexp"
+	"runtime/debug"
 	"strconv"
+	"strings"
+	"time"
+
+	"golang_boilerplate_module/internal/config/secrets"
 )
 
+// Settings are described by struct tags: key is the name in the config file
+// (nested under the section key), env the environment variable, default the
+// value when no layer sets it, validate the rules it must satisfy and doc the
+// line in the generated reference. Values of settings tagged secret, or read
+// from a secret source, are masked by Settings and listed by SecretValues.
+// Settings tagged reload are applied by the running components when the
+// configuration is reloaded; changing any other one needs a restart.
+
 type AppConfig struct {
-	ServiceName string
-	Port        int
-	Env         string
-	Version     string
+	ServiceName string `key:"service_name" env:"SERVICE_NAME" default:"boilerplate-api" validate:"required" doc:"Service name attached to logs and telemetry"`
+	Port        int    `key:"port" env:"PORT" default:"3000" validate:"min=1,max=65535" doc:"HTTP server port"`
+	Env         string `key:"env" env:"APP_ENV" default:"production" validate:"required" doc:"Deployment environment; selects the config file overlay and the environment-dependent defaults"`
+	Version     string `key:"version" env:"APP_VERSION" doc:"Version reported in logs and telemetry (default: the version the binary was built with)"`
+
+	ConfigWatchInterval time.Duration `key:"config_watch_interval" env:"CONFIG_WATCH_INTERVAL" default:"5s" validate:"min=0s" doc:"How often the config file is checked for changes; 0 leaves reloads to SIGHUP"`
+}
+
+type HTTPConfig struct {
+	CORSAllowedOrigins []string      `key:"cors_allowed_origins" env:"HTTP_CORS_ALLOWED_ORIGINS" default:"*" reload:"true" doc:"Origins allowed by CORS; * allows any"`
+	RateLimit          int           `key:"rate_limit" env:"HTTP_RATE_LIMIT" default:"0" validate:"min=0" reload:"true" doc:"Requests a client IP may make per rate_limit_window; 0 disables rate limiting"`
+	RateLimitWindow    time.Duration `key:"rate_limit_window" env:"HTTP_RATE_LIMIT_WINDOW" default:"1m" validate:"gt=0s" reload:"true" doc:"Window of the rate limit"`
 }
 
 type DatabaseConfig struct {
-	URL            string
-	MaxConnections int
+	URL            string `key:"url" env:"DATABASE_URL" validate:"required" secret:"url" doc:"PostgreSQL connection URL, or sqlite://<path> (sqlite://:memory: for a private in-memory database) for development without containers"`
+	MaxConnections int    `key:"max_connections" env:"DATABASE_MAX_CONNECTIONS" default:"10" validate:"min=1" doc:"Maximum open connections"`
+
+	MaxIdleConnections int           `key:"max_idle_connections" env:"DATABASE_MAX_IDLE_CONNECTIONS" validate:"min=0" doc:"Idle connections kept open; must not exceed max_connections (default: half of max_connections)"`
+	ConnMaxLifetime    time.Duration `key:"conn_max_lifetime" env:"DATABASE_CONN_MAX_LIFETIME" default:"30m" validate:"min=0s" doc:"Connections older than this are closed once released; 0 keeps them"`
+	ConnMaxIdleTime    time.Duration `key:"conn_max_idle_time" env:"DATABASE_CONN_MAX_IDLE_TIME" default:"5m" validate:"min=0s" doc:"Connections idle for this long are closed; 0 keeps them"`
+	ConnectTimeout     time.Duration `key:"connect_timeout" env:"DATABASE_CONNECT_TIMEOUT" default:"5s" validate:"gt=0s" doc:"Timeout of each attempt to open a connection"`
+	ConnectAttempts    int           `key:"connect_attempts" env:"DATABASE_CONNECT_ATTEMPTS" default:"5" validate:"min=1" doc:"Attempts to reach the database at startup before giving up"`
+	ConnectBackoff     time.Duration `key:"connect_backoff" env:"DATABASE_CONNECT_BACKOFF" default:"1s" validate:"gt=0s" doc:"Delay after the first failed startup attempt, doubled after each further failure"`
+	StatementTimeout   time.Duration `key:"statement_timeout" env:"DATABASE_STATEMENT_TIMEOUT" default:"30s" validate:"min=0s" doc:"statement_timeout of every connection; 0 leaves the server's. Migrations run without it"`
+	LockTimeout        time.Duration `key:"lock_timeout" env:"DATABASE_LOCK_TIMEOUT" default:"10s" validate:"min=0s" doc:"lock_timeout of every connection; 0 leaves the server's. Migrations run without it"`
+	ApplicationName    string        `key:"application_name" env:"DATABASE_APPLICATION_NAME" doc:"application_name shown in pg_stat_activity (default: the service name)"`
+
+	ReplicaURLs          []string      `key:"replica_urls" env:"DATABASE_REPLICA_URLS" secret:"urls" doc:"Read replica connection URLs; read-only repository calls are balanced across the healthy ones"`
+	ReplicaCheckInterval time.Duration `key:"replica_check_interval" env:"DATABASE_REPLICA_CHECK_INTERVAL" default:"5s" validate:"gt=0s" doc:"How often each replica's availability and lag are checked"`
+	ReplicaMaxLag        time.Duration `key:"replica_max_lag" env:"DATABASE_REPLICA_MAX_LAG" default:"10s" validate:"min=0s" doc:"Replicas further behind the primary are taken out of rotation; 0 disables the limit"`
+	ReadYourWritesWindow time.Duration `key:"read_your_writes_window" env:"DATABASE_READ_YOUR_WRITES_WINDOW" default:"5s" validate:"min=0s" doc:"After a write, reads in the same request go to the primary for this long"`
+
+	Repositories string `key:"repositories" env:"DATABASE_REPOSITORIES" default:"gorm" validate:"required,oneof=gorm pgx" doc:"Implementation of the repositories that have a pgx one (users): gorm, or pgx for prepared statements without GORM's reflection"`
+
+	SlowQueryThreshold time.Duration `key:"slow_query_threshold" env:"DATABASE_SLOW_QUERY_THRESHOLD" default:"200ms" validate:"min=0s" reload:"true" doc:"Queries running longer are logged as warnings and counted in db.client.slow_queries; 0 disables"`
+	LogSQL             bool          `key:"log_sql" env:"DATABASE_LOG_SQL" default:"false" reload:"true" doc:"Include the SQL, with placeholders instead of parameters, in query logs"`
+}
+
+const (
+	DialectPostgres = "postgres"
+	DialectSQLite   = "sqlite"
+)
+
+// Dialect is the database URL points to: sqlite for sqlite: URLs, postgres
+// for anything else.
+func (c DatabaseConfig) Dialect() string {
+	if strings.HasPrefix(c.URL, DialectSQLite+":") {
+		return DialectSQLite
+	}
+	return DialectPostgres
 }
 
 type LoggerConfig struct {
-	Level string
+	Level string `key:"level" env:"LOG_LEVEL" default:"error" validate:"required,oneof=debug info warn error dpanic panic fatal" reload:"true" doc:"Minimum log level"`
 }
 
 type OtelConfig struct {
-	Endpoint string
-	Protocol string
+	Endpoint string `key:"endpoint" env:"OTEL_EXPORTER_OTLP_ENDPOINT" doc:"OTLP endpoint; empty disables telemetry export"`
+	Protocol string `key:"protocol" env:"OTEL_EXPORTER_OTLP_PROTOCOL" default:"http/protobuf" doc:"OTLP protocol"`
+
+	SamplerRatio float64 `key:"sampler_ratio" env:"OTEL_TRACES_SAMPLER_RATIO" default:"1" validate:"min=0,max=1" reload:"true" doc:"Share of root traces sampled; child spans follow their parent's decision"`
 }
 
-type Config struct {
-	App      AppConfig
-	Database DatabaseConfig
-	Logger   LoggerConfig
-	Otel     OtelConfig
+type PrivacyConfig struct {
+	ErasureGracePeriod        time.Duration `key:"erasure_grace_period" env:"PRIVACY_ERASURE_GRACE_PERIOD" default:"720h" validate:"min=0s" doc:"Delay between an erasure request and its execution"`
+	ErasureCancellationWindow time.Duration `key:"erasure_cancellation_window" env:"PRIVACY_ERASURE_CANCELLATION_WINDOW" validate:"min=0s" doc:"Window in which an erasure request can still be cancelled (default: the grace period)"`
+	ErasureCheckInterval      time.Duration `key:"erasure_check_interval" env:"PRIVACY_ERASURE_CHECK_INTERVAL" default:"1m" validate:"gt=0s" doc:"Interval of the job that executes due erasures"`
+	ErasureClaimTimeout       time.Duration `key:"erasure_claim_timeout" env:"PRIVACY_ERASURE_CLAIM_TIMEOUT" default:"1h" validate:"gt=0s" doc:"How long an erasure may stay processing before the job claims it again, as after a crash"`
+	ErasureMaxAttempts        int           `key:"erasure_max_attempts" env:"PRIVACY_ERASURE_MAX_ATTEMPTS" default:"5" validate:"min=1" doc:"Runs an erasure gets before a module failure marks it failed"`
+	ErasureRetryBackoff       time.Duration `key:"erasure_retry_backoff" env:"PRIVACY_ERASURE_RETRY_BACKOFF" default:"5m" validate:"gt=0s" doc:"Delay before a failed erasure runs again, multiplied by the number of attempts"`
+	Admins                    []string      `key:"admins" env:"PRIVACY_ADMINS" doc:"IDs of the users allowed to export or erase the data of any user"`
 }
 
-func NewConfig() (*Config, error) {
-	dbURL := os.Getenv("DATABASE_URL")
-	if dbURL == "" {
-		return nil, fmt.Errorf("DATABASE_URL is required")
+type UsersConfig struct {
+	Admins []string `key:"admins" env:"USERS_ADMINS" doc:"IDs of the users allowed to update or delete any user"`
+}
+
+type TenancyConfig struct {
+	Resolvers        []string `key:"resolvers" env:"TENANCY_RESOLVERS" default:"session,subdomain" validate:"oneof=session header subdomain" doc:"Tenant resolution strategies, in order; a tenant that differs from the session's is refused"`
+	Header           string   `key:"header" env:"TENANCY_HEADER" default:"X-Tenant-ID" validate:"required" doc:"Header read by the header strategy"`
+	BaseDomain       string   `key:"base_domain" env:"TENANCY_BASE_DOMAIN" doc:"Base domain of the subdomain strategy (app.com resolves acme.app.com)"`
+	DefaultTenant    string   `key:"default_tenant" env:"TENANCY_DEFAULT_TENANT,allowempty" default:"default" doc:"Tenant used when none is resolved; empty makes a tenant mandatory"`
+	RowLevelSecurity bool     `key:"row_level_security" env:"TENANCY_ROW_LEVEL_SECURITY" default:"false" doc:"Run every statement with app.current_tenant set (see migrations/optional/)"`
+	Admins           []string `key:"admins" env:"TENANCY_ADMINS" doc:"IDs of the users allowed to create and look up tenants"`
+}
+
+type SessionsConfig struct {
+	Store           string        `key:"store" env:"SESSION_STORE" default:"postgres" validate:"required,oneof=postgres memory" doc:"Session store"`
+	CookieName      string        `key:"cookie_name" env:"SESSION_COOKIE_NAME" default:"session_id" validate:"required" doc:"HttpOnly cookie holding the session token"`
+	CookieDomain    string        `key:"cookie_domain" env:"SESSION_COOKIE_DOMAIN" doc:"Domain of the session cookies"`
+	CookieSecure    bool          `key:"cookie_secure" env:"SESSION_COOKIE_SECURE" default:"true" doc:"Send the session cookies over HTTPS only"`
+	CookieSameSite  string        `key:"cookie_same_site" env:"SESSION_COOKIE_SAME_SITE" default:"Lax" validate:"required,oneof=Strict Lax None" doc:"SameSite attribute of the session cookies; None requires cookie_secure"`
+	CSRFCookieName  string        `key:"csrf_cookie_name" env:"SESSION_CSRF_COOKIE_NAME" default:"csrf_token" validate:"required" doc:"Cookie holding the CSRF token, readable by scripts"`
+	CSRFHeader      string        `key:"csrf_header" env:"SESSION_CSRF_HEADER" default:"X-CSRF-Token" validate:"required" doc:"Header that must repeat the CSRF token on unsafe methods"`
+	IdleTimeout     time.Duration `key:"idle_timeout" env:"SESSION_IDLE_TIMEOUT" default:"30m" validate:"gt=0s" doc:"Inactivity expiry, renewed on every request"`
+	AbsoluteTimeout time.Duration `key:"absolute_timeout" env:"SESSION_ABSOLUTE_TIMEOUT" default:"12h" validate:"gt=0s" doc:"Maximum session lifetime; must not be shorter than idle_timeout"`
+	CleanupInterval time.Duration `key:"cleanup_interval" env:"SESSION_CLEANUP_INTERVAL" default:"10m" validate:"gt=0s" doc:"Interval of the job that removes expired and revoked sessions"`
+}
+
+type OIDCProviderConfig struct {
+	Name         string   `key:"name"`
+	Issuer       string   `key:"issuer" env:"ISSUER" validate:"required" doc:"Issuer URL, used for discovery"`
+	ClientID     string   `key:"client_id" env:"CLIENT_ID" validate:"required" doc:"Client id registered with the provider"`
+	ClientSecret string   `key:"client_secret" env:"CLIENT_SECRET" secret:"true" doc:"Client secret registered with the provider"`
+	RedirectURL  string   `key:"redirect_url" env:"REDIRECT_URL" validate:"required" doc:"Callback URL (.../api/auth/oidc/<name>/callback)"`
+	Scopes       []string `key:"scopes" env:"SCOPES" default:"openid,email,profile" doc:"Requested scopes"`
+}
+
+type OIDCConfig struct {
+	Providers         []OIDCProviderConfig `key:"providers" env:"OIDC_PROVIDERS" item:"OIDC_%s_" doc:"Enabled OpenID Connect providers"`
+	JITProvisioning   bool                 `key:"jit_provisioning" env:"OIDC_JIT_PROVISIONING" default:"true" doc:"Create the user on first login when no account has the verified email"`
+	StateTTL          time.Duration        `key:"state_ttl" env:"OIDC_STATE_TTL" default:"10m" validate:"gt=0s" doc:"Validity of the state and nonce of a login in progress"`
+	PostLoginRedirect string               `key:"post_login_redirect" env:"OIDC_POST_LOGIN_REDIRECT" default:"/" doc:"Default destination after login; relative paths only"`
+}
+
+type OpenAPIConfig struct {
+	DocsEnabled       bool `key:"docs_enabled" env:"OPENAPI_DOCS_ENABLED" default:"true" doc:"Serve /openapi.json, /docs and /docs/redoc"`
+	ValidateRequests  bool `key:"validate_requests" env:"OPENAPI_VALIDATE_REQUESTS" doc:"Reject requests that break the contract (default: on outside production)"`
+	ValidateResponses bool `key:"validate_responses" env:"OPENAPI_VALIDATE_RESPONSES" doc:"Log and count responses that break the contract (default: on outside production)"`
+}
+
+type APIVersionConfig struct {
+	Name           string    `key:"name"`
+	DeprecatedAt   time.Time `key:"deprecated_at" env:"DEPRECATED_AT" doc:"Deprecation date (2006-01-02 or RFC 3339); enables the Deprecation header"`
+	SunsetAt       time.Time `key:"sunset_at" env:"SUNSET_AT" doc:"Removal date, sent in the Sunset header; requires deprecated_at"`
+	DeprecationURL string    `key:"deprecation_url" env:"DEPRECATION_URL" doc:"Migration guide, linked with rel=deprecation"`
+}
+
+type APIConfig struct {
+	Versions       []APIVersionConfig `key:"versions" env:"API_VERSIONS" item:"API_%s_" default:"v1" doc:"Served API versions (v1, v2, ...)"`
+	DefaultVersion string             `key:"default_version" env:"API_DEFAULT_VERSION" doc:"Version of requests naming none (default: the first version)"`
+}
+
+type GRPCConfig struct {
+	Enabled           bool `key:"enabled" env:"GRPC_ENABLED" default:"true" doc:"Start the gRPC server"`
+	Port              int  `key:"port" env:"GRPC_PORT" default:"9090" validate:"min=0,max=65535" doc:"gRPC server port; 0 picks a free one"`
+	ReflectionEnabled bool `key:"reflection_enabled" env:"GRPC_REFLECTION_ENABLED" doc:"Register the reflection service (default: on outside production)"`
+}
+
+type GraphQLConfig struct {
+	Enabled                 bool   `key:"enabled" env:"GRAPHQL_ENABLED" default:"true" doc:"Serve /graphql"`
+	MaxDepth                int    `key:"max_depth" env:"GRAPHQL_MAX_DEPTH" default:"10" validate:"min=1" doc:"Maximum depth of an operation"`
+	MaxComplexity           int    `key:"max_complexity" env:"GRAPHQL_MAX_COMPLEXITY" default:"1000" validate:"min=1" doc:"Maximum estimated complexity of an operation"`
+	IntrospectionEnabled    bool   `key:"introspection_enabled" env:"GRAPHQL_INTROSPECTION_ENABLED" doc:"Allow __schema and __type queries (default: on outside production)"`
+	PersistedQueriesOnly    bool   `key:"persisted_queries_only" env:"GRAPHQL_PERSISTED_QUERIES_ONLY" default:"false" doc:"Accept only allowlisted queries"`
+	PersistedQueriesDir     string `key:"persisted_queries_dir" env:"GRAPHQL_PERSISTED_QUERIES_DIR" doc:"Directory of *.graphql files forming the allowlist"`
+	PersistedQueryCacheSize int    `key:"persisted_queries_cache_size" env:"GRAPHQL_PERSISTED_QUERIES_CACHE_SIZE" default:"1000" validate:"min=1" doc:"Queries registered through APQ kept in memory (LRU)"`
+}
+
+type StreamsConfig struct {
+	EventBus          string        `key:"event_bus" env:"STREAMS_EVENT_BUS" default:"postgres" validate:"required,oneof=postgres memory" doc:"postgres (LISTEN/NOTIFY, sees every replica) or memory (this process only)"`
+	HeartbeatInterval time.Duration `key:"heartbeat_interval" env:"STREAMS_HEARTBEAT_INTERVAL" default:"15s" validate:"gt=0s" doc:"SSE comment / WebSocket ping interval"`
+	SubscriberBuffer  int           `key:"subscriber_buffer" env:"STREAMS_SUBSCRIBER_BUFFER" default:"64" validate:"min=1" doc:"Pending events per connection before it is dropped as a slow consumer"`
+	WriteTimeout      time.Duration `key:"write_timeout" env:"STREAMS_WRITE_TIMEOUT" default:"10s" validate:"gt=0s" doc:"Write deadline of a WebSocket message"`
+	AllowedOrigins    []string      `key:"allowed_origins" env:"STREAMS_ALLOWED_ORIGINS" doc:"Origins accepted in the WebSocket handshake; empty accepts all"`
+	EventRetention    time.Duration `key:"event_retention" env:"STREAMS_EVENT_RETENTION" default:"24h" validate:"gt=0s" doc:"How long events stay available for resuming"`
+	CleanupInterval   time.Duration `key:"cleanup_interval" env:"STREAMS_CLEANUP_INTERVAL" default:"1h" validate:"gt=0s" doc:"Interval of the job that removes events past the retention"`
+}
+
+type ModulesConfig struct {
+	Disabled []string `key:"disabled" env:"MODULES_DISABLED" doc:"Optional modules to switch off"`
+}
+
+type BusConfig struct {
+	QueryAttempts int           `key:"query_attempts" env:"BUS_QUERY_ATTEMPTS" default:"2" validate:"min=1" doc:"Attempts of a query failing with a transient error; 1 disables retries"`
+	RetryBackoff  time.Duration `key:"retry_backoff" env:"BUS_RETRY_BACKOFF" default:"50ms" validate:"min=0s" doc:"Wait before the next attempt, multiplied by the attempt number"`
+}
+
+type VaultConfig struct {
+	Address   string        `key:"address" env:"VAULT_ADDR" doc:"Vault address (https://vault:8200)"`
+	Token     string        `key:"token" env:"VAULT_TOKEN" secret:"true" doc:"Vault token; must outlive the process or be renewed outside it"`
+	Namespace string        `key:"namespace" env:"VAULT_NAMESPACE" doc:"Vault Enterprise namespace"`
+	Path      string        `key:"path" env:"VAULT_SECRET_PATH" default:"secret/data/boilerplate-api" doc:"Secret read, relative to /v1; KV v2 entries are unwrapped"`
+	Timeout   time.Duration `key:"timeout" env:"VAULT_TIMEOUT" default:"5s" validate:"gt=0s" doc:"Timeout of each Vault request"`
+}
+
+type SecretsConfig struct {
+	Backend string      `key:"backend" env:"SECRETS_BACKEND" default:"none" validate:"required,oneof=none file vault" doc:"Secret backend layered between the config files and the environment"`
+	File    string      `key:"file" env:"SECRETS_FILE" doc:"Encrypted secrets file read by the file backend (api secrets encrypt)"`
+	Key     string      `key:"key" env:"SECRETS_KEY" secret:"true" doc:"Base64 key of the secrets file (api secrets keygen)"`
+	Vault   VaultConfig `key:"vault"`
+}
+
+// check reports the settings the selected backend is missing.
+func (c SecretsConfig) check(report func(key, message string)) {
+	switch c.Backend {
+	case "file":
+		if c.File == "" {
+			report("secrets.file", "is required by the file backend")
+		}
+		if _, err := secrets.ParseKey(c.Key); err != nil {
+			report("secrets.key", err.Error())
+		}
+	case "vault":
+		if c.Vault.Address == "" {
+			report("secrets.vault.address", "is required by the vault backend")
+		}
+		if c.Vault.Token == "" {
+			report("secrets.vault.token", "is required by the vault backend")
+		}
+		if c.Vault.Path == "" {
+			report("secrets.vault.path", "is required by the vault backend")
+		}
 	}
+}
 
-	port, err := strconv.Atoi(getEnvOrDefault("PORT", "3000"))
-	if err != nil {
-		return nil, fmt.Errorf("PORT must be a valid number: %w", err)
+func (c SecretsConfig) store() secrets.Store {
+	switch c.Backend {
+	case "file":
+		key, _ := secrets.ParseKey(c.Key)
+		return secrets.NewFileStore(c.File, key)
+	case "vault":
+		return secrets.NewVaultStore(secrets.VaultOptions{
+			Address:   c.Vault.Address,
+			Token:     c.Vault.Token,
+			Namespace: c.Vault.Namespace,
+			Path:      c.Vault.Path,
+			Timeout:   c.Vault.Timeout,
+		})
 	}
+	return nil
+}
+
+type Config struct {
+	App      AppConfig      `key:"app"`
+	HTTP     HTTPConfig     `key:"http"`
+	Database DatabaseConfig `key:"database"`
+	Logger   LoggerConfig   `key:"logger"`
+	Otel     OtelConfig     `key:"otel"`
+	Privacy  PrivacyConfig  `key:"privacy"`
+	Users    UsersConfig    `key:"users"`
+	Tenancy  TenancyConfig  `key:"tenancy"`
+
+	Sessions SessionsConfig `key:"sessions"`
+	OIDC     OIDCConfig     `key:"oidc"`
+	OpenAPI  OpenAPIConfig  `key:"openapi"`
+	API      APIConfig      `key:"api"`
+	GRPC     GRPCConfig     `key:"grpc"`
+	GraphQL  GraphQLConfig  `key:"graphql"`
+	Streams  StreamsConfig  `key:"streams"`
+	Modules  ModulesConfig  `key:"modules"`
+	Bus      BusConfig      `key:"bus"`
+	Secrets  SecretsConfig  `key:"secrets"`
+
+	opts         []Option
+	files        []string
+	sections     map[string]any
+	origins      map[string]string
+	secretStore  secrets.Store
+	secretKeys   map[string]bool
+	secretValues []string
+}
 
-	maxConn, err := strconv.Atoi(getEnvOrDefault("DATABASE_MAX_CONNECTIONS", "10"))
+// NewConfig resolves the settings from, lowest to highest precedence: the
+// defaults, the config file (CONFIG_FILE or WithFile), its overlay for the
+// environment, the secret store, environment variables (or the files named
+// by their _FILE variants) and overrides. Every invalid setting is reported
+// in a single *ValidationError.
+func NewConfig(opts ...Option) (*Config, error) {
+	l, err := newLoader(opts...)
 	if err != nil {
-		return nil, fmt.Errorf("DATABASE_MAX_CONNECTIONS must be a valid number: %w", err)
-	}
-
-	return &Config{
-		App: AppConfig{
-			ServiceName: getEnvOrDefault("SERVICE_NAME", "boilerplate-api"),
-			Port:        port,
-			Env:         getEnvOrDefault("APP_ENV", "production"),
-			Version:     "0.1.0",
-		},
-		Database: DatabaseConfig{
-			URL:            dbURL,
-			MaxConnections: maxConn,
-		},
-		Logger: LoggerConfig{
-			Level: getEnvOrDefault("LOG_LEVEL", "error"),
-		},
-		Otel: OtelConfig{
-			Endpoint: getEnvOrDefault("OTEL_EXPORTER_OTLP_ENDPOINT", ""),
-			Protocol: getEnvOrDefault("OTEL_EXPORTER_OTLP_PROTOCOL", "http/protobuf"),
-		},
-	}, nil
-}
-
-func getEnvOrDefault(key, defaultValue string) string {
-	if v := os.Getenv(key); v != "" {
+		return nil, err
+	}
+
+	// The secret store is configured by the other layers, so its section is
+	// resolved on its own first.
+	store := l.secretStore
+	if store == nil {
+		var section SecretsConfig
+		l.decode("secrets", "", &section)
+		section.check(l.report)
+		if len(l.problems) == 0 {
+			store = section.store()
+		}
+	}
+	l.reset()
+	if store != nil {
+		if err := l.loadSecrets(context.Background(), store); err != nil {
+			return nil, err
+		}
+	}
+
+	cfg := &Config{opts: opts, files: l.files, sections: make(map[string]any), secretStore: store}
+	l.decode("", "", cfg)
+	for _, section := range l.sections {
+		value := reflect.New(reflect.TypeOf(section.Value).Elem()).Interface()
+		l.decode(section.Key, "", value)
+		cfg.sections[section.Key] = value
+	}
+	cfg.derive(l.isSet)
+	cfg.check(l.report)
+	l.reportUnknownKeys()
+
+	if err := l.err(); err != nil {
+		return nil, err
+	}
+	cfg.origins = l.origins
+	cfg.secretKeys = l.secretKeys
+	cfg.secretValues = l.secretValues
+	return cfg, nil
+}
+
+// derive fills the defaults that depend on other settings.
+func (c *Config) derive(isSet func(key string) bool) {
+	if !isSet("app.version") {
+		c.App.Version = buildVersion()
+	}
+	if !isSet("database.max_idle_connections") {
+		c.Database.MaxIdleConnections = c.Database.MaxConnections / 2
+	}
+	if !isSet("database.application_name") {
+		c.Database.ApplicationName = c.App.ServiceName
+	}
+	if !isSet("privacy.erasure_cancellation_window") {
+		c.Privacy.ErasureCancellationWindow = c.Privacy.ErasureGracePeriod
+	}
+
+	outsideProduction := c.App.Env != "production"
+	if !isSet("openapi.validate_requests") {
+		c.OpenAPI.ValidateRequests = outsideProduction
+	}
+	if !isSet("openapi.validate_responses") {
+		c.OpenAPI.ValidateResponses = outsideProduction
+	}
+	if !isSet("grpc.reflection_enabled") {
+		c.GRPC.ReflectionEnabled = outsideProduction
+	}
+	if !isSet("graphql.introspection_enabled") {
+		c.GraphQL.IntrospectionEnabled = outsideProduction
+	}
+
+	if !isSet("api.default_version") && len(c.API.Versions) > 0 {
+		c.API.DefaultVersion = c.API.Versions[0].Name
+	}
+}
+
+var apiVersionName = regexp.MustCompile(`^v[0-9]+$`)
+
+// check validates the rules that span several settings.
+func (c *Config) check(report func(key, message string)) {
+	if c.Database.MaxIdleConnections > c.Database.MaxConnections {
+		report("database.max_idle_connections", "must not exceed database.max_connections")
+	}
+
+	if c.Database.Dialect() == DialectSQLite {
+		if len(c.Database.ReplicaURLs) > 0 {
+			report("database.replica_urls", "requires PostgreSQL")
+		}
+		if c.Database.Repositories == "pgx" {
+			report("database.repositories", "pgx requires PostgreSQL")
+		}
+		if c.Tenancy.RowLevelSecurity {
+			report("tenancy.row_level_security", "requires PostgreSQL")
+		}
+	}
+
+	if c.Privacy.ErasureCancellationWindow > c.Privacy.ErasureGracePeriod {
+		report("privacy.erasure_cancellation_window", "must not exceed privacy.erasure_grace_period")
+	}
+
+	if c.Sessions.CookieSameSite == "None" && !c.Sessions.CookieSecure {
+		report("sessions.cookie_same_site", "None requires sessions.cookie_secure")
+	}
+	if c.Sessions.AbsoluteTimeout < c.Sessions.IdleTimeout {
+		report("sessions.absolute_timeout", "must not be shorter than sessions.idle_timeout")
+	}
+
+	redirect := c.OIDC.PostLoginRedirect
+	if !strings.HasPrefix(redirect, "/") || strings.HasPrefix(redirect, "//") {
+		report("oidc.post_login_redirect", "must be a relative path")
+	}
+
+	if len(c.API.Versions) == 0 {
+		report("api.versions", "must list at least one version")
+	}
+	for _, version := range c.API.Versions {
+		if !apiVersionName.MatchString(version.Name) {
+			report("api.versions", "entries must look like v1, v2, got "+strconv.Quote(version.Name))
+			continue
+		}
+		if !version.SunsetAt.IsZero() && (version.DeprecatedAt.IsZero() || version.SunsetAt.Before(version.DeprecatedAt)) {
+			report("api.versions."+version.Name+".sunset_at", "requires deprecated_at and must not precede it")
+		}
+	}
+	if _, ok := c.API.Version(c.API.DefaultVersion); !ok && len(c.API.Versions) > 0 {
+		report("api.default_version", "must be one of api.versions")
+	}
+
+	c.Secrets.check(report)
+}
+
+func (c APIConfig) Version(name string) (APIVersionConfig, bool) {
+	for _, version := range c.Versions {
+		if version.Name == name {
+			return version, true
+		}
+	}
+	return APIVersionConfig{}, false
+}
+
+// Section returns the settings a module declared with WithSection.
+func (c *Config) Section(key string) (any, bool) {
+	section, ok := c.sections[key]
+	return section, ok
+}
+
+// Origin names the layer that set key: default, file, overlay, the secret
+// store's name, env, env-file or flag.
+func (c *Config) Origin(key string) string {
+	if origin, ok := c.origins[key]; ok {
+		return origin
+	}
+	return "default"
+}
+
+// SecretStore returns the store the settings were loaded from, or nil.
+func (c *Config) SecretStore() secrets.Store {
+	return c.secretStore
+}
+
+// SecretValues returns the values to keep out of logs: those of settings
+// tagged secret and of every setting read from a secret source.
+func (c *Config) SecretValues() []string {
+	return c.secretValues
+}
+
+const redactedValue = "REDACTED"
+
+var dsnPassword = regexp.MustCompile(`(password=)(\S+)`)
+
+func urlPassword(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil || u.Scheme == "" {
+		if match := dsnPassword.FindStringSubmatch(raw); match != nil {
+			return match[2]
+		}
+		return ""
+	}
+	if password, ok := u.User.Password(); ok {
+		return password
+	}
+	return u.Query().Get("password")
+}
+
+func redactURL(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil || u.Scheme == "" {
+		return dsnPassword.ReplaceAllString(raw, "${1}"+redactedValue)
+	}
+	if _, ok := u.User.Password(); ok {
+		u.User = url.UserPassword(u.User.Username(), redactedValue)
+	}
+	query := u.Query()
+	if query.Has("password") {
+		query.Set("password", redactedValue)
+		u.RawQuery = query.Encode()
+	}
+	return u.String()
+}
+
+// version is set at build time with
+// -ldflags "-X golang_boilerplate_module/internal/config.version=...".
+var version string
+
+func buildVersion() string {
+	if version != "" {
+		return version
+	}
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "dev"
+	}
+	if v := info.Main.Version; v != "" && v != "(devel)" {
 		return v
 	}
-	return defaultValue
+	for _, setting := range info.Settings {
+		if setting.Key == "vcs.revision" && len(setting.Value) >= 12 {
+			return setting.Value[:12]
+		}
+	}
+	return "dev"
 }
@@ -0,0 +1,512 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang_boilerplate_module/internal/config/secrets"
+	"golang_boilerplate_module/internal/shared/domain/validation"
+
+	"github.com/BurntSushi/toml"
+	"go.yaml.in/yaml/v3"
+)
+
+type Option func(*options)
+
+type options struct {
+	file        string
+	overrides   map[string]string
+	sections    []Section
+	secretStore secrets.Store
+}
+
+// Section is a group of settings decoded under Key. Value points to a struct
+// tagged like the sections of Config.
+type Section struct {
+	Key   string
+	Value any
+}
+
+// WithFile reads path instead of CONFIG_FILE. The format follows the
+// extension: .yaml, .yml or .toml.
+func WithFile(path string) Option {
+	return func(o *options) { o.file = path }
+}
+
+// WithOverride sets key, named as in the config file (app.port), above every
+// other layer. The CLI uses it for -set key=value.
+func WithOverride(key, value string) Option {
+	return func(o *options) { o.overrides[key] = value }
+}
+
+// WithSection declares a module's settings, resolved and validated with the
+// core ones and available through Config.Section. value is a pointer to the
+// zero value of the section's struct; every load decodes into a new one.
+func WithSection(key string, value any) Option {
+	return func(o *options) { o.sections = append(o.sections, Section{Key: key, Value: value}) }
+}
+
+// WithSecretStore loads secrets from store instead of the backend the
+// secrets section selects.
+func WithSecretStore(store secrets.Store) Option {
+	return func(o *options) { o.secretStore = store }
+}
+
+// envFileOrigin is the origin of values read from the file named by a _FILE
+// variable, as Docker and Kubernetes mount secrets.
+const envFileOrigin = "env-file"
+
+// minSecretLength keeps short values, which would match ordinary text, out
+// of log redaction. They are still masked in configuration dumps.
+const minSecretLength = 6
+
+type layer struct {
+	origin string
+	values map[string]string
+}
+
+type loader struct {
+	files         []string
+	layers        []layer
+	overrides     map[string]string
+	sections      []Section
+	secretStore   secrets.Store
+	secretOrigins map[string]bool
+	known         map[string]bool
+	lists         []string
+	envs          map[string]string
+	origins       map[string]string
+	secretKeys    map[string]bool
+	secretValues  []string
+	problems      []Problem
+}
+
+func newLoader(opts ...Option) (*loader, error) {
+	o := options{overrides: make(map[string]string)}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	l := &loader{
+		overrides:     o.overrides,
+		sections:      o.sections,
+		secretStore:   o.secretStore,
+		secretOrigins: map[string]bool{envFileOrigin: true},
+	}
+	l.reset()
+
+	file := o.file
+	if file == "" {
+		file = os.Getenv("CONFIG_FILE")
+	}
+	if file == "" {
+		return l, nil
+	}
+
+	values, err := readFile(file)
+	if err != nil {
+		return nil, err
+	}
+	l.layers = append(l.layers, layer{origin: "file", values: values})
+
+	env, _, _ := l.lookup("app.env", "APP_ENV", false)
+	if env == "" {
+		env = "production"
+	}
+	ext := filepath.Ext(file)
+	overlay := strings.TrimSuffix(file, ext) + "." + env + ext
+	l.files = []string{file, overlay}
+	if _, err := os.Stat(overlay); err == nil {
+		values, err := readFile(overlay)
+		if err != nil {
+			return nil, err
+		}
+		l.layers = append(l.layers, layer{origin: "overlay", values: values})
+	}
+	return l, nil
+}
+
+// reset forgets what decoding recorded, keeping the layers.
+func (l *loader) reset() {
+	l.known = make(map[string]bool)
+	l.lists = nil
+	l.envs = make(map[string]string)
+	l.origins = make(map[string]string)
+	l.secretKeys = make(map[string]bool)
+	l.secretValues = nil
+	l.problems = nil
+}
+
+// loadSecrets adds the values of store as a layer above the config files.
+func (l *loader) loadSecrets(ctx context.Context, store secrets.Store) error {
+	tree, err := store.Load(ctx)
+	if err != nil {
+		return fmt.Errorf("secrets: %w", err)
+	}
+	values := make(map[string]string)
+	if err := flatten("", tree, values); err != nil {
+		return fmt.Errorf("secrets: %s: %w", store.Name(), err)
+	}
+	l.layers = append(l.layers, layer{origin: store.Name(), values: values})
+	l.secretOrigins[store.Name()] = true
+	return nil
+}
+
+// lookup returns the value of key from the highest layer that sets it.
+// Empty environment variables count as unset unless allowEmpty; <env>_FILE
+// names a file holding the value instead.
+func (l *loader) lookup(key, env string, allowEmpty bool) (string, string, bool) {
+	if v, ok := l.overrides[key]; ok {
+		return v, "flag", true
+	}
+	if env != "" {
+		v, ok := os.LookupEnv(env)
+		set := ok && (v != "" || allowEmpty)
+		if path := os.Getenv(env + "_FILE"); path != "" {
+			if set {
+				l.report(key, fmt.Sprintf("set either %s or %s_FILE", env, env))
+				return v, "env", true
+			}
+			data, err := os.ReadFile(path)
+			if err != nil {
+				l.report(key, err.Error())
+				return "", "", false
+			}
+			return strings.TrimRight(string(data), "\r\n"), envFileOrigin, true
+		}
+		if set {
+			return v, "env", true
+		}
+	}
+	for i := len(l.layers) - 1; i >= 0; i-- {
+		if v, ok := l.layers[i].values[key]; ok {
+			return v, l.layers[i].origin, true
+		}
+	}
+	return "", "", false
+}
+
+func (l *loader) isSet(key string) bool {
+	return l.origins[key] != ""
+}
+
+func (l *loader) report(key, message string) {
+	l.problems = append(l.problems, Problem{Key: key, Env: l.envs[key], Message: message})
+}
+
+func (l *loader) err() error {
+	if len(l.problems) == 0 {
+		return nil
+	}
+	return &ValidationError{Problems: l.problems}
+}
+
+func (l *loader) decode(prefix, envPrefix string, target any) {
+	l.decodeStruct(prefix, envPrefix, reflect.ValueOf(target).Elem())
+}
+
+func (l *loader) decodeStruct(prefix, envPrefix string, v reflect.Value) {
+	for i := range v.NumField() {
+		field := v.Type().Field(i)
+		name, ok := field.Tag.Lookup("key")
+		if !ok || !field.IsExported() {
+			continue
+		}
+		key := joinKey(prefix, name)
+
+		switch {
+		case isSection(field.Type):
+			l.decodeStruct(key, envPrefix, v.Field(i))
+		case isItems(field.Type):
+			l.decodeItems(key, field, v.Field(i))
+		default:
+			l.decodeLeaf(key, envPrefix, field, v.Field(i))
+		}
+	}
+}
+
+func (l *loader) decodeLeaf(key, envPrefix string, field reflect.StructField, v reflect.Value) {
+	env, allowEmpty := envTag(field)
+	if env != "" {
+		env = envPrefix + env
+		l.envs[key] = env
+	}
+	l.known[key] = true
+
+	raw, origin, ok := l.lookup(key, env, allowEmpty)
+	if ok {
+		l.origins[key] = origin
+		if tag := field.Tag.Get("secret"); tag != "" || l.secretOrigins[origin] {
+			l.recordSecret(key, tag, raw)
+		}
+	} else {
+		raw, ok = field.Tag.Lookup("default")
+	}
+	if ok {
+		if err := setValue(v, raw); err != nil {
+			l.report(key, err.Error())
+			return
+		}
+	}
+
+	for _, problem := range validation.Value(field.Tag.Get("validate"), v) {
+		l.report(key, problem)
+	}
+}
+
+// decodeItems reads a list of named entries, such as the OIDC providers: the
+// names come from the list setting and each entry's settings from
+// <key>.<name>.* in files and from the item-prefixed variables in the
+// environment.
+func (l *loader) decodeItems(key string, field reflect.StructField, v reflect.Value) {
+	env, _ := envTag(field)
+	l.envs[key] = env
+	l.known[key] = true
+	l.lists = append(l.lists, key)
+
+	raw, origin, ok := l.lookup(key, env, false)
+	if ok {
+		l.origins[key] = origin
+	} else {
+		raw = field.Tag.Get("default")
+	}
+
+	items := reflect.MakeSlice(field.Type, 0, 0)
+	for _, name := range splitList(raw) {
+		item := reflect.New(field.Type.Elem()).Elem()
+		l.decodeStruct(key+"."+name, fmt.Sprintf(field.Tag.Get("item"), envSegment(name)), item)
+		item.FieldByName("Name").SetString(name)
+		items = reflect.Append(items, item)
+	}
+	v.Set(items)
+}
+
+// recordSecret marks key for masking and keeps its value, or the password
+// of a secret URL, for log redaction.
+func (l *loader) recordSecret(key, tag, raw string) {
+	l.secretKeys[key] = true
+	values := []string{raw}
+	switch tag {
+	case "url":
+		values = append(values, urlPassword(raw))
+	case "urls":
+		for u := range strings.SplitSeq(raw, ",") {
+			values = append(values, urlPassword(strings.TrimSpace(u)))
+		}
+	}
+	for _, value := range values {
+		if len(value) >= minSecretLength {
+			l.secretValues = append(l.secretValues, value)
+		}
+	}
+}
+
+func (l *loader) reportUnknownKeys() {
+	var unknown []string
+	seen := make(map[string]bool)
+	for _, values := range append(l.layers, layer{values: l.overrides}) {
+		for key := range values.values {
+			if !l.known[key] && !seen[key] && !l.isListEntry(key) {
+				seen[key] = true
+				unknown = append(unknown, key)
+			}
+		}
+	}
+	sort.Strings(unknown)
+	for _, key := range unknown {
+		l.report(key, "is not a known setting")
+	}
+}
+
+// isListEntry tells whether key belongs to an entry of a list, which the
+// environment may have replaced with entries of other names.
+func (l *loader) isListEntry(key string) bool {
+	for _, list := range l.lists {
+		if strings.HasPrefix(key, list+".") {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	durationType = reflect.TypeFor[time.Duration]()
+	timeType     = reflect.TypeFor[time.Time]()
+)
+
+func isSection(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct && t != timeType
+}
+
+func isItems(t reflect.Type) bool {
+	return t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Struct
+}
+
+func envTag(field reflect.StructField) (string, bool) {
+	env, flags, _ := strings.Cut(field.Tag.Get("env"), ",")
+	return env, flags == "allowempty"
+}
+
+func envSegment(name string) string {
+	return strings.ToUpper(strings.ReplaceAll(name, "-", "_"))
+}
+
+func joinKey(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+	return prefix + "." + name
+}
+
+func setValue(v reflect.Value, raw string) error {
+	switch {
+	case v.Type() == durationType:
+		d, err := time.ParseDuration(raw)
+		if err != nil {
+			return errors.New("must be a valid duration")
+		}
+		v.SetInt(int64(d))
+	case v.Type() == timeType:
+		t, err := parseDate(raw)
+		if err != nil {
+			return errors.New("must be a date (2006-01-02) or RFC 3339 timestamp")
+		}
+		v.Set(reflect.ValueOf(t))
+	case v.Kind() == reflect.String:
+		v.SetString(raw)
+	case v.Kind() == reflect.Int:
+		n, err := strconv.Atoi(raw)
+		if err != nil {
+			return errors.New("must be a valid number")
+		}
+		v.SetInt(int64(n))
+	case v.Kind() == reflect.Float64:
+		f, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return errors.New("must be a valid number")
+		}
+		v.SetFloat(f)
+	case v.Kind() == reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return errors.New("must be a valid boolean")
+		}
+		v.SetBool(b)
+	case v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.String:
+		v.Set(reflect.ValueOf(splitList(raw)))
+	default:
+		return fmt.Errorf("has unsupported type %s", v.Type())
+	}
+	return nil
+}
+
+func readFile(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("config file: %w", err)
+	}
+
+	var tree map[string]any
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, &tree)
+	case ".toml":
+		_, err = toml.Decode(string(data), &tree)
+	default:
+		return nil, fmt.Errorf("config file %s: use a .yaml, .yml or .toml file", path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("config file %s: %w", path, err)
+	}
+
+	values := make(map[string]string)
+	if err := flatten("", tree, values); err != nil {
+		return nil, fmt.Errorf("config file %s: %w", path, err)
+	}
+	return values, nil
+}
+
+// flatten turns a parsed file into dotted keys. Lists of scalars become
+// comma-separated values; lists of tables become the list of their names
+// plus <key>.<name>.* for each entry.
+func flatten(key string, node any, values map[string]string) error {
+	switch node := node.(type) {
+	case map[string]any:
+		for name, child := range node {
+			if err := flatten(joinKey(key, name), child, values); err != nil {
+				return err
+			}
+		}
+	case []map[string]any:
+		items := make([]any, len(node))
+		for i := range node {
+			items[i] = node[i]
+		}
+		return flatten(key, items, values)
+	case []any:
+		var entries []string
+		tables := 0
+		for _, item := range node {
+			table, ok := item.(map[string]any)
+			if !ok {
+				entries = append(entries, scalar(item))
+				continue
+			}
+			name, _ := table["name"].(string)
+			if name == "" {
+				return fmt.Errorf("%s: every entry needs a name", key)
+			}
+			if err := flatten(key+"."+name, table, values); err != nil {
+				return err
+			}
+			entries = append(entries, name)
+			tables++
+		}
+		if tables != 0 && tables != len(node) {
+			return fmt.Errorf("%s: mixes tables and values", key)
+		}
+		values[key] = strings.Join(entries, ",")
+	default:
+		values[key] = scalar(node)
+	}
+	return nil
+}
+
+func scalar(value any) string {
+	switch value := value.(type) {
+	case nil:
+		return ""
+	case time.Time:
+		return value.Format(time.RFC3339)
+	default:
+		return fmt.Sprint(value)
+	}
+}
+
+func parseDate(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.DateOnly, value); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
+
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,216 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"golang_boilerplate_module/internal/config"
+)
+
+// loadConfig resolves the configuration from files written to a temporary
+// directory, the first of which is the config file, plus env and overrides.
+func loadConfig(t *testing.T, files map[string]string, env map[string]string, overrides map[string]string) (*config.Config, error) {
+	t.Helper()
+	t.Setenv("CONFIG_FILE", "")
+	t.Setenv("APP_ENV", "")
+	t.Setenv("DATABASE_URL", "sqlite://:memory:")
+	for key, value := range env {
+		t.Setenv(key, value)
+	}
+
+	var opts []config.Option
+	dir := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatalf("write %s: %v", name, err)
+		}
+		if base := filepath.Base(name); base == "config.yaml" || base == "config.toml" {
+			opts = append(opts, config.WithFile(path))
+		}
+	}
+	for key, value := range overrides {
+		opts = append(opts, config.WithOverride(key, value))
+	}
+	return config.NewConfig(opts...)
+}
+
+func TestNewConfig_LayerPrecedence(t *testing.T) {
+	secretFile := filepath.Join(t.TempDir(), "rate_limit")
+	if err := os.WriteFile(secretFile, []byte("35\n"), 0o600); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		files      map[string]string
+		env        map[string]string
+		overrides  map[string]string
+		want       int
+		wantOrigin string
+	}{
+		{
+			name:       "default",
+			want:       0,
+			wantOrigin: "default",
+		},
+		{
+			name:       "file over default",
+			files:      map[string]string{"config.yaml": "http:\n  rate_limit: 10\n"},
+			want:       10,
+			wantOrigin: "file",
+		},
+		{
+			name: "overlay over file",
+			files: map[string]string{
+				"config.yaml":            "http:\n  rate_limit: 10\n",
+				"config.production.yaml": "http:\n  rate_limit: 20\n",
+			},
+			want:       20,
+			wantOrigin: "overlay",
+		},
+		{
+			name:       "env over file",
+			files:      map[string]string{"config.yaml": "http:\n  rate_limit: 10\n"},
+			env:        map[string]string{"HTTP_RATE_LIMIT": "30"},
+			want:       30,
+			wantOrigin: "env",
+		},
+		{
+			name:       "empty env is unset",
+			files:      map[string]string{"config.yaml": "http:\n  rate_limit: 10\n"},
+			env:        map[string]string{"HTTP_RATE_LIMIT": ""},
+			want:       10,
+			wantOrigin: "file",
+		},
+		{
+			name:       "env file over file",
+			files:      map[string]string{"config.yaml": "http:\n  rate_limit: 10\n"},
+			env:        map[string]string{"HTTP_RATE_LIMIT_FILE": secretFile},
+			want:       35,
+			wantOrigin: "env-file",
+		},
+		{
+			name:       "set over env",
+			files:      map[string]string{"config.yaml": "http:\n  rate_limit: 10\n"},
+			env:        map[string]string{"HTTP_RATE_LIMIT": "30"},
+			overrides:  map[string]string{"http.rate_limit": "40"},
+			want:       40,
+			wantOrigin: "flag",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := loadConfig(t, tt.files, tt.env, tt.overrides)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if cfg.HTTP.RateLimit != tt.want {
+				t.Fatalf("expected rate_limit=%d, got %d", tt.want, cfg.HTTP.RateLimit)
+			}
+			if origin := cfg.Origin("http.rate_limit"); origin != tt.wantOrigin {
+				t.Fatalf("expected origin %q, got %q", tt.wantOrigin, origin)
+			}
+		})
+	}
+}
+
+func TestNewConfig_ParsesDurationsAndLists(t *testing.T) {
+	tests := []struct {
+		name        string
+		files       map[string]string
+		env         map[string]string
+		wantWindow  time.Duration
+		wantOrigins []string
+	}{
+		{
+			name:        "defaults",
+			wantWindow:  time.Minute,
+			wantOrigins: []string{"*"},
+		},
+		{
+			name:        "env",
+			env:         map[string]string{"HTTP_RATE_LIMIT_WINDOW": "1m30s", "HTTP_CORS_ALLOWED_ORIGINS": "https://a.test, https://b.test,,"},
+			wantWindow:  90 * time.Second,
+			wantOrigins: []string{"https://a.test", "https://b.test"},
+		},
+		{
+			name:        "yaml",
+			files:       map[string]string{"config.yaml": "http:\n  rate_limit_window: 250ms\n  cors_allowed_origins:\n    - https://a.test\n    - https://b.test\n"},
+			wantWindow:  250 * time.Millisecond,
+			wantOrigins: []string{"https://a.test", "https://b.test"},
+		},
+		{
+			name:        "toml",
+			files:       map[string]string{"config.toml": "[http]\nrate_limit_window = \"2h\"\ncors_allowed_origins = [\"https://a.test\"]\n"},
+			wantWindow:  2 * time.Hour,
+			wantOrigins: []string{"https://a.test"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := loadConfig(t, tt.files, tt.env, nil)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if cfg.HTTP.RateLimitWindow != tt.wantWindow {
+				t.Fatalf("expected rate_limit_window=%s, got %s", tt.wantWindow, cfg.HTTP.RateLimitWindow)
+			}
+			if !slices.Equal(cfg.HTTP.CORSAllowedOrigins, tt.wantOrigins) {
+				t.Fatalf("expected cors_allowed_origins=%v, got %v", tt.wantOrigins, cfg.HTTP.CORSAllowedOrigins)
+			}
+		})
+	}
+}
+
+func TestNewConfig_SelectsTheOverlayOfTheEnvironment(t *testing.T) {
+	base := "app:\n  service_name: base\n"
+	overlays := map[string]string{
+		"config.staging.yaml":    "app:\n  service_name: staging\n",
+		"config.production.yaml": "app:\n  service_name: production\n",
+	}
+
+	tests := []struct {
+		name  string
+		base  string
+		env   map[string]string
+		files map[string]string
+		want  string
+	}{
+		{name: "production by default", base: base, files: overlays, want: "production"},
+		{name: "APP_ENV", base: base, env: map[string]string{"APP_ENV": "staging"}, files: overlays, want: "staging"},
+		{name: "app.env in the file", base: "app:\n  env: staging\n  service_name: base\n", files: overlays, want: "staging"},
+		{name: "no overlay for the environment", base: base, env: map[string]string{"APP_ENV": "test"}, files: overlays, want: "base"},
+		{
+			name:  "toml",
+			env:   map[string]string{"APP_ENV": "staging"},
+			files: map[string]string{"config.toml": "[app]\nservice_name = \"base\"\n", "config.staging.toml": "[app]\nservice_name = \"staging\"\n"},
+			want:  "staging",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files := map[string]string{}
+			for name, content := range tt.files {
+				files[name] = content
+			}
+			if tt.base != "" {
+				files["config.yaml"] = tt.base
+			}
+
+			cfg, err := loadConfig(t, files, tt.env, nil)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if cfg.App.ServiceName != tt.want {
+				t.Fatalf("expected service_name=%q, got %q", tt.want, cfg.App.ServiceName)
+			}
+		})
+	}
+}
@@ -0,0 +1,218 @@
+package config
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Setting describes one setting and, when resolved, its value and origin.
+type Setting struct {
+	Section string
+	Key     string
+	Env     string
+	Type    string
+	Default string
+	Rules   string
+	Doc     string
+	// Reloadable settings are applied without a restart.
+	Reloadable bool
+	Value      string
+	Origin     string
+}
+
+// Sections returns the core sections of c followed by the module sections,
+// in key order.
+func (c *Config) Sections() []Section {
+	v := reflect.ValueOf(c).Elem()
+	var sections []Section
+	for i := range v.NumField() {
+		if key, ok := v.Type().Field(i).Tag.Lookup("key"); ok {
+			sections = append(sections, Section{Key: key, Value: v.Field(i).Addr().Interface()})
+		}
+	}
+
+	keys := make([]string, 0, len(c.sections))
+	for key := range c.sections {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		sections = append(sections, Section{Key: key, Value: c.sections[key]})
+	}
+	return sections
+}
+
+// Settings lists the resolved settings with secrets masked.
+func (c *Config) Settings() []Setting {
+	var settings []Setting
+	for _, section := range c.Sections() {
+		walk(section.Key, section.Key, "", reflect.ValueOf(section.Value).Elem(), true, func(s Setting, field reflect.StructField, v reflect.Value) {
+			s.Value = mask(field.Tag.Get("secret"), c.secretKeys[s.Key], formatValue(v))
+			s.Origin = c.Origin(s.Key)
+			settings = append(settings, s)
+		})
+	}
+	return settings
+}
+
+// Describe lists the settings of sections without resolving them. Entries of
+// lists are shown as <name>.
+func Describe(sections ...Section) []Setting {
+	var settings []Setting
+	for _, section := range sections {
+		walk(section.Key, section.Key, "", reflect.ValueOf(section.Value).Elem(), false, func(s Setting, _ reflect.StructField, _ reflect.Value) {
+			settings = append(settings, s)
+		})
+	}
+	return settings
+}
+
+func walk(section, prefix, envPrefix string, v reflect.Value, resolved bool, fn func(Setting, reflect.StructField, reflect.Value)) {
+	for i := range v.NumField() {
+		field := v.Type().Field(i)
+		name, ok := field.Tag.Lookup("key")
+		if !ok || !field.IsExported() {
+			continue
+		}
+		key := joinKey(prefix, name)
+		// An entry's name is its key in the list, not a setting.
+		if envPrefix != "" && field.Name == "Name" {
+			continue
+		}
+
+		if isSection(field.Type) {
+			walk(section, key, envPrefix, v.Field(i), resolved, fn)
+			continue
+		}
+
+		env, _ := envTag(field)
+		if env != "" {
+			env = envPrefix + env
+		}
+		setting := Setting{
+			Section:    section,
+			Key:        key,
+			Env:        env,
+			Type:       typeName(field.Type),
+			Default:    field.Tag.Get("default"),
+			Rules:      field.Tag.Get("validate"),
+			Doc:        field.Tag.Get("doc"),
+			Reloadable: field.Tag.Get("reload") == "true",
+		}
+		fn(setting, field, v.Field(i))
+
+		if !isItems(field.Type) {
+			continue
+		}
+		item := field.Tag.Get("item")
+		if !resolved {
+			walk(section, key+".<name>", fmt.Sprintf(item, "<NAME>"), reflect.New(field.Type.Elem()).Elem(), false, fn)
+			continue
+		}
+		for j := range v.Field(i).Len() {
+			entry := v.Field(i).Index(j)
+			name := entry.FieldByName("Name").String()
+			walk(section, key+"."+name, fmt.Sprintf(item, envSegment(name)), entry, true, fn)
+		}
+	}
+}
+
+func typeName(t reflect.Type) string {
+	switch {
+	case t == durationType:
+		return "duration"
+	case t == timeType:
+		return "date"
+	case isItems(t):
+		return "list of entries"
+	case t.Kind() == reflect.Slice:
+		return "list"
+	case t.Kind() == reflect.Float64:
+		return "number"
+	default:
+		return t.Kind().String()
+	}
+}
+
+func formatValue(v reflect.Value) string {
+	switch value := v.Interface().(type) {
+	case time.Duration:
+		return value.String()
+	case time.Time:
+		if value.IsZero() {
+			return ""
+		}
+		return value.Format(time.RFC3339)
+	case []string:
+		return strings.Join(value, ",")
+	}
+	if isItems(v.Type()) {
+		names := make([]string, v.Len())
+		for i := range v.Len() {
+			names[i] = v.Index(i).FieldByName("Name").String()
+		}
+		return strings.Join(names, ",")
+	}
+	return fmt.Sprint(v.Interface())
+}
+
+func mask(tag string, secret bool, value string) string {
+	switch {
+	case tag == "url":
+		return redactURL(value)
+	case tag == "urls":
+		urls := strings.Split(value, ",")
+		for i, u := range urls {
+			urls[i] = redactURL(u)
+		}
+		return strings.Join(urls, ",")
+	case (tag != "" || secret) && value != "":
+		return redactedValue
+	}
+	return value
+}
+
+// WriteReference writes the settings as a Markdown document.
+func WriteReference(w io.Writer, settings []Setting) error {
+	var b strings.Builder
+	b.WriteString("# Configuration reference\n\n")
+	b.WriteString("Generated by `api config reference`; do not edit by hand.\n\n")
+	b.WriteString("Each setting resolves from, lowest to highest precedence: its default, the config file ")
+	b.WriteString("(`CONFIG_FILE` or `-config`, YAML or TOML), the file's overlay for `app.env` ")
+	b.WriteString("(`config.production.yaml` next to `config.yaml`), the secret store selected by `secrets.backend`, ")
+	b.WriteString("the environment variable (or the file named by `<VARIABLE>_FILE`) and `-set key=value`. ")
+	b.WriteString("Lists are comma-separated in the environment and flags. Entries of lists such as `oidc.providers` ")
+	b.WriteString("are named by the list and configured under `<list>.<name>` in files.\n\n")
+	b.WriteString("The configuration is reloaded on SIGHUP and when the config file changes. Settings marked in the ")
+	b.WriteString("Reload column take effect immediately; changes to the others are logged and wait for a restart.\n")
+
+	section := ""
+	for _, s := range settings {
+		if s.Section != section {
+			section = s.Section
+			fmt.Fprintf(&b, "\n## %s\n\n", section)
+			b.WriteString("| Key | Environment | Type | Default | Rules | Reload | Description |\n")
+			b.WriteString("|---|---|---|---|---|---|---|\n")
+		}
+		reload := ""
+		if s.Reloadable {
+			reload = "yes"
+		}
+		fmt.Fprintf(&b, "| `%s` | %s | %s | %s | %s | %s | %s |\n",
+			s.Key, code(s.Env), s.Type, code(s.Default), strings.ReplaceAll(s.Rules, ",", ", "), reload, strings.ReplaceAll(s.Doc, "<", "&lt;"))
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func code(value string) string {
+	if value == "" {
+		return ""
+	}
+	return "`" + value + "`"
+}
@@ -0,0 +1,79 @@
+package config_test
+
+import (
+	"strings"
+	"testing"
+
+	"golang_boilerplate_module/internal/config"
+)
+
+func TestDescribe_ListsSettingsFromTheirTags(t *testing.T) {
+	settings := config.Describe(config.Section{Key: "http", Value: &config.HTTPConfig{}})
+
+	want := config.Setting{
+		Section:    "http",
+		Key:        "http.rate_limit_window",
+		Env:        "HTTP_RATE_LIMIT_WINDOW",
+		Type:       "duration",
+		Default:    "1m",
+		Rules:      "gt=0s",
+		Doc:        "Window of the rate limit",
+		Reloadable: true,
+	}
+	for _, setting := range settings {
+		if setting.Key == want.Key {
+			if setting != want {
+				t.Fatalf("expected %+v, got %+v", want, setting)
+			}
+			return
+		}
+	}
+	t.Fatalf("expected %s among %+v", want.Key, settings)
+}
+
+func TestSettings_MasksSecrets(t *testing.T) {
+	tests := []struct {
+		key  string
+		env  map[string]string
+		want string
+	}{
+		{"database.url", map[string]string{"DATABASE_URL": "postgres://app:hunter22@db:5432/app"}, "postgres://app:REDACTED@db:5432/app"},
+		{"secrets.key", map[string]string{"SECRETS_KEY": "c2VjcmV0LWtleQ=="}, "REDACTED"},
+		{"app.service_name", nil, "boilerplate-api"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			cfg, err := loadConfig(t, nil, tt.env, nil)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			for _, setting := range cfg.Settings() {
+				if setting.Key == tt.key {
+					if setting.Value != tt.want {
+						t.Fatalf("expected %q, got %q", tt.want, setting.Value)
+					}
+					return
+				}
+			}
+			t.Fatalf("expected %s among the settings", tt.key)
+		})
+	}
+}
+
+func TestWriteReference_GroupsSettingsBySection(t *testing.T) {
+	var b strings.Builder
+	settings := config.Describe(config.Section{Key: "http", Value: &config.HTTPConfig{}})
+	if err := config.WriteReference(&b, settings); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	doc := b.String()
+	if strings.Count(doc, "\n## ") != 1 || !strings.Contains(doc, "\n## http\n") {
+		t.Fatalf("expected a single http section, got %s", doc)
+	}
+	row := "| `http.rate_limit_window` | `HTTP_RATE_LIMIT_WINDOW` | duration | `1m` | gt=0s | yes | Window of the rate limit |"
+	if !strings.Contains(doc, row) {
+		t.Fatalf("expected the row %s, got %s", row, doc)
+	}
+}
@@ -0,0 +1,168 @@
+package config
+
+import (
+	"maps"
+	"os"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Reloader resolves the configuration again, from the same options, and
+// hands the result to the components that subscribed to what changed. The
+// *Config supplied at startup is never modified; Current returns the latest.
+type Reloader struct {
+	mu          sync.Mutex
+	current     *Config
+	stamps      map[string]fileStamp
+	subscribers []subscriber
+}
+
+type subscriber struct {
+	keys []string
+	fn   func(*Config)
+}
+
+// Reload reports what a reload changed. Restart lists the changed settings
+// the running components do not apply.
+type Reload struct {
+	Changed []string
+	Restart []string
+}
+
+type fileStamp struct {
+	modTime time.Time
+	size    int64
+}
+
+func NewReloader(cfg *Config) *Reloader {
+	return &Reloader{current: cfg, stamps: stampFiles(cfg.files)}
+}
+
+func (r *Reloader) Current() *Config {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.current
+}
+
+// Subscribe calls fn with the new configuration after a reload that changed
+// any of keys or the settings under them (logger.level, http). Without keys
+// fn sees every change.
+func (r *Reloader) Subscribe(fn func(*Config), keys ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.subscribers = append(r.subscribers, subscriber{keys: keys, fn: fn})
+}
+
+// Modified tells whether the config file or its overlay changed since the
+// last load.
+func (r *Reloader) Modified() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return !maps.Equal(r.stamps, stampFiles(r.current.files))
+}
+
+// Reload loads and validates the configuration and, when it is valid, makes
+// it current and notifies the subscribers of the changed settings. An
+// invalid configuration is returned as an error and changes nothing.
+func (r *Reloader) Reload() (Reload, error) {
+	r.mu.Lock()
+	result, notify, err := r.reload()
+	r.mu.Unlock()
+	if err != nil {
+		return Reload{}, err
+	}
+
+	for _, fn := range notify {
+		fn(r.Current())
+	}
+	return result, nil
+}
+
+func (r *Reloader) reload() (Reload, []func(*Config), error) {
+	opts := slices.Clip(r.current.opts)
+	if store := r.current.secretStore; store != nil {
+		opts = append(opts, WithSecretStore(store))
+	}
+	// A rejected file is not retried until it changes again.
+	r.stamps = stampFiles(r.current.files)
+	next, err := NewConfig(opts...)
+	if err != nil {
+		return Reload{}, nil, err
+	}
+
+	previous, current := r.current.values(), next.values()
+	var result Reload
+	for key, setting := range current {
+		if old, ok := previous[key]; ok && old.Value == setting.Value {
+			continue
+		}
+		result.Changed = append(result.Changed, key)
+		if !setting.Reloadable {
+			result.Restart = append(result.Restart, key)
+		}
+	}
+	for key, setting := range previous {
+		if _, ok := current[key]; !ok {
+			result.Changed = append(result.Changed, key)
+			if !setting.Reloadable {
+				result.Restart = append(result.Restart, key)
+			}
+		}
+	}
+	slices.Sort(result.Changed)
+	slices.Sort(result.Restart)
+
+	if !slices.Equal(next.files, r.current.files) {
+		r.stamps = stampFiles(next.files)
+	}
+	r.current = next
+	var notify []func(*Config)
+	for _, s := range r.subscribers {
+		if s.matches(result.Changed) {
+			notify = append(notify, s.fn)
+		}
+	}
+	return result, notify, nil
+}
+
+func (s subscriber) matches(changed []string) bool {
+	if len(changed) == 0 {
+		return false
+	}
+	if len(s.keys) == 0 {
+		return true
+	}
+	for _, key := range changed {
+		for _, prefix := range s.keys {
+			if key == prefix || strings.HasPrefix(key, prefix+".") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// values lists the settings with their unmasked values, by key.
+func (c *Config) values() map[string]Setting {
+	values := make(map[string]Setting)
+	for _, section := range c.Sections() {
+		walk(section.Key, section.Key, "", reflect.ValueOf(section.Value).Elem(), true, func(s Setting, _ reflect.StructField, v reflect.Value) {
+			s.Value = formatValue(v)
+			values[s.Key] = s
+		})
+	}
+	return values
+}
+
+func stampFiles(paths []string) map[string]fileStamp {
+	stamps := make(map[string]fileStamp, len(paths))
+	for _, path := range paths {
+		if info, err := os.Stat(path); err == nil {
+			stamps[path] = fileStamp{modTime: info.ModTime(), size: info.Size()}
+		}
+	}
+	return stamps
+}
@@ -0,0 +1,97 @@
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+
+	"go.yaml.in/yaml/v3"
+	"golang.org/x/crypto/nacl/secretbox"
+)
+
+const (
+	keySize   = 32
+	nonceSize = 24
+)
+
+// GenerateKey returns a new base64 key for an encrypted secrets file.
+func GenerateKey() (string, error) {
+	key := make([]byte, keySize)
+	if _, err := rand.Read(key); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(key), nil
+}
+
+// ParseKey decodes a key made by GenerateKey.
+func ParseKey(encoded string) (*[keySize]byte, error) {
+	raw, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil || len(raw) != keySize {
+		return nil, errors.New("must be a base64 key of 32 bytes (api secrets keygen)")
+	}
+	var key [keySize]byte
+	copy(key[:], raw)
+	return &key, nil
+}
+
+// Encrypt seals plaintext with NaCl secretbox. The result is a single base64
+// line holding the nonce and the sealed box, so it can be committed.
+func Encrypt(plaintext []byte, key *[keySize]byte) ([]byte, error) {
+	var nonce [nonceSize]byte
+	if _, err := rand.Read(nonce[:]); err != nil {
+		return nil, err
+	}
+	sealed := secretbox.Seal(nonce[:], plaintext, &nonce, key)
+	return append([]byte(base64.StdEncoding.EncodeToString(sealed)), '\n'), nil
+}
+
+// Decrypt opens data sealed by Encrypt.
+func Decrypt(data []byte, key *[keySize]byte) ([]byte, error) {
+	sealed, err := base64.StdEncoding.DecodeString(string(bytes.TrimSpace(data)))
+	if err != nil || len(sealed) < nonceSize {
+		return nil, errors.New("not an encrypted secrets file")
+	}
+	var nonce [nonceSize]byte
+	copy(nonce[:], sealed[:nonceSize])
+	plaintext, ok := secretbox.Open(nil, sealed[nonceSize:], &nonce, key)
+	if !ok {
+		return nil, errors.New("wrong key or corrupted file")
+	}
+	return plaintext, nil
+}
+
+// FileStore reads a YAML document of settings encrypted with Encrypt. It is
+// meant for development, where no secret manager runs.
+type FileStore struct {
+	path string
+	key  *[keySize]byte
+}
+
+func NewFileStore(path string, key *[keySize]byte) *FileStore {
+	return &FileStore{path: path, key: key}
+}
+
+func (s *FileStore) Name() string {
+	return "secrets-file"
+}
+
+func (s *FileStore) Load(_ context.Context) (map[string]any, error) {
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := Decrypt(data, s.key)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", s.path, err)
+	}
+
+	var values map[string]any
+	if err := yaml.Unmarshal(plaintext, &values); err != nil {
+		return nil, fmt.Errorf("%s: %w", s.path, err)
+	}
+	return values, nil
+}
@@ -0,0 +1,26 @@
+// Package secrets loads settings from secret backends. config.NewConfig
+// layers what a Store returns between the config files and the environment.
+package secrets
+
+import (
+	"context"
+	"time"
+)
+
+// Store is a secret backend. Load returns settings shaped like the config
+// file, nested (database: {url: ...}) or keyed by their dotted name
+// (database.url).
+type Store interface {
+	Name() string
+	Load(ctx context.Context) (map[string]any, error)
+}
+
+// Leased is a Store whose secrets expire unless their lease is renewed.
+type Leased interface {
+	Store
+	// Lease is the validity of the loaded secrets; zero means they do not
+	// expire.
+	Lease() time.Duration
+	// Renew extends the lease and returns its new validity.
+	Renew(ctx context.Context) (time.Duration, error)
+}
@@ -0,0 +1,146 @@
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+type VaultOptions struct {
+	Address   string
+	Token     string
+	Namespace string
+	// Path is the secret read, relative to /v1: secret/data/<name> for a
+	// KV v2 mount, or a dynamic engine such as database/creds/<role>.
+	Path    string
+	Timeout time.Duration
+}
+
+// VaultStore reads settings from a HashiCorp Vault compatible HTTP API.
+// KV v2 responses are unwrapped, and leased secrets can be renewed through
+// sys/leases/renew.
+type VaultStore struct {
+	opts   VaultOptions
+	client *http.Client
+
+	mu        sync.Mutex
+	leaseID   string
+	lease     time.Duration
+	renewable bool
+}
+
+func NewVaultStore(opts VaultOptions) *VaultStore {
+	return &VaultStore{opts: opts, client: &http.Client{Timeout: opts.Timeout}}
+}
+
+type vaultResponse struct {
+	LeaseID       string         `json:"lease_id"`
+	LeaseDuration int            `json:"lease_duration"`
+	Renewable     bool           `json:"renewable"`
+	Data          map[string]any `json:"data"`
+	Errors        []string       `json:"errors"`
+}
+
+func (s *VaultStore) Name() string {
+	return "vault"
+}
+
+func (s *VaultStore) Load(ctx context.Context) (map[string]any, error) {
+	resp, err := s.do(ctx, http.MethodGet, s.opts.Path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	s.leaseID = resp.LeaseID
+	s.lease = time.Duration(resp.LeaseDuration) * time.Second
+	s.renewable = resp.Renewable
+	s.mu.Unlock()
+
+	data := resp.Data
+	if inner, ok := data["data"].(map[string]any); ok && data["metadata"] != nil {
+		data = inner
+	}
+	return data, nil
+}
+
+// Lease is zero for secrets without a renewable lease, such as KV entries.
+func (s *VaultStore) Lease() time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.leaseID == "" || !s.renewable {
+		return 0
+	}
+	return s.lease
+}
+
+func (s *VaultStore) Renew(ctx context.Context) (time.Duration, error) {
+	s.mu.Lock()
+	leaseID, lease := s.leaseID, s.lease
+	s.mu.Unlock()
+	if leaseID == "" {
+		return 0, errors.New("vault: no lease to renew")
+	}
+
+	body := map[string]any{"lease_id": leaseID, "increment": int(lease.Seconds())}
+	resp, err := s.do(ctx, http.MethodPut, "sys/leases/renew", body)
+	if err != nil {
+		return 0, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lease = time.Duration(resp.LeaseDuration) * time.Second
+	s.renewable = resp.Renewable
+	return s.lease, nil
+}
+
+func (s *VaultStore) do(ctx context.Context, method, path string, body any) (*vaultResponse, error) {
+	var payload io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		payload = bytes.NewReader(encoded)
+	}
+
+	url := strings.TrimSuffix(s.opts.Address, "/") + "/v1/" + strings.TrimPrefix(path, "/")
+	req, err := http.NewRequestWithContext(ctx, method, url, payload)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Vault-Token", s.opts.Token)
+	if s.opts.Namespace != "" {
+		req.Header.Set("X-Vault-Namespace", s.opts.Namespace)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("vault: %w", err)
+	}
+	defer res.Body.Close()
+
+	var resp vaultResponse
+	if err := json.NewDecoder(res.Body).Decode(&resp); err != nil && res.StatusCode == http.StatusOK {
+		return nil, fmt.Errorf("vault: %s %s: %w", method, path, err)
+	}
+	if res.StatusCode != http.StatusOK {
+		status := res.Status
+		if len(resp.Errors) > 0 {
+			status += ": " + strings.Join(resp.Errors, "; ")
+		}
+		return nil, fmt.Errorf("vault: %s %s: %s", method, path, status)
+	}
+	return &resp, nil
+}
@@ -0,0 +1,34 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Problem is an invalid setting, named by its key and environment variable.
+type Problem struct {
+	Key     string
+	Env     string
+	Message string
+}
+
+func (p Problem) String() string {
+	if p.Env == "" {
+		return p.Key + ": " + p.Message
+	}
+	return fmt.Sprintf("%s (%s): %s", p.Key, p.Env, p.Message)
+}
+
+// ValidationError reports every invalid setting at once.
+type ValidationError struct {
+	Problems []Problem
+}
+
+func (e *ValidationError) Error() string {
+	lines := make([]string, 0, len(e.Problems)+1)
+	lines = append(lines, "invalid configuration:")
+	for _, problem := range e.Problems {
+		lines = append(lines, "  "+problem.String())
+	}
+	return strings.Join(lines, "\n")
+}
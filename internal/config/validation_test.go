@@ -0,0 +1,74 @@
+package config_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"golang_boilerplate_module/internal/config"
+)
+
+func TestNewConfig_ReportsEveryInvalidSetting(t *testing.T) {
+	files := map[string]string{"config.yaml": "http:\n  rate_limt: 5\n"}
+	env := map[string]string{
+		"DATABASE_URL":                  "",
+		"PORT":                          "0",
+		"DATABASE_REPOSITORIES":         "mongo",
+		"HTTP_RATE_LIMIT_WINDOW":        "0s",
+		"DATABASE_CONNECT_TIMEOUT":      "soon",
+		"DATABASE_MAX_CONNECTIONS":      "2",
+		"DATABASE_MAX_IDLE_CONNECTIONS": "3",
+	}
+
+	_, err := loadConfig(t, files, env, nil)
+
+	var validationErr *config.ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("expected *config.ValidationError, got %v", err)
+	}
+
+	tests := []struct {
+		key     string
+		env     string
+		message string
+	}{
+		{"database.url", "DATABASE_URL", "is required"},
+		{"app.port", "PORT", "must be at least 1"},
+		{"database.repositories", "DATABASE_REPOSITORIES", `must be one of gorm, pgx, got "mongo"`},
+		{"http.rate_limit_window", "HTTP_RATE_LIMIT_WINDOW", "must be greater than 0s"},
+		{"database.connect_timeout", "DATABASE_CONNECT_TIMEOUT", "must be a valid duration"},
+		{"database.max_idle_connections", "DATABASE_MAX_IDLE_CONNECTIONS", "must not exceed database.max_connections"},
+		{"http.rate_limt", "", "is not a known setting"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			want := config.Problem{Key: tt.key, Env: tt.env, Message: tt.message}
+			for _, problem := range validationErr.Problems {
+				if problem == want {
+					return
+				}
+			}
+			t.Fatalf("expected problem %+v, got %+v", want, validationErr.Problems)
+		})
+	}
+
+	if len(validationErr.Problems) != len(tests) {
+		t.Fatalf("expected %d problems, got %+v", len(tests), validationErr.Problems)
+	}
+	if lines := strings.Split(err.Error(), "\n"); len(lines) != len(tests)+1 {
+		t.Fatalf("expected a line per problem, got %q", err.Error())
+	}
+}
+
+func TestNewConfig_ValueAndFileVariableTogether(t *testing.T) {
+	_, err := loadConfig(t, nil, map[string]string{"HTTP_RATE_LIMIT": "5", "HTTP_RATE_LIMIT_FILE": "/nonexistent"}, nil)
+
+	var validationErr *config.ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("expected *config.ValidationError, got %v", err)
+	}
+	want := config.Problem{Key: "http.rate_limit", Env: "HTTP_RATE_LIMIT", Message: "set either HTTP_RATE_LIMIT or HTTP_RATE_LIMIT_FILE"}
+	if len(validationErr.Problems) != 1 || validationErr.Problems[0] != want {
+		t.Fatalf("expected %+v, got %+v", want, validationErr.Problems)
+	}
+}
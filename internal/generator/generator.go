@@ -0,0 +1,202 @@
+// Package generator scaffolds a CRUD module that follows the conventions of
+// the hand-written ones under internal/modules.
+package generator
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+//go:embed templates/*.tmpl
+var templatesFS embed.FS
+
+var templates = template.Must(template.ParseFS(templatesFS, "templates/*.tmpl"))
+
+var migrationPattern = regexp.MustCompile(`^V(\d+)__.+\.sql$`)
+
+type output struct {
+	template string
+	path     string
+}
+
+func (m Module) outputs() []output {
+	base := filepath.Join("internal", "modules", m.Name)
+	domain := filepath.Join(base, m.Name+"domain")
+	usecases := filepath.Join(base, "application", m.Name+"usecases")
+	file := strings.ToLower(m.EntityVar)
+
+	return []output{
+		{"module.go.tmpl", filepath.Join(base, "module.go")},
+		{"entity.go.tmpl", filepath.Join(domain, file+".go")},
+		{"repository.go.tmpl", filepath.Join(domain, m.Name+"repo", file+"_repository.go")},
+		{"create.go.tmpl", filepath.Join(usecases, "create_"+file+".go")},
+		{"get.go.tmpl", filepath.Join(usecases, "get_"+file+".go")},
+		{"list.go.tmpl", filepath.Join(usecases, "list_"+m.Name+".go")},
+		{"update.go.tmpl", filepath.Join(usecases, "update_"+file+".go")},
+		{"delete.go.tmpl", filepath.Join(usecases, "delete_"+file+".go")},
+		{"mocks_test.go.tmpl", filepath.Join(usecases, "mocks_test.go")},
+		{"create_test.go.tmpl", filepath.Join(usecases, "create_"+file+"_test.go")},
+		{"get_test.go.tmpl", filepath.Join(usecases, "get_"+file+"_test.go")},
+		{"list_test.go.tmpl", filepath.Join(usecases, "list_"+m.Name+"_test.go")},
+		{"update_test.go.tmpl", filepath.Join(usecases, "update_"+file+"_test.go")},
+		{"delete_test.go.tmpl", filepath.Join(usecases, "delete_"+file+"_test.go")},
+		{"controller.go.tmpl", filepath.Join(base, "infra", m.Name+"http", file+"_controller.go")},
+		{"routes.go.tmpl", filepath.Join(base, "infra", m.Name+"http", file+"_routes.go")},
+		{"gorm_repository.go.tmpl", filepath.Join(base, "infra", m.Name+"persistence", "gorm_"+file+"_repository.go")},
+		{"migration.sql.tmpl", filepath.Join("migrations", m.Migration)},
+		{"undo.sql.tmpl", filepath.Join("migrations", "undo", strings.TrimPrefix(m.Migration, "V"))},
+		{"sqlite_migration.sql.tmpl", filepath.Join("migrations", "sqlite", m.Migration)},
+		{"undo.sql.tmpl", filepath.Join("migrations", "sqlite", "undo", strings.TrimPrefix(m.Migration, "V"))},
+		{"integration_test.go.tmpl", filepath.Join("internal", "test", "integration", m.Name+"_test.go")},
+	}
+}
+
+// Generate writes the module under root and registers it in
+// internal/bootstrap/modules.go and the unit test target of the Makefile.
+// It returns the paths it created or changed, relative to root.
+func Generate(root string, m Module) ([]string, error) {
+	if _, err := os.Stat(filepath.Join(root, "internal", "modules", m.Name)); err == nil {
+		return nil, fmt.Errorf("module %q already exists", m.Name)
+	}
+
+	version, err := nextMigrationVersion(filepath.Join(root, "migrations"))
+	if err != nil {
+		return nil, err
+	}
+	m.Migration = fmt.Sprintf("V%d__create_%s.sql", version, m.Name)
+
+	rendered := make(map[string][]byte)
+	var paths []string
+	for _, out := range m.outputs() {
+		if _, err := os.Stat(filepath.Join(root, out.path)); err == nil {
+			return nil, fmt.Errorf("%s already exists", out.path)
+		}
+		content, err := render(out.template, m)
+		if err != nil {
+			return nil, fmt.Errorf("render %s: %w", out.path, err)
+		}
+		rendered[out.path] = content
+		paths = append(paths, out.path)
+	}
+
+	for _, path := range paths {
+		target := filepath.Join(root, path)
+		if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+			return nil, err
+		}
+		if err := os.WriteFile(target, rendered[path], 0o644); err != nil {
+			return nil, err
+		}
+	}
+
+	registered, err := register(root, m)
+	if err != nil {
+		return paths, err
+	}
+	return append(paths, registered...), nil
+}
+
+func render(name string, m Module) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := templates.ExecuteTemplate(&buf, name, m); err != nil {
+		return nil, err
+	}
+	if !strings.HasSuffix(name, ".go.tmpl") {
+		return buf.Bytes(), nil
+	}
+	return format.Source(buf.Bytes())
+}
+
+func nextMigrationVersion(dir string) (int, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return 0, fmt.Errorf("read migrations: %w", err)
+	}
+	latest := 0
+	for _, entry := range entries {
+		match := migrationPattern.FindStringSubmatch(entry.Name())
+		if match == nil {
+			continue
+		}
+		version, _ := strconv.Atoi(match[1])
+		latest = max(latest, version)
+	}
+	return latest + 1, nil
+}
+
+func register(root string, m Module) ([]string, error) {
+	modulesPath := filepath.Join("internal", "bootstrap", "modules.go")
+	if err := registerModule(filepath.Join(root, modulesPath), m); err != nil {
+		return nil, fmt.Errorf("register module: %w", err)
+	}
+	makefilePath := "Makefile"
+	if err := registerUnitTests(filepath.Join(root, makefilePath), m); err != nil {
+		return []string{modulesPath}, fmt.Errorf("register unit tests: %w", err)
+	}
+	return []string{modulesPath, makefilePath}, nil
+}
+
+// registerModule imports the module and appends it to bootstrap.Modules.
+func registerModule(path string, m Module) error {
+	source, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	content := string(source)
+
+	platformImport := fmt.Sprintf("\t%q\n", m.GoModule+"/internal/shared/infra/platform")
+	listEnd := "\n}\n"
+	if !strings.Contains(content, platformImport) || !strings.HasSuffix(content, listEnd) {
+		return fmt.Errorf("%s does not have the expected layout", path)
+	}
+	moduleImport := fmt.Sprintf("\t%q\n", m.GoModule+"/internal/modules/"+m.Name)
+	content = strings.Replace(content, platformImport, moduleImport+platformImport, 1)
+	content = strings.TrimSuffix(content, listEnd) + "\n\t" + m.Name + ".Module," + listEnd
+
+	formatted, err := format.Source([]byte(content))
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, formatted, 0o644)
+}
+
+// registerUnitTests adds the use case package to the test/unit target.
+func registerUnitTests(path string, m Module) error {
+	source, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	lines := strings.Split(string(source), "\n")
+
+	start := -1
+	for i, line := range lines {
+		if strings.HasPrefix(line, "test/unit:") {
+			start = i
+			break
+		}
+	}
+	if start < 0 {
+		return fmt.Errorf("%s has no test/unit target", path)
+	}
+
+	last := start
+	for i := start + 1; i < len(lines) && strings.HasPrefix(lines[i], "\t"); i++ {
+		last = i
+	}
+	if last == start || strings.HasSuffix(lines[last], `\`) {
+		return fmt.Errorf("%s test/unit target does not have the expected layout", path)
+	}
+
+	pkg := fmt.Sprintf("\t\t./internal/modules/%s/application/%susecases/...", m.Name, m.Name)
+	lines[last] += ` \`
+	lines = append(lines[:last+1], append([]string{pkg}, lines[last+1:]...)...)
+	return os.WriteFile(path, []byte(strings.Join(lines, "\n")), 0o644)
+}
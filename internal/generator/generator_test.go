@@ -0,0 +1,237 @@
+package generator_test
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"golang_boilerplate_module/internal/generator"
+)
+
+const goModule = "example.com/app"
+
+func TestParseFields(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    string
+		want    []string
+		wantErr string
+	}{
+		{
+			name: "every type",
+			spec: "title:string, body:text,stock:int,views:int64,price:float64,published:bool,released_at:time",
+			want: []string{"Title string", "Body string", "Stock int", "Views int64", "Price float64", "Published bool", "ReleasedAt time.Time"},
+		},
+		{name: "float is an alias of float64", spec: "price:float", want: []string{"Price float64"}},
+		{name: "initialisms", spec: "api_url:string", want: []string{"APIURL string"}},
+		{name: "empty entries are skipped", spec: "title:string,,", want: []string{"Title string"}},
+		{name: "no fields", spec: " ", wantErr: "at least one field is required"},
+		{name: "missing type", spec: "title", wantErr: `field "title" must be written as name:type`},
+		{name: "unknown type", spec: "price:decimal", wantErr: `field "price" has unknown type "decimal" (use ` + generator.TypeNames + ")"},
+		{name: "not snake_case", spec: "releaseDate:time", wantErr: `field "releaseDate" must be snake_case`},
+		{name: "duplicate field", spec: "title:string,title:text", wantErr: `field "title" is declared twice`},
+		{name: "reserved id", spec: "id:int", wantErr: `field "id" is added automatically`},
+		{name: "reserved tenant_id", spec: "tenant_id:string", wantErr: `field "tenant_id" is added automatically`},
+		{name: "reserved timestamps", spec: "title:string,created_at:time", wantErr: `field "created_at" is added automatically`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fields, err := generator.ParseFields(tt.spec)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("expected %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			got := make([]string, 0, len(fields))
+			for _, f := range fields {
+				got = append(got, f.GoName+" "+f.GoType)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Fatalf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestNewModule(t *testing.T) {
+	fields, err := generator.ParseFields("title:string")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		module     string
+		entity     string
+		wantEntity string
+		wantErr    string
+	}{
+		{name: "plural", module: "products", wantEntity: "Product"},
+		{name: "ies", module: "categories", wantEntity: "Category"},
+		{name: "es", module: "boxes", wantEntity: "Box"},
+		{name: "explicit entity", module: "news", entity: "article", wantEntity: "Article"},
+		{name: "invalid module name", module: "Products", wantErr: `module name "Products" must be lowercase letters and digits`},
+		{name: "entity equal to the module", module: "sheep", wantErr: `entity name must differ from the module name "sheep"; pass --entity`},
+		{name: "entity collides with a keyword", module: "types", wantErr: `entity name "type" collides with a Go identifier; pass --entity`},
+		{name: "entity collides with a builtin", module: "strings", wantErr: `entity name "string" collides with a Go identifier; pass --entity`},
+		{name: "entity collides with the generated code", module: "inputs", wantErr: `entity name "input" collides with a Go identifier; pass --entity`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := generator.NewModule(goModule, tt.module, tt.entity, fields)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("expected %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if m.Entity != tt.wantEntity {
+				t.Fatalf("expected entity %s, got %s", tt.wantEntity, m.Entity)
+			}
+		})
+	}
+}
+
+func TestGenerate_WritesAndRegistersTheModule(t *testing.T) {
+	root := newRoot(t)
+	fields, err := generator.ParseFields("title:string,price:float,released_at:time")
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err := generator.NewModule(goModule, "products", "", fields)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	paths, err := generator.Generate(root, m)
+	if err != nil {
+		t.Fatalf("generate: %v", err)
+	}
+
+	want := []string{
+		"internal/modules/products/module.go",
+		"internal/modules/products/productsdomain/product.go",
+		"internal/modules/products/productsdomain/productsrepo/product_repository.go",
+		"internal/modules/products/application/productsusecases/create_product.go",
+		"internal/modules/products/application/productsusecases/get_product.go",
+		"internal/modules/products/application/productsusecases/list_products.go",
+		"internal/modules/products/application/productsusecases/update_product.go",
+		"internal/modules/products/application/productsusecases/delete_product.go",
+		"internal/modules/products/application/productsusecases/mocks_test.go",
+		"internal/modules/products/application/productsusecases/create_product_test.go",
+		"internal/modules/products/application/productsusecases/get_product_test.go",
+		"internal/modules/products/application/productsusecases/list_products_test.go",
+		"internal/modules/products/application/productsusecases/update_product_test.go",
+		"internal/modules/products/application/productsusecases/delete_product_test.go",
+		"internal/modules/products/infra/productshttp/product_controller.go",
+		"internal/modules/products/infra/productshttp/product_routes.go",
+		"internal/modules/products/infra/productspersistence/gorm_product_repository.go",
+		"migrations/V8__create_products.sql",
+		"migrations/undo/8__create_products.sql",
+		"migrations/sqlite/V8__create_products.sql",
+		"migrations/sqlite/undo/8__create_products.sql",
+		"internal/test/integration/products_test.go",
+		"internal/bootstrap/modules.go",
+		"Makefile",
+	}
+	for i := range want {
+		want[i] = filepath.FromSlash(want[i])
+	}
+	if !slices.Equal(paths, want) {
+		t.Fatalf("expected paths\n%s\ngot\n%s", strings.Join(want, "\n"), strings.Join(paths, "\n"))
+	}
+
+	entity := readFile(t, root, "internal/modules/products/productsdomain/product.go")
+	for _, field := range []string{"Title ", "Price ", "float64", "ReleasedAt ", "time.Time"} {
+		if !strings.Contains(entity, field) {
+			t.Errorf("expected the entity to contain %q:\n%s", field, entity)
+		}
+	}
+
+	migration := readFile(t, root, "migrations/V8__create_products.sql")
+	for _, column := range []string{"title       VARCHAR(255)     NOT NULL", "price       DOUBLE PRECISION NOT NULL DEFAULT 0", "released_at TIMESTAMPTZ      NOT NULL"} {
+		if !strings.Contains(migration, column) {
+			t.Errorf("expected the migration to contain %q:\n%s", column, migration)
+		}
+	}
+
+	modules := readFile(t, root, "internal/bootstrap/modules.go")
+	if !strings.Contains(modules, "\t\""+goModule+"/internal/modules/products\"\n") {
+		t.Errorf("expected modules.go to import the module:\n%s", modules)
+	}
+	if !strings.HasSuffix(modules, "\thealth.Module,\n\tproducts.Module,\n}\n") {
+		t.Errorf("expected modules.go to list the module last:\n%s", modules)
+	}
+
+	makefile := readFile(t, root, "Makefile")
+	wantTarget := "test/unit:\n\tgo test -v -count=1 \\\n\t\t./internal/config/... \\\n\t\t./internal/modules/products/application/productsusecases/...\n"
+	if !strings.Contains(makefile, wantTarget) {
+		t.Errorf("expected the test/unit target to run the use cases:\n%s", makefile)
+	}
+}
+
+func TestGenerate_RefusesAnExistingModule(t *testing.T) {
+	root := newRoot(t)
+	writeFile(t, root, "internal/modules/products/module.go", "package products\n")
+	fields, _ := generator.ParseFields("title:string")
+	m, _ := generator.NewModule(goModule, "products", "", fields)
+
+	_, err := generator.Generate(root, m)
+	if err == nil || err.Error() != `module "products" already exists` {
+		t.Fatalf("expected the module to be refused, got %v", err)
+	}
+}
+
+// newRoot lays out the files Generate reads and rewrites in a repository.
+func newRoot(t *testing.T) string {
+	t.Helper()
+
+	root := t.TempDir()
+	writeFile(t, root, "migrations/V7__create_orders.sql", "")
+	writeFile(t, root, "migrations/V2__create_users.sql", "")
+	writeFile(t, root, "internal/bootstrap/modules.go", `package bootstrap
+
+import (
+	"`+goModule+`/internal/modules/health"
+	"`+goModule+`/internal/shared/infra/platform"
+)
+
+var Modules = []platform.Module{
+	health.Module,
+}
+`)
+	writeFile(t, root, "Makefile", "test/unit:\n\tgo test -v -count=1 \\\n\t\t./internal/config/...\n\ntest: test/unit\n")
+	return root
+}
+
+func writeFile(t *testing.T, root, path, content string) {
+	t.Helper()
+
+	target := filepath.Join(root, filepath.FromSlash(path))
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(target, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func readFile(t *testing.T, root, path string) string {
+	t.Helper()
+
+	content, err := os.ReadFile(filepath.Join(root, filepath.FromSlash(path)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(content)
+}
@@ -0,0 +1,353 @@
+package generator
+
+import (
+	"fmt"
+	"go/token"
+	"go/types"
+	"regexp"
+	"strings"
+)
+
+var (
+	moduleNamePattern = regexp.MustCompile(`^[a-z][a-z0-9]*$`)
+	fieldNamePattern  = regexp.MustCompile(`^[a-z][a-z0-9]*(_[a-z0-9]+)*$`)
+)
+
+// reservedNames collide with identifiers the generated code already uses.
+var reservedNames = map[string]bool{
+	"ctx": true, "err": true, "id": true, "input": true, "log": true, "span": true, "uc": true,
+	"updates": true, "value": true, "strings": true, "time": true, "output": true, "limit": true,
+}
+
+var reservedFields = map[string]bool{
+	"id": true, "tenant_id": true, "created_at": true, "updated_at": true,
+}
+
+var initialisms = map[string]string{"id": "ID", "url": "URL", "uri": "URI", "api": "API", "ip": "IP", "sku": "SKU"}
+
+type fieldType struct {
+	GoType   string
+	SQLType  string
+	Default  string
+	Required bool
+	Sample   string
+	Update   string
+	JSON     string
+	JSONUp   string
+}
+
+var fieldTypes = map[string]fieldType{
+	"string":  {GoType: "string", SQLType: "VARCHAR(255)", Required: true},
+	"text":    {GoType: "string", SQLType: "TEXT", Required: true},
+	"int":     {GoType: "int", SQLType: "INTEGER", Default: "0", Sample: "42", Update: "43"},
+	"int64":   {GoType: "int64", SQLType: "BIGINT", Default: "0", Sample: "42", Update: "int64(43)", JSONUp: "43"},
+	"float64": {GoType: "float64", SQLType: "DOUBLE PRECISION", Default: "0", Sample: "9.5", Update: "10.5"},
+	"bool":    {GoType: "bool", SQLType: "BOOLEAN", Default: "FALSE", Sample: "true", Update: "false"},
+	"time": {
+		GoType: "time.Time", SQLType: "TIMESTAMPTZ", Required: true,
+		Sample: "time.Date(2026, time.January, 2, 0, 0, 0, 0, time.UTC)",
+		Update: "time.Date(2026, time.January, 3, 0, 0, 0, 0, time.UTC)",
+		JSON:   `"2026-01-02T00:00:00Z"`, JSONUp: `"2026-01-03T00:00:00Z"`,
+	},
+}
+
+// typeAliases are the other names a field type is accepted under.
+var typeAliases = map[string]string{"float": "float64"}
+
+// TypeNames lists the field types ParseFields accepts, aliases included.
+const TypeNames = "string, text, int, int64, float64 (or float), bool, time"
+
+type Field struct {
+	Name     string
+	Kind     string
+	GoName   string
+	GoType   string
+	SQLType  string
+	Default  string
+	Required bool
+	Label    string
+
+	Sample       string
+	UpdateSample string
+	SampleJSON   string
+	UpdateJSON   string
+	// UpdateText is how the updated value prints once decoded from JSON.
+	UpdateText string
+}
+
+func (f Field) IsString() bool { return f.GoType == "string" }
+func (f Field) IsTime() bool   { return f.Kind == "time" }
+
+func (f Field) Validate() string {
+	switch {
+	case f.Kind == "string":
+		return "required,max=255"
+	case f.Required:
+		return "required"
+	}
+	return ""
+}
+
+func (f Field) UpdateValidate() string {
+	if f.Kind == "string" {
+		return "max=255"
+	}
+	return ""
+}
+
+// IsEmpty renders the check that rejects a missing value of a required field.
+func (f Field) IsEmpty(expr string) string {
+	if f.IsTime() {
+		return expr + ".IsZero()"
+	}
+	return "strings.TrimSpace(" + expr + `) == ""`
+}
+
+// IsEmptyPtr is IsEmpty for an optional (pointer) value that is known to be set.
+func (f Field) IsEmptyPtr(expr string) string {
+	if f.IsTime() {
+		return expr + ".IsZero()"
+	}
+	return f.IsEmpty("*" + expr)
+}
+
+// ParseFields reads a spec such as "title:string,price:float64,published:bool".
+func ParseFields(spec string) ([]Field, error) {
+	var fields []Field
+	seen := make(map[string]bool)
+	for _, raw := range strings.Split(spec, ",") {
+		raw = strings.TrimSpace(raw)
+		if raw == "" {
+			continue
+		}
+		name, kind, ok := strings.Cut(raw, ":")
+		if !ok {
+			return nil, fmt.Errorf("field %q must be written as name:type", raw)
+		}
+		if !fieldNamePattern.MatchString(name) {
+			return nil, fmt.Errorf("field %q must be snake_case", name)
+		}
+		if reservedFields[name] {
+			return nil, fmt.Errorf("field %q is added automatically", name)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("field %q is declared twice", name)
+		}
+		seen[name] = true
+
+		if alias, ok := typeAliases[kind]; ok {
+			kind = alias
+		}
+		ft, ok := fieldTypes[kind]
+		if !ok {
+			return nil, fmt.Errorf("field %q has unknown type %q (use %s)", name, kind, TypeNames)
+		}
+		fields = append(fields, newField(name, kind, ft))
+	}
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("at least one field is required")
+	}
+	return fields, nil
+}
+
+func newField(name, kind string, ft fieldType) Field {
+	f := Field{
+		Name:     name,
+		Kind:     kind,
+		GoName:   goName(name),
+		GoType:   ft.GoType,
+		SQLType:  ft.SQLType,
+		Default:  ft.Default,
+		Required: ft.Required,
+		Label:    label(name),
+
+		Sample:       ft.Sample,
+		UpdateSample: ft.Update,
+		SampleJSON:   ft.JSON,
+		UpdateJSON:   ft.JSONUp,
+	}
+	if f.IsString() {
+		f.Sample = fmt.Sprintf("%q", "Sample "+strings.ReplaceAll(name, "_", " "))
+		f.UpdateSample = fmt.Sprintf("%q", "Updated "+strings.ReplaceAll(name, "_", " "))
+	}
+	if f.SampleJSON == "" {
+		f.SampleJSON = f.Sample
+	}
+	if f.UpdateJSON == "" {
+		f.UpdateJSON = f.UpdateSample
+	}
+	f.UpdateText = strings.Trim(f.UpdateJSON, `"`)
+	return f
+}
+
+type Module struct {
+	GoModule  string
+	Name      string
+	Entity    string
+	EntityVar string
+	Plural    string
+	Human     string
+	Fields    []Field
+	Migration string
+}
+
+// NewModule derives the naming of a module from its (plural) name. entity
+// overrides the singular form when trimming the plural gets it wrong.
+func NewModule(goModule, name, entity string, fields []Field) (Module, error) {
+	if !moduleNamePattern.MatchString(name) {
+		return Module{}, fmt.Errorf("module name %q must be lowercase letters and digits", name)
+	}
+	if entity == "" {
+		entity = singular(name)
+	}
+	if !moduleNamePattern.MatchString(entity) {
+		return Module{}, fmt.Errorf("entity name %q must be lowercase letters and digits", entity)
+	}
+	if entity == name {
+		return Module{}, fmt.Errorf("entity name must differ from the module name %q; pass --entity", name)
+	}
+	if token.IsKeyword(entity) || types.Universe.Lookup(entity) != nil || reservedNames[entity] {
+		return Module{}, fmt.Errorf("entity name %q collides with a Go identifier; pass --entity", entity)
+	}
+
+	return Module{
+		GoModule:  goModule,
+		Name:      name,
+		Entity:    goName(entity),
+		EntityVar: entity,
+		Plural:    goName(name),
+		Human:     entity,
+		Fields:    fields,
+	}, nil
+}
+
+func (m Module) First() Field { return m.Fields[0] }
+
+func (m Module) HasTimeFields() bool {
+	for _, f := range m.Fields {
+		if f.IsTime() {
+			return true
+		}
+	}
+	return false
+}
+
+func (m Module) HasRequiredFields() bool {
+	for _, f := range m.Fields {
+		if f.Required {
+			return true
+		}
+	}
+	return false
+}
+
+func (m Module) HasRequiredStrings() bool {
+	for _, f := range m.Fields {
+		if f.Required && f.IsString() {
+			return true
+		}
+	}
+	return false
+}
+
+// FirstRequired is the field the generated tests blank out to exercise
+// validation.
+func (m Module) FirstRequired() Field {
+	for _, f := range m.Fields {
+		if f.Required {
+			return f
+		}
+	}
+	return Field{}
+}
+
+func (m Module) SampleJSON() string {
+	parts := make([]string, 0, len(m.Fields))
+	for _, f := range m.Fields {
+		parts = append(parts, fmt.Sprintf("%q:%s", f.Name, f.SampleJSON))
+	}
+	return "{" + strings.Join(parts, ",") + "}"
+}
+
+// Columns renders the column definitions of the migration, aligned like the
+// hand-written ones.
+func (m Module) Columns() []string {
+	return m.columns(func(kind, rest string) (string, string) { return kind, rest })
+}
+
+// SQLiteColumns renders the columns of the SQLite migration.
+func (m Module) SQLiteColumns() []string {
+	return m.columns(func(kind, rest string) (string, string) {
+		switch kind {
+		case "SERIAL":
+			return "INTEGER", rest + " AUTOINCREMENT"
+		case "TIMESTAMPTZ":
+			return "TIMESTAMP", strings.Replace(rest, "NOW()", "CURRENT_TIMESTAMP", 1)
+		}
+		return kind, rest
+	})
+}
+
+func (m Module) columns(dialect func(kind, rest string) (string, string)) []string {
+	type column struct{ name, kind, rest string }
+	columns := []column{
+		{"id", "SERIAL", "PRIMARY KEY"},
+		{"tenant_id", "VARCHAR(63)", "NOT NULL REFERENCES tenants (id)"},
+	}
+	for _, f := range m.Fields {
+		rest := "NOT NULL"
+		if f.Default != "" {
+			rest += " DEFAULT " + f.Default
+		}
+		columns = append(columns, column{f.Name, f.SQLType, rest})
+	}
+	columns = append(columns,
+		column{"created_at", "TIMESTAMPTZ", "NOT NULL DEFAULT NOW()"},
+		column{"updated_at", "TIMESTAMPTZ", "NOT NULL DEFAULT NOW()"},
+	)
+
+	nameWidth, kindWidth := 0, 0
+	for i, c := range columns {
+		columns[i].kind, columns[i].rest = dialect(c.kind, c.rest)
+		nameWidth = max(nameWidth, len(c.name))
+		kindWidth = max(kindWidth, len(columns[i].kind))
+	}
+	lines := make([]string, 0, len(columns))
+	for i, c := range columns {
+		line := fmt.Sprintf("%-*s %-*s %s", nameWidth, c.name, kindWidth, c.kind, c.rest)
+		if i < len(columns)-1 {
+			line += ","
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+func goName(snake string) string {
+	var b strings.Builder
+	for _, part := range strings.Split(snake, "_") {
+		if upper, ok := initialisms[part]; ok {
+			b.WriteString(upper)
+			continue
+		}
+		b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	return b.String()
+}
+
+func label(snake string) string {
+	words := strings.ReplaceAll(snake, "_", " ")
+	return strings.ToUpper(words[:1]) + words[1:]
+}
+
+func singular(plural string) string {
+	switch {
+	case strings.HasSuffix(plural, "ies") && len(plural) > 3:
+		return strings.TrimSuffix(plural, "ies") + "y"
+	case strings.HasSuffix(plural, "sses"), strings.HasSuffix(plural, "xes"), strings.HasSuffix(plural, "ches"), strings.HasSuffix(plural, "shes"):
+		return strings.TrimSuffix(plural, "es")
+	case strings.HasSuffix(plural, "s") && !strings.HasSuffix(plural, "ss"):
+		return strings.TrimSuffix(plural, "s")
+	}
+	return plural
+}
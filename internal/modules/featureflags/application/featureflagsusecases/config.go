@@ -0,0 +1,44 @@
+package featureflagsusecases
+
+import (
+	"slices"
+	"strconv"
+	"sync/atomic"
+	"time"
+)
+
+// Config is the featureflags section of the configuration.
+type Config struct {
+	Store             string        `key:"store" env:"FEATURE_FLAGS_STORE" default:"postgres" validate:"required,oneof=postgres file" doc:"postgres (managed through the API, changes reach every replica) or file (read-only)"`
+	File              string        `key:"file" env:"FEATURE_FLAGS_FILE" doc:"YAML or JSON file of the file store"`
+	FileWatchInterval time.Duration `key:"file_watch_interval" env:"FEATURE_FLAGS_FILE_WATCH_INTERVAL" default:"5s" validate:"gt=0s" doc:"How often the file store checks its file for changes"`
+	CacheTTL          time.Duration `key:"cache_ttl" env:"FEATURE_FLAGS_CACHE_TTL" default:"1m" validate:"gt=0s" reload:"true" doc:"How long flags are evaluated from memory when no change is notified"`
+	Admins            []string      `key:"admins" env:"FEATURE_FLAGS_ADMINS" reload:"true" doc:"IDs of the users allowed to manage flags"`
+}
+
+// Settings holds the settings applied again when the configuration is
+// reloaded.
+type Settings struct {
+	admins   atomic.Pointer[[]string]
+	cacheTTL atomic.Int64
+}
+
+func NewSettings(cfg *Config) *Settings {
+	s := &Settings{}
+	s.Apply(cfg)
+	return s
+}
+
+func (s *Settings) Apply(cfg *Config) {
+	admins := slices.Clone(cfg.Admins)
+	s.admins.Store(&admins)
+	s.cacheTTL.Store(int64(cfg.CacheTTL))
+}
+
+func (s *Settings) IsAdmin(userID uint) bool {
+	return slices.Contains(*s.admins.Load(), strconv.FormatUint(uint64(userID), 10))
+}
+
+func (s *Settings) CacheTTL() time.Duration {
+	return time.Duration(s.cacheTTL.Load())
+}
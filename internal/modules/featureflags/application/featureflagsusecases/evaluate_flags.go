@@ -0,0 +1,46 @@
+package featureflagsusecases
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	"golang_boilerplate_module/internal/shared/domain/providers"
+	"golang_boilerplate_module/internal/shared/infra/observability"
+)
+
+type EvaluationOutput struct {
+	Key     string `json:"key"`
+	Variant string `json:"variant"`
+	Reason  string `json:"reason"`
+}
+
+// EvaluateFlagsUseCase evaluates every flag for the current user, for
+// clients that branch on flags themselves. Only user_id and tenant_id are
+// known here; other attributes are treated as missing.
+type EvaluateFlagsUseCase struct {
+	evaluator *FlagEvaluator
+}
+
+func NewEvaluateFlagsUseCase(evaluator *FlagEvaluator) *EvaluateFlagsUseCase {
+	return &EvaluateFlagsUseCase{evaluator: evaluator}
+}
+
+func (uc *EvaluateFlagsUseCase) Execute(ctx context.Context, userID uint) ([]EvaluationOutput, error) {
+	ctx, span := flagTracer.Start(ctx, "EvaluateFlagsUseCase.Execute")
+	defer span.End()
+
+	subject := providers.FlagSubject{UserID: strconv.FormatUint(uint64(userID), 10)}
+	evaluations, err := uc.evaluator.EvaluateAll(ctx, subject)
+	if err != nil {
+		observability.RecordError(span, err)
+		return nil, err
+	}
+
+	outputs := make([]EvaluationOutput, 0, len(evaluations))
+	for key, evaluation := range evaluations {
+		outputs = append(outputs, EvaluationOutput{Key: key, Variant: evaluation.Variant, Reason: string(evaluation.Reason)})
+	}
+	sort.Slice(outputs, func(i, j int) bool { return outputs[i].Key < outputs[j].Key })
+	return outputs, nil
+}
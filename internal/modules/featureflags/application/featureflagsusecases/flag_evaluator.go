@@ -0,0 +1,192 @@
+package featureflagsusecases
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang_boilerplate_module/internal/modules/featureflags/featureflagsdomain"
+	"golang_boilerplate_module/internal/modules/featureflags/featureflagsdomain/featureflagsrepo"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+	"golang_boilerplate_module/internal/shared/domain/tenancy"
+	"golang_boilerplate_module/internal/shared/infra/observability"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var flagTracer = otel.Tracer("featureflags")
+
+const providerName = "boilerplate-api"
+
+// FlagEvaluator implements providers.FeatureFlags over an in-memory copy of
+// the flags. The copy is loaded again after Invalidate, which the store's
+// change notifications call on every replica, or once it is older than the
+// cache TTL. While the store fails, the previous copy keeps being served.
+type FlagEvaluator struct {
+	flags    featureflagsrepo.FlagRepository
+	settings *Settings
+	logger   providers.LoggerProvider
+
+	mu         sync.Mutex
+	cache      atomic.Pointer[flagCache]
+	generation atomic.Uint64
+}
+
+type flagCache struct {
+	flags    map[string]*featureflagsdomain.Flag
+	loadedAt time.Time
+	stale    bool
+}
+
+func NewFlagEvaluator(flags featureflagsrepo.FlagRepository, settings *Settings, logger providers.LoggerProvider) *FlagEvaluator {
+	return &FlagEvaluator{flags: flags, settings: settings, logger: logger}
+}
+
+func (e *FlagEvaluator) Bool(ctx context.Context, key string, subject providers.FlagSubject, fallback bool) bool {
+	variant := featureflagsdomain.VariantOff
+	if fallback {
+		variant = featureflagsdomain.VariantOn
+	}
+	return e.evaluate(ctx, key, featureflagsdomain.KindBoolean, subject, variant).Variant == featureflagsdomain.VariantOn
+}
+
+func (e *FlagEvaluator) Variant(ctx context.Context, key string, subject providers.FlagSubject, fallback string) string {
+	return e.evaluate(ctx, key, "", subject, fallback).Variant
+}
+
+// EvaluateAll evaluates every flag for subject.
+func (e *FlagEvaluator) EvaluateAll(ctx context.Context, subject providers.FlagSubject) (map[string]featureflagsdomain.Evaluation, error) {
+	cache, err := e.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	evaluations := make(map[string]featureflagsdomain.Evaluation, len(cache.flags))
+	for key := range cache.flags {
+		evaluations[key] = e.evaluate(ctx, key, "", subject, "")
+	}
+	return evaluations, nil
+}
+
+// Invalidate makes the next evaluation load the flags from the store.
+func (e *FlagEvaluator) Invalidate() {
+	e.generation.Add(1)
+	e.markStale()
+}
+
+// markStale swaps the cache for a stale copy, retrying if a load replaced it
+// in the meantime.
+func (e *FlagEvaluator) markStale() {
+	for {
+		cache := e.cache.Load()
+		if cache == nil || cache.stale {
+			return
+		}
+		if e.cache.CompareAndSwap(cache, &flagCache{flags: cache.flags, loadedAt: cache.loadedAt, stale: true}) {
+			return
+		}
+	}
+}
+
+// store publishes a cache loaded at generation. A change notified since then
+// may have marked the previous cache instead, so the new one is marked too.
+func (e *FlagEvaluator) store(cache *flagCache, generation uint64) {
+	e.cache.Store(cache)
+	if e.generation.Load() != generation {
+		e.markStale()
+	}
+}
+
+// evaluate records the result as a feature_flag.evaluation event on the
+// current span. kind, when set, is the kind the caller expects.
+func (e *FlagEvaluator) evaluate(
+	ctx context.Context,
+	key string,
+	kind featureflagsdomain.Kind,
+	subject providers.FlagSubject,
+	fallback string,
+) featureflagsdomain.Evaluation {
+	if subject.TenantID == "" {
+		subject.TenantID, _ = tenancy.TenantIDFromContext(ctx)
+	}
+
+	result := featureflagsdomain.Evaluation{Variant: fallback, Reason: featureflagsdomain.ReasonError}
+	errorType := ""
+	var flag *featureflagsdomain.Flag
+	cache, err := e.load(ctx)
+	if err == nil {
+		flag = cache.flags[key]
+	}
+	switch {
+	case err != nil:
+		errorType = "provider_not_ready"
+	case flag == nil:
+		errorType = "flag_not_found"
+	case kind != "" && flag.Kind != kind:
+		errorType = "type_mismatch"
+	default:
+		result = flag.Evaluate(subject)
+	}
+
+	attrs := []attribute.KeyValue{
+		attribute.String("feature_flag.key", key),
+		attribute.String("feature_flag.provider.name", providerName),
+		attribute.String("feature_flag.result.variant", result.Variant),
+		attribute.String("feature_flag.result.reason", string(result.Reason)),
+	}
+	if result.Rule > 0 {
+		attrs = append(attrs, attribute.Int("feature_flag.rule", result.Rule))
+	}
+	if errorType != "" {
+		attrs = append(attrs, attribute.String("error.type", errorType))
+	}
+	trace.SpanFromContext(ctx).AddEvent("feature_flag.evaluation", trace.WithAttributes(attrs...))
+	return result
+}
+
+func (e *FlagEvaluator) load(ctx context.Context) (*flagCache, error) {
+	if cache := e.cache.Load(); cache.fresh(e.settings.CacheTTL()) {
+		return cache, nil
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	previous := e.cache.Load()
+	if previous.fresh(e.settings.CacheTTL()) {
+		return previous, nil
+	}
+
+	ctx, span := flagTracer.Start(ctx, "FlagEvaluator.load")
+	defer span.End()
+
+	generation := e.generation.Load()
+	flags, err := e.flags.List(ctx)
+	if err != nil {
+		observability.RecordError(span, err)
+		observability.LoggerWithTrace(ctx, e.logger).Error("failed to load feature flags", "error", err.Error())
+		if previous == nil {
+			return nil, err
+		}
+		// Retried after another TTL, or on the next change notification.
+		cache := &flagCache{flags: previous.flags, loadedAt: time.Now()}
+		e.store(cache, generation)
+		return cache, nil
+	}
+
+	cache := &flagCache{
+		flags:    make(map[string]*featureflagsdomain.Flag, len(flags)),
+		loadedAt: time.Now(),
+	}
+	for i := range flags {
+		cache.flags[flags[i].Key] = &flags[i]
+	}
+	span.SetAttributes(attribute.Int("feature_flag.count", len(flags)))
+	e.store(cache, generation)
+	return cache, nil
+}
+
+func (c *flagCache) fresh(ttl time.Duration) bool {
+	return c != nil && !c.stale && time.Since(c.loadedAt) < ttl
+}
@@ -0,0 +1,212 @@
+package featureflagsusecases_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"golang_boilerplate_module/internal/modules/featureflags/application/featureflagsusecases"
+	"golang_boilerplate_module/internal/modules/featureflags/featureflagsdomain"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func flagsSettings(admins ...string) *featureflagsusecases.Settings {
+	return featureflagsusecases.NewSettings(&featureflagsusecases.Config{CacheTTL: time.Minute, Admins: admins})
+}
+
+func user(id int) providers.FlagSubject {
+	return providers.FlagSubject{UserID: strconv.Itoa(id), TenantID: "default"}
+}
+
+func TestFlagEvaluator_RolloutIsStableAndProportional(t *testing.T) {
+	repo := flagsWith(featureflagsdomain.Flag{
+		Key:     "new-checkout",
+		Enabled: true,
+		Default: featureflagsdomain.Serve{Rollout: []featureflagsdomain.Weight{
+			{Variant: featureflagsdomain.VariantOn, Weight: 20},
+			{Variant: featureflagsdomain.VariantOff, Weight: 80},
+		}},
+	})
+	evaluator := featureflagsusecases.NewFlagEvaluator(repo, flagsSettings(), &mockLogger{})
+	ctx := context.Background()
+
+	on := 0
+	for id := range 10000 {
+		first := evaluator.Bool(ctx, "new-checkout", user(id), false)
+		if evaluator.Bool(ctx, "new-checkout", user(id), false) != first {
+			t.Fatalf("expected user %d to get the same variant every time", id)
+		}
+		if first {
+			on++
+		}
+	}
+	if on < 1800 || on > 2200 {
+		t.Fatalf("expected about 20%% of users on, got %d of 10000", on)
+	}
+}
+
+func TestFlagEvaluator_TargetingRulesAndKillSwitch(t *testing.T) {
+	flag := featureflagsdomain.Flag{
+		Key:      "checkout-theme",
+		Kind:     featureflagsdomain.KindMultivariate,
+		Enabled:  true,
+		Variants: []string{"classic", "dark", "neon"},
+		Rules: []featureflagsdomain.Rule{
+			{
+				Conditions: []featureflagsdomain.Condition{
+					{Attribute: "tenant_id", Operator: featureflagsdomain.OperatorIn, Values: []string{"acme"}},
+					{Attribute: "email", Operator: featureflagsdomain.OperatorEndsWith, Values: []string{"@acme.com"}},
+				},
+				Serve: featureflagsdomain.Serve{Variant: "neon"},
+			},
+			{
+				Conditions: []featureflagsdomain.Condition{
+					{Attribute: "plan", Operator: featureflagsdomain.OperatorNotIn, Values: []string{"free"}},
+				},
+				Serve: featureflagsdomain.Serve{Variant: "dark"},
+			},
+		},
+	}
+	repo := flagsWith(flag)
+	evaluator := featureflagsusecases.NewFlagEvaluator(repo, flagsSettings(), &mockLogger{})
+	ctx := context.Background()
+
+	staff := providers.FlagSubject{UserID: "1", TenantID: "acme", Attributes: map[string]string{"email": "ana@acme.com", "plan": "free"}}
+	if got := evaluator.Variant(ctx, "checkout-theme", staff, "classic"); got != "neon" {
+		t.Fatalf("expected the first matching rule to serve neon, got %q", got)
+	}
+	paying := providers.FlagSubject{UserID: "2", TenantID: "globex", Attributes: map[string]string{"plan": "pro"}}
+	if got := evaluator.Variant(ctx, "checkout-theme", paying, "classic"); got != "dark" {
+		t.Fatalf("expected the plan rule to serve dark, got %q", got)
+	}
+	free := providers.FlagSubject{UserID: "3", TenantID: "globex", Attributes: map[string]string{"plan": "free"}}
+	if got := evaluator.Variant(ctx, "checkout-theme", free, "neon"); got != "classic" {
+		t.Fatalf("expected the default (first variant), got %q", got)
+	}
+
+	flag.Normalize()
+	flag.Enabled = false
+	repo.flags[flag.Key] = flag
+	evaluator.Invalidate()
+	if got := evaluator.Variant(ctx, "checkout-theme", staff, "neon"); got != "classic" {
+		t.Fatalf("expected a disabled flag to serve its off variant, got %q", got)
+	}
+}
+
+func TestFlagEvaluator_FallsBackOnUnknownFlagsAndKinds(t *testing.T) {
+	repo := flagsWith(featureflagsdomain.Flag{
+		Key:      "theme",
+		Kind:     featureflagsdomain.KindMultivariate,
+		Enabled:  true,
+		Variants: []string{"light", "dark"},
+	})
+	evaluator := featureflagsusecases.NewFlagEvaluator(repo, flagsSettings(), &mockLogger{})
+	ctx := context.Background()
+
+	if !evaluator.Bool(ctx, "missing", user(1), true) {
+		t.Fatal("expected the fallback for an unknown flag")
+	}
+	if !evaluator.Bool(ctx, "theme", user(1), true) {
+		t.Fatal("expected the fallback for a multivariate flag read as a boolean")
+	}
+}
+
+func TestFlagEvaluator_CachesUntilInvalidated(t *testing.T) {
+	repo := flagsWith(featureflagsdomain.Flag{Key: "beta", Enabled: true})
+	evaluator := featureflagsusecases.NewFlagEvaluator(repo, flagsSettings(), &mockLogger{})
+	ctx := context.Background()
+
+	for id := range 5 {
+		evaluator.Bool(ctx, "beta", user(id), false)
+	}
+	if repo.listCalls != 1 {
+		t.Fatalf("expected the flags to be loaded once, got %d loads", repo.listCalls)
+	}
+
+	repo.listErr = errors.New("connection refused")
+	evaluator.Invalidate()
+	if !evaluator.Bool(ctx, "beta", user(1), false) {
+		t.Fatal("expected the cached flags to be served while the store fails")
+	}
+
+	repo.listErr = nil
+	delete(repo.flags, "beta")
+	evaluator.Invalidate()
+	if evaluator.Bool(ctx, "beta", user(1), false) {
+		t.Fatal("expected the reloaded flags after invalidation")
+	}
+}
+
+func TestFlagEvaluator_InvalidationDuringALoadIsNotLost(t *testing.T) {
+	repo := flagsWith(featureflagsdomain.Flag{Key: "beta", Enabled: true})
+	evaluator := featureflagsusecases.NewFlagEvaluator(repo, flagsSettings(), &mockLogger{})
+	ctx := context.Background()
+
+	repo.onList = func() {
+		repo.onList = nil
+		evaluator.Invalidate()
+	}
+	evaluator.Bool(ctx, "beta", user(1), false)
+	evaluator.Bool(ctx, "beta", user(1), false)
+
+	if repo.listCalls != 2 {
+		t.Fatalf("expected the flags to be loaded again after the invalidation, got %d loads", repo.listCalls)
+	}
+}
+
+func TestFlagEvaluator_ServesTheLastChangeAfterConcurrentInvalidations(t *testing.T) {
+	repo := flagsWith(featureflagsdomain.Flag{Key: "beta", Enabled: true})
+	evaluator := featureflagsusecases.NewFlagEvaluator(repo, flagsSettings(), &mockLogger{})
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for id := range 20 {
+		wg.Go(func() { evaluator.Bool(ctx, "beta", user(id), false) })
+		wg.Go(func() {
+			repo.set(featureflagsdomain.Flag{Key: "beta", Enabled: false})
+			evaluator.Invalidate()
+		})
+	}
+	wg.Wait()
+
+	if evaluator.Bool(ctx, "beta", user(1), true) {
+		t.Fatal("expected the flag disabled by the last change")
+	}
+}
+
+func TestFlagEvaluator_RecordsEvaluationsAsSpanEvents(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tracer := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("test")
+
+	repo := flagsWith(featureflagsdomain.Flag{Key: "beta", Enabled: true})
+	evaluator := featureflagsusecases.NewFlagEvaluator(repo, flagsSettings(), &mockLogger{})
+
+	ctx, span := tracer.Start(context.Background(), "request")
+	evaluator.Bool(ctx, "beta", user(1), false)
+	span.End()
+
+	var spans []sdktrace.ReadOnlySpan
+	for _, s := range recorder.Ended() {
+		if s.Name() == "request" {
+			spans = append(spans, s)
+		}
+	}
+	if len(spans) != 1 || len(spans[0].Events()) != 1 {
+		t.Fatalf("expected one evaluation event on the request span, got %v", spans)
+	}
+	event := spans[0].Events()[0]
+	attrs := map[string]string{}
+	for _, attr := range event.Attributes {
+		attrs[string(attr.Key)] = attr.Value.Emit()
+	}
+	if event.Name != "feature_flag.evaluation" || attrs["feature_flag.key"] != "beta" ||
+		attrs["feature_flag.result.variant"] != "on" || attrs["feature_flag.result.reason"] != "default" {
+		t.Fatalf("unexpected evaluation event %s %v", event.Name, attrs)
+	}
+}
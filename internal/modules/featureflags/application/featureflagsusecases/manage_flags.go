@@ -0,0 +1,285 @@
+package featureflagsusecases
+
+import (
+	"context"
+	"time"
+
+	"golang_boilerplate_module/internal/modules/featureflags/featureflagsdomain"
+	"golang_boilerplate_module/internal/modules/featureflags/featureflagsdomain/featureflagsrepo"
+	"golang_boilerplate_module/internal/shared/domain/bus"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+
+	"go.opentelemetry.io/otel/attribute"
+	oteltrace "go.opentelemetry.io/otel/trace"
+)
+
+type FlagOutput struct {
+	Key         string                    `json:"key"`
+	Kind        featureflagsdomain.Kind   `json:"kind"`
+	Description string                    `json:"description"`
+	Enabled     bool                      `json:"enabled"`
+	Variants    []string                  `json:"variants"`
+	OffVariant  string                    `json:"off_variant"`
+	Rules       []featureflagsdomain.Rule `json:"rules"`
+	Default     featureflagsdomain.Serve  `json:"default"`
+	UpdatedBy   *uint                     `json:"updated_by"`
+	UpdatedAt   time.Time                 `json:"updated_at"`
+}
+
+func toFlagOutput(f *featureflagsdomain.Flag) FlagOutput {
+	rules := f.Rules
+	if rules == nil {
+		rules = []featureflagsdomain.Rule{}
+	}
+	return FlagOutput{
+		Key:         f.Key,
+		Kind:        f.Kind,
+		Description: f.Description,
+		Enabled:     f.Enabled,
+		Variants:    f.Variants,
+		OffVariant:  f.OffVariant,
+		Rules:       rules,
+		Default:     f.Default,
+		UpdatedBy:   f.UpdatedBy,
+		UpdatedAt:   f.UpdatedAt,
+	}
+}
+
+func requireAdmin(settings *Settings, actorID uint) error {
+	if !settings.IsAdmin(actorID) {
+		return exceptions.NewForbiddenException("Managing feature flags requires an administrator", nil)
+	}
+	return nil
+}
+
+type ListFlagsInput struct {
+	ActorID uint `json:"-"`
+}
+
+type ListFlagsUseCase struct {
+	flags    featureflagsrepo.FlagRepository
+	settings *Settings
+}
+
+func NewListFlagsUseCase(flags featureflagsrepo.FlagRepository, settings *Settings) *ListFlagsUseCase {
+	return &ListFlagsUseCase{flags: flags, settings: settings}
+}
+
+func NewListFlagsHandler(b *bus.Bus, uc *ListFlagsUseCase) bus.Handler[ListFlagsInput, []FlagOutput] {
+	return bus.Query[ListFlagsInput, []FlagOutput](b, "ListFlags", uc)
+}
+
+func (uc *ListFlagsUseCase) Authorize(_ context.Context, input ListFlagsInput) error {
+	return requireAdmin(uc.settings, input.ActorID)
+}
+
+func (uc *ListFlagsUseCase) Handle(ctx context.Context, _ ListFlagsInput) ([]FlagOutput, error) {
+	flags, err := uc.flags.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	outputs := make([]FlagOutput, 0, len(flags))
+	for i := range flags {
+		outputs = append(outputs, toFlagOutput(&flags[i]))
+	}
+	return outputs, nil
+}
+
+type GetFlagInput struct {
+	Key     string `json:"-"`
+	ActorID uint   `json:"-"`
+}
+
+type GetFlagUseCase struct {
+	flags    featureflagsrepo.FlagRepository
+	settings *Settings
+}
+
+func NewGetFlagUseCase(flags featureflagsrepo.FlagRepository, settings *Settings) *GetFlagUseCase {
+	return &GetFlagUseCase{flags: flags, settings: settings}
+}
+
+func NewGetFlagHandler(b *bus.Bus, uc *GetFlagUseCase) bus.Handler[GetFlagInput, FlagOutput] {
+	return bus.Query[GetFlagInput, FlagOutput](b, "GetFlag", uc)
+}
+
+func (uc *GetFlagUseCase) Authorize(_ context.Context, input GetFlagInput) error {
+	return requireAdmin(uc.settings, input.ActorID)
+}
+
+func (uc *GetFlagUseCase) Handle(ctx context.Context, input GetFlagInput) (FlagOutput, error) {
+	oteltrace.SpanFromContext(ctx).SetAttributes(attribute.String("feature_flag.key", input.Key))
+
+	flag, err := uc.flags.GetByKey(ctx, input.Key)
+	if err != nil {
+		return FlagOutput{}, err
+	}
+	return toFlagOutput(flag), nil
+}
+
+type SaveFlagInput struct {
+	Key         string                    `json:"-"`
+	ActorID     uint                      `json:"-"`
+	Kind        featureflagsdomain.Kind   `json:"kind" validate:"oneof=boolean multivariate" doc:"Defaults to boolean, whose variants are on and off"`
+	Description string                    `json:"description"`
+	Enabled     bool                      `json:"enabled"`
+	Variants    []string                  `json:"variants" doc:"Variants of a multivariate flag"`
+	OffVariant  string                    `json:"off_variant" doc:"Served while the flag is disabled; defaults to off or the first variant"`
+	Rules       []featureflagsdomain.Rule `json:"rules" doc:"Targeting rules; the first whose conditions all match is served"`
+	Default     featureflagsdomain.Serve  `json:"default" doc:"Served when no rule matches; defaults to on or the first variant"`
+}
+
+type SaveFlagUseCase struct {
+	flags     featureflagsrepo.FlagRepository
+	evaluator *FlagEvaluator
+	settings  *Settings
+	logger    providers.LoggerProvider
+}
+
+func NewSaveFlagUseCase(
+	flags featureflagsrepo.FlagRepository,
+	evaluator *FlagEvaluator,
+	settings *Settings,
+	logger providers.LoggerProvider,
+) *SaveFlagUseCase {
+	return &SaveFlagUseCase{flags: flags, evaluator: evaluator, settings: settings, logger: logger}
+}
+
+func NewSaveFlagHandler(b *bus.Bus, uc *SaveFlagUseCase) bus.Handler[SaveFlagInput, FlagOutput] {
+	return bus.Command[SaveFlagInput, FlagOutput](b, "SaveFlag", uc)
+}
+
+func (uc *SaveFlagUseCase) Authorize(_ context.Context, input SaveFlagInput) error {
+	return requireAdmin(uc.settings, input.ActorID)
+}
+
+// Handle creates the flag or replaces its definition.
+func (uc *SaveFlagUseCase) Handle(ctx context.Context, input SaveFlagInput) (FlagOutput, error) {
+	oteltrace.SpanFromContext(ctx).SetAttributes(attribute.String("feature_flag.key", input.Key))
+
+	log := bus.Logger(ctx, uc.logger).With("flag", input.Key, "actorId", input.ActorID)
+
+	flag := &featureflagsdomain.Flag{
+		Key:         input.Key,
+		Kind:        input.Kind,
+		Description: input.Description,
+		Enabled:     input.Enabled,
+		Variants:    input.Variants,
+		OffVariant:  input.OffVariant,
+		Rules:       input.Rules,
+		Default:     input.Default,
+		UpdatedBy:   &input.ActorID,
+	}
+	flag.Normalize()
+	if err := flag.Validate(); err != nil {
+		return FlagOutput{}, exceptions.NewBadRequestException("Invalid feature flag: "+err.Error(), nil)
+	}
+
+	saved, err := uc.flags.Save(ctx, flag)
+	if err != nil {
+		return FlagOutput{}, err
+	}
+	uc.evaluator.Invalidate()
+
+	log.Info("feature flag saved", "enabled", saved.Enabled)
+	return toFlagOutput(saved), nil
+}
+
+type SetFlagEnabledInput struct {
+	Key     string `json:"-"`
+	ActorID uint   `json:"-"`
+	Enabled bool   `json:"enabled" doc:"false serves the off variant to everyone"`
+}
+
+type SetFlagEnabledUseCase struct {
+	flags     featureflagsrepo.FlagRepository
+	evaluator *FlagEvaluator
+	settings  *Settings
+	logger    providers.LoggerProvider
+}
+
+func NewSetFlagEnabledUseCase(
+	flags featureflagsrepo.FlagRepository,
+	evaluator *FlagEvaluator,
+	settings *Settings,
+	logger providers.LoggerProvider,
+) *SetFlagEnabledUseCase {
+	return &SetFlagEnabledUseCase{flags: flags, evaluator: evaluator, settings: settings, logger: logger}
+}
+
+func NewSetFlagEnabledHandler(b *bus.Bus, uc *SetFlagEnabledUseCase) bus.Handler[SetFlagEnabledInput, FlagOutput] {
+	return bus.Command[SetFlagEnabledInput, FlagOutput](b, "SetFlagEnabled", uc)
+}
+
+func (uc *SetFlagEnabledUseCase) Authorize(_ context.Context, input SetFlagEnabledInput) error {
+	return requireAdmin(uc.settings, input.ActorID)
+}
+
+// Handle switches a flag on or off without changing its targeting.
+func (uc *SetFlagEnabledUseCase) Handle(ctx context.Context, input SetFlagEnabledInput) (FlagOutput, error) {
+	oteltrace.SpanFromContext(ctx).SetAttributes(
+		attribute.String("feature_flag.key", input.Key),
+		attribute.Bool("feature_flag.enabled", input.Enabled),
+	)
+
+	log := bus.Logger(ctx, uc.logger).With("flag", input.Key, "actorId", input.ActorID)
+
+	flag, err := uc.flags.GetByKey(ctx, input.Key)
+	if err != nil {
+		return FlagOutput{}, err
+	}
+	flag.Enabled = input.Enabled
+	flag.UpdatedBy = &input.ActorID
+
+	saved, err := uc.flags.Save(ctx, flag)
+	if err != nil {
+		return FlagOutput{}, err
+	}
+	uc.evaluator.Invalidate()
+
+	log.Info("feature flag toggled", "enabled", saved.Enabled)
+	return toFlagOutput(saved), nil
+}
+
+type DeleteFlagInput struct {
+	Key     string `json:"-"`
+	ActorID uint   `json:"-"`
+}
+
+type DeleteFlagUseCase struct {
+	flags     featureflagsrepo.FlagRepository
+	evaluator *FlagEvaluator
+	settings  *Settings
+	logger    providers.LoggerProvider
+}
+
+func NewDeleteFlagUseCase(
+	flags featureflagsrepo.FlagRepository,
+	evaluator *FlagEvaluator,
+	settings *Settings,
+	logger providers.LoggerProvider,
+) *DeleteFlagUseCase {
+	return &DeleteFlagUseCase{flags: flags, evaluator: evaluator, settings: settings, logger: logger}
+}
+
+func NewDeleteFlagHandler(b *bus.Bus, uc *DeleteFlagUseCase) bus.Handler[DeleteFlagInput, struct{}] {
+	return bus.Command[DeleteFlagInput, struct{}](b, "DeleteFlag", uc)
+}
+
+func (uc *DeleteFlagUseCase) Authorize(_ context.Context, input DeleteFlagInput) error {
+	return requireAdmin(uc.settings, input.ActorID)
+}
+
+func (uc *DeleteFlagUseCase) Handle(ctx context.Context, input DeleteFlagInput) (struct{}, error) {
+	oteltrace.SpanFromContext(ctx).SetAttributes(attribute.String("feature_flag.key", input.Key))
+
+	if err := uc.flags.DeleteByKey(ctx, input.Key); err != nil {
+		return struct{}{}, err
+	}
+	uc.evaluator.Invalidate()
+
+	bus.Logger(ctx, uc.logger).With("flag", input.Key, "actorId", input.ActorID).Info("feature flag deleted")
+	return struct{}{}, nil
+}
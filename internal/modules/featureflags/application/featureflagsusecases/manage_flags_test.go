@@ -0,0 +1,151 @@
+package featureflagsusecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"golang_boilerplate_module/internal/modules/featureflags/application/featureflagsusecases"
+	"golang_boilerplate_module/internal/modules/featureflags/featureflagsdomain"
+	"golang_boilerplate_module/internal/shared/domain/bus"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+)
+
+func flagsBus() *bus.Bus {
+	return bus.New(bus.Validation(), bus.Authorization())
+}
+
+func expectCode(t *testing.T, err error, code exceptions.ExceptionCode) {
+	t.Helper()
+	var domainErr *exceptions.DomainError
+	if !errors.As(err, &domainErr) {
+		t.Fatalf("expected DomainError, got %T (%v)", err, err)
+	}
+	if domainErr.Code != code {
+		t.Fatalf("expected code=%s, got %s", code, domainErr.Code)
+	}
+}
+
+func TestSaveFlagUseCase_RequiresAnAdministrator(t *testing.T) {
+	repo := flagsWith()
+	settings := flagsSettings("1")
+	evaluator := featureflagsusecases.NewFlagEvaluator(repo, settings, &mockLogger{})
+	uc := featureflagsusecases.NewSaveFlagHandler(flagsBus(), featureflagsusecases.NewSaveFlagUseCase(repo, evaluator, settings, &mockLogger{}))
+
+	_, err := uc.Handle(context.Background(), featureflagsusecases.SaveFlagInput{Key: "beta", ActorID: 2, Enabled: true})
+	expectCode(t, err, exceptions.CodeForbidden)
+
+	settings.Apply(&featureflagsusecases.Config{Admins: []string{"1", "2"}})
+	out, err := uc.Handle(context.Background(), featureflagsusecases.SaveFlagInput{Key: "beta", ActorID: 2, Enabled: true})
+	if err != nil {
+		t.Fatalf("expected no error after the reload, got %v", err)
+	}
+	if out.Kind != featureflagsdomain.KindBoolean || out.OffVariant != featureflagsdomain.VariantOff || out.Default.Variant != featureflagsdomain.VariantOn {
+		t.Fatalf("expected a normalized boolean flag, got %+v", out)
+	}
+	if out.UpdatedBy == nil || *out.UpdatedBy != 2 {
+		t.Fatalf("expected the change to be attributed to user 2, got %v", out.UpdatedBy)
+	}
+}
+
+func TestSaveFlagUseCase_RejectsInvalidRollouts(t *testing.T) {
+	repo := flagsWith()
+	settings := flagsSettings("1")
+	evaluator := featureflagsusecases.NewFlagEvaluator(repo, settings, &mockLogger{})
+	uc := featureflagsusecases.NewSaveFlagHandler(flagsBus(), featureflagsusecases.NewSaveFlagUseCase(repo, evaluator, settings, &mockLogger{}))
+
+	_, err := uc.Handle(context.Background(), featureflagsusecases.SaveFlagInput{
+		Key:     "beta",
+		ActorID: 1,
+		Default: featureflagsdomain.Serve{Rollout: []featureflagsdomain.Weight{
+			{Variant: featureflagsdomain.VariantOn, Weight: 30},
+			{Variant: featureflagsdomain.VariantOff, Weight: 30},
+		}},
+	})
+	expectCode(t, err, exceptions.CodeBadRequest)
+
+	_, err = uc.Handle(context.Background(), featureflagsusecases.SaveFlagInput{
+		Key:      "theme",
+		ActorID:  1,
+		Kind:     featureflagsdomain.KindMultivariate,
+		Variants: []string{"light", "dark"},
+		Default:  featureflagsdomain.Serve{Variant: "neon"},
+	})
+	expectCode(t, err, exceptions.CodeBadRequest)
+	if len(repo.flags) != 0 {
+		t.Fatalf("expected nothing to be saved, got %v", repo.flags)
+	}
+}
+
+func TestSetFlagEnabledUseCase_TogglesAndRefreshesTheCache(t *testing.T) {
+	repo := flagsWith(featureflagsdomain.Flag{Key: "beta", Enabled: false})
+	settings := flagsSettings("1")
+	evaluator := featureflagsusecases.NewFlagEvaluator(repo, settings, &mockLogger{})
+	uc := featureflagsusecases.NewSetFlagEnabledHandler(flagsBus(), featureflagsusecases.NewSetFlagEnabledUseCase(repo, evaluator, settings, &mockLogger{}))
+	ctx := context.Background()
+
+	if evaluator.Bool(ctx, "beta", user(7), true) {
+		t.Fatal("expected the disabled flag to be off")
+	}
+
+	out, err := uc.Handle(ctx, featureflagsusecases.SetFlagEnabledInput{Key: "beta", ActorID: 1, Enabled: true})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !out.Enabled {
+		t.Fatal("expected the flag to be enabled")
+	}
+	if !evaluator.Bool(ctx, "beta", user(7), false) {
+		t.Fatal("expected the evaluator to see the change without waiting for the TTL")
+	}
+
+	_, err = uc.Handle(ctx, featureflagsusecases.SetFlagEnabledInput{Key: "missing", ActorID: 1, Enabled: true})
+	expectCode(t, err, exceptions.CodeNotFound)
+}
+
+func TestManageFlags_RequireAnAdministrator(t *testing.T) {
+	repo := flagsWith(featureflagsdomain.Flag{Key: "beta", Enabled: true})
+	settings := flagsSettings("1")
+	evaluator := featureflagsusecases.NewFlagEvaluator(repo, settings, &mockLogger{})
+	b := flagsBus()
+
+	listFlags := featureflagsusecases.NewListFlagsHandler(b, featureflagsusecases.NewListFlagsUseCase(repo, settings))
+	getFlag := featureflagsusecases.NewGetFlagHandler(b, featureflagsusecases.NewGetFlagUseCase(repo, settings))
+	setFlagEnabled := featureflagsusecases.NewSetFlagEnabledHandler(b, featureflagsusecases.NewSetFlagEnabledUseCase(repo, evaluator, settings, &mockLogger{}))
+	deleteFlag := featureflagsusecases.NewDeleteFlagHandler(b, featureflagsusecases.NewDeleteFlagUseCase(repo, evaluator, settings, &mockLogger{}))
+
+	tests := []struct {
+		name string
+		call func(ctx context.Context, actorID uint) error
+	}{
+		{name: "ListFlags", call: func(ctx context.Context, actorID uint) error {
+			_, err := listFlags.Handle(ctx, featureflagsusecases.ListFlagsInput{ActorID: actorID})
+			return err
+		}},
+		{name: "GetFlag", call: func(ctx context.Context, actorID uint) error {
+			_, err := getFlag.Handle(ctx, featureflagsusecases.GetFlagInput{Key: "beta", ActorID: actorID})
+			return err
+		}},
+		{name: "SetFlagEnabled", call: func(ctx context.Context, actorID uint) error {
+			_, err := setFlagEnabled.Handle(ctx, featureflagsusecases.SetFlagEnabledInput{Key: "beta", ActorID: actorID, Enabled: true})
+			return err
+		}},
+		{name: "DeleteFlag", call: func(ctx context.Context, actorID uint) error {
+			_, err := deleteFlag.Handle(ctx, featureflagsusecases.DeleteFlagInput{Key: "beta", ActorID: actorID})
+			return err
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			expectCode(t, tt.call(ctx, 2), exceptions.CodeForbidden)
+			if _, ok := repo.flags["beta"]; !ok {
+				t.Fatal("expected the refused call to leave the flag in place")
+			}
+			if err := tt.call(ctx, 1); err != nil {
+				t.Fatalf("expected the administrator to pass, got %v", err)
+			}
+			repo.flags["beta"] = featureflagsdomain.Flag{Key: "beta", Enabled: true}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package featureflagsusecases_test
+
+import (
+	"context"
+	"sync"
+
+	"golang_boilerplate_module/internal/modules/featureflags/featureflagsdomain"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+)
+
+type mockFlagRepo struct {
+	mu        sync.Mutex
+	flags     map[string]featureflagsdomain.Flag
+	listErr   error
+	listCalls int
+	onList    func()
+}
+
+func flagsWith(flags ...featureflagsdomain.Flag) *mockFlagRepo {
+	repo := &mockFlagRepo{flags: make(map[string]featureflagsdomain.Flag)}
+	for _, flag := range flags {
+		flag.Normalize()
+		repo.flags[flag.Key] = flag
+	}
+	return repo
+}
+
+func (m *mockFlagRepo) List(ctx context.Context) ([]featureflagsdomain.Flag, error) {
+	m.listCalls++
+	if m.onList != nil {
+		m.onList()
+	}
+	if m.listErr != nil {
+		return nil, m.listErr
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	flags := make([]featureflagsdomain.Flag, 0, len(m.flags))
+	for _, flag := range m.flags {
+		flags = append(flags, flag)
+	}
+	return flags, nil
+}
+
+// set changes a flag while evaluations may be loading the flags.
+func (m *mockFlagRepo) set(flag featureflagsdomain.Flag) {
+	flag.Normalize()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.flags[flag.Key] = flag
+}
+
+func (m *mockFlagRepo) GetByKey(ctx context.Context, key string) (*featureflagsdomain.Flag, error) {
+	flag, ok := m.flags[key]
+	if !ok {
+		return nil, exceptions.NewNotFoundException("Feature flag not found", nil)
+	}
+	return &flag, nil
+}
+
+func (m *mockFlagRepo) Save(ctx context.Context, flag *featureflagsdomain.Flag) (*featureflagsdomain.Flag, error) {
+	m.flags[flag.Key] = *flag
+	return flag, nil
+}
+
+func (m *mockFlagRepo) DeleteByKey(ctx context.Context, key string) error {
+	if _, ok := m.flags[key]; !ok {
+		return exceptions.NewNotFoundException("Feature flag not found", nil)
+	}
+	delete(m.flags, key)
+	return nil
+}
+
+type mockLogger struct{}
+
+func (l *mockLogger) Info(msg string, fields ...any)            {}
+func (l *mockLogger) Warn(msg string, fields ...any)            {}
+func (l *mockLogger) Error(msg string, fields ...any)           {}
+func (l *mockLogger) Debug(msg string, fields ...any)           {}
+func (l *mockLogger) Sync() error                               { return nil }
+func (l *mockLogger) With(args ...any) providers.LoggerProvider { return l }
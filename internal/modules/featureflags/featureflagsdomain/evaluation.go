@@ -0,0 +1,127 @@
+package featureflagsdomain
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"slices"
+	"strings"
+
+	"golang_boilerplate_module/internal/shared/domain/providers"
+)
+
+// Reasons follow the OpenTelemetry feature flag conventions.
+type Reason string
+
+const (
+	ReasonDisabled       Reason = "disabled"
+	ReasonTargetingMatch Reason = "targeting_match"
+	ReasonSplit          Reason = "split"
+	ReasonDefault        Reason = "default"
+	ReasonError          Reason = "error"
+)
+
+// rolloutBuckets gives rollouts a resolution of 0.01%.
+const rolloutBuckets = 10000
+
+type Evaluation struct {
+	Variant string
+	Reason  Reason
+	// Rule is the 1-based index of the matching rule, 0 when none matched.
+	Rule int
+}
+
+func (f *Flag) Evaluate(subject providers.FlagSubject) Evaluation {
+	if !f.Enabled {
+		return Evaluation{Variant: f.OffVariant, Reason: ReasonDisabled}
+	}
+	for i, rule := range f.Rules {
+		if !rule.matches(subject) {
+			continue
+		}
+		variant, split := f.serve(rule.Serve, subject)
+		reason := ReasonTargetingMatch
+		if split {
+			reason = ReasonSplit
+		}
+		return Evaluation{Variant: variant, Reason: reason, Rule: i + 1}
+	}
+	variant, split := f.serve(f.Default, subject)
+	if split {
+		return Evaluation{Variant: variant, Reason: ReasonSplit}
+	}
+	return Evaluation{Variant: variant, Reason: ReasonDefault}
+}
+
+func (f *Flag) serve(serve Serve, subject providers.FlagSubject) (string, bool) {
+	if len(serve.Rollout) == 0 {
+		return serve.Variant, false
+	}
+	bucket := Bucket(f.Key, subject)
+	cumulative := 0
+	for _, weight := range serve.Rollout {
+		cumulative += weight.Weight * rolloutBuckets / 100
+		if bucket < cumulative {
+			return weight.Variant, true
+		}
+	}
+	return serve.Rollout[len(serve.Rollout)-1].Variant, true
+}
+
+// Bucket places the subject in [0, 10000) by hashing the flag key with the
+// user id, or the tenant id for subjects without a user. The same subject
+// always lands in the same bucket of a flag, so raising a rollout percentage
+// only adds subjects, and different flags bucket independently.
+func Bucket(flagKey string, subject providers.FlagSubject) int {
+	id := subject.UserID
+	if id == "" {
+		id = "tenant:" + subject.TenantID
+	}
+	sum := sha256.Sum256([]byte(flagKey + "/" + id))
+	return int(binary.BigEndian.Uint64(sum[:8]) % rolloutBuckets)
+}
+
+func (r Rule) matches(subject providers.FlagSubject) bool {
+	for _, condition := range r.Conditions {
+		if !condition.matches(subject) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c Condition) matches(subject providers.FlagSubject) bool {
+	var value string
+	var ok bool
+	switch c.Attribute {
+	case "user_id":
+		value, ok = subject.UserID, subject.UserID != ""
+	case "tenant_id":
+		value, ok = subject.TenantID, subject.TenantID != ""
+	default:
+		value, ok = subject.Attributes[c.Attribute]
+	}
+	if c.Operator == OperatorNotIn {
+		return !ok || !slices.Contains(c.Values, value)
+	}
+	if !ok {
+		return false
+	}
+
+	for _, expected := range c.Values {
+		var match bool
+		switch c.Operator {
+		case OperatorIn:
+			match = value == expected
+		case OperatorContains:
+			match = strings.Contains(value, expected)
+		case OperatorStartsWith:
+			match = strings.HasPrefix(value, expected)
+		case OperatorEndsWith:
+			match = strings.HasSuffix(value, expected)
+		}
+		if match {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,22 @@
+package featureflagsrepo
+
+import (
+	"context"
+
+	"golang_boilerplate_module/internal/modules/featureflags/featureflagsdomain"
+)
+
+type FlagRepository interface {
+	List(ctx context.Context) ([]featureflagsdomain.Flag, error)
+	GetByKey(ctx context.Context, key string) (*featureflagsdomain.Flag, error)
+	// Save creates the flag or replaces the flag with its key.
+	Save(ctx context.Context, flag *featureflagsdomain.Flag) (*featureflagsdomain.Flag, error)
+	DeleteByKey(ctx context.Context, key string) error
+}
+
+// FlagChanges reports the changes made to the flags by any replica.
+type FlagChanges interface {
+	// Watch calls onChange with the key of each changed flag, or with an
+	// empty key when changes may have been missed, until ctx is canceled.
+	Watch(ctx context.Context, onChange func(key string))
+}
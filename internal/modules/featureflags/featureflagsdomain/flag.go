@@ -0,0 +1,169 @@
+package featureflagsdomain
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"time"
+)
+
+type Kind string
+
+const (
+	KindBoolean      Kind = "boolean"
+	KindMultivariate Kind = "multivariate"
+)
+
+// Boolean flags serve one of these variants.
+const (
+	VariantOn  = "on"
+	VariantOff = "off"
+)
+
+type Operator string
+
+const (
+	OperatorIn         Operator = "in"
+	OperatorNotIn      Operator = "not_in"
+	OperatorContains   Operator = "contains"
+	OperatorStartsWith Operator = "starts_with"
+	OperatorEndsWith   Operator = "ends_with"
+)
+
+var operators = []Operator{OperatorIn, OperatorNotIn, OperatorContains, OperatorStartsWith, OperatorEndsWith}
+
+var flagKeyPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9._-]{0,99}$`)
+
+// Flag is served as OffVariant while disabled. Enabled, it serves the first
+// rule whose conditions all match the subject, or Default.
+type Flag struct {
+	Key         string    `json:"key"`
+	Kind        Kind      `json:"kind"`
+	Description string    `json:"description"`
+	Enabled     bool      `json:"enabled"`
+	Variants    []string  `json:"variants"`
+	OffVariant  string    `json:"off_variant"`
+	Rules       []Rule    `json:"rules"`
+	Default     Serve     `json:"default"`
+	UpdatedBy   *uint     `json:"updated_by,omitempty"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+type Rule struct {
+	Description string      `json:"description,omitempty"`
+	Conditions  []Condition `json:"conditions"`
+	Serve       Serve       `json:"serve"`
+}
+
+// Condition matches an attribute of the subject: user_id, tenant_id or one
+// of its attributes. in and not_in compare the whole value, the others any
+// of Values.
+type Condition struct {
+	Attribute string   `json:"attribute"`
+	Operator  Operator `json:"operator"`
+	Values    []string `json:"values"`
+}
+
+// Serve is a single variant or a percentage rollout across variants.
+type Serve struct {
+	Variant string   `json:"variant,omitempty"`
+	Rollout []Weight `json:"rollout,omitempty"`
+}
+
+// Weight is the percentage of subjects a rollout serves Variant to.
+type Weight struct {
+	Variant string `json:"variant"`
+	Weight  int    `json:"weight"`
+}
+
+func IsValidFlagKey(key string) bool {
+	return flagKeyPattern.MatchString(key)
+}
+
+// Normalize fills what a definition may leave out: the variants of a boolean
+// flag, the off variant (off, or the first variant) and the default (on, or
+// the first variant).
+func (f *Flag) Normalize() {
+	if f.Kind == "" {
+		f.Kind = KindBoolean
+	}
+	if f.Kind == KindBoolean {
+		f.Variants = []string{VariantOn, VariantOff}
+	}
+	if len(f.Variants) == 0 {
+		return
+	}
+	if f.OffVariant == "" {
+		f.OffVariant = f.Variants[0]
+		if f.Kind == KindBoolean {
+			f.OffVariant = VariantOff
+		}
+	}
+	if f.Default.Variant == "" && len(f.Default.Rollout) == 0 {
+		f.Default.Variant = f.Variants[0]
+	}
+}
+
+func (f *Flag) Validate() error {
+	if !IsValidFlagKey(f.Key) {
+		return errors.New("key must be a lowercase slug of up to 100 characters")
+	}
+	if f.Kind != KindBoolean && f.Kind != KindMultivariate {
+		return fmt.Errorf("kind must be %s or %s", KindBoolean, KindMultivariate)
+	}
+	if len(f.Variants) < 2 {
+		return errors.New("a multivariate flag needs at least two variants")
+	}
+	for i, variant := range f.Variants {
+		if variant == "" || slices.Contains(f.Variants[:i], variant) {
+			return fmt.Errorf("variants must be unique and non-empty, got %q", variant)
+		}
+	}
+	if !slices.Contains(f.Variants, f.OffVariant) {
+		return fmt.Errorf("off_variant %q is not a variant", f.OffVariant)
+	}
+	for i, rule := range f.Rules {
+		if len(rule.Conditions) == 0 {
+			return fmt.Errorf("rule %d has no conditions", i+1)
+		}
+		for _, condition := range rule.Conditions {
+			if condition.Attribute == "" || !slices.Contains(operators, condition.Operator) || len(condition.Values) == 0 {
+				return fmt.Errorf("rule %d: conditions need an attribute, an operator (in, not_in, contains, starts_with, ends_with) and values", i+1)
+			}
+		}
+		if err := f.validateServe(rule.Serve); err != nil {
+			return fmt.Errorf("rule %d: %w", i+1, err)
+		}
+	}
+	if err := f.validateServe(f.Default); err != nil {
+		return fmt.Errorf("default: %w", err)
+	}
+	return nil
+}
+
+func (f *Flag) validateServe(serve Serve) error {
+	if (serve.Variant == "") == (len(serve.Rollout) == 0) {
+		return errors.New("serve either a variant or a rollout")
+	}
+	if serve.Variant != "" {
+		if !slices.Contains(f.Variants, serve.Variant) {
+			return fmt.Errorf("%q is not a variant", serve.Variant)
+		}
+		return nil
+	}
+	total := 0
+	for _, weight := range serve.Rollout {
+		if !slices.Contains(f.Variants, weight.Variant) {
+			return fmt.Errorf("%q is not a variant", weight.Variant)
+		}
+		if weight.Weight < 0 {
+			return errors.New("rollout weights must not be negative")
+		}
+		total += weight.Weight
+	}
+	if total != 100 {
+		return fmt.Errorf("rollout weights must add up to 100, got %d", total)
+	}
+	return nil
+}
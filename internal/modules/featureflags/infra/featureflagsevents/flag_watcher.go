@@ -0,0 +1,58 @@
+package featureflagsevents
+
+import (
+	"context"
+
+	"golang_boilerplate_module/internal/config"
+	"golang_boilerplate_module/internal/modules/featureflags/application/featureflagsusecases"
+	"golang_boilerplate_module/internal/modules/featureflags/featureflagsdomain/featureflagsrepo"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+
+	"go.uber.org/fx"
+)
+
+// RegisterFlagWatcher drops the cached flags whenever the store reports a
+// change, so every replica evaluates the new definitions.
+func RegisterFlagWatcher(
+	lc fx.Lifecycle,
+	changes featureflagsrepo.FlagChanges,
+	evaluator *featureflagsusecases.FlagEvaluator,
+	logger providers.LoggerProvider,
+) {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	lc.Append(fx.Hook{
+		OnStart: func(_ context.Context) error {
+			go func() {
+				defer close(done)
+				changes.Watch(ctx, func(key string) {
+					if key != "" {
+						logger.Debug("feature flag changed", "flag", key)
+					}
+					evaluator.Invalidate()
+				})
+			}()
+			logger.Info("Feature flag watcher started")
+			return nil
+		},
+		OnStop: func(stopCtx context.Context) error {
+			cancel()
+			select {
+			case <-done:
+			case <-stopCtx.Done():
+			}
+			return nil
+		},
+	})
+}
+
+// RegisterSettingsReload applies the reloadable settings of the module when
+// the configuration is reloaded.
+func RegisterSettingsReload(reloader *config.Reloader, settings *featureflagsusecases.Settings) {
+	reloader.Subscribe(func(cfg *config.Config) {
+		if section, ok := cfg.Section("featureflags"); ok {
+			settings.Apply(section.(*featureflagsusecases.Config))
+		}
+	}, "featureflags")
+}
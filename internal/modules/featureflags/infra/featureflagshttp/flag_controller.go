@@ -0,0 +1,191 @@
+package featureflagshttp
+
+import (
+	"golang_boilerplate_module/internal/modules/featureflags/application/featureflagsusecases"
+	"golang_boilerplate_module/internal/shared/domain/bus"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+	"golang_boilerplate_module/internal/shared/infra/http/middleware"
+	"golang_boilerplate_module/internal/shared/infra/observability"
+
+	"github.com/gofiber/fiber/v2"
+	"go.opentelemetry.io/otel"
+	oteltrace "go.opentelemetry.io/otel/trace"
+	"go.uber.org/fx"
+)
+
+var tracer = otel.Tracer("featureflags.http")
+
+type FlagControllerParams struct {
+	fx.In
+
+	ListFlags      bus.Handler[featureflagsusecases.ListFlagsInput, []featureflagsusecases.FlagOutput]
+	GetFlag        bus.Handler[featureflagsusecases.GetFlagInput, featureflagsusecases.FlagOutput]
+	SaveFlag       bus.Handler[featureflagsusecases.SaveFlagInput, featureflagsusecases.FlagOutput]
+	SetFlagEnabled bus.Handler[featureflagsusecases.SetFlagEnabledInput, featureflagsusecases.FlagOutput]
+	DeleteFlag     bus.Handler[featureflagsusecases.DeleteFlagInput, struct{}]
+	EvaluateFlags  *featureflagsusecases.EvaluateFlagsUseCase
+	Logger         providers.LoggerProvider
+}
+
+type FlagController struct {
+	usecases FlagControllerParams
+	logger   providers.LoggerProvider
+}
+
+func NewFlagController(params FlagControllerParams) *FlagController {
+	return &FlagController{usecases: params, logger: params.Logger}
+}
+
+func (ctrl *FlagController) ListFlags(c *fiber.Ctx) error {
+	ctx, span := tracer.Start(c.UserContext(), "FlagController.ListFlags")
+	defer span.End()
+
+	log := middleware.LoggerFromLocals(c, ctrl.logger).With("handler", "FlagController.ListFlags")
+
+	actorID, err := requireActor(c, span, log)
+	if err != nil {
+		return err
+	}
+
+	outputs, err := ctrl.usecases.ListFlags.Handle(ctx, featureflagsusecases.ListFlagsInput{ActorID: actorID})
+	if err != nil {
+		observability.RecordError(span, err)
+		return err
+	}
+
+	return c.JSON(outputs)
+}
+
+func (ctrl *FlagController) GetFlag(c *fiber.Ctx) error {
+	ctx, span := tracer.Start(c.UserContext(), "FlagController.GetFlag")
+	defer span.End()
+
+	log := middleware.LoggerFromLocals(c, ctrl.logger).With("handler", "FlagController.GetFlag")
+
+	actorID, err := requireActor(c, span, log)
+	if err != nil {
+		return err
+	}
+
+	output, err := ctrl.usecases.GetFlag.Handle(ctx, featureflagsusecases.GetFlagInput{Key: c.Params("key"), ActorID: actorID})
+	if err != nil {
+		observability.RecordError(span, err)
+		return err
+	}
+
+	return c.JSON(output)
+}
+
+func (ctrl *FlagController) SaveFlag(c *fiber.Ctx) error {
+	ctx, span := tracer.Start(c.UserContext(), "FlagController.SaveFlag")
+	defer span.End()
+
+	log := middleware.LoggerFromLocals(c, ctrl.logger).With("handler", "FlagController.SaveFlag")
+
+	actorID, err := requireActor(c, span, log)
+	if err != nil {
+		return err
+	}
+
+	var input featureflagsusecases.SaveFlagInput
+	if err := parseBody(c, &input, span, log); err != nil {
+		return err
+	}
+	input.Key = c.Params("key")
+	input.ActorID = actorID
+
+	output, err := ctrl.usecases.SaveFlag.Handle(ctx, input)
+	if err != nil {
+		observability.RecordError(span, err)
+		return err
+	}
+
+	return c.JSON(output)
+}
+
+func (ctrl *FlagController) SetFlagEnabled(c *fiber.Ctx) error {
+	ctx, span := tracer.Start(c.UserContext(), "FlagController.SetFlagEnabled")
+	defer span.End()
+
+	log := middleware.LoggerFromLocals(c, ctrl.logger).With("handler", "FlagController.SetFlagEnabled")
+
+	actorID, err := requireActor(c, span, log)
+	if err != nil {
+		return err
+	}
+
+	var input featureflagsusecases.SetFlagEnabledInput
+	if err := parseBody(c, &input, span, log); err != nil {
+		return err
+	}
+	input.Key = c.Params("key")
+	input.ActorID = actorID
+
+	output, err := ctrl.usecases.SetFlagEnabled.Handle(ctx, input)
+	if err != nil {
+		observability.RecordError(span, err)
+		return err
+	}
+
+	return c.JSON(output)
+}
+
+func (ctrl *FlagController) DeleteFlag(c *fiber.Ctx) error {
+	ctx, span := tracer.Start(c.UserContext(), "FlagController.DeleteFlag")
+	defer span.End()
+
+	log := middleware.LoggerFromLocals(c, ctrl.logger).With("handler", "FlagController.DeleteFlag")
+
+	actorID, err := requireActor(c, span, log)
+	if err != nil {
+		return err
+	}
+
+	if _, err := ctrl.usecases.DeleteFlag.Handle(ctx, featureflagsusecases.DeleteFlagInput{Key: c.Params("key"), ActorID: actorID}); err != nil {
+		observability.RecordError(span, err)
+		return err
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func (ctrl *FlagController) EvaluateFlags(c *fiber.Ctx) error {
+	ctx, span := tracer.Start(c.UserContext(), "FlagController.EvaluateFlags")
+	defer span.End()
+
+	log := middleware.LoggerFromLocals(c, ctrl.logger).With("handler", "FlagController.EvaluateFlags")
+
+	userID, err := requireActor(c, span, log)
+	if err != nil {
+		return err
+	}
+
+	outputs, err := ctrl.usecases.EvaluateFlags.Execute(ctx, userID)
+	if err != nil {
+		observability.RecordError(span, err)
+		return err
+	}
+
+	return c.JSON(outputs)
+}
+
+func requireActor(c *fiber.Ctx, span oteltrace.Span, log providers.LoggerProvider) (uint, error) {
+	actorID, err := middleware.RequireCurrentUserID(c)
+	if err != nil {
+		log.Warn("unauthenticated request")
+		observability.RecordError(span, err)
+		return 0, err
+	}
+	return actorID, nil
+}
+
+func parseBody(c *fiber.Ctx, out any, span oteltrace.Span, log providers.LoggerProvider) error {
+	if err := c.BodyParser(out); err != nil {
+		domainErr := exceptions.NewBadRequestException("Invalid request body", nil)
+		log.Warn("failed to parse request body", "error", err.Error())
+		observability.RecordError(span, domainErr)
+		return domainErr
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package featureflagshttp
+
+import (
+	"golang_boilerplate_module/internal/modules/featureflags/application/featureflagsusecases"
+	"golang_boilerplate_module/internal/shared/infra/http/openapi"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func RegisterRoutes(router *openapi.Router, controller *FlagController) {
+	key := openapi.PathParam("key", "string", "Flag key")
+	adminErrors := openapi.Errors(fiber.StatusForbidden, fiber.StatusNotFound)
+
+	api := router.Version("v1", openapi.Tags("Feature flags")).Group("/feature-flags", openapi.Authenticated())
+	api.Get("/evaluations", controller.EvaluateFlags,
+		openapi.Summary("Evaluate every flag for the current user"),
+		openapi.Returns[[]featureflagsusecases.EvaluationOutput](fiber.StatusOK),
+	)
+	api.Get("/", controller.ListFlags,
+		openapi.Summary("List the feature flags"),
+		openapi.Description("Requires an administrator (FEATURE_FLAGS_ADMINS)."),
+		openapi.Returns[[]featureflagsusecases.FlagOutput](fiber.StatusOK),
+		openapi.Errors(fiber.StatusForbidden),
+	)
+	api.Get("/:key", controller.GetFlag,
+		openapi.Summary("Get a feature flag"),
+		openapi.Description("Requires an administrator (FEATURE_FLAGS_ADMINS)."),
+		key,
+		openapi.Returns[featureflagsusecases.FlagOutput](fiber.StatusOK),
+		adminErrors,
+	)
+	api.Put("/:key", controller.SaveFlag,
+		openapi.Summary("Create or replace a feature flag"),
+		openapi.Description("Requires an administrator (FEATURE_FLAGS_ADMINS). The file store rejects changes."),
+		key,
+		openapi.Body[featureflagsusecases.SaveFlagInput](),
+		openapi.Returns[featureflagsusecases.FlagOutput](fiber.StatusOK),
+		openapi.Errors(fiber.StatusBadRequest, fiber.StatusForbidden, fiber.StatusUnprocessableEntity),
+	)
+	api.Patch("/:key", controller.SetFlagEnabled,
+		openapi.Summary("Switch a feature flag on or off"),
+		openapi.Description("Requires an administrator (FEATURE_FLAGS_ADMINS). The targeting is kept."),
+		key,
+		openapi.Body[featureflagsusecases.SetFlagEnabledInput](),
+		openapi.Returns[featureflagsusecases.FlagOutput](fiber.StatusOK),
+		openapi.Errors(fiber.StatusBadRequest, fiber.StatusForbidden, fiber.StatusNotFound, fiber.StatusUnprocessableEntity),
+	)
+	api.Delete("/:key", controller.DeleteFlag,
+		openapi.Summary("Delete a feature flag"),
+		openapi.Description("Requires an administrator (FEATURE_FLAGS_ADMINS)."),
+		key,
+		openapi.NoContent(),
+		openapi.Errors(fiber.StatusForbidden, fiber.StatusNotFound, fiber.StatusUnprocessableEntity),
+	)
+}
@@ -0,0 +1,132 @@
+package featureflagspersistence
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"golang_boilerplate_module/internal/modules/featureflags/featureflagsdomain"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+
+	"go.yaml.in/yaml/v3"
+)
+
+// FileFlagStore reads the flags from a YAML or JSON file holding a list
+// under flags. It is read-only: flags change by editing the file, which each
+// replica watches on its own.
+type FileFlagStore struct {
+	path     string
+	interval time.Duration
+}
+
+type flagFile struct {
+	Flags []featureflagsdomain.Flag `json:"flags"`
+}
+
+func NewFileFlagStore(path string, interval time.Duration) *FileFlagStore {
+	return &FileFlagStore{path: path, interval: interval}
+}
+
+func (s *FileFlagStore) List(_ context.Context) ([]featureflagsdomain.Flag, error) {
+	flags, err := s.read()
+	if err != nil {
+		return nil, exceptions.NewInternalException(map[string]any{"error": err.Error()})
+	}
+	return flags, nil
+}
+
+func (s *FileFlagStore) GetByKey(ctx context.Context, key string) (*featureflagsdomain.Flag, error) {
+	flags, err := s.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range flags {
+		if flags[i].Key == key {
+			return &flags[i], nil
+		}
+	}
+	return nil, exceptions.NewNotFoundException("Feature flag not found", map[string]any{"key": key})
+}
+
+func (s *FileFlagStore) Save(_ context.Context, _ *featureflagsdomain.Flag) (*featureflagsdomain.Flag, error) {
+	return nil, readOnly()
+}
+
+func (s *FileFlagStore) DeleteByKey(_ context.Context, _ string) error {
+	return readOnly()
+}
+
+// Watch reports an empty key whenever the file's size or modification time
+// changes.
+func (s *FileFlagStore) Watch(ctx context.Context, onChange func(key string)) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	last := s.stamp()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if stamp := s.stamp(); stamp != last {
+			last = stamp
+			onChange("")
+		}
+	}
+}
+
+func (s *FileFlagStore) stamp() string {
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return ""
+	}
+	return fmt.Sprintf("%d/%d", info.Size(), info.ModTime().UnixNano())
+}
+
+// read decodes the file as YAML, which JSON is a subset of, and checks every
+// flag like the API does.
+func (s *FileFlagStore) read() ([]featureflagsdomain.Flag, error) {
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return nil, err
+	}
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw any
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("%s: %w", s.path, err)
+	}
+	encoded, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", s.path, err)
+	}
+	var file flagFile
+	if err := json.Unmarshal(encoded, &file); err != nil {
+		return nil, fmt.Errorf("%s: %w", s.path, err)
+	}
+
+	seen := make(map[string]bool, len(file.Flags))
+	for i := range file.Flags {
+		flag := &file.Flags[i]
+		flag.Normalize()
+		if err := flag.Validate(); err != nil {
+			return nil, fmt.Errorf("%s: flag %q: %w", s.path, flag.Key, err)
+		}
+		if seen[flag.Key] {
+			return nil, fmt.Errorf("%s: flag %q is defined twice", s.path, flag.Key)
+		}
+		seen[flag.Key] = true
+		flag.UpdatedAt = info.ModTime()
+	}
+	return file.Flags, nil
+}
+
+func readOnly() error {
+	return exceptions.NewUnprocessableException("Feature flags are read from a file and cannot be changed through the API", nil)
+}
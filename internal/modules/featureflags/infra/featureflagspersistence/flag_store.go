@@ -0,0 +1,32 @@
+package featureflagspersistence
+
+import (
+	"errors"
+
+	"golang_boilerplate_module/internal/config"
+	"golang_boilerplate_module/internal/modules/featureflags/application/featureflagsusecases"
+	"golang_boilerplate_module/internal/modules/featureflags/featureflagsdomain/featureflagsrepo"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+
+	"gorm.io/gorm"
+)
+
+type FlagStore interface {
+	featureflagsrepo.FlagRepository
+	featureflagsrepo.FlagChanges
+}
+
+func NewFlagStore(
+	flagsCfg *featureflagsusecases.Config,
+	cfg *config.Config,
+	db *gorm.DB,
+	logger providers.LoggerProvider,
+) (FlagStore, error) {
+	if flagsCfg.Store == "file" {
+		if flagsCfg.File == "" {
+			return nil, errors.New("featureflags: FEATURE_FLAGS_FILE is required by the file store")
+		}
+		return NewFileFlagStore(flagsCfg.File, flagsCfg.FileWatchInterval), nil
+	}
+	return NewPostgresFlagStore(db, cfg.Database.URL, logger), nil
+}
@@ -0,0 +1,215 @@
+package featureflagspersistence
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"golang_boilerplate_module/internal/modules/featureflags/featureflagsdomain"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+	"golang_boilerplate_module/internal/shared/infra/observability"
+	"golang_boilerplate_module/internal/shared/infra/persistence"
+
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	notifyChannel      = "feature_flags"
+	listenerRetryDelay = 2 * time.Second
+)
+
+var dbTracer = otel.Tracer("featureflags.persistence")
+
+type flagRecord struct {
+	Key          string                    `gorm:"primarykey"`
+	Kind         string                    `gorm:"not null"`
+	Description  string                    `gorm:"not null"`
+	Enabled      bool                      `gorm:"not null"`
+	Variants     []string                  `gorm:"serializer:json"`
+	OffVariant   string                    `gorm:"not null"`
+	Rules        []featureflagsdomain.Rule `gorm:"serializer:json"`
+	DefaultServe featureflagsdomain.Serve  `gorm:"serializer:json"`
+	UpdatedBy    *uint
+	UpdatedAt    time.Time
+}
+
+func (flagRecord) TableName() string {
+	return "feature_flags"
+}
+
+func toFlagRecord(f *featureflagsdomain.Flag) flagRecord {
+	return flagRecord{
+		Key:          f.Key,
+		Kind:         string(f.Kind),
+		Description:  f.Description,
+		Enabled:      f.Enabled,
+		Variants:     f.Variants,
+		OffVariant:   f.OffVariant,
+		Rules:        f.Rules,
+		DefaultServe: f.Default,
+		UpdatedBy:    f.UpdatedBy,
+	}
+}
+
+func (r flagRecord) toDomain() featureflagsdomain.Flag {
+	return featureflagsdomain.Flag{
+		Key:         r.Key,
+		Kind:        featureflagsdomain.Kind(r.Kind),
+		Description: r.Description,
+		Enabled:     r.Enabled,
+		Variants:    r.Variants,
+		OffVariant:  r.OffVariant,
+		Rules:       r.Rules,
+		Default:     r.DefaultServe,
+		UpdatedBy:   r.UpdatedBy,
+		UpdatedAt:   r.UpdatedAt,
+	}
+}
+
+// PostgresFlagStore keeps the flags in the feature_flags table. Its NOTIFY
+// trigger reports every change to the listeners of all replicas.
+type PostgresFlagStore struct {
+	db          *gorm.DB
+	databaseURL string
+	logger      providers.LoggerProvider
+}
+
+func NewPostgresFlagStore(db *gorm.DB, databaseURL string, logger providers.LoggerProvider) *PostgresFlagStore {
+	return &PostgresFlagStore{db: db, databaseURL: databaseURL, logger: logger}
+}
+
+func (s *PostgresFlagStore) List(ctx context.Context) ([]featureflagsdomain.Flag, error) {
+	ctx, span := dbTracer.Start(ctx, "PostgresFlagStore.List")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("db.operation", "List"))
+
+	var records []flagRecord
+	if err := s.db.WithContext(ctx).Order("key").Find(&records).Error; err != nil {
+		observability.RecordError(span, err)
+		return nil, exceptions.NewInternalException(map[string]any{"error": err.Error()})
+	}
+
+	flags := make([]featureflagsdomain.Flag, 0, len(records))
+	for _, record := range records {
+		flags = append(flags, record.toDomain())
+	}
+	return flags, nil
+}
+
+func (s *PostgresFlagStore) GetByKey(ctx context.Context, key string) (*featureflagsdomain.Flag, error) {
+	ctx, span := dbTracer.Start(ctx, "PostgresFlagStore.GetByKey")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.operation", "GetByKey"),
+		attribute.String("feature_flag.key", key),
+	)
+
+	var record flagRecord
+	err := s.db.WithContext(ctx).Where("key = ?", key).First(&record).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		domainErr := exceptions.NewNotFoundException("Feature flag not found", map[string]any{"key": key})
+		observability.RecordError(span, domainErr)
+		return nil, domainErr
+	}
+	if err != nil {
+		observability.RecordError(span, err)
+		return nil, exceptions.NewInternalException(map[string]any{"error": err.Error()})
+	}
+
+	flag := record.toDomain()
+	return &flag, nil
+}
+
+func (s *PostgresFlagStore) Save(ctx context.Context, flag *featureflagsdomain.Flag) (*featureflagsdomain.Flag, error) {
+	ctx, span := dbTracer.Start(ctx, "PostgresFlagStore.Save")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.operation", "Save"),
+		attribute.String("feature_flag.key", flag.Key),
+	)
+
+	record := toFlagRecord(flag)
+	if err := s.db.WithContext(ctx).Clauses(clause.OnConflict{UpdateAll: true}).Create(&record).Error; err != nil {
+		observability.RecordError(span, err)
+		return nil, exceptions.NewInternalException(map[string]any{"error": err.Error()})
+	}
+
+	saved := record.toDomain()
+	return &saved, nil
+}
+
+func (s *PostgresFlagStore) DeleteByKey(ctx context.Context, key string) error {
+	ctx, span := dbTracer.Start(ctx, "PostgresFlagStore.DeleteByKey")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.operation", "DeleteByKey"),
+		attribute.String("feature_flag.key", key),
+	)
+
+	result := s.db.WithContext(ctx).Where("key = ?", key).Delete(&flagRecord{})
+	if result.Error != nil {
+		observability.RecordError(span, result.Error)
+		return exceptions.NewInternalException(map[string]any{"error": result.Error.Error()})
+	}
+	if result.RowsAffected == 0 {
+		domainErr := exceptions.NewNotFoundException("Feature flag not found", map[string]any{"key": key})
+		observability.RecordError(span, domainErr)
+		return domainErr
+	}
+	return nil
+}
+
+// Watch listens on the feature_flags channel, reconnecting after failures.
+// Changes made while disconnected are unknown, so every connection starts by
+// reporting an empty key. A database without LISTEN only reports that; the
+// process's own changes invalidate the flags as they are made.
+func (s *PostgresFlagStore) Watch(ctx context.Context, onChange func(key string)) {
+	if !persistence.Supports(s.db, persistence.ListenNotify) {
+		onChange("")
+		<-ctx.Done()
+		return
+	}
+	for {
+		err := s.listenOnce(ctx, onChange)
+		if ctx.Err() != nil {
+			return
+		}
+		s.logger.Error("feature flag listener disconnected", "error", err.Error(), "retryIn", listenerRetryDelay.String())
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(listenerRetryDelay):
+		}
+	}
+}
+
+func (s *PostgresFlagStore) listenOnce(ctx context.Context, onChange func(key string)) error {
+	conn, err := pgx.Connect(ctx, s.databaseURL)
+	if err != nil {
+		return err
+	}
+	defer conn.Close(context.Background())
+
+	if _, err := conn.Exec(ctx, "LISTEN "+notifyChannel); err != nil {
+		return err
+	}
+	onChange("")
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+		onChange(notification.Payload)
+	}
+}
@@ -0,0 +1,51 @@
+package featureflags
+
+import (
+	"golang_boilerplate_module/internal/modules/featureflags/application/featureflagsusecases"
+	"golang_boilerplate_module/internal/modules/featureflags/featureflagsdomain/featureflagsrepo"
+	"golang_boilerplate_module/internal/modules/featureflags/infra/featureflagsevents"
+	"golang_boilerplate_module/internal/modules/featureflags/infra/featureflagshttp"
+	"golang_boilerplate_module/internal/modules/featureflags/infra/featureflagspersistence"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+	"golang_boilerplate_module/internal/shared/infra/platform"
+
+	"go.uber.org/fx"
+)
+
+var Module = platform.Module{
+	Name:         "featureflags",
+	Description:  "Feature flags with targeting rules and percentage rollouts",
+	ConfigPrefix: "FEATURE_FLAGS_",
+	Config:       &featureflagsusecases.Config{},
+	Migrations:   []string{"V8__create_feature_flags.sql"},
+	Providers: []any{
+		fx.Annotate(
+			featureflagspersistence.NewFlagStore,
+			fx.As(new(featureflagsrepo.FlagRepository)),
+			fx.As(new(featureflagsrepo.FlagChanges)),
+		),
+		featureflagsusecases.NewSettings,
+		fx.Annotate(
+			featureflagsusecases.NewFlagEvaluator,
+			fx.As(fx.Self()),
+			fx.As(new(providers.FeatureFlags)),
+		),
+		featureflagsusecases.NewListFlagsUseCase,
+		featureflagsusecases.NewListFlagsHandler,
+		featureflagsusecases.NewGetFlagUseCase,
+		featureflagsusecases.NewGetFlagHandler,
+		featureflagsusecases.NewSaveFlagUseCase,
+		featureflagsusecases.NewSaveFlagHandler,
+		featureflagsusecases.NewSetFlagEnabledUseCase,
+		featureflagsusecases.NewSetFlagEnabledHandler,
+		featureflagsusecases.NewDeleteFlagUseCase,
+		featureflagsusecases.NewDeleteFlagHandler,
+		featureflagsusecases.NewEvaluateFlagsUseCase,
+		featureflagshttp.NewFlagController,
+	},
+	Routes: []any{featureflagshttp.RegisterRoutes},
+	EventHandlers: []any{
+		featureflagsevents.RegisterFlagWatcher,
+		featureflagsevents.RegisterSettingsReload,
+	},
+}
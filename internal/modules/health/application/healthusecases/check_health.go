@@ -4,13 +4,15 @@ import (
 	"context"
 
 	"golang_boilerplate_module/internal/modules/health/healthdomain"
+	"golang_boilerplate_module/internal/shared/domain/bus"
 	"golang_boilerplate_module/internal/shared/domain/providers"
-	"golang_boilerplate_module/internal/shared/infra/observability"
 
 	"go.opentelemetry.io/otel/attribute"
-	"go.opentelemetry.io/otel/codes"
+	oteltrace "go.opentelemetry.io/otel/trace"
 )
 
+type CheckHealthInput struct{}
+
 type CheckHealthOutput struct {
 	Status healthdomain.HealthStatus `json:"status"`
 }
@@ -23,17 +25,15 @@ func NewCheckHealthUseCase(logger providers.LoggerProvider) *CheckHealthUseCase
 	return &CheckHealthUseCase{logger: logger}
 }
 
-func (uc *CheckHealthUseCase) Execute(ctx context.Context) CheckHealthOutput {
-	ctx, span := healthTracer.Start(ctx, "CheckHealthUseCase.Execute")
-	defer span.End()
-
-	log := observability.LoggerWithTrace(ctx, uc.logger).With("usecase", "CheckHealth")
+func NewCheckHealthHandler(b *bus.Bus, uc *CheckHealthUseCase) bus.Handler[CheckHealthInput, CheckHealthOutput] {
+	return bus.Query[CheckHealthInput, CheckHealthOutput](b, "CheckHealth", uc)
+}
 
+func (uc *CheckHealthUseCase) Handle(ctx context.Context, _ CheckHealthInput) (CheckHealthOutput, error) {
 	output := CheckHealthOutput{Status: healthdomain.HealthStatusHealthy}
 
-	span.SetAttributes(attribute.String("health.status", string(output.Status)))
-	span.SetStatus(codes.Ok, "liveness ok")
-	log.Debug("liveness check called", "status", output.Status)
+	oteltrace.SpanFromContext(ctx).SetAttributes(attribute.String("health.status", string(output.Status)))
+	bus.Logger(ctx, uc.logger).Debug("liveness check called", "status", output.Status)
 
-	return output
+	return output, nil
 }
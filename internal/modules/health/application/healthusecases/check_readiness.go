@@ -2,73 +2,106 @@ package healthusecases
 
 import (
 	"context"
+	"errors"
 
 	"golang_boilerplate_module/internal/modules/health/healthdomain"
-	"golang_boilerplate_module/internal/modules/health/healthdomain/healthrepo"
+	"golang_boilerplate_module/internal/shared/domain/bus"
 	"golang_boilerplate_module/internal/shared/domain/exceptions"
 	"golang_boilerplate_module/internal/shared/domain/providers"
-	"golang_boilerplate_module/internal/shared/infra/observability"
 
-	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
-	"go.opentelemetry.io/otel/codes"
+	oteltrace "go.opentelemetry.io/otel/trace"
+	"go.uber.org/fx"
 )
 
-var healthTracer = otel.Tracer("health")
+type CheckReadinessInput struct{}
 
 type ComponentHealth struct {
-	Status healthdomain.HealthStatus `json:"status"`
+	Status  healthdomain.HealthStatus `json:"status"`
+	Details map[string]any            `json:"details,omitempty"`
 }
 
 type CheckReadinessOutput struct {
-	Status     healthdomain.HealthStatus        `json:"status"`
+	Status     healthdomain.HealthStatus  `json:"status"`
 	Components map[string]ComponentHealth `json:"components"`
 }
 
-type CheckReadinessUseCase struct {
-	healthRepo healthrepo.HealthRepository
-	logger     providers.LoggerProvider
-}
+type CheckReadinessParams struct {
+	fx.In
 
-func NewCheckReadinessUseCase(healthRepo healthrepo.HealthRepository, logger providers.LoggerProvider) *CheckReadinessUseCase {
-	return &CheckReadinessUseCase{healthRepo: healthRepo, logger: logger}
+	Checkers []providers.HealthChecker `group:"health_checkers"`
+	Logger   providers.LoggerProvider
 }
 
-func (uc *CheckReadinessUseCase) Execute(ctx context.Context) (CheckReadinessOutput, error) {
-	ctx, span := healthTracer.Start(ctx, "CheckReadinessUseCase.Execute")
-	defer span.End()
+type CheckReadinessUseCase struct {
+	checkers []providers.HealthChecker
+	logger   providers.LoggerProvider
+}
 
-	log := observability.LoggerWithTrace(ctx, uc.logger).With("usecase", "CheckReadiness")
+func NewCheckReadinessUseCase(p CheckReadinessParams) *CheckReadinessUseCase {
+	return &CheckReadinessUseCase{checkers: p.Checkers, logger: p.Logger}
+}
 
-	dbPing, _ := uc.healthRepo.Ping(ctx)
+func NewCheckReadinessHandler(b *bus.Bus, uc *CheckReadinessUseCase) bus.Handler[CheckReadinessInput, CheckReadinessOutput] {
+	return bus.Query[CheckReadinessInput, CheckReadinessOutput](b, "CheckReadiness", uc, bus.WithoutRetry())
+}
 
-	dbStatus := healthdomain.ToHealthStatus(dbPing)
-	span.SetAttributes(attribute.String("health.database.status", string(dbStatus)))
+func (uc *CheckReadinessUseCase) Handle(ctx context.Context, _ CheckReadinessInput) (CheckReadinessOutput, error) {
+	span := oteltrace.SpanFromContext(ctx)
+	log := bus.Logger(ctx, uc.logger)
+
+	overall := healthdomain.HealthStatusHealthy
+	components := make(map[string]ComponentHealth, len(uc.checkers))
+	for _, checker := range uc.checkers {
+		var details map[string]any
+		var err error
+		if detailed, ok := checker.(providers.DetailedHealthChecker); ok {
+			details, err = detailed.CheckDetails(ctx)
+		} else {
+			err = checker.Check(ctx)
+		}
+		if err != nil {
+			log.Warn("health check failed", "component", checker.Name(), "error", err.Error())
+		}
 
-	components := map[string]ComponentHealth{
-		"database": {Status: dbStatus},
+		status := componentStatus(err)
+		if status == healthdomain.HealthStatusDegraded {
+			overall = status
+		}
+		span.SetAttributes(attribute.String("health."+checker.Name()+".status", string(status)))
+		components[checker.Name()] = ComponentHealth{Status: status, Details: details}
 	}
 
 	for name, component := range components {
 		if component.Status == healthdomain.HealthStatusUnhealthy {
-			err := exceptions.NewServiceUnavailableException(
-				"Readiness check detected unhealthy components",
-				map[string]any{"component": name},
-			)
 			log.Error("readiness check failed — unhealthy component",
 				"component", name,
 				"status", component.Status,
 			)
-			observability.RecordError(span, err)
-			return CheckReadinessOutput{}, err
+			return CheckReadinessOutput{}, exceptions.NewServiceUnavailableException(
+				"Readiness check detected unhealthy components",
+				map[string]any{"component": name},
+			)
 		}
 	}
 
-	span.SetStatus(codes.Ok, "all components healthy")
 	log.Info("readiness check passed", "components", len(components))
 
 	return CheckReadinessOutput{
-		Status:     healthdomain.HealthStatusHealthy,
+		Status:     overall,
 		Components: components,
 	}, nil
 }
+
+// componentStatus tells a dependency that is down from one that only serves
+// with reduced capacity, which does not fail readiness.
+func componentStatus(err error) healthdomain.HealthStatus {
+	switch {
+	case err == nil:
+		return healthdomain.HealthStatusHealthy
+	case errors.Is(err, providers.ErrDegraded):
+		return healthdomain.HealthStatusDegraded
+	default:
+		return healthdomain.HealthStatusUnhealthy
+	}
+}
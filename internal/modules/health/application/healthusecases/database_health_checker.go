@@ -0,0 +1,34 @@
+package healthusecases
+
+import (
+	"context"
+	"errors"
+
+	"golang_boilerplate_module/internal/modules/health/healthdomain/healthrepo"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+)
+
+var errDatabaseUnreachable = errors.New("database ping failed")
+
+type DatabaseHealthChecker struct {
+	healthRepo healthrepo.HealthRepository
+}
+
+func NewDatabaseHealthChecker(healthRepo healthrepo.HealthRepository) providers.HealthChecker {
+	return &DatabaseHealthChecker{healthRepo: healthRepo}
+}
+
+func (c *DatabaseHealthChecker) Name() string {
+	return "database"
+}
+
+func (c *DatabaseHealthChecker) Check(ctx context.Context) error {
+	ok, err := c.healthRepo.Ping(ctx)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errDatabaseUnreachable
+	}
+	return nil
+}
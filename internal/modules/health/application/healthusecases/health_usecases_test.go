@@ -4,15 +4,17 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"golang_boilerplate_module/internal/modules/health/application/healthusecases"
 	"golang_boilerplate_module/internal/modules/health/healthdomain"
 	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/domain/providers"
 )
 
 func TestCheckHealthUseCase_AlwaysHealthy(t *testing.T) {
 	uc := healthusecases.NewCheckHealthUseCase(&mockLogger{})
-	out := uc.Execute(context.Background())
+	out, _ := uc.Handle(context.Background(), healthusecases.CheckHealthInput{})
 
 	if out.Status != healthdomain.HealthStatusHealthy {
 		t.Fatalf("expected status=healthy, got %q", out.Status)
@@ -26,8 +28,11 @@ func TestCheckReadinessUseCase_DatabaseHealthy(t *testing.T) {
 		},
 	}
 
-	uc := healthusecases.NewCheckReadinessUseCase(repo, &mockLogger{})
-	out, err := uc.Execute(context.Background())
+	uc := healthusecases.NewCheckReadinessUseCase(healthusecases.CheckReadinessParams{
+		Checkers: []providers.HealthChecker{healthusecases.NewDatabaseHealthChecker(repo)},
+		Logger:   &mockLogger{},
+	})
+	out, err := uc.Handle(context.Background(), healthusecases.CheckReadinessInput{})
 
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
@@ -51,8 +56,11 @@ func TestCheckReadinessUseCase_DatabaseUnhealthy(t *testing.T) {
 		},
 	}
 
-	uc := healthusecases.NewCheckReadinessUseCase(repo, &mockLogger{})
-	_, err := uc.Execute(context.Background())
+	uc := healthusecases.NewCheckReadinessUseCase(healthusecases.CheckReadinessParams{
+		Checkers: []providers.HealthChecker{healthusecases.NewDatabaseHealthChecker(repo)},
+		Logger:   &mockLogger{},
+	})
+	_, err := uc.Handle(context.Background(), healthusecases.CheckReadinessInput{})
 
 	if err == nil {
 		t.Fatal("expected error for unhealthy database, got nil")
@@ -74,8 +82,11 @@ func TestCheckReadinessUseCase_DatabasePingFalse(t *testing.T) {
 		},
 	}
 
-	uc := healthusecases.NewCheckReadinessUseCase(repo, &mockLogger{})
-	_, err := uc.Execute(context.Background())
+	uc := healthusecases.NewCheckReadinessUseCase(healthusecases.CheckReadinessParams{
+		Checkers: []providers.HealthChecker{healthusecases.NewDatabaseHealthChecker(repo)},
+		Logger:   &mockLogger{},
+	})
+	_, err := uc.Handle(context.Background(), healthusecases.CheckReadinessInput{})
 
 	if err == nil {
 		t.Fatal("expected SERVICE_UNAVAILABLE when ping returns false, got nil")
@@ -89,3 +100,95 @@ func TestCheckReadinessUseCase_DatabasePingFalse(t *testing.T) {
 		t.Fatalf("expected SERVICE_UNAVAILABLE, got %s", domainErr.Code)
 	}
 }
+
+func TestCheckReadinessUseCase_ReportsEveryChecker(t *testing.T) {
+	uc := healthusecases.NewCheckReadinessUseCase(healthusecases.CheckReadinessParams{
+		Checkers: []providers.HealthChecker{
+			healthusecases.NewDatabaseHealthChecker(&mockHealthRepo{}),
+			&mockHealthChecker{name: "event_bus"},
+		},
+		Logger: &mockLogger{},
+	})
+	out, err := uc.Handle(context.Background(), healthusecases.CheckReadinessInput{})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(out.Components) != 2 {
+		t.Fatalf("expected 2 components, got %d", len(out.Components))
+	}
+	if out.Components["event_bus"].Status != healthdomain.HealthStatusHealthy {
+		t.Fatalf("expected event_bus status=healthy, got %q", out.Components["event_bus"].Status)
+	}
+}
+
+func TestCheckReadinessUseCase_UnhealthyCheckerNamesComponent(t *testing.T) {
+	uc := healthusecases.NewCheckReadinessUseCase(healthusecases.CheckReadinessParams{
+		Checkers: []providers.HealthChecker{
+			&mockHealthChecker{name: "event_bus", err: errors.New("listener disconnected")},
+		},
+		Logger: &mockLogger{},
+	})
+	_, err := uc.Handle(context.Background(), healthusecases.CheckReadinessInput{})
+
+	var domainErr *exceptions.DomainError
+	if !errors.As(err, &domainErr) {
+		t.Fatalf("expected DomainError, got %v", err)
+	}
+	if domainErr.Metadata["component"] != "event_bus" {
+		t.Fatalf("expected component=event_bus, got %v", domainErr.Metadata["component"])
+	}
+}
+
+func TestCheckReadinessUseCase_ReplicaOutOfRotationDegrades(t *testing.T) {
+	repo := &mockHealthRepo{replicas: []healthdomain.ReplicaHealth{
+		{Name: "replica-1", Healthy: true, Lag: 200 * time.Millisecond},
+		{Name: "replica-2", Healthy: false, Lag: 30 * time.Second},
+	}}
+
+	uc := healthusecases.NewCheckReadinessUseCase(healthusecases.CheckReadinessParams{
+		Checkers: []providers.HealthChecker{
+			healthusecases.NewDatabaseHealthChecker(repo),
+			healthusecases.NewReplicaHealthChecker(repo),
+		},
+		Logger: &mockLogger{},
+	})
+	out, err := uc.Handle(context.Background(), healthusecases.CheckReadinessInput{})
+
+	if err != nil {
+		t.Fatalf("expected a lagging replica not to fail readiness, got %v", err)
+	}
+	if out.Status != healthdomain.HealthStatusDegraded {
+		t.Fatalf("expected status=degraded, got %q", out.Status)
+	}
+	replicas := out.Components["database_replicas"]
+	if replicas.Status != healthdomain.HealthStatusDegraded {
+		t.Fatalf("expected database_replicas status=degraded, got %q", replicas.Status)
+	}
+	lagging, ok := replicas.Details["replica-2"].(map[string]any)
+	if !ok || lagging["status"] != healthdomain.HealthStatusUnhealthy || lagging["lag_seconds"] != 30.0 {
+		t.Fatalf("unexpected replica-2 details %v", replicas.Details["replica-2"])
+	}
+}
+
+func TestCheckReadinessUseCase_ReplicasInRotationAreHealthy(t *testing.T) {
+	repo := &mockHealthRepo{replicas: []healthdomain.ReplicaHealth{
+		{Name: "replica-1", Healthy: true, Lag: 200 * time.Millisecond},
+	}}
+
+	uc := healthusecases.NewCheckReadinessUseCase(healthusecases.CheckReadinessParams{
+		Checkers: []providers.HealthChecker{healthusecases.NewReplicaHealthChecker(repo)},
+		Logger:   &mockLogger{},
+	})
+	out, err := uc.Handle(context.Background(), healthusecases.CheckReadinessInput{})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if out.Status != healthdomain.HealthStatusHealthy {
+		t.Fatalf("expected status=healthy, got %q", out.Status)
+	}
+	if _, ok := out.Components["database_replicas"].Details["replica-1"]; !ok {
+		t.Fatal("expected replica-1 in the details")
+	}
+}
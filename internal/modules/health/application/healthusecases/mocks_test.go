@@ -3,11 +3,13 @@ package healthusecases_test
 import (
 	"context"
 
+	"golang_boilerplate_module/internal/modules/health/healthdomain"
 	"golang_boilerplate_module/internal/shared/domain/providers"
 )
 
 type mockHealthRepo struct {
-	pingFn func(ctx context.Context) (bool, error)
+	pingFn   func(ctx context.Context) (bool, error)
+	replicas []healthdomain.ReplicaHealth
 }
 
 func (m *mockHealthRepo) Ping(ctx context.Context) (bool, error) {
@@ -17,6 +19,18 @@ func (m *mockHealthRepo) Ping(ctx context.Context) (bool, error) {
 	return true, nil
 }
 
+func (m *mockHealthRepo) Replicas(_ context.Context) []healthdomain.ReplicaHealth {
+	return m.replicas
+}
+
+type mockHealthChecker struct {
+	name string
+	err  error
+}
+
+func (m *mockHealthChecker) Name() string                  { return m.name }
+func (m *mockHealthChecker) Check(_ context.Context) error { return m.err }
+
 type mockLogger struct{}
 
 func (l *mockLogger) Info(msg string, fields ...any)            {}
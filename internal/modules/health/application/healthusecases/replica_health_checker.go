@@ -0,0 +1,51 @@
+package healthusecases
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"golang_boilerplate_module/internal/modules/health/healthdomain"
+	"golang_boilerplate_module/internal/modules/health/healthdomain/healthrepo"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+)
+
+// ReplicaHealthChecker reports the status and lag of each read replica.
+// Reads fall back to the primary, so replicas out of rotation only degrade
+// the service.
+type ReplicaHealthChecker struct {
+	healthRepo healthrepo.HealthRepository
+}
+
+func NewReplicaHealthChecker(healthRepo healthrepo.HealthRepository) providers.HealthChecker {
+	return &ReplicaHealthChecker{healthRepo: healthRepo}
+}
+
+func (c *ReplicaHealthChecker) Name() string {
+	return "database_replicas"
+}
+
+func (c *ReplicaHealthChecker) Check(ctx context.Context) error {
+	_, err := c.CheckDetails(ctx)
+	return err
+}
+
+func (c *ReplicaHealthChecker) CheckDetails(ctx context.Context) (map[string]any, error) {
+	replicas := c.healthRepo.Replicas(ctx)
+	details := make(map[string]any, len(replicas))
+	var outOfRotation []string
+	for _, replica := range replicas {
+		details[replica.Name] = map[string]any{
+			"status":      healthdomain.ToHealthStatus(replica.Healthy),
+			"lag_seconds": replica.Lag.Seconds(),
+		}
+		if !replica.Healthy {
+			outOfRotation = append(outOfRotation, replica.Name)
+		}
+	}
+
+	if len(outOfRotation) > 0 {
+		return details, fmt.Errorf("%w: out of rotation: %s", providers.ErrDegraded, strings.Join(outOfRotation, ", "))
+	}
+	return details, nil
+}
@@ -1,9 +1,12 @@
 package healthdomain
 
+import "time"
+
 type HealthStatus string
 
 const (
 	HealthStatusHealthy   HealthStatus = "healthy"
+	HealthStatusDegraded  HealthStatus = "degraded"
 	HealthStatusUnhealthy HealthStatus = "unhealthy"
 )
 
@@ -13,3 +16,10 @@ func ToHealthStatus(healthy bool) HealthStatus {
 	}
 	return HealthStatusUnhealthy
 }
+
+// ReplicaHealth is the last check of a read replica.
+type ReplicaHealth struct {
+	Name    string
+	Healthy bool
+	Lag     time.Duration
+}
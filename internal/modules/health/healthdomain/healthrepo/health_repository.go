@@ -1,7 +1,13 @@
 package healthrepo
 
-import "context"
+import (
+	"context"
+
+	"golang_boilerplate_module/internal/modules/health/healthdomain"
+)
 
 type HealthRepository interface {
 	Ping(ctx context.Context) (bool, error)
+	// Replicas lists the read replicas, empty when reads are not routed.
+	Replicas(ctx context.Context) []healthdomain.ReplicaHealth
 }
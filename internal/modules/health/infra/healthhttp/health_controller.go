@@ -2,6 +2,7 @@ package healthhttp
 
 import (
 	"golang_boilerplate_module/internal/modules/health/application/healthusecases"
+	"golang_boilerplate_module/internal/shared/domain/bus"
 	"golang_boilerplate_module/internal/shared/domain/providers"
 	"golang_boilerplate_module/internal/shared/infra/http/middleware"
 	"golang_boilerplate_module/internal/shared/infra/observability"
@@ -15,14 +16,14 @@ import (
 var tracer = otel.Tracer("health.http")
 
 type HealthController struct {
-	checkHealth    *healthusecases.CheckHealthUseCase
-	checkReadiness *healthusecases.CheckReadinessUseCase
+	checkHealth    bus.Handler[healthusecases.CheckHealthInput, healthusecases.CheckHealthOutput]
+	checkReadiness bus.Handler[healthusecases.CheckReadinessInput, healthusecases.CheckReadinessOutput]
 	logger         providers.LoggerProvider
 }
 
 func NewHealthController(
-	checkHealth *healthusecases.CheckHealthUseCase,
-	checkReadiness *healthusecases.CheckReadinessUseCase,
+	checkHealth bus.Handler[healthusecases.CheckHealthInput, healthusecases.CheckHealthOutput],
+	checkReadiness bus.Handler[healthusecases.CheckReadinessInput, healthusecases.CheckReadinessOutput],
 	logger providers.LoggerProvider,
 ) *HealthController {
 	return &HealthController{
@@ -38,7 +39,11 @@ func (h *HealthController) CheckHealth(c *fiber.Ctx) error {
 
 	log := middleware.LoggerFromLocals(c, h.logger).With("handler", "HealthController.CheckHealth")
 
-	output := h.checkHealth.Execute(ctx)
+	output, err := h.checkHealth.Handle(ctx, healthusecases.CheckHealthInput{})
+	if err != nil {
+		observability.RecordError(span, err)
+		return err
+	}
 
 	span.SetAttributes(
 		attribute.String("health.status", string(output.Status)),
@@ -56,7 +61,7 @@ func (h *HealthController) CheckReadiness(c *fiber.Ctx) error {
 
 	log := middleware.LoggerFromLocals(c, h.logger).With("handler", "HealthController.CheckReadiness")
 
-	output, err := h.checkReadiness.Execute(ctx)
+	output, err := h.checkReadiness.Handle(ctx, healthusecases.CheckReadinessInput{})
 	if err != nil {
 		log.Error("readiness probe failed", "error", err.Error())
 		observability.RecordError(span, err)
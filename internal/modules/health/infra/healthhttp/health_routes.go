@@ -1,8 +1,21 @@
 package healthhttp
 
-import "github.com/gofiber/fiber/v2"
+import (
+	"golang_boilerplate_module/internal/modules/health/application/healthusecases"
+	"golang_boilerplate_module/internal/shared/infra/http/openapi"
 
-func RegisterRoutes(app *fiber.App, controller *HealthController) {
-	app.Get("/healthz", controller.CheckHealth)
-	app.Get("/readyz", controller.CheckReadiness)
-}
\ No newline at end of file
+	"github.com/gofiber/fiber/v2"
+)
+
+func RegisterRoutes(router *openapi.Router, controller *HealthController) {
+	health := router.Group("", openapi.Tags("Health"))
+	health.Get("/healthz", controller.CheckHealth,
+		openapi.Summary("Liveness probe"),
+		openapi.Returns[healthusecases.CheckHealthOutput](fiber.StatusOK),
+	)
+	health.Get("/readyz", controller.CheckReadiness,
+		openapi.Summary("Readiness probe"),
+		openapi.Returns[healthusecases.CheckReadinessOutput](fiber.StatusOK),
+		openapi.Errors(fiber.StatusServiceUnavailable),
+	)
+}
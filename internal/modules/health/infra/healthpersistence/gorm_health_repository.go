@@ -3,7 +3,9 @@ package healthpersistence
 import (
 	"context"
 
+	"golang_boilerplate_module/internal/modules/health/healthdomain"
 	"golang_boilerplate_module/internal/modules/health/healthdomain/healthrepo"
+	"golang_boilerplate_module/internal/shared/infra/persistence"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
@@ -42,4 +44,22 @@ func (r *GORMHealthRepository) Ping(ctx context.Context) (bool, error) {
 
 	span.SetStatus(codes.Ok, "ping ok")
 	return true, nil
-}
\ No newline at end of file
+}
+
+func (r *GORMHealthRepository) Replicas(_ context.Context) []healthdomain.ReplicaHealth {
+	replicas := persistence.ReplicasOf(r.db)
+	if replicas == nil {
+		return nil
+	}
+
+	statuses := replicas.Status()
+	health := make([]healthdomain.ReplicaHealth, 0, len(statuses))
+	for _, status := range statuses {
+		health = append(health, healthdomain.ReplicaHealth{
+			Name:    status.Name,
+			Healthy: status.Healthy,
+			Lag:     status.Lag,
+		})
+	}
+	return health
+}
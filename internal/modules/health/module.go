@@ -4,16 +4,21 @@ import (
 	"golang_boilerplate_module/internal/modules/health/application/healthusecases"
 	"golang_boilerplate_module/internal/modules/health/infra/healthhttp"
 	"golang_boilerplate_module/internal/modules/health/infra/healthpersistence"
-
-	"go.uber.org/fx"
+	"golang_boilerplate_module/internal/shared/infra/platform"
 )
 
-var Module = fx.Module("health",
-	fx.Provide(
+var Module = platform.Module{
+	Name:        "health",
+	Description: "Liveness and readiness probes",
+	Required:    true,
+	Providers: []any{
 		healthpersistence.NewGORMHealthRepository,
 		healthusecases.NewCheckHealthUseCase,
+		healthusecases.NewCheckHealthHandler,
 		healthusecases.NewCheckReadinessUseCase,
+		healthusecases.NewCheckReadinessHandler,
 		healthhttp.NewHealthController,
-	),
-	fx.Invoke(healthhttp.RegisterRoutes),
-)
+	},
+	Routes:       []any{healthhttp.RegisterRoutes},
+	HealthChecks: []any{healthusecases.NewDatabaseHealthChecker, healthusecases.NewReplicaHealthChecker},
+}
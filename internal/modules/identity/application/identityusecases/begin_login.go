@@ -0,0 +1,124 @@
+package identityusecases
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"golang_boilerplate_module/internal/config"
+	"golang_boilerplate_module/internal/modules/identity/identitydomain"
+	"golang_boilerplate_module/internal/modules/identity/identitydomain/identityrepo"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+	"golang_boilerplate_module/internal/shared/infra/observability"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+var identityTracer = otel.Tracer("identity")
+
+type BeginLoginInput struct {
+	Provider   string
+	RedirectTo string
+}
+
+type BeginLoginOutput struct {
+	AuthorizationURL string
+	State            string
+	ExpiresAt        time.Time
+}
+
+type BeginLoginUseCase struct {
+	registry identitydomain.IdentityProviderRegistry
+	states   identityrepo.LoginStateRepository
+	cfg      *config.Config
+	logger   providers.LoggerProvider
+}
+
+func NewBeginLoginUseCase(
+	registry identitydomain.IdentityProviderRegistry,
+	states identityrepo.LoginStateRepository,
+	cfg *config.Config,
+	logger providers.LoggerProvider,
+) *BeginLoginUseCase {
+	return &BeginLoginUseCase{registry: registry, states: states, cfg: cfg, logger: logger}
+}
+
+func (uc *BeginLoginUseCase) Execute(ctx context.Context, input BeginLoginInput) (BeginLoginOutput, error) {
+	ctx, span := identityTracer.Start(ctx, "BeginLoginUseCase.Execute")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("identity.provider", input.Provider))
+
+	log := observability.LoggerWithTrace(ctx, uc.logger).With("usecase", "BeginLogin", "provider", input.Provider)
+
+	redirectTo := input.RedirectTo
+	if redirectTo == "" {
+		redirectTo = uc.cfg.OIDC.PostLoginRedirect
+	}
+	if !isRelativeRedirect(redirectTo) {
+		err := exceptions.NewBadRequestException("Redirect target must be a relative path", map[string]any{"redirect_to": redirectTo})
+		log.Warn("validation failed — absolute redirect target", "redirectTo", redirectTo)
+		observability.RecordError(span, err)
+		return BeginLoginOutput{}, err
+	}
+
+	state, nonce, verifier, err := newLoginSecrets()
+	if err != nil {
+		domainErr := exceptions.NewInternalException(map[string]any{"error": err.Error()})
+		log.Error("failed to generate login secrets", "error", err.Error())
+		observability.RecordError(span, domainErr)
+		return BeginLoginOutput{}, domainErr
+	}
+
+	authURL, err := uc.registry.AuthCodeURL(ctx, input.Provider, state, nonce, verifier)
+	if err != nil {
+		log.Warn("failed to build authorization url", "error", err.Error())
+		observability.RecordError(span, err)
+		return BeginLoginOutput{}, err
+	}
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	loginState := &identitydomain.LoginState{
+		ID:           identitydomain.HashState(state),
+		Provider:     input.Provider,
+		Nonce:        nonce,
+		CodeVerifier: verifier,
+		RedirectTo:   redirectTo,
+		ExpiresAt:    now.Add(uc.cfg.OIDC.StateTTL),
+		CreatedAt:    now,
+	}
+	if _, err := uc.states.Add(ctx, loginState); err != nil {
+		log.Error("failed to store login state", "error", err.Error())
+		observability.RecordError(span, err)
+		return BeginLoginOutput{}, err
+	}
+
+	log.Info("oidc login started")
+	return BeginLoginOutput{
+		AuthorizationURL: authURL,
+		State:            state,
+		ExpiresAt:        loginState.ExpiresAt,
+	}, nil
+}
+
+func newLoginSecrets() (state, nonce, verifier string, err error) {
+	if state, err = identitydomain.NewRandomToken(); err != nil {
+		return "", "", "", err
+	}
+	if nonce, err = identitydomain.NewRandomToken(); err != nil {
+		return "", "", "", err
+	}
+	if verifier, err = identitydomain.NewRandomToken(); err != nil {
+		return "", "", "", err
+	}
+	return state, nonce, verifier, nil
+}
+
+func isRelativeRedirect(target string) bool {
+	return strings.HasPrefix(target, "/") &&
+		!strings.HasPrefix(target, "//") &&
+		!strings.HasPrefix(target, "/\\") &&
+		!strings.ContainsAny(target, "\r\n")
+}
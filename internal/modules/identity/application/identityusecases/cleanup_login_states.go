@@ -0,0 +1,41 @@
+package identityusecases
+
+import (
+	"context"
+	"time"
+
+	"golang_boilerplate_module/internal/modules/identity/identitydomain/identityrepo"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+	"golang_boilerplate_module/internal/shared/infra/observability"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type CleanupLoginStatesUseCase struct {
+	states identityrepo.LoginStateRepository
+	logger providers.LoggerProvider
+}
+
+func NewCleanupLoginStatesUseCase(states identityrepo.LoginStateRepository, logger providers.LoggerProvider) *CleanupLoginStatesUseCase {
+	return &CleanupLoginStatesUseCase{states: states, logger: logger}
+}
+
+func (uc *CleanupLoginStatesUseCase) Execute(ctx context.Context) (int64, error) {
+	ctx, span := identityTracer.Start(ctx, "CleanupLoginStatesUseCase.Execute")
+	defer span.End()
+
+	log := observability.LoggerWithTrace(ctx, uc.logger).With("usecase", "CleanupLoginStates")
+
+	deleted, err := uc.states.DeleteExpired(ctx, time.Now().UTC())
+	if err != nil {
+		log.Error("failed to delete expired login states", "error", err.Error())
+		observability.RecordError(span, err)
+		return 0, err
+	}
+
+	span.SetAttributes(attribute.Int64("identity.login_states.deleted", deleted))
+	if deleted > 0 {
+		log.Info("expired login states deleted", "count", deleted)
+	}
+	return deleted, nil
+}
@@ -0,0 +1,224 @@
+package identityusecases
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"strings"
+	"time"
+
+	"golang_boilerplate_module/internal/config"
+	"golang_boilerplate_module/internal/modules/identity/identitydomain"
+	"golang_boilerplate_module/internal/modules/identity/identitydomain/identityrepo"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+	"golang_boilerplate_module/internal/shared/infra/observability"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type CompleteLoginInput struct {
+	Provider    string
+	State       string
+	StateCookie string
+	Code        string
+	UserAgent   string
+	IPAddress   string
+}
+
+type CompleteLoginOutput struct {
+	UserID      uint
+	Session     providers.IssuedSession
+	RedirectTo  string
+	Linked      bool
+	Provisioned bool
+}
+
+type CompleteLoginUseCase struct {
+	registry    identitydomain.IdentityProviderRegistry
+	states      identityrepo.LoginStateRepository
+	identities  identityrepo.ExternalIdentityRepository
+	users       providers.UserDirectory
+	provisioner providers.UserProvisioner
+	sessions    providers.SessionIssuer
+	cfg         *config.Config
+	logger      providers.LoggerProvider
+}
+
+func NewCompleteLoginUseCase(
+	registry identitydomain.IdentityProviderRegistry,
+	states identityrepo.LoginStateRepository,
+	identities identityrepo.ExternalIdentityRepository,
+	users providers.UserDirectory,
+	provisioner providers.UserProvisioner,
+	sessions providers.SessionIssuer,
+	cfg *config.Config,
+	logger providers.LoggerProvider,
+) *CompleteLoginUseCase {
+	return &CompleteLoginUseCase{
+		registry:    registry,
+		states:      states,
+		identities:  identities,
+		users:       users,
+		provisioner: provisioner,
+		sessions:    sessions,
+		cfg:         cfg,
+		logger:      logger,
+	}
+}
+
+func (uc *CompleteLoginUseCase) Execute(ctx context.Context, input CompleteLoginInput) (CompleteLoginOutput, error) {
+	ctx, span := identityTracer.Start(ctx, "CompleteLoginUseCase.Execute")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("identity.provider", input.Provider))
+
+	log := observability.LoggerWithTrace(ctx, uc.logger).With("usecase", "CompleteLogin", "provider", input.Provider)
+
+	if input.State == "" || input.Code == "" {
+		err := exceptions.NewBadRequestException("State and code are required", nil)
+		log.Warn("validation failed — missing state or code")
+		observability.RecordError(span, err)
+		return CompleteLoginOutput{}, err
+	}
+
+	if subtle.ConstantTimeCompare([]byte(input.State), []byte(input.StateCookie)) != 1 {
+		err := exceptions.NewUnauthorizedException("Invalid login state", nil)
+		log.Warn("state does not match browser cookie")
+		observability.RecordError(span, err)
+		return CompleteLoginOutput{}, err
+	}
+
+	state, err := uc.states.Consume(ctx, identitydomain.HashState(input.State))
+	if err != nil {
+		if isNotFound(err) {
+			err = exceptions.NewUnauthorizedException("Invalid login state", nil)
+			log.Warn("unknown or already used login state")
+		} else {
+			log.Error("failed to load login state", "error", err.Error())
+		}
+		observability.RecordError(span, err)
+		return CompleteLoginOutput{}, err
+	}
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	if state.Provider != input.Provider || state.IsExpiredAt(now) {
+		err := exceptions.NewUnauthorizedException("Invalid login state", nil)
+		log.Warn("login state expired or issued for another provider", "stateProvider", state.Provider)
+		observability.RecordError(span, err)
+		return CompleteLoginOutput{}, err
+	}
+
+	claims, err := uc.registry.Exchange(ctx, input.Provider, input.Code, state.CodeVerifier)
+	if err != nil {
+		log.Warn("code exchange failed", "error", err.Error())
+		observability.RecordError(span, err)
+		return CompleteLoginOutput{}, err
+	}
+
+	if subtle.ConstantTimeCompare([]byte(claims.Nonce), []byte(state.Nonce)) != 1 {
+		err := exceptions.NewUnauthorizedException("Invalid ID token", nil)
+		log.Warn("id token nonce mismatch")
+		observability.RecordError(span, err)
+		return CompleteLoginOutput{}, err
+	}
+
+	output := CompleteLoginOutput{RedirectTo: state.RedirectTo}
+
+	identity, err := uc.identities.GetByProviderSubject(ctx, input.Provider, claims.Subject)
+	switch {
+	case err == nil:
+		output.UserID = identity.UserID
+		if _, err := uc.identities.UpdateByID(ctx, identity.ID, map[string]any{
+			"email":         claims.Email,
+			"last_login_at": now,
+		}); err != nil {
+			log.Error("failed to update external identity", "error", err.Error())
+			observability.RecordError(span, err)
+			return CompleteLoginOutput{}, err
+		}
+	case isNotFound(err):
+		userID, provisioned, err := uc.resolveUser(ctx, claims, log)
+		if err != nil {
+			observability.RecordError(span, err)
+			return CompleteLoginOutput{}, err
+		}
+
+		if _, err := uc.identities.Add(ctx, &identitydomain.ExternalIdentity{
+			UserID:      userID,
+			Provider:    input.Provider,
+			Subject:     claims.Subject,
+			Email:       claims.Email,
+			CreatedAt:   now,
+			LastLoginAt: now,
+		}); err != nil {
+			log.Error("failed to link external identity", "error", err.Error())
+			observability.RecordError(span, err)
+			return CompleteLoginOutput{}, err
+		}
+
+		output.UserID = userID
+		output.Linked = true
+		output.Provisioned = provisioned
+	default:
+		log.Error("failed to load external identity", "error", err.Error())
+		observability.RecordError(span, err)
+		return CompleteLoginOutput{}, err
+	}
+
+	session, err := uc.sessions.IssueSession(ctx, output.UserID, input.UserAgent, input.IPAddress)
+	if err != nil {
+		log.Error("failed to issue session", "error", err.Error())
+		observability.RecordError(span, err)
+		return CompleteLoginOutput{}, err
+	}
+	output.Session = session
+
+	span.SetAttributes(
+		attribute.Int("user.id", int(output.UserID)),
+		attribute.Bool("identity.linked", output.Linked),
+		attribute.Bool("identity.provisioned", output.Provisioned),
+	)
+	log.Info("oidc login completed", "userId", output.UserID, "linked", output.Linked, "provisioned", output.Provisioned)
+
+	return output, nil
+}
+
+func (uc *CompleteLoginUseCase) resolveUser(ctx context.Context, claims identitydomain.IDTokenClaims, log providers.LoggerProvider) (uint, bool, error) {
+	if claims.Email == "" || !claims.EmailVerified {
+		log.Warn("identity has no verified email")
+		return 0, false, exceptions.NewForbiddenException("A verified email is required to sign in", nil)
+	}
+
+	user, err := uc.users.FindUserByEmail(ctx, claims.Email)
+	if err == nil {
+		return user.ID, false, nil
+	}
+	if !isNotFound(err) {
+		log.Error("failed to look up user by email", "error", err.Error())
+		return 0, false, err
+	}
+
+	if !uc.cfg.OIDC.JITProvisioning {
+		log.Warn("no account for identity and provisioning disabled")
+		return 0, false, exceptions.NewForbiddenException("No account is associated with this identity", nil)
+	}
+
+	name := claims.Name
+	if name == "" {
+		name, _, _ = strings.Cut(claims.Email, "@")
+	}
+
+	provisioned, err := uc.provisioner.ProvisionUser(ctx, name, claims.Email)
+	if err != nil {
+		log.Error("failed to provision user", "error", err.Error())
+		return 0, false, err
+	}
+
+	return provisioned.ID, true, nil
+}
+
+func isNotFound(err error) bool {
+	var domainErr *exceptions.DomainError
+	return errors.As(err, &domainErr) && domainErr.Code == exceptions.CodeNotFound
+}
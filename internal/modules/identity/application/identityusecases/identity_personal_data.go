@@ -0,0 +1,86 @@
+package identityusecases
+
+import (
+	"context"
+	"time"
+
+	"golang_boilerplate_module/internal/modules/identity/identitydomain/identityrepo"
+	"golang_boilerplate_module/internal/shared/domain/privacy"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+	"golang_boilerplate_module/internal/shared/infra/observability"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type ExternalIdentityOutput struct {
+	Provider    string    `json:"provider"`
+	Subject     string    `json:"subject"`
+	Email       string    `json:"email"`
+	CreatedAt   time.Time `json:"created_at"`
+	LastLoginAt time.Time `json:"last_login_at"`
+}
+
+type IdentityPersonalDataProvider struct {
+	identities identityrepo.ExternalIdentityRepository
+	logger     providers.LoggerProvider
+}
+
+func NewIdentityPersonalDataProvider(identities identityrepo.ExternalIdentityRepository, logger providers.LoggerProvider) *IdentityPersonalDataProvider {
+	return &IdentityPersonalDataProvider{identities: identities, logger: logger}
+}
+
+func (p *IdentityPersonalDataProvider) Module() string {
+	return "identity"
+}
+
+func (p *IdentityPersonalDataProvider) ExportPersonalData(ctx context.Context, userID uint) (any, error) {
+	ctx, span := identityTracer.Start(ctx, "IdentityPersonalDataProvider.ExportPersonalData")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("user.id", int(userID)))
+
+	log := observability.LoggerWithTrace(ctx, p.logger).With("provider", "IdentityPersonalData", "userId", userID)
+
+	identities, err := p.identities.ListByUser(ctx, userID)
+	if err != nil {
+		log.Warn("failed to load external identities for export", "error", err.Error())
+		observability.RecordError(span, err)
+		return nil, err
+	}
+
+	outputs := make([]ExternalIdentityOutput, 0, len(identities))
+	for _, identity := range identities {
+		outputs = append(outputs, ExternalIdentityOutput{
+			Provider:    identity.Provider,
+			Subject:     identity.Subject,
+			Email:       identity.Email,
+			CreatedAt:   identity.CreatedAt,
+			LastLoginAt: identity.LastLoginAt,
+		})
+	}
+
+	return outputs, nil
+}
+
+func (p *IdentityPersonalDataProvider) ErasePersonalData(ctx context.Context, userID uint) (privacy.ErasureResult, error) {
+	ctx, span := identityTracer.Start(ctx, "IdentityPersonalDataProvider.ErasePersonalData")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("user.id", int(userID)))
+
+	log := observability.LoggerWithTrace(ctx, p.logger).With("provider", "IdentityPersonalData", "userId", userID)
+
+	deleted, err := p.identities.DeleteByUser(ctx, userID)
+	if err != nil {
+		log.Error("failed to delete external identities", "error", err.Error())
+		observability.RecordError(span, err)
+		return privacy.ErasureResult{}, err
+	}
+
+	log.Info("external identities deleted", "count", deleted)
+
+	return privacy.ErasureResult{
+		Strategy:        privacy.ErasureStrategyDelete,
+		RecordsAffected: deleted,
+	}, nil
+}
@@ -0,0 +1,261 @@
+package identityusecases_test
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"golang_boilerplate_module/internal/modules/identity/identitydomain"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+)
+
+type mockLogger struct{}
+
+func (l *mockLogger) Info(msg string, fields ...any)            {}
+func (l *mockLogger) Warn(msg string, fields ...any)            {}
+func (l *mockLogger) Error(msg string, fields ...any)           {}
+func (l *mockLogger) Debug(msg string, fields ...any)           {}
+func (l *mockLogger) Sync() error                               { return nil }
+func (l *mockLogger) With(args ...any) providers.LoggerProvider { return l }
+
+type mockRegistry struct {
+	claims       identitydomain.IDTokenClaims
+	exchangeErr  error
+	lastNonce    string
+	lastVerifier string
+}
+
+func (m *mockRegistry) Names() []string { return []string{"mock"} }
+
+func (m *mockRegistry) AuthCodeURL(_ context.Context, provider, state, nonce, codeVerifier string) (string, error) {
+	if provider != "mock" {
+		return "", exceptions.NewNotFoundException("Identity provider not found", nil)
+	}
+	m.lastNonce = nonce
+	m.lastVerifier = codeVerifier
+	return "https://idp.example.com/authorize?state=" + state, nil
+}
+
+func (m *mockRegistry) Exchange(_ context.Context, _, _, codeVerifier string) (identitydomain.IDTokenClaims, error) {
+	if m.exchangeErr != nil {
+		return identitydomain.IDTokenClaims{}, m.exchangeErr
+	}
+	if codeVerifier != m.lastVerifier {
+		return identitydomain.IDTokenClaims{}, exceptions.NewUnauthorizedException("invalid verifier", nil)
+	}
+	claims := m.claims
+	if claims.Nonce == "" {
+		claims.Nonce = m.lastNonce
+	}
+	return claims, nil
+}
+
+type mockLoginStateRepo struct {
+	mu     sync.Mutex
+	states map[string]identitydomain.LoginState
+}
+
+func newMockLoginStateRepo() *mockLoginStateRepo {
+	return &mockLoginStateRepo{states: make(map[string]identitydomain.LoginState)}
+}
+
+func (m *mockLoginStateRepo) Add(_ context.Context, state *identitydomain.LoginState) (*identitydomain.LoginState, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.states[state.ID] = *state
+	return state, nil
+}
+
+func (m *mockLoginStateRepo) GetByID(_ context.Context, id string) (*identitydomain.LoginState, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	state, ok := m.states[id]
+	if !ok {
+		return nil, exceptions.NewNotFoundException("", nil)
+	}
+	return &state, nil
+}
+
+func (m *mockLoginStateRepo) UpdateByID(_ context.Context, id string, _ map[string]any) (*identitydomain.LoginState, error) {
+	return m.GetByID(context.Background(), id)
+}
+
+func (m *mockLoginStateRepo) DeleteByID(_ context.Context, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.states, id)
+	return nil
+}
+
+func (m *mockLoginStateRepo) DeleteAll(_ context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.states = make(map[string]identitydomain.LoginState)
+	return nil
+}
+
+func (m *mockLoginStateRepo) Consume(_ context.Context, id string) (*identitydomain.LoginState, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	state, ok := m.states[id]
+	if !ok {
+		return nil, exceptions.NewNotFoundException("Login state not found", nil)
+	}
+	delete(m.states, id)
+	return &state, nil
+}
+
+func (m *mockLoginStateRepo) DeleteExpired(_ context.Context, now time.Time) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var deleted int64
+	for id, state := range m.states {
+		if state.IsExpiredAt(now) {
+			delete(m.states, id)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+type mockIdentityRepo struct {
+	mu         sync.Mutex
+	nextID     uint
+	identities map[uint]identitydomain.ExternalIdentity
+}
+
+func newMockIdentityRepo() *mockIdentityRepo {
+	return &mockIdentityRepo{identities: make(map[uint]identitydomain.ExternalIdentity)}
+}
+
+func (m *mockIdentityRepo) Add(_ context.Context, identity *identitydomain.ExternalIdentity) (*identitydomain.ExternalIdentity, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.nextID++
+	identity.ID = m.nextID
+	m.identities[identity.ID] = *identity
+	return identity, nil
+}
+
+func (m *mockIdentityRepo) GetByID(_ context.Context, id uint) (*identitydomain.ExternalIdentity, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	identity, ok := m.identities[id]
+	if !ok {
+		return nil, exceptions.NewNotFoundException("", nil)
+	}
+	return &identity, nil
+}
+
+func (m *mockIdentityRepo) UpdateByID(_ context.Context, id uint, updates map[string]any) (*identitydomain.ExternalIdentity, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	identity, ok := m.identities[id]
+	if !ok {
+		return nil, exceptions.NewNotFoundException("", nil)
+	}
+	if email, ok := updates["email"].(string); ok {
+		identity.Email = email
+	}
+	if lastLogin, ok := updates["last_login_at"].(time.Time); ok {
+		identity.LastLoginAt = lastLogin
+	}
+	m.identities[id] = identity
+	return &identity, nil
+}
+
+func (m *mockIdentityRepo) DeleteByID(_ context.Context, id uint) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.identities, id)
+	return nil
+}
+
+func (m *mockIdentityRepo) DeleteAll(_ context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.identities = make(map[uint]identitydomain.ExternalIdentity)
+	return nil
+}
+
+func (m *mockIdentityRepo) GetByProviderSubject(_ context.Context, provider, subject string) (*identitydomain.ExternalIdentity, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, identity := range m.identities {
+		if identity.Provider == provider && identity.Subject == subject {
+			return &identity, nil
+		}
+	}
+	return nil, exceptions.NewNotFoundException("External identity not found", nil)
+}
+
+func (m *mockIdentityRepo) ListByUser(_ context.Context, userID uint) ([]identitydomain.ExternalIdentity, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var identities []identitydomain.ExternalIdentity
+	for _, identity := range m.identities {
+		if identity.UserID == userID {
+			identities = append(identities, identity)
+		}
+	}
+	return identities, nil
+}
+
+func (m *mockIdentityRepo) DeleteByUser(_ context.Context, userID uint) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var deleted int64
+	for id, identity := range m.identities {
+		if identity.UserID == userID {
+			delete(m.identities, id)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+type mockUsers struct {
+	nextID uint
+	users  []providers.UserSummary
+}
+
+func (m *mockUsers) GetUserSummary(_ context.Context, userID uint) (providers.UserSummary, error) {
+	for _, user := range m.users {
+		if user.ID == userID {
+			return user, nil
+		}
+	}
+	return providers.UserSummary{}, exceptions.NewNotFoundException("User not found", nil)
+}
+
+func (m *mockUsers) FindUserByEmail(_ context.Context, email string) (providers.UserSummary, error) {
+	for _, user := range m.users {
+		if strings.EqualFold(user.Email, email) {
+			return user, nil
+		}
+	}
+	return providers.UserSummary{}, exceptions.NewNotFoundException("User not found", nil)
+}
+
+func (m *mockUsers) ProvisionUser(_ context.Context, name, email string) (providers.UserSummary, error) {
+	m.nextID++
+	user := providers.UserSummary{ID: 100 + m.nextID, Name: name, Email: email}
+	m.users = append(m.users, user)
+	return user, nil
+}
+
+type mockSessions struct {
+	issued []uint
+}
+
+func (m *mockSessions) IssueSession(_ context.Context, userID uint, _, _ string) (providers.IssuedSession, error) {
+	m.issued = append(m.issued, userID)
+	return providers.IssuedSession{
+		ID:        "session-id",
+		Token:     "session-token",
+		CSRFToken: "csrf-token",
+		ExpiresAt: time.Now().Add(time.Hour),
+	}, nil
+}
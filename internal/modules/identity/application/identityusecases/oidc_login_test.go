@@ -0,0 +1,194 @@
+package identityusecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"golang_boilerplate_module/internal/config"
+	"golang_boilerplate_module/internal/modules/identity/application/identityusecases"
+	"golang_boilerplate_module/internal/modules/identity/identitydomain"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+)
+
+type loginFixture struct {
+	registry   *mockRegistry
+	states     *mockLoginStateRepo
+	identities *mockIdentityRepo
+	users      *mockUsers
+	sessions   *mockSessions
+	cfg        *config.Config
+	begin      *identityusecases.BeginLoginUseCase
+	complete   *identityusecases.CompleteLoginUseCase
+}
+
+func newLoginFixture(jit bool) *loginFixture {
+	f := &loginFixture{
+		registry:   &mockRegistry{},
+		states:     newMockLoginStateRepo(),
+		identities: newMockIdentityRepo(),
+		users:      &mockUsers{},
+		sessions:   &mockSessions{},
+		cfg: &config.Config{OIDC: config.OIDCConfig{
+			JITProvisioning:   jit,
+			StateTTL:          10 * time.Minute,
+			PostLoginRedirect: "/",
+		}},
+	}
+	f.begin = identityusecases.NewBeginLoginUseCase(f.registry, f.states, f.cfg, &mockLogger{})
+	f.complete = identityusecases.NewCompleteLoginUseCase(f.registry, f.states, f.identities, f.users, f.users, f.sessions, f.cfg, &mockLogger{})
+	return f
+}
+
+func (f *loginFixture) login(t *testing.T, claims identitydomain.IDTokenClaims) (identityusecases.CompleteLoginOutput, error) {
+	t.Helper()
+
+	started, err := f.begin.Execute(context.Background(), identityusecases.BeginLoginInput{Provider: "mock", RedirectTo: "/dashboard"})
+	if err != nil {
+		t.Fatalf("begin login: %v", err)
+	}
+
+	f.registry.claims = claims
+	return f.complete.Execute(context.Background(), identityusecases.CompleteLoginInput{
+		Provider:    "mock",
+		State:       started.State,
+		StateCookie: started.State,
+		Code:        "code",
+	})
+}
+
+func expectCode(t *testing.T, err error, code exceptions.ExceptionCode) {
+	t.Helper()
+	var domainErr *exceptions.DomainError
+	if !errors.As(err, &domainErr) {
+		t.Fatalf("expected DomainError, got %T (%v)", err, err)
+	}
+	if domainErr.Code != code {
+		t.Fatalf("expected code=%s, got %s", code, domainErr.Code)
+	}
+}
+
+func TestBeginLoginUseCase_RejectsAbsoluteRedirect(t *testing.T) {
+	f := newLoginFixture(true)
+
+	for _, target := range []string{"https://evil.example.com", "//evil.example.com", "/\\evil.example.com"} {
+		_, err := f.begin.Execute(context.Background(), identityusecases.BeginLoginInput{Provider: "mock", RedirectTo: target})
+		expectCode(t, err, exceptions.CodeBadRequest)
+	}
+}
+
+func TestBeginLoginUseCase_UnknownProvider(t *testing.T) {
+	f := newLoginFixture(true)
+
+	_, err := f.begin.Execute(context.Background(), identityusecases.BeginLoginInput{Provider: "unknown"})
+	expectCode(t, err, exceptions.CodeNotFound)
+}
+
+func TestCompleteLoginUseCase_ProvisionsUserJustInTime(t *testing.T) {
+	f := newLoginFixture(true)
+
+	output, err := f.login(t, identitydomain.IDTokenClaims{Subject: "sub-1", Email: "new@example.com", EmailVerified: true, Name: "New"})
+	if err != nil {
+		t.Fatalf("complete login: %v", err)
+	}
+
+	if !output.Provisioned || !output.Linked {
+		t.Fatalf("expected provisioned and linked identity, got %+v", output)
+	}
+	if output.RedirectTo != "/dashboard" {
+		t.Fatalf("expected redirect to /dashboard, got %q", output.RedirectTo)
+	}
+	if len(f.sessions.issued) != 1 || f.sessions.issued[0] != output.UserID {
+		t.Fatalf("expected one session for user %d, got %v", output.UserID, f.sessions.issued)
+	}
+}
+
+func TestCompleteLoginUseCase_LinksExistingUserByVerifiedEmail(t *testing.T) {
+	f := newLoginFixture(false)
+	f.users.users = []providers.UserSummary{{ID: 7, Name: "Ana", Email: "ana@example.com"}}
+
+	output, err := f.login(t, identitydomain.IDTokenClaims{Subject: "sub-ana", Email: "ANA@example.com", EmailVerified: true})
+	if err != nil {
+		t.Fatalf("complete login: %v", err)
+	}
+	if output.UserID != 7 || !output.Linked || output.Provisioned {
+		t.Fatalf("expected link to user 7, got %+v", output)
+	}
+
+	second, err := f.login(t, identitydomain.IDTokenClaims{Subject: "sub-ana", Email: "ana@example.com", EmailVerified: true})
+	if err != nil {
+		t.Fatalf("second login: %v", err)
+	}
+	if second.UserID != 7 || second.Linked {
+		t.Fatalf("expected returning identity for user 7, got %+v", second)
+	}
+}
+
+func TestCompleteLoginUseCase_RequiresVerifiedEmailForNewIdentity(t *testing.T) {
+	f := newLoginFixture(true)
+	f.users.users = []providers.UserSummary{{ID: 7, Name: "Ana", Email: "ana@example.com"}}
+
+	_, err := f.login(t, identitydomain.IDTokenClaims{Subject: "sub-ana", Email: "ana@example.com", EmailVerified: false})
+	expectCode(t, err, exceptions.CodeForbidden)
+}
+
+func TestCompleteLoginUseCase_ForbiddenWithoutProvisioning(t *testing.T) {
+	f := newLoginFixture(false)
+
+	_, err := f.login(t, identitydomain.IDTokenClaims{Subject: "sub-x", Email: "x@example.com", EmailVerified: true})
+	expectCode(t, err, exceptions.CodeForbidden)
+}
+
+func TestCompleteLoginUseCase_RejectsStateMismatchAndReplay(t *testing.T) {
+	f := newLoginFixture(true)
+	f.registry.claims = identitydomain.IDTokenClaims{Subject: "sub-1", Email: "a@example.com", EmailVerified: true}
+
+	started, err := f.begin.Execute(context.Background(), identityusecases.BeginLoginInput{Provider: "mock"})
+	if err != nil {
+		t.Fatalf("begin login: %v", err)
+	}
+
+	_, err = f.complete.Execute(context.Background(), identityusecases.CompleteLoginInput{
+		Provider: "mock", State: started.State, StateCookie: "other", Code: "code",
+	})
+	expectCode(t, err, exceptions.CodeUnauthorized)
+
+	input := identityusecases.CompleteLoginInput{Provider: "mock", State: started.State, StateCookie: started.State, Code: "code"}
+	if _, err := f.complete.Execute(context.Background(), input); err != nil {
+		t.Fatalf("complete login: %v", err)
+	}
+
+	_, err = f.complete.Execute(context.Background(), input)
+	expectCode(t, err, exceptions.CodeUnauthorized)
+}
+
+func TestCompleteLoginUseCase_RejectsNonceMismatch(t *testing.T) {
+	f := newLoginFixture(true)
+
+	_, err := f.login(t, identitydomain.IDTokenClaims{Subject: "sub-1", Email: "a@example.com", EmailVerified: true, Nonce: "forged"})
+	expectCode(t, err, exceptions.CodeUnauthorized)
+	if len(f.sessions.issued) != 0 {
+		t.Fatalf("expected no session to be issued")
+	}
+}
+
+func TestCompleteLoginUseCase_RejectsExpiredState(t *testing.T) {
+	f := newLoginFixture(true)
+
+	started, err := f.begin.Execute(context.Background(), identityusecases.BeginLoginInput{Provider: "mock"})
+	if err != nil {
+		t.Fatalf("begin login: %v", err)
+	}
+
+	id := identitydomain.HashState(started.State)
+	state := f.states.states[id]
+	state.ExpiresAt = time.Now().Add(-time.Minute)
+	f.states.states[id] = state
+
+	_, err = f.complete.Execute(context.Background(), identityusecases.CompleteLoginInput{
+		Provider: "mock", State: started.State, StateCookie: started.State, Code: "code",
+	})
+	expectCode(t, err, exceptions.CodeUnauthorized)
+}
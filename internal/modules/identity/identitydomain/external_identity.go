@@ -0,0 +1,18 @@
+package identitydomain
+
+import "time"
+
+type ExternalIdentity struct {
+	ID          uint      `json:"id" gorm:"primarykey"`
+	TenantID    string    `json:"tenant_id" gorm:"uniqueIndex:idx_external_identities_provider_subject"`
+	UserID      uint      `json:"user_id" gorm:"index"`
+	Provider    string    `json:"provider" gorm:"uniqueIndex:idx_external_identities_provider_subject"`
+	Subject     string    `json:"subject" gorm:"uniqueIndex:idx_external_identities_provider_subject"`
+	Email       string    `json:"email"`
+	CreatedAt   time.Time `json:"created_at"`
+	LastLoginAt time.Time `json:"last_login_at"`
+}
+
+func (ExternalIdentity) TableName() string {
+	return "external_identities"
+}
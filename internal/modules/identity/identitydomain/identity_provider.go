@@ -0,0 +1,17 @@
+package identitydomain
+
+import "context"
+
+type IDTokenClaims struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+	Nonce         string
+}
+
+type IdentityProviderRegistry interface {
+	Names() []string
+	AuthCodeURL(ctx context.Context, provider, state, nonce, codeVerifier string) (string, error)
+	Exchange(ctx context.Context, provider, code, codeVerifier string) (IDTokenClaims, error)
+}
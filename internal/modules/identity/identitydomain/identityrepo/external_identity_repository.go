@@ -0,0 +1,15 @@
+package identityrepo
+
+import (
+	"context"
+
+	"golang_boilerplate_module/internal/modules/identity/identitydomain"
+	sharedrepo "golang_boilerplate_module/internal/shared/domain/repositories"
+)
+
+type ExternalIdentityRepository interface {
+	sharedrepo.GenericRepository[identitydomain.ExternalIdentity, uint]
+	GetByProviderSubject(ctx context.Context, provider, subject string) (*identitydomain.ExternalIdentity, error)
+	ListByUser(ctx context.Context, userID uint) ([]identitydomain.ExternalIdentity, error)
+	DeleteByUser(ctx context.Context, userID uint) (int64, error)
+}
@@ -0,0 +1,15 @@
+package identityrepo
+
+import (
+	"context"
+	"time"
+
+	"golang_boilerplate_module/internal/modules/identity/identitydomain"
+	sharedrepo "golang_boilerplate_module/internal/shared/domain/repositories"
+)
+
+type LoginStateRepository interface {
+	sharedrepo.GenericRepository[identitydomain.LoginState, string]
+	Consume(ctx context.Context, id string) (*identitydomain.LoginState, error)
+	DeleteExpired(ctx context.Context, now time.Time) (int64, error)
+}
@@ -0,0 +1,41 @@
+package identitydomain
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+)
+
+type LoginState struct {
+	ID           string    `json:"-" gorm:"primarykey"`
+	TenantID     string    `json:"tenant_id" gorm:"index"`
+	Provider     string    `json:"provider"`
+	Nonce        string    `json:"-"`
+	CodeVerifier string    `json:"-"`
+	RedirectTo   string    `json:"redirect_to"`
+	ExpiresAt    time.Time `json:"expires_at"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+func (LoginState) TableName() string {
+	return "oidc_login_states"
+}
+
+func (s *LoginState) IsExpiredAt(now time.Time) bool {
+	return !now.Before(s.ExpiresAt)
+}
+
+func NewRandomToken() (string, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+func HashState(state string) string {
+	sum := sha256.Sum256([]byte(state))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,128 @@
+package identityhttp
+
+import (
+	"time"
+
+	"golang_boilerplate_module/internal/config"
+	"golang_boilerplate_module/internal/modules/identity/application/identityusecases"
+	"golang_boilerplate_module/internal/modules/identity/identitydomain"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+	"golang_boilerplate_module/internal/shared/infra/http/middleware"
+	"golang_boilerplate_module/internal/shared/infra/observability"
+
+	"github.com/gofiber/fiber/v2"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	stateCookieName = "oidc_state"
+	stateCookiePath = "/api"
+)
+
+var tracer = otel.Tracer("identity.http")
+
+type ProvidersResponse struct {
+	Providers []string `json:"providers"`
+}
+
+type OIDCController struct {
+	registry      identitydomain.IdentityProviderRegistry
+	beginLogin    *identityusecases.BeginLoginUseCase
+	completeLogin *identityusecases.CompleteLoginUseCase
+	cfg           *config.Config
+	logger        providers.LoggerProvider
+}
+
+func NewOIDCController(
+	registry identitydomain.IdentityProviderRegistry,
+	beginLogin *identityusecases.BeginLoginUseCase,
+	completeLogin *identityusecases.CompleteLoginUseCase,
+	cfg *config.Config,
+	logger providers.LoggerProvider,
+) *OIDCController {
+	return &OIDCController{
+		registry:      registry,
+		beginLogin:    beginLogin,
+		completeLogin: completeLogin,
+		cfg:           cfg,
+		logger:        logger,
+	}
+}
+
+func (ctrl *OIDCController) ListProviders(c *fiber.Ctx) error {
+	return c.JSON(ProvidersResponse{Providers: ctrl.registry.Names()})
+}
+
+func (ctrl *OIDCController) Login(c *fiber.Ctx) error {
+	ctx, span := tracer.Start(c.UserContext(), "OIDCController.Login")
+	defer span.End()
+
+	provider := c.Params("provider")
+	span.SetAttributes(attribute.String("identity.provider", provider))
+
+	output, err := ctrl.beginLogin.Execute(ctx, identityusecases.BeginLoginInput{
+		Provider:   provider,
+		RedirectTo: c.Query("redirect_to"),
+	})
+	if err != nil {
+		observability.RecordError(span, err)
+		return err
+	}
+
+	ctrl.setStateCookie(c, output.State, output.ExpiresAt)
+	return c.Redirect(output.AuthorizationURL, fiber.StatusFound)
+}
+
+func (ctrl *OIDCController) Callback(c *fiber.Ctx) error {
+	ctx, span := tracer.Start(c.UserContext(), "OIDCController.Callback")
+	defer span.End()
+
+	log := middleware.LoggerFromLocals(c, ctrl.logger).With("handler", "OIDCController.Callback")
+
+	provider := c.Params("provider")
+	span.SetAttributes(attribute.String("identity.provider", provider))
+
+	stateCookie := c.Cookies(stateCookieName)
+	ctrl.setStateCookie(c, "", time.Unix(0, 0))
+
+	if providerErr := c.Query("error"); providerErr != "" {
+		err := exceptions.NewUnauthorizedException("Login was not completed at the identity provider", map[string]any{
+			"error":             providerErr,
+			"error_description": c.Query("error_description"),
+		})
+		log.Warn("identity provider returned an error", "error", providerErr)
+		observability.RecordError(span, err)
+		return err
+	}
+
+	output, err := ctrl.completeLogin.Execute(ctx, identityusecases.CompleteLoginInput{
+		Provider:    provider,
+		State:       c.Query("state"),
+		StateCookie: stateCookie,
+		Code:        c.Query("code"),
+		UserAgent:   c.Get(fiber.HeaderUserAgent),
+		IPAddress:   c.IP(),
+	})
+	if err != nil {
+		observability.RecordError(span, err)
+		return err
+	}
+
+	middleware.SetSessionCookies(c, ctrl.cfg, output.Session.Token, output.Session.CSRFToken, output.Session.ExpiresAt)
+	return c.Redirect(output.RedirectTo, fiber.StatusFound)
+}
+
+func (ctrl *OIDCController) setStateCookie(c *fiber.Ctx, value string, expiresAt time.Time) {
+	c.Cookie(&fiber.Cookie{
+		Name:     stateCookieName,
+		Value:    value,
+		Path:     stateCookiePath,
+		Domain:   ctrl.cfg.Sessions.CookieDomain,
+		Expires:  expiresAt,
+		Secure:   ctrl.cfg.Sessions.CookieSecure,
+		HTTPOnly: true,
+		SameSite: fiber.CookieSameSiteLaxMode,
+	})
+}
@@ -0,0 +1,29 @@
+package identityhttp
+
+import (
+	"golang_boilerplate_module/internal/shared/infra/http/openapi"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func RegisterRoutes(router *openapi.Router, controller *OIDCController) {
+	api := router.Version("v1").Group("/auth/oidc", openapi.Tags("Authentication"))
+	api.Get("/providers", controller.ListProviders,
+		openapi.Summary("List the configured OpenID Connect providers"),
+		openapi.Returns[ProvidersResponse](fiber.StatusOK),
+	)
+	api.Get("/:provider/login", controller.Login,
+		openapi.Summary("Start an OpenID Connect login"),
+		openapi.QueryParam("redirect_to", "string", "Relative path to return to after login"),
+		openapi.Redirect("Redirect to the identity provider"),
+		openapi.Errors(fiber.StatusBadRequest, fiber.StatusNotFound, fiber.StatusServiceUnavailable),
+	)
+	api.Get("/:provider/callback", controller.Callback,
+		openapi.Summary("Complete an OpenID Connect login"),
+		openapi.QueryParam("code", "string", "Authorization code"),
+		openapi.QueryParam("state", "string", "State issued at login"),
+		openapi.QueryParam("error", "string", "Error reported by the identity provider"),
+		openapi.Redirect("Session cookies set; redirect to the requested page"),
+		openapi.Errors(fiber.StatusBadRequest, fiber.StatusUnauthorized, fiber.StatusForbidden, fiber.StatusNotFound),
+	)
+}
@@ -0,0 +1,20 @@
+package identityjobs
+
+import (
+	"context"
+
+	"golang_boilerplate_module/internal/config"
+	"golang_boilerplate_module/internal/modules/identity/application/identityusecases"
+	"golang_boilerplate_module/internal/shared/infra/platform"
+)
+
+func NewLoginStateCleanupJob(cfg *config.Config, cleanupLoginStates *identityusecases.CleanupLoginStatesUseCase) platform.Job {
+	return platform.Job{
+		Name:     "oidc_login_state_cleanup",
+		Interval: cfg.OIDC.StateTTL,
+		Run: func(ctx context.Context) error {
+			_, err := cleanupLoginStates.Execute(ctx)
+			return err
+		},
+	}
+}
@@ -0,0 +1,102 @@
+package identitypersistence
+
+import (
+	"context"
+	"errors"
+
+	"golang_boilerplate_module/internal/modules/identity/identitydomain"
+	"golang_boilerplate_module/internal/modules/identity/identitydomain/identityrepo"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	sharedrepo "golang_boilerplate_module/internal/shared/infra/persistence/repositories"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"gorm.io/gorm"
+)
+
+var dbTracer = otel.Tracer("identity.persistence")
+
+type GORMExternalIdentityRepository struct {
+	*sharedrepo.GORMGenericRepository[identitydomain.ExternalIdentity, uint]
+	db *gorm.DB
+}
+
+func NewGORMExternalIdentityRepository(db *gorm.DB) identityrepo.ExternalIdentityRepository {
+	return &GORMExternalIdentityRepository{
+		GORMGenericRepository: sharedrepo.NewGORMGenericRepository[identitydomain.ExternalIdentity, uint](db),
+		db:                    db,
+	}
+}
+
+func (r *GORMExternalIdentityRepository) GetByProviderSubject(ctx context.Context, provider, subject string) (*identitydomain.ExternalIdentity, error) {
+	ctx, span := dbTracer.Start(ctx, "GORMExternalIdentityRepository.GetByProviderSubject")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.operation", "GetByProviderSubject"),
+		attribute.String("identity.provider", provider),
+	)
+
+	var identity identitydomain.ExternalIdentity
+	err := sharedrepo.WithTenantSession(ctx, r.db, func(tx *gorm.DB) error {
+		return tx.Where("provider = ? AND subject = ?", provider, subject).First(&identity).Error
+	})
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		span.SetStatus(codes.Error, "not found")
+		return nil, exceptions.NewNotFoundException("External identity not found", nil)
+	}
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+		return nil, sharedrepo.DatabaseError(err)
+	}
+
+	return &identity, nil
+}
+
+func (r *GORMExternalIdentityRepository) ListByUser(ctx context.Context, userID uint) ([]identitydomain.ExternalIdentity, error) {
+	ctx, span := dbTracer.Start(ctx, "GORMExternalIdentityRepository.ListByUser")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.operation", "ListByUser"),
+		attribute.Int("user.id", int(userID)),
+	)
+
+	var identities []identitydomain.ExternalIdentity
+	err := sharedrepo.WithTenantSession(ctx, r.db, func(tx *gorm.DB) error {
+		return tx.Where("user_id = ?", userID).Order("id ASC").Find(&identities).Error
+	})
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+		return nil, sharedrepo.DatabaseError(err)
+	}
+
+	return identities, nil
+}
+
+func (r *GORMExternalIdentityRepository) DeleteByUser(ctx context.Context, userID uint) (int64, error) {
+	ctx, span := dbTracer.Start(ctx, "GORMExternalIdentityRepository.DeleteByUser")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.operation", "DeleteByUser"),
+		attribute.Int("user.id", int(userID)),
+	)
+
+	var deleted int64
+	err := sharedrepo.WithTenantSession(ctx, r.db, func(tx *gorm.DB) error {
+		result := tx.Where("user_id = ?", userID).Delete(&identitydomain.ExternalIdentity{})
+		deleted = result.RowsAffected
+		return result.Error
+	})
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+		return 0, sharedrepo.DatabaseError(err)
+	}
+
+	return deleted, nil
+}
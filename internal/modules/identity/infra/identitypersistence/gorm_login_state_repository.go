@@ -0,0 +1,71 @@
+package identitypersistence
+
+import (
+	"context"
+	"time"
+
+	"golang_boilerplate_module/internal/modules/identity/identitydomain"
+	"golang_boilerplate_module/internal/modules/identity/identitydomain/identityrepo"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/domain/tenancy"
+	sharedrepo "golang_boilerplate_module/internal/shared/infra/persistence/repositories"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type GORMLoginStateRepository struct {
+	*sharedrepo.GORMGenericRepository[identitydomain.LoginState, string]
+	db *gorm.DB
+}
+
+func NewGORMLoginStateRepository(db *gorm.DB) identityrepo.LoginStateRepository {
+	return &GORMLoginStateRepository{
+		GORMGenericRepository: sharedrepo.NewGORMGenericRepository[identitydomain.LoginState, string](db),
+		db:                    db,
+	}
+}
+
+func (r *GORMLoginStateRepository) Consume(ctx context.Context, id string) (*identitydomain.LoginState, error) {
+	ctx, span := dbTracer.Start(ctx, "GORMLoginStateRepository.Consume")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("db.operation", "Consume"))
+
+	var states []identitydomain.LoginState
+	err := sharedrepo.WithTenantSession(ctx, r.db, func(tx *gorm.DB) error {
+		return tx.Clauses(clause.Returning{}).Where("id = ?", id).Delete(&states).Error
+	})
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+		return nil, sharedrepo.DatabaseError(err)
+	}
+	if len(states) == 0 {
+		span.SetStatus(codes.Error, "not found")
+		return nil, exceptions.NewNotFoundException("Login state not found", nil)
+	}
+
+	return &states[0], nil
+}
+
+func (r *GORMLoginStateRepository) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	ctx, span := dbTracer.Start(ctx, "GORMLoginStateRepository.DeleteExpired")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("db.operation", "DeleteExpired"))
+
+	result := r.db.WithContext(tenancy.WithSystemScope(ctx)).
+		Where("expires_at <= ?", now).
+		Delete(&identitydomain.LoginState{})
+	if result.Error != nil {
+		span.SetStatus(codes.Error, result.Error.Error())
+		span.RecordError(result.Error)
+		return 0, exceptions.NewInternalException(map[string]any{"error": result.Error.Error()})
+	}
+
+	span.SetAttributes(attribute.Int64("identity.login_states.deleted", result.RowsAffected))
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,176 @@
+package identityproviders
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang_boilerplate_module/internal/config"
+	"golang_boilerplate_module/internal/modules/identity/identitydomain"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"golang.org/x/oauth2"
+)
+
+var tracer = otel.Tracer("identity.providers")
+
+type oidcClient struct {
+	oauth    oauth2.Config
+	verifier *oidc.IDTokenVerifier
+}
+
+type OIDCProviderRegistry struct {
+	providers  map[string]config.OIDCProviderConfig
+	names      []string
+	httpClient *http.Client
+	logger     providers.LoggerProvider
+
+	mu      sync.Mutex
+	clients map[string]*oidcClient
+}
+
+func NewOIDCProviderRegistry(cfg *config.Config, logger providers.LoggerProvider) identitydomain.IdentityProviderRegistry {
+	registry := &OIDCProviderRegistry{
+		providers: make(map[string]config.OIDCProviderConfig, len(cfg.OIDC.Providers)),
+		httpClient: &http.Client{
+			Timeout:   10 * time.Second,
+			Transport: otelhttp.NewTransport(http.DefaultTransport),
+		},
+		logger:  logger,
+		clients: make(map[string]*oidcClient),
+	}
+	for _, provider := range cfg.OIDC.Providers {
+		registry.providers[provider.Name] = provider
+		registry.names = append(registry.names, provider.Name)
+	}
+	return registry
+}
+
+func (r *OIDCProviderRegistry) Names() []string {
+	return append([]string{}, r.names...)
+}
+
+func (r *OIDCProviderRegistry) AuthCodeURL(ctx context.Context, provider, state, nonce, codeVerifier string) (string, error) {
+	ctx, span := tracer.Start(ctx, "OIDCProviderRegistry.AuthCodeURL")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("identity.provider", provider))
+
+	client, err := r.client(ctx, provider)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return "", err
+	}
+
+	return client.oauth.AuthCodeURL(state,
+		oidc.Nonce(nonce),
+		oauth2.S256ChallengeOption(codeVerifier),
+	), nil
+}
+
+func (r *OIDCProviderRegistry) Exchange(ctx context.Context, provider, code, codeVerifier string) (identitydomain.IDTokenClaims, error) {
+	ctx, span := tracer.Start(ctx, "OIDCProviderRegistry.Exchange")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("identity.provider", provider))
+
+	client, err := r.client(ctx, provider)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return identitydomain.IDTokenClaims{}, err
+	}
+
+	ctx = oidc.ClientContext(ctx, r.httpClient)
+
+	token, err := client.oauth.Exchange(ctx, code, oauth2.VerifierOption(codeVerifier))
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+		r.logger.Warn("authorization code exchange failed", "provider", provider, "error", err.Error())
+		return identitydomain.IDTokenClaims{}, exceptions.NewUnauthorizedException("Identity provider rejected the login", nil)
+	}
+
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok || rawIDToken == "" {
+		span.SetStatus(codes.Error, "missing id_token")
+		return identitydomain.IDTokenClaims{}, exceptions.NewUnauthorizedException("Identity provider did not return an ID token", nil)
+	}
+
+	idToken, err := client.verifier.Verify(ctx, rawIDToken)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+		r.logger.Warn("id token verification failed", "provider", provider, "error", err.Error())
+		return identitydomain.IDTokenClaims{}, exceptions.NewUnauthorizedException("Invalid ID token", nil)
+	}
+
+	var claims struct {
+		Email         string `json:"email"`
+		EmailVerified any    `json:"email_verified"`
+		Name          string `json:"name"`
+	}
+	if err := idToken.Claims(&claims); err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return identitydomain.IDTokenClaims{}, exceptions.NewUnauthorizedException("Invalid ID token claims", nil)
+	}
+
+	return identitydomain.IDTokenClaims{
+		Subject:       idToken.Subject,
+		Email:         claims.Email,
+		EmailVerified: parseEmailVerified(claims.EmailVerified),
+		Name:          claims.Name,
+		Nonce:         idToken.Nonce,
+	}, nil
+}
+
+func (r *OIDCProviderRegistry) client(ctx context.Context, name string) (*oidcClient, error) {
+	cfg, ok := r.providers[name]
+	if !ok {
+		return nil, exceptions.NewNotFoundException("Identity provider not found", map[string]any{"provider": name})
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if client, ok := r.clients[name]; ok {
+		return client, nil
+	}
+
+	discovered, err := oidc.NewProvider(oidc.ClientContext(ctx, r.httpClient), cfg.Issuer)
+	if err != nil {
+		r.logger.Error("oidc discovery failed", "provider", name, "issuer", cfg.Issuer, "error", err.Error())
+		return nil, exceptions.NewServiceUnavailableException("Identity provider unavailable", map[string]any{"provider": name})
+	}
+
+	client := &oidcClient{
+		oauth: oauth2.Config{
+			ClientID:     cfg.ClientID,
+			ClientSecret: cfg.ClientSecret,
+			RedirectURL:  cfg.RedirectURL,
+			Endpoint:     discovered.Endpoint(),
+			Scopes:       cfg.Scopes,
+		},
+		verifier: discovered.Verifier(&oidc.Config{ClientID: cfg.ClientID}),
+	}
+	r.clients[name] = client
+	return client, nil
+}
+
+func parseEmailVerified(value any) bool {
+	switch v := value.(type) {
+	case bool:
+		return v
+	case string:
+		verified, _ := strconv.ParseBool(v)
+		return verified
+	}
+	return false
+}
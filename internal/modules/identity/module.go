@@ -0,0 +1,42 @@
+package identity
+
+import (
+	"golang_boilerplate_module/internal/modules/identity/application/identityusecases"
+	"golang_boilerplate_module/internal/modules/identity/infra/identityhttp"
+	"golang_boilerplate_module/internal/modules/identity/infra/identityjobs"
+	"golang_boilerplate_module/internal/modules/identity/infra/identitypersistence"
+	"golang_boilerplate_module/internal/modules/identity/infra/identityproviders"
+	"golang_boilerplate_module/internal/shared/domain/privacy"
+	"golang_boilerplate_module/internal/shared/infra/platform"
+
+	"go.uber.org/fx"
+)
+
+var Module = platform.Module{
+	Name:         "identity",
+	Description:  "OpenID Connect login and linked external identities",
+	DependsOn:    []string{"users", "sessions"},
+	ConfigPrefix: "OIDC_",
+	Migrations:   []string{"V6__create_external_identities.sql"},
+	Providers: []any{
+		identitypersistence.NewGORMExternalIdentityRepository,
+		identitypersistence.NewGORMLoginStateRepository,
+		identityproviders.NewOIDCProviderRegistry,
+		identityusecases.NewBeginLoginUseCase,
+		identityusecases.NewCompleteLoginUseCase,
+		identityusecases.NewCleanupLoginStatesUseCase,
+		identityhttp.NewOIDCController,
+		fx.Annotate(
+			identityusecases.NewIdentityPersonalDataProvider,
+			fx.As(new(privacy.PersonalDataExporter)),
+			fx.ResultTags(privacy.ExportersGroup),
+		),
+		fx.Annotate(
+			identityusecases.NewIdentityPersonalDataProvider,
+			fx.As(new(privacy.PersonalDataEraser)),
+			fx.ResultTags(privacy.ErasersGroup),
+		),
+	},
+	Routes: []any{identityhttp.RegisterRoutes},
+	Jobs:   []any{identityjobs.NewLoginStateCleanupJob},
+}
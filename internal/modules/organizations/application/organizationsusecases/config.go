@@ -0,0 +1,8 @@
+package organizationsusecases
+
+import "time"
+
+// Config is the organizations section of the configuration.
+type Config struct {
+	InvitationTTL time.Duration `key:"invitation_ttl" env:"ORGANIZATIONS_INVITATION_TTL" default:"168h" validate:"gt=0s" doc:"Validity of an invitation"`
+}
@@ -0,0 +1,73 @@
+package organizationsusecases
+
+import (
+	"context"
+	"strings"
+
+	"golang_boilerplate_module/internal/modules/organizations/organizationsdomain"
+	"golang_boilerplate_module/internal/modules/organizations/organizationsdomain/organizationsrepo"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+	"golang_boilerplate_module/internal/shared/infra/observability"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type CreateOrganizationInput struct {
+	Name    string `json:"name" validate:"required"`
+	ActorID uint   `json:"-"`
+}
+
+type CreateOrganizationUseCase struct {
+	organizationRepo organizationsrepo.OrganizationRepository
+	membershipRepo   organizationsrepo.MembershipRepository
+	logger           providers.LoggerProvider
+}
+
+func NewCreateOrganizationUseCase(
+	organizationRepo organizationsrepo.OrganizationRepository,
+	membershipRepo organizationsrepo.MembershipRepository,
+	logger providers.LoggerProvider,
+) *CreateOrganizationUseCase {
+	return &CreateOrganizationUseCase{organizationRepo: organizationRepo, membershipRepo: membershipRepo, logger: logger}
+}
+
+func (uc *CreateOrganizationUseCase) Execute(ctx context.Context, input CreateOrganizationInput) (OrganizationOutput, error) {
+	ctx, span := organizationTracer.Start(ctx, "CreateOrganizationUseCase.Execute")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("user.id", int(input.ActorID)))
+
+	log := observability.LoggerWithTrace(ctx, uc.logger).With("usecase", "CreateOrganization", "actorId", input.ActorID)
+
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		err := exceptions.NewBadRequestException("Organization name is required", nil)
+		log.Warn("validation failed — empty organization name")
+		observability.RecordError(span, err)
+		return OrganizationOutput{}, err
+	}
+
+	organization, err := uc.organizationRepo.Add(ctx, &organizationsdomain.Organization{Name: name})
+	if err != nil {
+		log.Error("failed to create organization", "error", err.Error())
+		observability.RecordError(span, err)
+		return OrganizationOutput{}, err
+	}
+
+	_, err = uc.membershipRepo.Add(ctx, &organizationsdomain.Membership{
+		OrganizationID: organization.ID,
+		UserID:         input.ActorID,
+		Role:           organizationsdomain.RoleOwner,
+	})
+	if err != nil {
+		log.Error("failed to create owner membership", "error", err.Error(), "organizationId", organization.ID)
+		observability.RecordError(span, err)
+		_ = uc.organizationRepo.DeleteByID(ctx, organization.ID)
+		return OrganizationOutput{}, err
+	}
+
+	span.SetAttributes(attribute.Int("organization.id", int(organization.ID)))
+	log.Info("organization created", "organizationId", organization.ID)
+	return toOrganizationOutput(organization, organizationsdomain.RoleOwner), nil
+}
@@ -0,0 +1,100 @@
+package organizationsusecases
+
+import (
+	"context"
+
+	"golang_boilerplate_module/internal/modules/organizations/organizationsdomain"
+	"golang_boilerplate_module/internal/modules/organizations/organizationsdomain/organizationsrepo"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+	"golang_boilerplate_module/internal/shared/infra/observability"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type GetOrganizationUseCase struct {
+	organizationRepo organizationsrepo.OrganizationRepository
+	membershipRepo   organizationsrepo.MembershipRepository
+	logger           providers.LoggerProvider
+}
+
+func NewGetOrganizationUseCase(
+	organizationRepo organizationsrepo.OrganizationRepository,
+	membershipRepo organizationsrepo.MembershipRepository,
+	logger providers.LoggerProvider,
+) *GetOrganizationUseCase {
+	return &GetOrganizationUseCase{organizationRepo: organizationRepo, membershipRepo: membershipRepo, logger: logger}
+}
+
+func (uc *GetOrganizationUseCase) Execute(ctx context.Context, organizationID, actorID uint) (OrganizationOutput, error) {
+	ctx, span := organizationTracer.Start(ctx, "GetOrganizationUseCase.Execute")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("organization.id", int(organizationID)))
+
+	log := observability.LoggerWithTrace(ctx, uc.logger).With("usecase", "GetOrganization", "organizationId", organizationID)
+
+	membership, err := requireRole(ctx, uc.membershipRepo, organizationID, actorID, organizationsdomain.RoleMember)
+	if err != nil {
+		log.Warn("access denied", "error", err.Error())
+		observability.RecordError(span, err)
+		return OrganizationOutput{}, err
+	}
+
+	organization, err := uc.organizationRepo.GetByID(ctx, organizationID)
+	if err != nil {
+		log.Warn("organization not found", "error", err.Error())
+		observability.RecordError(span, err)
+		return OrganizationOutput{}, err
+	}
+
+	return toOrganizationOutput(organization, membership.Role), nil
+}
+
+type ListOrganizationsUseCase struct {
+	membershipRepo   organizationsrepo.MembershipRepository
+	organizationRepo organizationsrepo.OrganizationRepository
+	logger           providers.LoggerProvider
+}
+
+func NewListOrganizationsUseCase(
+	organizationRepo organizationsrepo.OrganizationRepository,
+	membershipRepo organizationsrepo.MembershipRepository,
+	logger providers.LoggerProvider,
+) *ListOrganizationsUseCase {
+	return &ListOrganizationsUseCase{organizationRepo: organizationRepo, membershipRepo: membershipRepo, logger: logger}
+}
+
+func (uc *ListOrganizationsUseCase) Execute(ctx context.Context, actorID uint) ([]OrganizationOutput, error) {
+	ctx, span := organizationTracer.Start(ctx, "ListOrganizationsUseCase.Execute")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("user.id", int(actorID)))
+
+	log := observability.LoggerWithTrace(ctx, uc.logger).With("usecase", "ListOrganizations", "actorId", actorID)
+
+	memberships, err := uc.membershipRepo.ListByUser(ctx, actorID)
+	if err != nil {
+		log.Error("failed to list memberships", "error", err.Error())
+		observability.RecordError(span, err)
+		return nil, err
+	}
+
+	roles := make(map[uint]organizationsdomain.Role, len(memberships))
+	for _, membership := range memberships {
+		roles[membership.OrganizationID] = membership.Role
+	}
+
+	organizations, err := uc.organizationRepo.ListByUserID(ctx, actorID)
+	if err != nil {
+		log.Error("failed to list organizations", "error", err.Error())
+		observability.RecordError(span, err)
+		return nil, err
+	}
+
+	outputs := make([]OrganizationOutput, 0, len(organizations))
+	for i := range organizations {
+		outputs = append(outputs, toOrganizationOutput(&organizations[i], roles[organizations[i].ID]))
+	}
+
+	return outputs, nil
+}
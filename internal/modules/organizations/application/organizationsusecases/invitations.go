@@ -0,0 +1,263 @@
+package organizationsusecases
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"golang_boilerplate_module/internal/modules/organizations/organizationsdomain"
+	"golang_boilerplate_module/internal/modules/organizations/organizationsdomain/organizationsrepo"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+	"golang_boilerplate_module/internal/shared/infra/observability"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type InvitationOutput struct {
+	ID             uint                                 `json:"id"`
+	OrganizationID uint                                 `json:"organization_id"`
+	Email          string                               `json:"email"`
+	Role           organizationsdomain.Role             `json:"role"`
+	Status         organizationsdomain.InvitationStatus `json:"status"`
+	ExpiresAt      time.Time                            `json:"expires_at"`
+	Token          string                               `json:"token,omitempty"`
+}
+
+func toInvitationOutput(i *organizationsdomain.Invitation) InvitationOutput {
+	return InvitationOutput{
+		ID:             i.ID,
+		OrganizationID: i.OrganizationID,
+		Email:          i.Email,
+		Role:           i.Role,
+		Status:         i.Status,
+		ExpiresAt:      i.ExpiresAt,
+	}
+}
+
+type InviteMemberInput struct {
+	OrganizationID uint                     `json:"-"`
+	ActorID        uint                     `json:"-"`
+	Email          string                   `json:"email" validate:"required,email"`
+	Role           organizationsdomain.Role `json:"role" validate:"oneof=admin member" doc:"Defaults to member"`
+}
+
+type InviteMemberUseCase struct {
+	invitationRepo organizationsrepo.InvitationRepository
+	membershipRepo organizationsrepo.MembershipRepository
+	cfg            *Config
+	logger         providers.LoggerProvider
+}
+
+func NewInviteMemberUseCase(
+	invitationRepo organizationsrepo.InvitationRepository,
+	membershipRepo organizationsrepo.MembershipRepository,
+	cfg *Config,
+	logger providers.LoggerProvider,
+) *InviteMemberUseCase {
+	return &InviteMemberUseCase{invitationRepo: invitationRepo, membershipRepo: membershipRepo, cfg: cfg, logger: logger}
+}
+
+func (uc *InviteMemberUseCase) Execute(ctx context.Context, input InviteMemberInput) (InvitationOutput, error) {
+	ctx, span := organizationTracer.Start(ctx, "InviteMemberUseCase.Execute")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("organization.id", int(input.OrganizationID)))
+
+	log := observability.LoggerWithTrace(ctx, uc.logger).With("usecase", "InviteMember", "organizationId", input.OrganizationID)
+
+	email := strings.TrimSpace(input.Email)
+	if input.Role == "" {
+		input.Role = organizationsdomain.RoleMember
+	}
+	if email == "" || !strings.Contains(email, "@") {
+		err := exceptions.NewBadRequestException("A valid email is required", nil)
+		log.Warn("validation failed — invalid invitation email")
+		observability.RecordError(span, err)
+		return InvitationOutput{}, err
+	}
+	if !input.Role.IsValid() || input.Role == organizationsdomain.RoleOwner {
+		err := exceptions.NewBadRequestException("Invitation role must be admin or member", nil)
+		log.Warn("validation failed — invalid invitation role", "role", input.Role)
+		observability.RecordError(span, err)
+		return InvitationOutput{}, err
+	}
+
+	actor, err := requireRole(ctx, uc.membershipRepo, input.OrganizationID, input.ActorID, organizationsdomain.RoleAdmin)
+	if err != nil {
+		log.Warn("access denied", "error", err.Error())
+		observability.RecordError(span, err)
+		return InvitationOutput{}, err
+	}
+
+	if existing, _ := uc.invitationRepo.GetPendingByEmail(ctx, input.OrganizationID, email, time.Now().UTC()); existing != nil {
+		err := exceptions.NewUnprocessableException("A pending invitation already exists for this email", map[string]any{"email": email})
+		log.Warn("pending invitation already exists")
+		observability.RecordError(span, err)
+		return InvitationOutput{}, err
+	}
+
+	token, tokenHash, err := organizationsdomain.NewInvitationToken()
+	if err != nil {
+		domainErr := exceptions.NewInternalException(map[string]any{"error": err.Error()})
+		log.Error("failed to generate invitation token", "error", err.Error())
+		observability.RecordError(span, domainErr)
+		return InvitationOutput{}, domainErr
+	}
+
+	invitation, err := uc.invitationRepo.Add(ctx, &organizationsdomain.Invitation{
+		OrganizationID:  input.OrganizationID,
+		Email:           email,
+		Role:            input.Role,
+		TokenHash:       tokenHash,
+		Status:          organizationsdomain.InvitationStatusPending,
+		InvitedByUserID: actor.UserID,
+		ExpiresAt:       time.Now().UTC().Truncate(time.Microsecond).Add(uc.cfg.InvitationTTL),
+	})
+	if err != nil {
+		log.Error("failed to create invitation", "error", err.Error())
+		observability.RecordError(span, err)
+		return InvitationOutput{}, err
+	}
+
+	span.SetAttributes(attribute.Int("organization.invitation.id", int(invitation.ID)))
+	log.Info("invitation created", "invitationId", invitation.ID)
+
+	output := toInvitationOutput(invitation)
+	output.Token = token
+	return output, nil
+}
+
+type AcceptInvitationUseCase struct {
+	invitationRepo organizationsrepo.InvitationRepository
+	membershipRepo organizationsrepo.MembershipRepository
+	users          providers.UserDirectory
+	logger         providers.LoggerProvider
+}
+
+func NewAcceptInvitationUseCase(
+	invitationRepo organizationsrepo.InvitationRepository,
+	membershipRepo organizationsrepo.MembershipRepository,
+	users providers.UserDirectory,
+	logger providers.LoggerProvider,
+) *AcceptInvitationUseCase {
+	return &AcceptInvitationUseCase{invitationRepo: invitationRepo, membershipRepo: membershipRepo, users: users, logger: logger}
+}
+
+func (uc *AcceptInvitationUseCase) Execute(ctx context.Context, token string, actorID uint) (MemberOutput, error) {
+	ctx, span := organizationTracer.Start(ctx, "AcceptInvitationUseCase.Execute")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("user.id", int(actorID)))
+
+	log := observability.LoggerWithTrace(ctx, uc.logger).With("usecase", "AcceptInvitation", "actorId", actorID)
+
+	invitation, err := loadRespondableInvitation(ctx, uc.invitationRepo, token)
+	if err != nil {
+		log.Warn("invitation cannot be accepted", "error", err.Error())
+		observability.RecordError(span, err)
+		return MemberOutput{}, err
+	}
+
+	span.SetAttributes(attribute.Int("organization.id", int(invitation.OrganizationID)))
+
+	user, err := uc.users.GetUserSummary(ctx, actorID)
+	if err != nil {
+		log.Warn("failed to load accepting user", "error", err.Error())
+		observability.RecordError(span, err)
+		return MemberOutput{}, err
+	}
+	if !strings.EqualFold(user.Email, invitation.Email) {
+		err := exceptions.NewForbiddenException("This invitation was issued to a different email", nil)
+		log.Warn("invitation email mismatch", "invitationId", invitation.ID)
+		observability.RecordError(span, err)
+		return MemberOutput{}, err
+	}
+
+	if existing, _ := uc.membershipRepo.GetByOrganizationAndUser(ctx, invitation.OrganizationID, actorID); existing != nil {
+		err := exceptions.NewUnprocessableException("User is already a member of this organization", nil)
+		log.Warn("user already a member", "invitationId", invitation.ID)
+		observability.RecordError(span, err)
+		return MemberOutput{}, err
+	}
+
+	var membership *organizationsdomain.Membership
+	respondedAt := time.Now().UTC().Truncate(time.Microsecond)
+	err = uc.invitationRepo.Respond(ctx, invitation.ID, organizationsdomain.InvitationStatusAccepted, respondedAt, func(ctx context.Context) error {
+		var err error
+		membership, err = uc.membershipRepo.Add(ctx, &organizationsdomain.Membership{
+			OrganizationID: invitation.OrganizationID,
+			UserID:         actorID,
+			Role:           invitation.Role,
+		})
+		return err
+	})
+	if err != nil {
+		log.Error("failed to accept invitation", "invitationId", invitation.ID, "error", err.Error())
+		observability.RecordError(span, err)
+		return MemberOutput{}, err
+	}
+
+	log.Info("invitation accepted", "invitationId", invitation.ID, "organizationId", invitation.OrganizationID)
+	return toMemberOutput(membership), nil
+}
+
+type DeclineInvitationUseCase struct {
+	invitationRepo organizationsrepo.InvitationRepository
+	logger         providers.LoggerProvider
+}
+
+func NewDeclineInvitationUseCase(invitationRepo organizationsrepo.InvitationRepository, logger providers.LoggerProvider) *DeclineInvitationUseCase {
+	return &DeclineInvitationUseCase{invitationRepo: invitationRepo, logger: logger}
+}
+
+func (uc *DeclineInvitationUseCase) Execute(ctx context.Context, token string) (InvitationOutput, error) {
+	ctx, span := organizationTracer.Start(ctx, "DeclineInvitationUseCase.Execute")
+	defer span.End()
+
+	log := observability.LoggerWithTrace(ctx, uc.logger).With("usecase", "DeclineInvitation")
+
+	invitation, err := loadRespondableInvitation(ctx, uc.invitationRepo, token)
+	if err != nil {
+		log.Warn("invitation cannot be declined", "error", err.Error())
+		observability.RecordError(span, err)
+		return InvitationOutput{}, err
+	}
+
+	span.SetAttributes(attribute.Int("organization.id", int(invitation.OrganizationID)))
+
+	respondedAt := time.Now().UTC().Truncate(time.Microsecond)
+	if err := uc.invitationRepo.Respond(ctx, invitation.ID, organizationsdomain.InvitationStatusDeclined, respondedAt, nil); err != nil {
+		log.Error("failed to decline invitation", "invitationId", invitation.ID, "error", err.Error())
+		observability.RecordError(span, err)
+		return InvitationOutput{}, err
+	}
+
+	invitation.Status = organizationsdomain.InvitationStatusDeclined
+	log.Info("invitation declined", "invitationId", invitation.ID)
+	return toInvitationOutput(invitation), nil
+}
+
+func loadRespondableInvitation(ctx context.Context, invitations organizationsrepo.InvitationRepository, token string) (*organizationsdomain.Invitation, error) {
+	if token == "" {
+		return nil, exceptions.NewNotFoundException("Invitation not found", nil)
+	}
+
+	invitation, err := invitations.GetByTokenHash(ctx, organizationsdomain.HashInvitationToken(token))
+	if err != nil {
+		return nil, err
+	}
+
+	if invitation.Status == organizationsdomain.InvitationStatusPending && invitation.IsExpiredAt(time.Now().UTC()) {
+		if _, err := invitations.UpdateByID(ctx, invitation.ID, map[string]any{"status": organizationsdomain.InvitationStatusExpired}); err != nil {
+			return nil, err
+		}
+		invitation.Status = organizationsdomain.InvitationStatusExpired
+	}
+
+	if invitation.Status != organizationsdomain.InvitationStatusPending {
+		return nil, exceptions.NewUnprocessableException("Invitation is no longer valid", map[string]any{"status": invitation.Status})
+	}
+
+	return invitation, nil
+}
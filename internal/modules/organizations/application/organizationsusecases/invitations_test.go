@@ -0,0 +1,180 @@
+package organizationsusecases_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"golang_boilerplate_module/internal/modules/organizations/application/organizationsusecases"
+	"golang_boilerplate_module/internal/modules/organizations/organizationsdomain"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+)
+
+func organizationsConfig() *organizationsusecases.Config {
+	return &organizationsusecases.Config{InvitationTTL: 24 * time.Hour}
+}
+
+func TestInviteMemberUseCase_ReturnsTokenOnce(t *testing.T) {
+	memberships := membershipsWith(organizationsdomain.Membership{OrganizationID: 1, UserID: 1, Role: organizationsdomain.RoleAdmin})
+	invitations := &mockInvitationRepo{}
+
+	uc := organizationsusecases.NewInviteMemberUseCase(invitations, memberships, organizationsConfig(), &mockLogger{})
+	out, err := uc.Execute(context.Background(), organizationsusecases.InviteMemberInput{
+		OrganizationID: 1, ActorID: 1, Email: "new@example.com",
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if out.Token == "" {
+		t.Fatalf("expected plaintext token in output")
+	}
+	if out.Role != organizationsdomain.RoleMember {
+		t.Fatalf("expected default role=member, got %q", out.Role)
+	}
+	stored := invitations.invitations[0]
+	if stored.TokenHash != organizationsdomain.HashInvitationToken(out.Token) {
+		t.Fatalf("expected only the token hash to be stored")
+	}
+	if got := time.Until(stored.ExpiresAt); got < 23*time.Hour || got > 24*time.Hour {
+		t.Fatalf("expected expiry ~24h from now, got %s", got)
+	}
+
+	_, err = uc.Execute(context.Background(), organizationsusecases.InviteMemberInput{
+		OrganizationID: 1, ActorID: 1, Email: "new@example.com",
+	})
+	expectCode(t, err, exceptions.CodeUnprocessable)
+}
+
+func TestInviteMemberUseCase_CannotInviteOwner(t *testing.T) {
+	memberships := membershipsWith(organizationsdomain.Membership{OrganizationID: 1, UserID: 1, Role: organizationsdomain.RoleOwner})
+
+	uc := organizationsusecases.NewInviteMemberUseCase(&mockInvitationRepo{}, memberships, organizationsConfig(), &mockLogger{})
+	_, err := uc.Execute(context.Background(), organizationsusecases.InviteMemberInput{
+		OrganizationID: 1, ActorID: 1, Email: "new@example.com", Role: organizationsdomain.RoleOwner,
+	})
+	expectCode(t, err, exceptions.CodeBadRequest)
+}
+
+func TestInviteMemberUseCase_ExpiredInvitationDoesNotBlock(t *testing.T) {
+	memberships := membershipsWith(organizationsdomain.Membership{OrganizationID: 1, UserID: 1, Role: organizationsdomain.RoleAdmin})
+	invitations := pendingInvitation("stale", time.Now().Add(-time.Hour))
+
+	uc := organizationsusecases.NewInviteMemberUseCase(invitations, memberships, organizationsConfig(), &mockLogger{})
+	if _, err := uc.Execute(context.Background(), organizationsusecases.InviteMemberInput{
+		OrganizationID: 1, ActorID: 1, Email: "invitee@example.com",
+	}); err != nil {
+		t.Fatalf("expected a new invitation once the pending one expired, got %v", err)
+	}
+}
+
+func pendingInvitation(token string, expiresAt time.Time) *mockInvitationRepo {
+	repo := &mockInvitationRepo{}
+	_, _ = repo.Add(context.Background(), &organizationsdomain.Invitation{
+		OrganizationID: 1,
+		Email:          "invitee@example.com",
+		Role:           organizationsdomain.RoleAdmin,
+		TokenHash:      organizationsdomain.HashInvitationToken(token),
+		Status:         organizationsdomain.InvitationStatusPending,
+		ExpiresAt:      expiresAt,
+	})
+	return repo
+}
+
+func inviteeDirectory() *mockUserDirectory {
+	return &mockUserDirectory{users: map[uint]providers.UserSummary{
+		5: {ID: 5, Email: "Invitee@Example.com"},
+		6: {ID: 6, Email: "someone@example.com"},
+	}}
+}
+
+func TestAcceptInvitationUseCase_Success(t *testing.T) {
+	invitations := pendingInvitation("tok", time.Now().Add(time.Hour))
+	memberships := &mockMembershipRepo{}
+
+	uc := organizationsusecases.NewAcceptInvitationUseCase(invitations, memberships, inviteeDirectory(), &mockLogger{})
+	out, err := uc.Execute(context.Background(), "tok", 5)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if out.Role != organizationsdomain.RoleAdmin || memberships.role(1, 5) != organizationsdomain.RoleAdmin {
+		t.Fatalf("expected admin membership, got %+v", out)
+	}
+	if invitations.invitations[0].Status != organizationsdomain.InvitationStatusAccepted {
+		t.Fatalf("expected invitation accepted, got %q", invitations.invitations[0].Status)
+	}
+
+	_, err = uc.Execute(context.Background(), "tok", 5)
+	expectCode(t, err, exceptions.CodeUnprocessable)
+}
+
+func TestAcceptInvitationUseCase_Expired(t *testing.T) {
+	invitations := pendingInvitation("tok", time.Now().Add(-time.Minute))
+
+	uc := organizationsusecases.NewAcceptInvitationUseCase(invitations, &mockMembershipRepo{}, inviteeDirectory(), &mockLogger{})
+	_, err := uc.Execute(context.Background(), "tok", 5)
+
+	expectCode(t, err, exceptions.CodeUnprocessable)
+	if invitations.invitations[0].Status != organizationsdomain.InvitationStatusExpired {
+		t.Fatalf("expected invitation marked expired, got %q", invitations.invitations[0].Status)
+	}
+}
+
+func TestAcceptInvitationUseCase_EmailMismatch(t *testing.T) {
+	invitations := pendingInvitation("tok", time.Now().Add(time.Hour))
+
+	uc := organizationsusecases.NewAcceptInvitationUseCase(invitations, &mockMembershipRepo{}, inviteeDirectory(), &mockLogger{})
+	_, err := uc.Execute(context.Background(), "tok", 6)
+	expectCode(t, err, exceptions.CodeForbidden)
+}
+
+func TestAcceptInvitationUseCase_RespondedConcurrently(t *testing.T) {
+	invitations := pendingInvitation("tok", time.Now().Add(time.Hour))
+	invitations.onRespond = func(invitation *organizationsdomain.Invitation) {
+		invitation.Status = organizationsdomain.InvitationStatusDeclined
+	}
+	memberships := &mockMembershipRepo{}
+
+	uc := organizationsusecases.NewAcceptInvitationUseCase(invitations, memberships, inviteeDirectory(), &mockLogger{})
+	_, err := uc.Execute(context.Background(), "tok", 5)
+
+	expectCode(t, err, exceptions.CodeUnprocessable)
+	if len(memberships.memberships) != 0 {
+		t.Fatalf("expected no membership, got %+v", memberships.memberships)
+	}
+}
+
+func TestAcceptInvitationUseCase_MembershipFailureKeepsTheInvitationPending(t *testing.T) {
+	invitations := pendingInvitation("tok", time.Now().Add(time.Hour))
+	memberships := &mockMembershipRepo{addErr: exceptions.NewInternalException(nil)}
+
+	uc := organizationsusecases.NewAcceptInvitationUseCase(invitations, memberships, inviteeDirectory(), &mockLogger{})
+	_, err := uc.Execute(context.Background(), "tok", 5)
+
+	expectCode(t, err, exceptions.CodeInternal)
+	if invitations.invitations[0].Status != organizationsdomain.InvitationStatusPending {
+		t.Fatalf("expected invitation still pending, got %q", invitations.invitations[0].Status)
+	}
+}
+
+func TestDeclineInvitationUseCase_Success(t *testing.T) {
+	invitations := pendingInvitation("tok", time.Now().Add(time.Hour))
+
+	uc := organizationsusecases.NewDeclineInvitationUseCase(invitations, &mockLogger{})
+	out, err := uc.Execute(context.Background(), "tok")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if out.Status != organizationsdomain.InvitationStatusDeclined || invitations.invitations[0].Status != organizationsdomain.InvitationStatusDeclined {
+		t.Fatalf("expected invitation declined, got %+v", out)
+	}
+
+	_, err = uc.Execute(context.Background(), "tok")
+	expectCode(t, err, exceptions.CodeUnprocessable)
+}
+
+func TestDeclineInvitationUseCase_UnknownToken(t *testing.T) {
+	uc := organizationsusecases.NewDeclineInvitationUseCase(&mockInvitationRepo{}, &mockLogger{})
+	_, err := uc.Execute(context.Background(), "missing")
+	expectCode(t, err, exceptions.CodeNotFound)
+}
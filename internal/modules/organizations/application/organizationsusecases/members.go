@@ -0,0 +1,285 @@
+package organizationsusecases
+
+import (
+	"context"
+
+	"golang_boilerplate_module/internal/modules/organizations/organizationsdomain"
+	"golang_boilerplate_module/internal/modules/organizations/organizationsdomain/organizationsrepo"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+	"golang_boilerplate_module/internal/shared/infra/observability"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type ListMembersUseCase struct {
+	membershipRepo organizationsrepo.MembershipRepository
+	logger         providers.LoggerProvider
+}
+
+func NewListMembersUseCase(membershipRepo organizationsrepo.MembershipRepository, logger providers.LoggerProvider) *ListMembersUseCase {
+	return &ListMembersUseCase{membershipRepo: membershipRepo, logger: logger}
+}
+
+func (uc *ListMembersUseCase) Execute(ctx context.Context, organizationID, actorID uint) ([]MemberOutput, error) {
+	ctx, span := organizationTracer.Start(ctx, "ListMembersUseCase.Execute")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("organization.id", int(organizationID)))
+
+	log := observability.LoggerWithTrace(ctx, uc.logger).With("usecase", "ListMembers", "organizationId", organizationID)
+
+	if _, err := requireRole(ctx, uc.membershipRepo, organizationID, actorID, organizationsdomain.RoleMember); err != nil {
+		log.Warn("access denied", "error", err.Error())
+		observability.RecordError(span, err)
+		return nil, err
+	}
+
+	memberships, err := uc.membershipRepo.ListByOrganization(ctx, organizationID)
+	if err != nil {
+		log.Error("failed to list members", "error", err.Error())
+		observability.RecordError(span, err)
+		return nil, err
+	}
+
+	outputs := make([]MemberOutput, 0, len(memberships))
+	for i := range memberships {
+		outputs = append(outputs, toMemberOutput(&memberships[i]))
+	}
+
+	return outputs, nil
+}
+
+type ChangeMemberRoleInput struct {
+	OrganizationID uint                     `json:"-"`
+	ActorID        uint                     `json:"-"`
+	UserID         uint                     `json:"-"`
+	Role           organizationsdomain.Role `json:"role" validate:"required,oneof=owner admin member"`
+}
+
+type ChangeMemberRoleUseCase struct {
+	membershipRepo organizationsrepo.MembershipRepository
+	logger         providers.LoggerProvider
+}
+
+func NewChangeMemberRoleUseCase(membershipRepo organizationsrepo.MembershipRepository, logger providers.LoggerProvider) *ChangeMemberRoleUseCase {
+	return &ChangeMemberRoleUseCase{membershipRepo: membershipRepo, logger: logger}
+}
+
+func (uc *ChangeMemberRoleUseCase) Execute(ctx context.Context, input ChangeMemberRoleInput) (MemberOutput, error) {
+	ctx, span := organizationTracer.Start(ctx, "ChangeMemberRoleUseCase.Execute")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("organization.id", int(input.OrganizationID)),
+		attribute.Int("user.id", int(input.UserID)),
+		attribute.String("organization.role", string(input.Role)),
+	)
+
+	log := observability.LoggerWithTrace(ctx, uc.logger).With(
+		"usecase", "ChangeMemberRole",
+		"organizationId", input.OrganizationID,
+		"userId", input.UserID,
+	)
+
+	if !input.Role.IsValid() {
+		err := exceptions.NewBadRequestException("Role must be one of owner, admin or member", nil)
+		log.Warn("validation failed — invalid role", "role", input.Role)
+		observability.RecordError(span, err)
+		return MemberOutput{}, err
+	}
+
+	var updated *organizationsdomain.Membership
+	err := uc.membershipRepo.WithOwnersLocked(ctx, input.OrganizationID, func(ctx context.Context, owners []organizationsdomain.Membership) error {
+		actor, err := requireRole(ctx, uc.membershipRepo, input.OrganizationID, input.ActorID, organizationsdomain.RoleAdmin)
+		if err != nil {
+			log.Warn("access denied", "error", err.Error())
+			return err
+		}
+
+		target, err := uc.membershipRepo.GetByOrganizationAndUser(ctx, input.OrganizationID, input.UserID)
+		if err != nil {
+			log.Warn("member not found", "error", err.Error())
+			return err
+		}
+
+		if (target.Role == organizationsdomain.RoleOwner || input.Role == organizationsdomain.RoleOwner) && actor.Role != organizationsdomain.RoleOwner {
+			log.Warn("non-owner attempted to change owner role")
+			return exceptions.NewForbiddenException("Only owners can grant or revoke the owner role", nil)
+		}
+
+		if input.Role != organizationsdomain.RoleOwner {
+			if err := ensureNotLastOwner(owners, target); err != nil {
+				log.Warn("refusing to demote last owner")
+				return err
+			}
+		}
+
+		updated, err = uc.membershipRepo.UpdateByID(ctx, target.ID, map[string]any{"role": input.Role})
+		if err != nil {
+			log.Error("failed to change member role", "error", err.Error())
+		}
+		return err
+	})
+	if err != nil {
+		observability.RecordError(span, err)
+		return MemberOutput{}, err
+	}
+
+	log.Info("member role changed", "role", input.Role)
+	return toMemberOutput(updated), nil
+}
+
+type RemoveMemberUseCase struct {
+	membershipRepo organizationsrepo.MembershipRepository
+	teamMemberRepo organizationsrepo.TeamMemberRepository
+	logger         providers.LoggerProvider
+}
+
+func NewRemoveMemberUseCase(
+	membershipRepo organizationsrepo.MembershipRepository,
+	teamMemberRepo organizationsrepo.TeamMemberRepository,
+	logger providers.LoggerProvider,
+) *RemoveMemberUseCase {
+	return &RemoveMemberUseCase{membershipRepo: membershipRepo, teamMemberRepo: teamMemberRepo, logger: logger}
+}
+
+func (uc *RemoveMemberUseCase) Execute(ctx context.Context, organizationID, actorID, userID uint) error {
+	ctx, span := organizationTracer.Start(ctx, "RemoveMemberUseCase.Execute")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("organization.id", int(organizationID)),
+		attribute.Int("user.id", int(userID)),
+	)
+
+	log := observability.LoggerWithTrace(ctx, uc.logger).With(
+		"usecase", "RemoveMember",
+		"organizationId", organizationID,
+		"userId", userID,
+	)
+
+	minRole := organizationsdomain.RoleAdmin
+	if actorID == userID {
+		minRole = organizationsdomain.RoleMember
+	}
+
+	err := uc.membershipRepo.WithOwnersLocked(ctx, organizationID, func(ctx context.Context, owners []organizationsdomain.Membership) error {
+		actor, err := requireRole(ctx, uc.membershipRepo, organizationID, actorID, minRole)
+		if err != nil {
+			log.Warn("access denied", "error", err.Error())
+			return err
+		}
+
+		target := actor
+		if actorID != userID {
+			target, err = uc.membershipRepo.GetByOrganizationAndUser(ctx, organizationID, userID)
+			if err != nil {
+				log.Warn("member not found", "error", err.Error())
+				return err
+			}
+			if target.Role == organizationsdomain.RoleOwner && actor.Role != organizationsdomain.RoleOwner {
+				log.Warn("non-owner attempted to remove an owner")
+				return exceptions.NewForbiddenException("Only owners can remove another owner", nil)
+			}
+		}
+
+		if err := ensureNotLastOwner(owners, target); err != nil {
+			log.Warn("refusing to remove last owner")
+			return err
+		}
+
+		if err := uc.teamMemberRepo.DeleteByOrganizationAndUser(ctx, organizationID, userID); err != nil {
+			log.Error("failed to remove team memberships", "error", err.Error())
+			return err
+		}
+
+		if err := uc.membershipRepo.DeleteByID(ctx, target.ID); err != nil {
+			log.Error("failed to remove member", "error", err.Error())
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		observability.RecordError(span, err)
+		return err
+	}
+
+	log.Info("member removed", "left", actorID == userID)
+	return nil
+}
+
+type TransferOwnershipInput struct {
+	OrganizationID uint `json:"-"`
+	ActorID        uint `json:"-"`
+	UserID         uint `json:"user_id" validate:"required"`
+}
+
+type TransferOwnershipUseCase struct {
+	membershipRepo organizationsrepo.MembershipRepository
+	logger         providers.LoggerProvider
+}
+
+func NewTransferOwnershipUseCase(membershipRepo organizationsrepo.MembershipRepository, logger providers.LoggerProvider) *TransferOwnershipUseCase {
+	return &TransferOwnershipUseCase{membershipRepo: membershipRepo, logger: logger}
+}
+
+func (uc *TransferOwnershipUseCase) Execute(ctx context.Context, input TransferOwnershipInput) ([]MemberOutput, error) {
+	ctx, span := organizationTracer.Start(ctx, "TransferOwnershipUseCase.Execute")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("organization.id", int(input.OrganizationID)),
+		attribute.Int("user.id", int(input.UserID)),
+	)
+
+	log := observability.LoggerWithTrace(ctx, uc.logger).With(
+		"usecase", "TransferOwnership",
+		"organizationId", input.OrganizationID,
+		"newOwnerId", input.UserID,
+	)
+
+	if input.UserID == 0 || input.UserID == input.ActorID {
+		err := exceptions.NewBadRequestException("Ownership must be transferred to another member", nil)
+		log.Warn("validation failed — invalid transfer target")
+		observability.RecordError(span, err)
+		return nil, err
+	}
+
+	var newOwner, previousOwner *organizationsdomain.Membership
+	err := uc.membershipRepo.WithOwnersLocked(ctx, input.OrganizationID, func(ctx context.Context, _ []organizationsdomain.Membership) error {
+		actor, err := requireRole(ctx, uc.membershipRepo, input.OrganizationID, input.ActorID, organizationsdomain.RoleOwner)
+		if err != nil {
+			log.Warn("access denied", "error", err.Error())
+			return err
+		}
+
+		target, err := uc.membershipRepo.GetByOrganizationAndUser(ctx, input.OrganizationID, input.UserID)
+		if err != nil {
+			if isNotFound(err) {
+				err = exceptions.NewUnprocessableException("Ownership can only be transferred to an existing member", nil)
+			}
+			log.Warn("transfer target is not a member", "error", err.Error())
+			return err
+		}
+
+		newOwner, err = uc.membershipRepo.UpdateByID(ctx, target.ID, map[string]any{"role": organizationsdomain.RoleOwner})
+		if err != nil {
+			log.Error("failed to promote new owner", "error", err.Error())
+			return err
+		}
+
+		previousOwner, err = uc.membershipRepo.UpdateByID(ctx, actor.ID, map[string]any{"role": organizationsdomain.RoleAdmin})
+		if err != nil {
+			log.Error("failed to demote previous owner", "error", err.Error())
+		}
+		return err
+	})
+	if err != nil {
+		observability.RecordError(span, err)
+		return nil, err
+	}
+
+	log.Info("ownership transferred")
+	return []MemberOutput{toMemberOutput(newOwner), toMemberOutput(previousOwner)}, nil
+}
@@ -0,0 +1,309 @@
+package organizationsusecases_test
+
+import (
+	"context"
+	"time"
+
+	"golang_boilerplate_module/internal/modules/organizations/organizationsdomain"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+)
+
+type mockOrganizationRepo struct {
+	organizations []organizationsdomain.Organization
+}
+
+func (m *mockOrganizationRepo) Add(_ context.Context, o *organizationsdomain.Organization) (*organizationsdomain.Organization, error) {
+	o.ID = uint(len(m.organizations) + 1)
+	m.organizations = append(m.organizations, *o)
+	return o, nil
+}
+
+func (m *mockOrganizationRepo) GetByID(_ context.Context, id uint) (*organizationsdomain.Organization, error) {
+	for i := range m.organizations {
+		if m.organizations[i].ID == id {
+			return &m.organizations[i], nil
+		}
+	}
+	return nil, exceptions.NewNotFoundException("Organization not found", nil)
+}
+
+func (m *mockOrganizationRepo) UpdateByID(_ context.Context, id uint, updates map[string]any) (*organizationsdomain.Organization, error) {
+	return &organizationsdomain.Organization{ID: id}, nil
+}
+
+func (m *mockOrganizationRepo) DeleteByID(_ context.Context, id uint) error {
+	return nil
+}
+
+func (m *mockOrganizationRepo) DeleteAll(_ context.Context) error {
+	return nil
+}
+
+func (m *mockOrganizationRepo) ListByUserID(_ context.Context, userID uint) ([]organizationsdomain.Organization, error) {
+	return m.organizations, nil
+}
+
+type mockMembershipRepo struct {
+	memberships []organizationsdomain.Membership
+	addErr      error
+}
+
+func (m *mockMembershipRepo) Add(_ context.Context, membership *organizationsdomain.Membership) (*organizationsdomain.Membership, error) {
+	if m.addErr != nil {
+		return nil, m.addErr
+	}
+	membership.ID = uint(len(m.memberships) + 1)
+	m.memberships = append(m.memberships, *membership)
+	return membership, nil
+}
+
+func (m *mockMembershipRepo) GetByID(_ context.Context, id uint) (*organizationsdomain.Membership, error) {
+	for i := range m.memberships {
+		if m.memberships[i].ID == id {
+			return &m.memberships[i], nil
+		}
+	}
+	return nil, exceptions.NewNotFoundException("Membership not found", nil)
+}
+
+func (m *mockMembershipRepo) UpdateByID(_ context.Context, id uint, updates map[string]any) (*organizationsdomain.Membership, error) {
+	for i := range m.memberships {
+		if m.memberships[i].ID == id {
+			if role, ok := updates["role"].(organizationsdomain.Role); ok {
+				m.memberships[i].Role = role
+			}
+			updated := m.memberships[i]
+			return &updated, nil
+		}
+	}
+	return nil, exceptions.NewNotFoundException("Membership not found", nil)
+}
+
+func (m *mockMembershipRepo) DeleteByID(_ context.Context, id uint) error {
+	for i := range m.memberships {
+		if m.memberships[i].ID == id {
+			m.memberships = append(m.memberships[:i], m.memberships[i+1:]...)
+			return nil
+		}
+	}
+	return exceptions.NewNotFoundException("Membership not found", nil)
+}
+
+func (m *mockMembershipRepo) DeleteAll(_ context.Context) error {
+	m.memberships = nil
+	return nil
+}
+
+func (m *mockMembershipRepo) GetByOrganizationAndUser(_ context.Context, organizationID, userID uint) (*organizationsdomain.Membership, error) {
+	for i := range m.memberships {
+		if m.memberships[i].OrganizationID == organizationID && m.memberships[i].UserID == userID {
+			found := m.memberships[i]
+			return &found, nil
+		}
+	}
+	return nil, exceptions.NewNotFoundException("Membership not found", nil)
+}
+
+func (m *mockMembershipRepo) ListByOrganization(_ context.Context, organizationID uint) ([]organizationsdomain.Membership, error) {
+	var result []organizationsdomain.Membership
+	for _, membership := range m.memberships {
+		if membership.OrganizationID == organizationID {
+			result = append(result, membership)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockMembershipRepo) ListByUser(_ context.Context, userID uint) ([]organizationsdomain.Membership, error) {
+	var result []organizationsdomain.Membership
+	for _, membership := range m.memberships {
+		if membership.UserID == userID {
+			result = append(result, membership)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockMembershipRepo) WithOwnersLocked(ctx context.Context, organizationID uint, fn func(ctx context.Context, owners []organizationsdomain.Membership) error) error {
+	var owners []organizationsdomain.Membership
+	for _, membership := range m.memberships {
+		if membership.OrganizationID == organizationID && membership.Role == organizationsdomain.RoleOwner {
+			owners = append(owners, membership)
+		}
+	}
+	return fn(ctx, owners)
+}
+
+func (m *mockMembershipRepo) DeleteByUser(_ context.Context, userID uint) (int64, error) {
+	var kept []organizationsdomain.Membership
+	for _, membership := range m.memberships {
+		if membership.UserID != userID {
+			kept = append(kept, membership)
+		}
+	}
+	deleted := int64(len(m.memberships) - len(kept))
+	m.memberships = kept
+	return deleted, nil
+}
+
+func (m *mockMembershipRepo) role(organizationID, userID uint) organizationsdomain.Role {
+	for _, membership := range m.memberships {
+		if membership.OrganizationID == organizationID && membership.UserID == userID {
+			return membership.Role
+		}
+	}
+	return ""
+}
+
+type mockTeamMemberRepo struct {
+	deletedForOrganization []uint
+}
+
+func (m *mockTeamMemberRepo) Add(_ context.Context, t *organizationsdomain.TeamMember) (*organizationsdomain.TeamMember, error) {
+	return t, nil
+}
+
+func (m *mockTeamMemberRepo) GetByID(_ context.Context, id uint) (*organizationsdomain.TeamMember, error) {
+	return nil, exceptions.NewNotFoundException("Team member not found", nil)
+}
+
+func (m *mockTeamMemberRepo) UpdateByID(_ context.Context, id uint, updates map[string]any) (*organizationsdomain.TeamMember, error) {
+	return nil, nil
+}
+
+func (m *mockTeamMemberRepo) DeleteByID(_ context.Context, id uint) error {
+	return nil
+}
+
+func (m *mockTeamMemberRepo) DeleteAll(_ context.Context) error {
+	return nil
+}
+
+func (m *mockTeamMemberRepo) GetByTeamAndUser(_ context.Context, teamID, userID uint) (*organizationsdomain.TeamMember, error) {
+	return nil, exceptions.NewNotFoundException("Team member not found", nil)
+}
+
+func (m *mockTeamMemberRepo) DeleteByOrganizationAndUser(_ context.Context, organizationID, userID uint) error {
+	m.deletedForOrganization = append(m.deletedForOrganization, userID)
+	return nil
+}
+
+func (m *mockTeamMemberRepo) DeleteByUser(_ context.Context, userID uint) (int64, error) {
+	return 0, nil
+}
+
+type mockInvitationRepo struct {
+	invitations []organizationsdomain.Invitation
+	// onRespond runs before Respond checks the status, as a concurrent
+	// response would.
+	onRespond func(invitation *organizationsdomain.Invitation)
+}
+
+func (m *mockInvitationRepo) Add(_ context.Context, i *organizationsdomain.Invitation) (*organizationsdomain.Invitation, error) {
+	i.ID = uint(len(m.invitations) + 1)
+	m.invitations = append(m.invitations, *i)
+	return i, nil
+}
+
+func (m *mockInvitationRepo) GetByID(_ context.Context, id uint) (*organizationsdomain.Invitation, error) {
+	for i := range m.invitations {
+		if m.invitations[i].ID == id {
+			return &m.invitations[i], nil
+		}
+	}
+	return nil, exceptions.NewNotFoundException("Invitation not found", nil)
+}
+
+func (m *mockInvitationRepo) UpdateByID(_ context.Context, id uint, updates map[string]any) (*organizationsdomain.Invitation, error) {
+	for i := range m.invitations {
+		if m.invitations[i].ID == id {
+			if status, ok := updates["status"].(organizationsdomain.InvitationStatus); ok {
+				m.invitations[i].Status = status
+			}
+			updated := m.invitations[i]
+			return &updated, nil
+		}
+	}
+	return nil, exceptions.NewNotFoundException("Invitation not found", nil)
+}
+
+func (m *mockInvitationRepo) DeleteByID(_ context.Context, id uint) error {
+	return nil
+}
+
+func (m *mockInvitationRepo) DeleteAll(_ context.Context) error {
+	return nil
+}
+
+func (m *mockInvitationRepo) GetByTokenHash(_ context.Context, tokenHash string) (*organizationsdomain.Invitation, error) {
+	for i := range m.invitations {
+		if m.invitations[i].TokenHash == tokenHash {
+			found := m.invitations[i]
+			return &found, nil
+		}
+	}
+	return nil, exceptions.NewNotFoundException("Invitation not found", nil)
+}
+
+func (m *mockInvitationRepo) GetPendingByEmail(_ context.Context, organizationID uint, email string, now time.Time) (*organizationsdomain.Invitation, error) {
+	for i := range m.invitations {
+		invitation := m.invitations[i]
+		if invitation.OrganizationID == organizationID && invitation.Email == email && invitation.Status == organizationsdomain.InvitationStatusPending && !invitation.IsExpiredAt(now) {
+			return &invitation, nil
+		}
+	}
+	return nil, exceptions.NewNotFoundException("Invitation not found", nil)
+}
+
+func (m *mockInvitationRepo) Respond(ctx context.Context, id uint, status organizationsdomain.InvitationStatus, _ time.Time, fn func(ctx context.Context) error) error {
+	for i := range m.invitations {
+		if m.invitations[i].ID != id {
+			continue
+		}
+		if m.onRespond != nil {
+			m.onRespond(&m.invitations[i])
+		}
+		if m.invitations[i].Status != organizationsdomain.InvitationStatusPending {
+			return exceptions.NewUnprocessableException("Invitation is no longer valid", nil)
+		}
+		if fn != nil {
+			if err := fn(ctx); err != nil {
+				return err
+			}
+		}
+		m.invitations[i].Status = status
+		return nil
+	}
+	return exceptions.NewUnprocessableException("Invitation is no longer valid", nil)
+}
+
+type mockUserDirectory struct {
+	users map[uint]providers.UserSummary
+}
+
+func (m *mockUserDirectory) GetUserSummary(_ context.Context, userID uint) (providers.UserSummary, error) {
+	user, ok := m.users[userID]
+	if !ok {
+		return providers.UserSummary{}, exceptions.NewNotFoundException("User not found", nil)
+	}
+	return user, nil
+}
+
+func (m *mockUserDirectory) FindUserByEmail(_ context.Context, email string) (providers.UserSummary, error) {
+	for _, user := range m.users {
+		if user.Email == email {
+			return user, nil
+		}
+	}
+	return providers.UserSummary{}, exceptions.NewNotFoundException("User not found", nil)
+}
+
+type mockLogger struct{}
+
+func (l *mockLogger) Info(msg string, fields ...any)            {}
+func (l *mockLogger) Warn(msg string, fields ...any)            {}
+func (l *mockLogger) Error(msg string, fields ...any)           {}
+func (l *mockLogger) Debug(msg string, fields ...any)           {}
+func (l *mockLogger) Sync() error                               { return nil }
+func (l *mockLogger) With(args ...any) providers.LoggerProvider { return l }
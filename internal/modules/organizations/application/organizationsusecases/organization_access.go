@@ -0,0 +1,79 @@
+package organizationsusecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"golang_boilerplate_module/internal/modules/organizations/organizationsdomain"
+	"golang_boilerplate_module/internal/modules/organizations/organizationsdomain/organizationsrepo"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+
+	"go.opentelemetry.io/otel"
+)
+
+var organizationTracer = otel.Tracer("organizations")
+
+type OrganizationOutput struct {
+	ID        uint                     `json:"id"`
+	Name      string                   `json:"name"`
+	Role      organizationsdomain.Role `json:"role"`
+	CreatedAt time.Time                `json:"created_at"`
+	UpdatedAt time.Time                `json:"updated_at"`
+}
+
+func toOrganizationOutput(o *organizationsdomain.Organization, role organizationsdomain.Role) OrganizationOutput {
+	return OrganizationOutput{ID: o.ID, Name: o.Name, Role: role, CreatedAt: o.CreatedAt, UpdatedAt: o.UpdatedAt}
+}
+
+type MemberOutput struct {
+	UserID   uint                     `json:"user_id"`
+	Role     organizationsdomain.Role `json:"role"`
+	JoinedAt time.Time                `json:"joined_at"`
+}
+
+func toMemberOutput(m *organizationsdomain.Membership) MemberOutput {
+	return MemberOutput{UserID: m.UserID, Role: m.Role, JoinedAt: m.CreatedAt}
+}
+
+func requireRole(
+	ctx context.Context,
+	memberships organizationsrepo.MembershipRepository,
+	organizationID, userID uint,
+	minRole organizationsdomain.Role,
+) (*organizationsdomain.Membership, error) {
+	membership, err := memberships.GetByOrganizationAndUser(ctx, organizationID, userID)
+	if err != nil {
+		if isNotFound(err) {
+			return nil, exceptions.NewNotFoundException("Organization not found", nil)
+		}
+		return nil, err
+	}
+
+	if !membership.Role.AtLeast(minRole) {
+		return nil, exceptions.NewForbiddenException("Insufficient organization role", map[string]any{
+			"required": minRole,
+			"actual":   membership.Role,
+		})
+	}
+
+	return membership, nil
+}
+
+// ensureNotLastOwner takes the owners locked by WithOwnersLocked, so no
+// concurrent change can leave the organization without one.
+func ensureNotLastOwner(owners []organizationsdomain.Membership, membership *organizationsdomain.Membership) error {
+	if membership.Role != organizationsdomain.RoleOwner {
+		return nil
+	}
+	if len(owners) <= 1 {
+		return exceptions.NewUnprocessableException("The last owner cannot leave the organization; transfer ownership first", nil)
+	}
+
+	return nil
+}
+
+func isNotFound(err error) bool {
+	var domainErr *exceptions.DomainError
+	return errors.As(err, &domainErr) && domainErr.Code == exceptions.CodeNotFound
+}
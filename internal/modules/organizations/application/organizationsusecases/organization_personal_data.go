@@ -0,0 +1,87 @@
+package organizationsusecases
+
+import (
+	"context"
+
+	"golang_boilerplate_module/internal/modules/organizations/organizationsdomain/organizationsrepo"
+	"golang_boilerplate_module/internal/shared/domain/privacy"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+	"golang_boilerplate_module/internal/shared/infra/observability"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type OrganizationPersonalData struct {
+	Memberships []MemberOutput `json:"memberships"`
+}
+
+type OrganizationPersonalDataProvider struct {
+	membershipRepo organizationsrepo.MembershipRepository
+	teamMemberRepo organizationsrepo.TeamMemberRepository
+	logger         providers.LoggerProvider
+}
+
+func NewOrganizationPersonalDataProvider(
+	membershipRepo organizationsrepo.MembershipRepository,
+	teamMemberRepo organizationsrepo.TeamMemberRepository,
+	logger providers.LoggerProvider,
+) *OrganizationPersonalDataProvider {
+	return &OrganizationPersonalDataProvider{membershipRepo: membershipRepo, teamMemberRepo: teamMemberRepo, logger: logger}
+}
+
+func (p *OrganizationPersonalDataProvider) Module() string {
+	return "organizations"
+}
+
+func (p *OrganizationPersonalDataProvider) ExportPersonalData(ctx context.Context, userID uint) (any, error) {
+	ctx, span := organizationTracer.Start(ctx, "OrganizationPersonalDataProvider.ExportPersonalData")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("user.id", int(userID)))
+
+	log := observability.LoggerWithTrace(ctx, p.logger).With("provider", "OrganizationPersonalData", "userId", userID)
+
+	memberships, err := p.membershipRepo.ListByUser(ctx, userID)
+	if err != nil {
+		log.Warn("failed to load memberships for export", "error", err.Error())
+		observability.RecordError(span, err)
+		return nil, err
+	}
+
+	data := OrganizationPersonalData{Memberships: make([]MemberOutput, 0, len(memberships))}
+	for i := range memberships {
+		data.Memberships = append(data.Memberships, toMemberOutput(&memberships[i]))
+	}
+
+	return data, nil
+}
+
+func (p *OrganizationPersonalDataProvider) ErasePersonalData(ctx context.Context, userID uint) (privacy.ErasureResult, error) {
+	ctx, span := organizationTracer.Start(ctx, "OrganizationPersonalDataProvider.ErasePersonalData")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("user.id", int(userID)))
+
+	log := observability.LoggerWithTrace(ctx, p.logger).With("provider", "OrganizationPersonalData", "userId", userID)
+
+	teamMembers, err := p.teamMemberRepo.DeleteByUser(ctx, userID)
+	if err != nil {
+		log.Error("failed to delete team memberships", "error", err.Error())
+		observability.RecordError(span, err)
+		return privacy.ErasureResult{}, err
+	}
+
+	memberships, err := p.membershipRepo.DeleteByUser(ctx, userID)
+	if err != nil {
+		log.Error("failed to delete organization memberships", "error", err.Error())
+		observability.RecordError(span, err)
+		return privacy.ErasureResult{}, err
+	}
+
+	log.Info("organization memberships deleted", "memberships", memberships, "teamMembers", teamMembers)
+
+	return privacy.ErasureResult{
+		Strategy:        privacy.ErasureStrategyDelete,
+		RecordsAffected: memberships + teamMembers,
+	}, nil
+}
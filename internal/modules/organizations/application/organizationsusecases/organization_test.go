@@ -0,0 +1,153 @@
+package organizationsusecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"golang_boilerplate_module/internal/modules/organizations/application/organizationsusecases"
+	"golang_boilerplate_module/internal/modules/organizations/organizationsdomain"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+)
+
+func expectCode(t *testing.T, err error, code exceptions.ExceptionCode) {
+	t.Helper()
+	var domainErr *exceptions.DomainError
+	if !errors.As(err, &domainErr) {
+		t.Fatalf("expected DomainError, got %T (%v)", err, err)
+	}
+	if domainErr.Code != code {
+		t.Fatalf("expected code=%s, got %s", code, domainErr.Code)
+	}
+}
+
+func membershipsWith(memberships ...organizationsdomain.Membership) *mockMembershipRepo {
+	repo := &mockMembershipRepo{}
+	for i := range memberships {
+		_, _ = repo.Add(context.Background(), &memberships[i])
+	}
+	return repo
+}
+
+func TestCreateOrganizationUseCase_CreatorBecomesOwner(t *testing.T) {
+	memberships := &mockMembershipRepo{}
+	uc := organizationsusecases.NewCreateOrganizationUseCase(&mockOrganizationRepo{}, memberships, &mockLogger{})
+
+	out, err := uc.Execute(context.Background(), organizationsusecases.CreateOrganizationInput{Name: "Acme", ActorID: 7})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if out.Role != organizationsdomain.RoleOwner {
+		t.Fatalf("expected role=owner, got %q", out.Role)
+	}
+	if got := memberships.role(out.ID, 7); got != organizationsdomain.RoleOwner {
+		t.Fatalf("expected owner membership for creator, got %q", got)
+	}
+}
+
+func TestCreateOrganizationUseCase_EmptyName(t *testing.T) {
+	uc := organizationsusecases.NewCreateOrganizationUseCase(&mockOrganizationRepo{}, &mockMembershipRepo{}, &mockLogger{})
+
+	_, err := uc.Execute(context.Background(), organizationsusecases.CreateOrganizationInput{Name: "  ", ActorID: 7})
+	expectCode(t, err, exceptions.CodeBadRequest)
+}
+
+func TestGetOrganizationUseCase_NonMemberSeesNotFound(t *testing.T) {
+	organizations := &mockOrganizationRepo{}
+	_, _ = organizations.Add(context.Background(), &organizationsdomain.Organization{Name: "Acme"})
+	memberships := membershipsWith(organizationsdomain.Membership{OrganizationID: 1, UserID: 1, Role: organizationsdomain.RoleOwner})
+
+	uc := organizationsusecases.NewGetOrganizationUseCase(organizations, memberships, &mockLogger{})
+	_, err := uc.Execute(context.Background(), 1, 99)
+	expectCode(t, err, exceptions.CodeNotFound)
+}
+
+func TestUpdateOrganizationUseCase_MemberForbidden(t *testing.T) {
+	memberships := membershipsWith(organizationsdomain.Membership{OrganizationID: 1, UserID: 2, Role: organizationsdomain.RoleMember})
+
+	uc := organizationsusecases.NewUpdateOrganizationUseCase(&mockOrganizationRepo{}, memberships, &mockLogger{})
+	_, err := uc.Execute(context.Background(), organizationsusecases.UpdateOrganizationInput{OrganizationID: 1, ActorID: 2, Name: "New"})
+	expectCode(t, err, exceptions.CodeForbidden)
+}
+
+func TestRemoveMemberUseCase_LastOwnerCannotLeave(t *testing.T) {
+	memberships := membershipsWith(
+		organizationsdomain.Membership{OrganizationID: 1, UserID: 1, Role: organizationsdomain.RoleOwner},
+		organizationsdomain.Membership{OrganizationID: 1, UserID: 2, Role: organizationsdomain.RoleAdmin},
+	)
+
+	uc := organizationsusecases.NewRemoveMemberUseCase(memberships, &mockTeamMemberRepo{}, &mockLogger{})
+	err := uc.Execute(context.Background(), 1, 1, 1)
+
+	expectCode(t, err, exceptions.CodeUnprocessable)
+	if got := memberships.role(1, 1); got != organizationsdomain.RoleOwner {
+		t.Fatalf("expected owner to remain, got %q", got)
+	}
+}
+
+func TestRemoveMemberUseCase_MemberCanLeave(t *testing.T) {
+	memberships := membershipsWith(
+		organizationsdomain.Membership{OrganizationID: 1, UserID: 1, Role: organizationsdomain.RoleOwner},
+		organizationsdomain.Membership{OrganizationID: 1, UserID: 2, Role: organizationsdomain.RoleMember},
+	)
+	teamMembers := &mockTeamMemberRepo{}
+
+	uc := organizationsusecases.NewRemoveMemberUseCase(memberships, teamMembers, &mockLogger{})
+	if err := uc.Execute(context.Background(), 1, 2, 2); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got := memberships.role(1, 2); got != "" {
+		t.Fatalf("expected membership removed, got role %q", got)
+	}
+	if len(teamMembers.deletedForOrganization) != 1 {
+		t.Fatalf("expected team memberships to be removed")
+	}
+}
+
+func TestRemoveMemberUseCase_AdminCannotRemoveOwner(t *testing.T) {
+	memberships := membershipsWith(
+		organizationsdomain.Membership{OrganizationID: 1, UserID: 1, Role: organizationsdomain.RoleOwner},
+		organizationsdomain.Membership{OrganizationID: 1, UserID: 2, Role: organizationsdomain.RoleAdmin},
+	)
+
+	uc := organizationsusecases.NewRemoveMemberUseCase(memberships, &mockTeamMemberRepo{}, &mockLogger{})
+	err := uc.Execute(context.Background(), 1, 2, 1)
+	expectCode(t, err, exceptions.CodeForbidden)
+}
+
+func TestChangeMemberRoleUseCase_CannotDemoteLastOwner(t *testing.T) {
+	memberships := membershipsWith(organizationsdomain.Membership{OrganizationID: 1, UserID: 1, Role: organizationsdomain.RoleOwner})
+
+	uc := organizationsusecases.NewChangeMemberRoleUseCase(memberships, &mockLogger{})
+	_, err := uc.Execute(context.Background(), organizationsusecases.ChangeMemberRoleInput{
+		OrganizationID: 1, ActorID: 1, UserID: 1, Role: organizationsdomain.RoleAdmin,
+	})
+	expectCode(t, err, exceptions.CodeUnprocessable)
+}
+
+func TestTransferOwnershipUseCase_Success(t *testing.T) {
+	memberships := membershipsWith(
+		organizationsdomain.Membership{OrganizationID: 1, UserID: 1, Role: organizationsdomain.RoleOwner},
+		organizationsdomain.Membership{OrganizationID: 1, UserID: 2, Role: organizationsdomain.RoleMember},
+	)
+
+	uc := organizationsusecases.NewTransferOwnershipUseCase(memberships, &mockLogger{})
+	_, err := uc.Execute(context.Background(), organizationsusecases.TransferOwnershipInput{OrganizationID: 1, ActorID: 1, UserID: 2})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got := memberships.role(1, 2); got != organizationsdomain.RoleOwner {
+		t.Fatalf("expected new owner, got %q", got)
+	}
+	if got := memberships.role(1, 1); got != organizationsdomain.RoleAdmin {
+		t.Fatalf("expected previous owner to become admin, got %q", got)
+	}
+}
+
+func TestTransferOwnershipUseCase_TargetMustBeMember(t *testing.T) {
+	memberships := membershipsWith(organizationsdomain.Membership{OrganizationID: 1, UserID: 1, Role: organizationsdomain.RoleOwner})
+
+	uc := organizationsusecases.NewTransferOwnershipUseCase(memberships, &mockLogger{})
+	_, err := uc.Execute(context.Background(), organizationsusecases.TransferOwnershipInput{OrganizationID: 1, ActorID: 1, UserID: 5})
+	expectCode(t, err, exceptions.CodeUnprocessable)
+}
@@ -0,0 +1,326 @@
+package organizationsusecases
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"golang_boilerplate_module/internal/modules/organizations/organizationsdomain"
+	"golang_boilerplate_module/internal/modules/organizations/organizationsdomain/organizationsrepo"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+	"golang_boilerplate_module/internal/shared/infra/observability"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type TeamOutput struct {
+	ID             uint      `json:"id"`
+	OrganizationID uint      `json:"organization_id"`
+	Name           string    `json:"name"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+func toTeamOutput(t *organizationsdomain.Team) TeamOutput {
+	return TeamOutput{ID: t.ID, OrganizationID: t.OrganizationID, Name: t.Name, CreatedAt: t.CreatedAt}
+}
+
+type CreateTeamInput struct {
+	OrganizationID uint   `json:"-"`
+	ActorID        uint   `json:"-"`
+	Name           string `json:"name" validate:"required"`
+}
+
+type CreateTeamUseCase struct {
+	teamRepo       organizationsrepo.TeamRepository
+	membershipRepo organizationsrepo.MembershipRepository
+	logger         providers.LoggerProvider
+}
+
+func NewCreateTeamUseCase(
+	teamRepo organizationsrepo.TeamRepository,
+	membershipRepo organizationsrepo.MembershipRepository,
+	logger providers.LoggerProvider,
+) *CreateTeamUseCase {
+	return &CreateTeamUseCase{teamRepo: teamRepo, membershipRepo: membershipRepo, logger: logger}
+}
+
+func (uc *CreateTeamUseCase) Execute(ctx context.Context, input CreateTeamInput) (TeamOutput, error) {
+	ctx, span := organizationTracer.Start(ctx, "CreateTeamUseCase.Execute")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("organization.id", int(input.OrganizationID)))
+
+	log := observability.LoggerWithTrace(ctx, uc.logger).With("usecase", "CreateTeam", "organizationId", input.OrganizationID)
+
+	if _, err := requireRole(ctx, uc.membershipRepo, input.OrganizationID, input.ActorID, organizationsdomain.RoleAdmin); err != nil {
+		log.Warn("access denied", "error", err.Error())
+		observability.RecordError(span, err)
+		return TeamOutput{}, err
+	}
+
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		err := exceptions.NewBadRequestException("Team name is required", nil)
+		log.Warn("validation failed — empty team name")
+		observability.RecordError(span, err)
+		return TeamOutput{}, err
+	}
+
+	team, err := uc.teamRepo.Add(ctx, &organizationsdomain.Team{OrganizationID: input.OrganizationID, Name: name})
+	if err != nil {
+		log.Error("failed to create team", "error", err.Error())
+		observability.RecordError(span, err)
+		return TeamOutput{}, err
+	}
+
+	span.SetAttributes(attribute.Int("organization.team.id", int(team.ID)))
+	log.Info("team created", "teamId", team.ID)
+	return toTeamOutput(team), nil
+}
+
+type ListTeamsUseCase struct {
+	teamRepo       organizationsrepo.TeamRepository
+	membershipRepo organizationsrepo.MembershipRepository
+	logger         providers.LoggerProvider
+}
+
+func NewListTeamsUseCase(
+	teamRepo organizationsrepo.TeamRepository,
+	membershipRepo organizationsrepo.MembershipRepository,
+	logger providers.LoggerProvider,
+) *ListTeamsUseCase {
+	return &ListTeamsUseCase{teamRepo: teamRepo, membershipRepo: membershipRepo, logger: logger}
+}
+
+func (uc *ListTeamsUseCase) Execute(ctx context.Context, organizationID, actorID uint) ([]TeamOutput, error) {
+	ctx, span := organizationTracer.Start(ctx, "ListTeamsUseCase.Execute")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("organization.id", int(organizationID)))
+
+	log := observability.LoggerWithTrace(ctx, uc.logger).With("usecase", "ListTeams", "organizationId", organizationID)
+
+	if _, err := requireRole(ctx, uc.membershipRepo, organizationID, actorID, organizationsdomain.RoleMember); err != nil {
+		log.Warn("access denied", "error", err.Error())
+		observability.RecordError(span, err)
+		return nil, err
+	}
+
+	teams, err := uc.teamRepo.ListByOrganization(ctx, organizationID)
+	if err != nil {
+		log.Error("failed to list teams", "error", err.Error())
+		observability.RecordError(span, err)
+		return nil, err
+	}
+
+	outputs := make([]TeamOutput, 0, len(teams))
+	for i := range teams {
+		outputs = append(outputs, toTeamOutput(&teams[i]))
+	}
+
+	return outputs, nil
+}
+
+type DeleteTeamUseCase struct {
+	teamRepo       organizationsrepo.TeamRepository
+	membershipRepo organizationsrepo.MembershipRepository
+	logger         providers.LoggerProvider
+}
+
+func NewDeleteTeamUseCase(
+	teamRepo organizationsrepo.TeamRepository,
+	membershipRepo organizationsrepo.MembershipRepository,
+	logger providers.LoggerProvider,
+) *DeleteTeamUseCase {
+	return &DeleteTeamUseCase{teamRepo: teamRepo, membershipRepo: membershipRepo, logger: logger}
+}
+
+func (uc *DeleteTeamUseCase) Execute(ctx context.Context, organizationID, teamID, actorID uint) error {
+	ctx, span := organizationTracer.Start(ctx, "DeleteTeamUseCase.Execute")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("organization.id", int(organizationID)),
+		attribute.Int("organization.team.id", int(teamID)),
+	)
+
+	log := observability.LoggerWithTrace(ctx, uc.logger).With("usecase", "DeleteTeam", "organizationId", organizationID, "teamId", teamID)
+
+	if _, err := requireRole(ctx, uc.membershipRepo, organizationID, actorID, organizationsdomain.RoleAdmin); err != nil {
+		log.Warn("access denied", "error", err.Error())
+		observability.RecordError(span, err)
+		return err
+	}
+
+	if _, err := loadTeam(ctx, uc.teamRepo, organizationID, teamID); err != nil {
+		log.Warn("team not found", "error", err.Error())
+		observability.RecordError(span, err)
+		return err
+	}
+
+	if err := uc.teamRepo.DeleteByID(ctx, teamID); err != nil {
+		log.Error("failed to delete team", "error", err.Error())
+		observability.RecordError(span, err)
+		return err
+	}
+
+	log.Info("team deleted")
+	return nil
+}
+
+type TeamMemberInput struct {
+	OrganizationID uint `json:"-"`
+	TeamID         uint `json:"-"`
+	ActorID        uint `json:"-"`
+	UserID         uint `json:"user_id" validate:"required"`
+}
+
+type AddTeamMemberUseCase struct {
+	teamRepo       organizationsrepo.TeamRepository
+	teamMemberRepo organizationsrepo.TeamMemberRepository
+	membershipRepo organizationsrepo.MembershipRepository
+	logger         providers.LoggerProvider
+}
+
+func NewAddTeamMemberUseCase(
+	teamRepo organizationsrepo.TeamRepository,
+	teamMemberRepo organizationsrepo.TeamMemberRepository,
+	membershipRepo organizationsrepo.MembershipRepository,
+	logger providers.LoggerProvider,
+) *AddTeamMemberUseCase {
+	return &AddTeamMemberUseCase{teamRepo: teamRepo, teamMemberRepo: teamMemberRepo, membershipRepo: membershipRepo, logger: logger}
+}
+
+func (uc *AddTeamMemberUseCase) Execute(ctx context.Context, input TeamMemberInput) error {
+	ctx, span := organizationTracer.Start(ctx, "AddTeamMemberUseCase.Execute")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("organization.id", int(input.OrganizationID)),
+		attribute.Int("organization.team.id", int(input.TeamID)),
+		attribute.Int("user.id", int(input.UserID)),
+	)
+
+	log := observability.LoggerWithTrace(ctx, uc.logger).With(
+		"usecase", "AddTeamMember",
+		"organizationId", input.OrganizationID,
+		"teamId", input.TeamID,
+		"userId", input.UserID,
+	)
+
+	if _, err := requireRole(ctx, uc.membershipRepo, input.OrganizationID, input.ActorID, organizationsdomain.RoleAdmin); err != nil {
+		log.Warn("access denied", "error", err.Error())
+		observability.RecordError(span, err)
+		return err
+	}
+
+	if _, err := loadTeam(ctx, uc.teamRepo, input.OrganizationID, input.TeamID); err != nil {
+		log.Warn("team not found", "error", err.Error())
+		observability.RecordError(span, err)
+		return err
+	}
+
+	if _, err := uc.membershipRepo.GetByOrganizationAndUser(ctx, input.OrganizationID, input.UserID); err != nil {
+		if isNotFound(err) {
+			err = exceptions.NewUnprocessableException("Only organization members can join its teams", nil)
+		}
+		log.Warn("user is not an organization member", "error", err.Error())
+		observability.RecordError(span, err)
+		return err
+	}
+
+	if existing, _ := uc.teamMemberRepo.GetByTeamAndUser(ctx, input.TeamID, input.UserID); existing != nil {
+		err := exceptions.NewUnprocessableException("User is already a member of this team", nil)
+		log.Warn("user already in team")
+		observability.RecordError(span, err)
+		return err
+	}
+
+	if _, err := uc.teamMemberRepo.Add(ctx, &organizationsdomain.TeamMember{TeamID: input.TeamID, UserID: input.UserID}); err != nil {
+		log.Error("failed to add team member", "error", err.Error())
+		observability.RecordError(span, err)
+		return err
+	}
+
+	log.Info("team member added")
+	return nil
+}
+
+type RemoveTeamMemberUseCase struct {
+	teamRepo       organizationsrepo.TeamRepository
+	teamMemberRepo organizationsrepo.TeamMemberRepository
+	membershipRepo organizationsrepo.MembershipRepository
+	logger         providers.LoggerProvider
+}
+
+func NewRemoveTeamMemberUseCase(
+	teamRepo organizationsrepo.TeamRepository,
+	teamMemberRepo organizationsrepo.TeamMemberRepository,
+	membershipRepo organizationsrepo.MembershipRepository,
+	logger providers.LoggerProvider,
+) *RemoveTeamMemberUseCase {
+	return &RemoveTeamMemberUseCase{teamRepo: teamRepo, teamMemberRepo: teamMemberRepo, membershipRepo: membershipRepo, logger: logger}
+}
+
+func (uc *RemoveTeamMemberUseCase) Execute(ctx context.Context, input TeamMemberInput) error {
+	ctx, span := organizationTracer.Start(ctx, "RemoveTeamMemberUseCase.Execute")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("organization.id", int(input.OrganizationID)),
+		attribute.Int("organization.team.id", int(input.TeamID)),
+		attribute.Int("user.id", int(input.UserID)),
+	)
+
+	log := observability.LoggerWithTrace(ctx, uc.logger).With(
+		"usecase", "RemoveTeamMember",
+		"organizationId", input.OrganizationID,
+		"teamId", input.TeamID,
+		"userId", input.UserID,
+	)
+
+	minRole := organizationsdomain.RoleAdmin
+	if input.ActorID == input.UserID {
+		minRole = organizationsdomain.RoleMember
+	}
+
+	if _, err := requireRole(ctx, uc.membershipRepo, input.OrganizationID, input.ActorID, minRole); err != nil {
+		log.Warn("access denied", "error", err.Error())
+		observability.RecordError(span, err)
+		return err
+	}
+
+	if _, err := loadTeam(ctx, uc.teamRepo, input.OrganizationID, input.TeamID); err != nil {
+		log.Warn("team not found", "error", err.Error())
+		observability.RecordError(span, err)
+		return err
+	}
+
+	member, err := uc.teamMemberRepo.GetByTeamAndUser(ctx, input.TeamID, input.UserID)
+	if err != nil {
+		log.Warn("team member not found", "error", err.Error())
+		observability.RecordError(span, err)
+		return err
+	}
+
+	if err := uc.teamMemberRepo.DeleteByID(ctx, member.ID); err != nil {
+		log.Error("failed to remove team member", "error", err.Error())
+		observability.RecordError(span, err)
+		return err
+	}
+
+	log.Info("team member removed")
+	return nil
+}
+
+func loadTeam(ctx context.Context, teams organizationsrepo.TeamRepository, organizationID, teamID uint) (*organizationsdomain.Team, error) {
+	team, err := teams.GetByID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	if team.OrganizationID != organizationID {
+		return nil, exceptions.NewNotFoundException("Team not found", nil)
+	}
+	return team, nil
+}
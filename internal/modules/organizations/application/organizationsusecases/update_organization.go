@@ -0,0 +1,106 @@
+package organizationsusecases
+
+import (
+	"context"
+	"strings"
+
+	"golang_boilerplate_module/internal/modules/organizations/organizationsdomain"
+	"golang_boilerplate_module/internal/modules/organizations/organizationsdomain/organizationsrepo"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+	"golang_boilerplate_module/internal/shared/infra/observability"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type UpdateOrganizationInput struct {
+	OrganizationID uint   `json:"-"`
+	ActorID        uint   `json:"-"`
+	Name           string `json:"name" validate:"required"`
+}
+
+type UpdateOrganizationUseCase struct {
+	organizationRepo organizationsrepo.OrganizationRepository
+	membershipRepo   organizationsrepo.MembershipRepository
+	logger           providers.LoggerProvider
+}
+
+func NewUpdateOrganizationUseCase(
+	organizationRepo organizationsrepo.OrganizationRepository,
+	membershipRepo organizationsrepo.MembershipRepository,
+	logger providers.LoggerProvider,
+) *UpdateOrganizationUseCase {
+	return &UpdateOrganizationUseCase{organizationRepo: organizationRepo, membershipRepo: membershipRepo, logger: logger}
+}
+
+func (uc *UpdateOrganizationUseCase) Execute(ctx context.Context, input UpdateOrganizationInput) (OrganizationOutput, error) {
+	ctx, span := organizationTracer.Start(ctx, "UpdateOrganizationUseCase.Execute")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("organization.id", int(input.OrganizationID)))
+
+	log := observability.LoggerWithTrace(ctx, uc.logger).With("usecase", "UpdateOrganization", "organizationId", input.OrganizationID)
+
+	membership, err := requireRole(ctx, uc.membershipRepo, input.OrganizationID, input.ActorID, organizationsdomain.RoleAdmin)
+	if err != nil {
+		log.Warn("access denied", "error", err.Error())
+		observability.RecordError(span, err)
+		return OrganizationOutput{}, err
+	}
+
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		err := exceptions.NewBadRequestException("Organization name is required", nil)
+		log.Warn("validation failed — empty organization name")
+		observability.RecordError(span, err)
+		return OrganizationOutput{}, err
+	}
+
+	organization, err := uc.organizationRepo.UpdateByID(ctx, input.OrganizationID, map[string]any{"name": name})
+	if err != nil {
+		log.Error("failed to update organization", "error", err.Error())
+		observability.RecordError(span, err)
+		return OrganizationOutput{}, err
+	}
+
+	log.Info("organization updated")
+	return toOrganizationOutput(organization, membership.Role), nil
+}
+
+type DeleteOrganizationUseCase struct {
+	organizationRepo organizationsrepo.OrganizationRepository
+	membershipRepo   organizationsrepo.MembershipRepository
+	logger           providers.LoggerProvider
+}
+
+func NewDeleteOrganizationUseCase(
+	organizationRepo organizationsrepo.OrganizationRepository,
+	membershipRepo organizationsrepo.MembershipRepository,
+	logger providers.LoggerProvider,
+) *DeleteOrganizationUseCase {
+	return &DeleteOrganizationUseCase{organizationRepo: organizationRepo, membershipRepo: membershipRepo, logger: logger}
+}
+
+func (uc *DeleteOrganizationUseCase) Execute(ctx context.Context, organizationID, actorID uint) error {
+	ctx, span := organizationTracer.Start(ctx, "DeleteOrganizationUseCase.Execute")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("organization.id", int(organizationID)))
+
+	log := observability.LoggerWithTrace(ctx, uc.logger).With("usecase", "DeleteOrganization", "organizationId", organizationID)
+
+	if _, err := requireRole(ctx, uc.membershipRepo, organizationID, actorID, organizationsdomain.RoleOwner); err != nil {
+		log.Warn("access denied", "error", err.Error())
+		observability.RecordError(span, err)
+		return err
+	}
+
+	if err := uc.organizationRepo.DeleteByID(ctx, organizationID); err != nil {
+		log.Error("failed to delete organization", "error", err.Error())
+		observability.RecordError(span, err)
+		return err
+	}
+
+	log.Info("organization deleted")
+	return nil
+}
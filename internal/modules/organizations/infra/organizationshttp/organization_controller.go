@@ -0,0 +1,551 @@
+package organizationshttp
+
+import (
+	"strconv"
+
+	"golang_boilerplate_module/internal/modules/organizations/application/organizationsusecases"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+	"golang_boilerplate_module/internal/shared/infra/http/middleware"
+	"golang_boilerplate_module/internal/shared/infra/observability"
+
+	"github.com/gofiber/fiber/v2"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	oteltrace "go.opentelemetry.io/otel/trace"
+	"go.uber.org/fx"
+)
+
+var tracer = otel.Tracer("organizations.http")
+
+type OrganizationControllerParams struct {
+	fx.In
+
+	CreateOrganization *organizationsusecases.CreateOrganizationUseCase
+	ListOrganizations  *organizationsusecases.ListOrganizationsUseCase
+	GetOrganization    *organizationsusecases.GetOrganizationUseCase
+	UpdateOrganization *organizationsusecases.UpdateOrganizationUseCase
+	DeleteOrganization *organizationsusecases.DeleteOrganizationUseCase
+	ListMembers        *organizationsusecases.ListMembersUseCase
+	ChangeMemberRole   *organizationsusecases.ChangeMemberRoleUseCase
+	RemoveMember       *organizationsusecases.RemoveMemberUseCase
+	TransferOwnership  *organizationsusecases.TransferOwnershipUseCase
+	InviteMember       *organizationsusecases.InviteMemberUseCase
+	AcceptInvitation   *organizationsusecases.AcceptInvitationUseCase
+	DeclineInvitation  *organizationsusecases.DeclineInvitationUseCase
+	CreateTeam         *organizationsusecases.CreateTeamUseCase
+	ListTeams          *organizationsusecases.ListTeamsUseCase
+	DeleteTeam         *organizationsusecases.DeleteTeamUseCase
+	AddTeamMember      *organizationsusecases.AddTeamMemberUseCase
+	RemoveTeamMember   *organizationsusecases.RemoveTeamMemberUseCase
+	Logger             providers.LoggerProvider
+}
+
+type OrganizationController struct {
+	usecases OrganizationControllerParams
+	logger   providers.LoggerProvider
+}
+
+func NewOrganizationController(params OrganizationControllerParams) *OrganizationController {
+	return &OrganizationController{usecases: params, logger: params.Logger}
+}
+
+func (ctrl *OrganizationController) CreateOrganization(c *fiber.Ctx) error {
+	ctx, span := tracer.Start(c.UserContext(), "OrganizationController.CreateOrganization")
+	defer span.End()
+
+	log := middleware.LoggerFromLocals(c, ctrl.logger).With("handler", "OrganizationController.CreateOrganization")
+
+	actorID, err := requireActor(c, span, log)
+	if err != nil {
+		return err
+	}
+
+	var input organizationsusecases.CreateOrganizationInput
+	if err := parseBody(c, &input, span, log); err != nil {
+		return err
+	}
+	input.ActorID = actorID
+
+	output, err := ctrl.usecases.CreateOrganization.Execute(ctx, input)
+	if err != nil {
+		observability.RecordError(span, err)
+		return err
+	}
+
+	span.SetAttributes(attribute.Int("organization.id", int(output.ID)))
+	return c.Status(fiber.StatusCreated).JSON(output)
+}
+
+func (ctrl *OrganizationController) ListOrganizations(c *fiber.Ctx) error {
+	ctx, span := tracer.Start(c.UserContext(), "OrganizationController.ListOrganizations")
+	defer span.End()
+
+	log := middleware.LoggerFromLocals(c, ctrl.logger).With("handler", "OrganizationController.ListOrganizations")
+
+	actorID, err := requireActor(c, span, log)
+	if err != nil {
+		return err
+	}
+
+	outputs, err := ctrl.usecases.ListOrganizations.Execute(ctx, actorID)
+	if err != nil {
+		observability.RecordError(span, err)
+		return err
+	}
+
+	return c.JSON(outputs)
+}
+
+func (ctrl *OrganizationController) GetOrganization(c *fiber.Ctx) error {
+	ctx, span := tracer.Start(c.UserContext(), "OrganizationController.GetOrganization")
+	defer span.End()
+
+	log := middleware.LoggerFromLocals(c, ctrl.logger).With("handler", "OrganizationController.GetOrganization")
+
+	actorID, err := requireActor(c, span, log)
+	if err != nil {
+		return err
+	}
+
+	orgID, err := parseIDParam(c, "orgId", "Invalid organization ID", span, log)
+	if err != nil {
+		return err
+	}
+
+	output, err := ctrl.usecases.GetOrganization.Execute(ctx, orgID, actorID)
+	if err != nil {
+		observability.RecordError(span, err)
+		return err
+	}
+
+	return c.JSON(output)
+}
+
+func (ctrl *OrganizationController) UpdateOrganization(c *fiber.Ctx) error {
+	ctx, span := tracer.Start(c.UserContext(), "OrganizationController.UpdateOrganization")
+	defer span.End()
+
+	log := middleware.LoggerFromLocals(c, ctrl.logger).With("handler", "OrganizationController.UpdateOrganization")
+
+	actorID, err := requireActor(c, span, log)
+	if err != nil {
+		return err
+	}
+
+	orgID, err := parseIDParam(c, "orgId", "Invalid organization ID", span, log)
+	if err != nil {
+		return err
+	}
+
+	var input organizationsusecases.UpdateOrganizationInput
+	if err := parseBody(c, &input, span, log); err != nil {
+		return err
+	}
+	input.OrganizationID = orgID
+	input.ActorID = actorID
+
+	output, err := ctrl.usecases.UpdateOrganization.Execute(ctx, input)
+	if err != nil {
+		observability.RecordError(span, err)
+		return err
+	}
+
+	return c.JSON(output)
+}
+
+func (ctrl *OrganizationController) DeleteOrganization(c *fiber.Ctx) error {
+	ctx, span := tracer.Start(c.UserContext(), "OrganizationController.DeleteOrganization")
+	defer span.End()
+
+	log := middleware.LoggerFromLocals(c, ctrl.logger).With("handler", "OrganizationController.DeleteOrganization")
+
+	actorID, err := requireActor(c, span, log)
+	if err != nil {
+		return err
+	}
+
+	orgID, err := parseIDParam(c, "orgId", "Invalid organization ID", span, log)
+	if err != nil {
+		return err
+	}
+
+	if err := ctrl.usecases.DeleteOrganization.Execute(ctx, orgID, actorID); err != nil {
+		observability.RecordError(span, err)
+		return err
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func (ctrl *OrganizationController) ListMembers(c *fiber.Ctx) error {
+	ctx, span := tracer.Start(c.UserContext(), "OrganizationController.ListMembers")
+	defer span.End()
+
+	log := middleware.LoggerFromLocals(c, ctrl.logger).With("handler", "OrganizationController.ListMembers")
+
+	actorID, err := requireActor(c, span, log)
+	if err != nil {
+		return err
+	}
+
+	orgID, err := parseIDParam(c, "orgId", "Invalid organization ID", span, log)
+	if err != nil {
+		return err
+	}
+
+	outputs, err := ctrl.usecases.ListMembers.Execute(ctx, orgID, actorID)
+	if err != nil {
+		observability.RecordError(span, err)
+		return err
+	}
+
+	return c.JSON(outputs)
+}
+
+func (ctrl *OrganizationController) ChangeMemberRole(c *fiber.Ctx) error {
+	ctx, span := tracer.Start(c.UserContext(), "OrganizationController.ChangeMemberRole")
+	defer span.End()
+
+	log := middleware.LoggerFromLocals(c, ctrl.logger).With("handler", "OrganizationController.ChangeMemberRole")
+
+	actorID, err := requireActor(c, span, log)
+	if err != nil {
+		return err
+	}
+
+	orgID, err := parseIDParam(c, "orgId", "Invalid organization ID", span, log)
+	if err != nil {
+		return err
+	}
+
+	userID, err := parseIDParam(c, "userId", "Invalid user ID", span, log)
+	if err != nil {
+		return err
+	}
+
+	var input organizationsusecases.ChangeMemberRoleInput
+	if err := parseBody(c, &input, span, log); err != nil {
+		return err
+	}
+	input.OrganizationID = orgID
+	input.ActorID = actorID
+	input.UserID = userID
+
+	output, err := ctrl.usecases.ChangeMemberRole.Execute(ctx, input)
+	if err != nil {
+		observability.RecordError(span, err)
+		return err
+	}
+
+	return c.JSON(output)
+}
+
+func (ctrl *OrganizationController) RemoveMember(c *fiber.Ctx) error {
+	ctx, span := tracer.Start(c.UserContext(), "OrganizationController.RemoveMember")
+	defer span.End()
+
+	log := middleware.LoggerFromLocals(c, ctrl.logger).With("handler", "OrganizationController.RemoveMember")
+
+	actorID, err := requireActor(c, span, log)
+	if err != nil {
+		return err
+	}
+
+	orgID, err := parseIDParam(c, "orgId", "Invalid organization ID", span, log)
+	if err != nil {
+		return err
+	}
+
+	userID, err := parseIDParam(c, "userId", "Invalid user ID", span, log)
+	if err != nil {
+		return err
+	}
+
+	if err := ctrl.usecases.RemoveMember.Execute(ctx, orgID, actorID, userID); err != nil {
+		observability.RecordError(span, err)
+		return err
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func (ctrl *OrganizationController) TransferOwnership(c *fiber.Ctx) error {
+	ctx, span := tracer.Start(c.UserContext(), "OrganizationController.TransferOwnership")
+	defer span.End()
+
+	log := middleware.LoggerFromLocals(c, ctrl.logger).With("handler", "OrganizationController.TransferOwnership")
+
+	actorID, err := requireActor(c, span, log)
+	if err != nil {
+		return err
+	}
+
+	orgID, err := parseIDParam(c, "orgId", "Invalid organization ID", span, log)
+	if err != nil {
+		return err
+	}
+
+	var input organizationsusecases.TransferOwnershipInput
+	if err := parseBody(c, &input, span, log); err != nil {
+		return err
+	}
+	input.OrganizationID = orgID
+	input.ActorID = actorID
+
+	outputs, err := ctrl.usecases.TransferOwnership.Execute(ctx, input)
+	if err != nil {
+		observability.RecordError(span, err)
+		return err
+	}
+
+	return c.JSON(outputs)
+}
+
+func (ctrl *OrganizationController) InviteMember(c *fiber.Ctx) error {
+	ctx, span := tracer.Start(c.UserContext(), "OrganizationController.InviteMember")
+	defer span.End()
+
+	log := middleware.LoggerFromLocals(c, ctrl.logger).With("handler", "OrganizationController.InviteMember")
+
+	actorID, err := requireActor(c, span, log)
+	if err != nil {
+		return err
+	}
+
+	orgID, err := parseIDParam(c, "orgId", "Invalid organization ID", span, log)
+	if err != nil {
+		return err
+	}
+
+	var input organizationsusecases.InviteMemberInput
+	if err := parseBody(c, &input, span, log); err != nil {
+		return err
+	}
+	input.OrganizationID = orgID
+	input.ActorID = actorID
+
+	output, err := ctrl.usecases.InviteMember.Execute(ctx, input)
+	if err != nil {
+		observability.RecordError(span, err)
+		return err
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(output)
+}
+
+func (ctrl *OrganizationController) AcceptInvitation(c *fiber.Ctx) error {
+	ctx, span := tracer.Start(c.UserContext(), "OrganizationController.AcceptInvitation")
+	defer span.End()
+
+	log := middleware.LoggerFromLocals(c, ctrl.logger).With("handler", "OrganizationController.AcceptInvitation")
+
+	actorID, err := requireActor(c, span, log)
+	if err != nil {
+		return err
+	}
+
+	output, err := ctrl.usecases.AcceptInvitation.Execute(ctx, c.Params("token"), actorID)
+	if err != nil {
+		observability.RecordError(span, err)
+		return err
+	}
+
+	return c.JSON(output)
+}
+
+func (ctrl *OrganizationController) DeclineInvitation(c *fiber.Ctx) error {
+	ctx, span := tracer.Start(c.UserContext(), "OrganizationController.DeclineInvitation")
+	defer span.End()
+
+	output, err := ctrl.usecases.DeclineInvitation.Execute(ctx, c.Params("token"))
+	if err != nil {
+		observability.RecordError(span, err)
+		return err
+	}
+
+	return c.JSON(output)
+}
+
+func (ctrl *OrganizationController) CreateTeam(c *fiber.Ctx) error {
+	ctx, span := tracer.Start(c.UserContext(), "OrganizationController.CreateTeam")
+	defer span.End()
+
+	log := middleware.LoggerFromLocals(c, ctrl.logger).With("handler", "OrganizationController.CreateTeam")
+
+	actorID, err := requireActor(c, span, log)
+	if err != nil {
+		return err
+	}
+
+	orgID, err := parseIDParam(c, "orgId", "Invalid organization ID", span, log)
+	if err != nil {
+		return err
+	}
+
+	var input organizationsusecases.CreateTeamInput
+	if err := parseBody(c, &input, span, log); err != nil {
+		return err
+	}
+	input.OrganizationID = orgID
+	input.ActorID = actorID
+
+	output, err := ctrl.usecases.CreateTeam.Execute(ctx, input)
+	if err != nil {
+		observability.RecordError(span, err)
+		return err
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(output)
+}
+
+func (ctrl *OrganizationController) ListTeams(c *fiber.Ctx) error {
+	ctx, span := tracer.Start(c.UserContext(), "OrganizationController.ListTeams")
+	defer span.End()
+
+	log := middleware.LoggerFromLocals(c, ctrl.logger).With("handler", "OrganizationController.ListTeams")
+
+	actorID, err := requireActor(c, span, log)
+	if err != nil {
+		return err
+	}
+
+	orgID, err := parseIDParam(c, "orgId", "Invalid organization ID", span, log)
+	if err != nil {
+		return err
+	}
+
+	outputs, err := ctrl.usecases.ListTeams.Execute(ctx, orgID, actorID)
+	if err != nil {
+		observability.RecordError(span, err)
+		return err
+	}
+
+	return c.JSON(outputs)
+}
+
+func (ctrl *OrganizationController) DeleteTeam(c *fiber.Ctx) error {
+	ctx, span := tracer.Start(c.UserContext(), "OrganizationController.DeleteTeam")
+	defer span.End()
+
+	log := middleware.LoggerFromLocals(c, ctrl.logger).With("handler", "OrganizationController.DeleteTeam")
+
+	actorID, err := requireActor(c, span, log)
+	if err != nil {
+		return err
+	}
+
+	orgID, err := parseIDParam(c, "orgId", "Invalid organization ID", span, log)
+	if err != nil {
+		return err
+	}
+
+	teamID, err := parseIDParam(c, "teamId", "Invalid team ID", span, log)
+	if err != nil {
+		return err
+	}
+
+	if err := ctrl.usecases.DeleteTeam.Execute(ctx, orgID, teamID, actorID); err != nil {
+		observability.RecordError(span, err)
+		return err
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func (ctrl *OrganizationController) AddTeamMember(c *fiber.Ctx) error {
+	ctx, span := tracer.Start(c.UserContext(), "OrganizationController.AddTeamMember")
+	defer span.End()
+
+	log := middleware.LoggerFromLocals(c, ctrl.logger).With("handler", "OrganizationController.AddTeamMember")
+
+	input, err := parseTeamMemberInput(c, span, log)
+	if err != nil {
+		return err
+	}
+	if err := parseBody(c, &input, span, log); err != nil {
+		return err
+	}
+
+	if err := ctrl.usecases.AddTeamMember.Execute(ctx, input); err != nil {
+		observability.RecordError(span, err)
+		return err
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func (ctrl *OrganizationController) RemoveTeamMember(c *fiber.Ctx) error {
+	ctx, span := tracer.Start(c.UserContext(), "OrganizationController.RemoveTeamMember")
+	defer span.End()
+
+	log := middleware.LoggerFromLocals(c, ctrl.logger).With("handler", "OrganizationController.RemoveTeamMember")
+
+	input, err := parseTeamMemberInput(c, span, log)
+	if err != nil {
+		return err
+	}
+
+	input.UserID, err = parseIDParam(c, "userId", "Invalid user ID", span, log)
+	if err != nil {
+		return err
+	}
+
+	if err := ctrl.usecases.RemoveTeamMember.Execute(ctx, input); err != nil {
+		observability.RecordError(span, err)
+		return err
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func parseTeamMemberInput(c *fiber.Ctx, span oteltrace.Span, log providers.LoggerProvider) (organizationsusecases.TeamMemberInput, error) {
+	actorID, err := requireActor(c, span, log)
+	if err != nil {
+		return organizationsusecases.TeamMemberInput{}, err
+	}
+
+	orgID, err := parseIDParam(c, "orgId", "Invalid organization ID", span, log)
+	if err != nil {
+		return organizationsusecases.TeamMemberInput{}, err
+	}
+
+	teamID, err := parseIDParam(c, "teamId", "Invalid team ID", span, log)
+	if err != nil {
+		return organizationsusecases.TeamMemberInput{}, err
+	}
+
+	return organizationsusecases.TeamMemberInput{OrganizationID: orgID, TeamID: teamID, ActorID: actorID}, nil
+}
+
+func requireActor(c *fiber.Ctx, span oteltrace.Span, log providers.LoggerProvider) (uint, error) {
+	actorID, err := middleware.RequireCurrentUserID(c)
+	if err != nil {
+		log.Warn("unauthenticated request")
+		observability.RecordError(span, err)
+		return 0, err
+	}
+	return actorID, nil
+}
+
+func parseBody(c *fiber.Ctx, out any, span oteltrace.Span, log providers.LoggerProvider) error {
+	if err := c.BodyParser(out); err != nil {
+		domainErr := exceptions.NewBadRequestException("Invalid request body", nil)
+		log.Warn("failed to parse request body", "error", err.Error())
+		observability.RecordError(span, domainErr)
+		return domainErr
+	}
+	return nil
+}
+
+func parseIDParam(c *fiber.Ctx, name, message string, span oteltrace.Span, log providers.LoggerProvider) (uint, error) {
+	raw := c.Params(name)
+	id, err := strconv.ParseUint(raw, 10, 64)
+	if err != nil {
+		domainErr := exceptions.NewBadRequestException(message, nil)
+		log.Warn("invalid id param", "param", name, "value", raw)
+		observability.RecordError(span, domainErr)
+		return 0, domainErr
+	}
+	return uint(id), nil
+}
@@ -0,0 +1,136 @@
+package organizationshttp
+
+import (
+	"golang_boilerplate_module/internal/modules/organizations/application/organizationsusecases"
+	"golang_boilerplate_module/internal/shared/infra/http/openapi"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func RegisterRoutes(router *openapi.Router, controller *OrganizationController) {
+	orgID := openapi.PathParam("orgId", "integer", "Organization ID")
+	userID := openapi.PathParam("userId", "integer", "User ID")
+	teamID := openapi.PathParam("teamId", "integer", "Team ID")
+	memberErrors := openapi.Errors(fiber.StatusBadRequest, fiber.StatusForbidden, fiber.StatusNotFound)
+
+	v1 := router.Version("v1", openapi.Tags("Organizations"))
+
+	api := v1.Group("/organizations", openapi.Authenticated())
+	api.Post("/", controller.CreateOrganization,
+		openapi.Summary("Create an organization owned by the current user"),
+		openapi.Body[organizationsusecases.CreateOrganizationInput](),
+		openapi.Returns[organizationsusecases.OrganizationOutput](fiber.StatusCreated),
+		openapi.Errors(fiber.StatusBadRequest),
+	)
+	api.Get("/", controller.ListOrganizations,
+		openapi.Summary("List the organizations of the current user"),
+		openapi.Returns[[]organizationsusecases.OrganizationOutput](fiber.StatusOK),
+	)
+	api.Get("/:orgId", controller.GetOrganization,
+		openapi.Summary("Get an organization"),
+		orgID,
+		openapi.Returns[organizationsusecases.OrganizationOutput](fiber.StatusOK),
+		memberErrors,
+	)
+	api.Patch("/:orgId", controller.UpdateOrganization,
+		openapi.Summary("Rename an organization"),
+		orgID,
+		openapi.Body[organizationsusecases.UpdateOrganizationInput](),
+		openapi.Returns[organizationsusecases.OrganizationOutput](fiber.StatusOK),
+		memberErrors,
+	)
+	api.Delete("/:orgId", controller.DeleteOrganization,
+		openapi.Summary("Delete an organization"),
+		orgID,
+		openapi.NoContent(),
+		memberErrors,
+	)
+
+	api.Get("/:orgId/members", controller.ListMembers,
+		openapi.Summary("List the members of an organization"),
+		orgID,
+		openapi.Returns[[]organizationsusecases.MemberOutput](fiber.StatusOK),
+		memberErrors,
+	)
+	api.Patch("/:orgId/members/:userId", controller.ChangeMemberRole,
+		openapi.Summary("Change the role of a member"),
+		orgID, userID,
+		openapi.Body[organizationsusecases.ChangeMemberRoleInput](),
+		openapi.Returns[organizationsusecases.MemberOutput](fiber.StatusOK),
+		memberErrors,
+		openapi.Errors(fiber.StatusUnprocessableEntity),
+	)
+	api.Delete("/:orgId/members/:userId", controller.RemoveMember,
+		openapi.Summary("Remove a member or leave the organization"),
+		orgID, userID,
+		openapi.NoContent(),
+		memberErrors,
+		openapi.Errors(fiber.StatusUnprocessableEntity),
+	)
+	api.Post("/:orgId/transfer-ownership", controller.TransferOwnership,
+		openapi.Summary("Transfer ownership to another member"),
+		orgID,
+		openapi.Body[organizationsusecases.TransferOwnershipInput](),
+		openapi.Returns[[]organizationsusecases.MemberOutput](fiber.StatusOK),
+		memberErrors,
+	)
+
+	api.Post("/:orgId/invitations", controller.InviteMember,
+		openapi.Summary("Invite someone by email"),
+		orgID,
+		openapi.Body[organizationsusecases.InviteMemberInput](),
+		openapi.Returns[organizationsusecases.InvitationOutput](fiber.StatusCreated),
+		memberErrors,
+		openapi.Errors(fiber.StatusUnprocessableEntity),
+	)
+
+	api.Post("/:orgId/teams", controller.CreateTeam,
+		openapi.Summary("Create a team"),
+		orgID,
+		openapi.Body[organizationsusecases.CreateTeamInput](),
+		openapi.Returns[organizationsusecases.TeamOutput](fiber.StatusCreated),
+		memberErrors,
+	)
+	api.Get("/:orgId/teams", controller.ListTeams,
+		openapi.Summary("List the teams of an organization"),
+		orgID,
+		openapi.Returns[[]organizationsusecases.TeamOutput](fiber.StatusOK),
+		memberErrors,
+	)
+	api.Delete("/:orgId/teams/:teamId", controller.DeleteTeam,
+		openapi.Summary("Delete a team"),
+		orgID, teamID,
+		openapi.NoContent(),
+		memberErrors,
+	)
+	api.Post("/:orgId/teams/:teamId/members", controller.AddTeamMember,
+		openapi.Summary("Add an organization member to a team"),
+		orgID, teamID,
+		openapi.Body[organizationsusecases.TeamMemberInput](),
+		openapi.NoContent(),
+		memberErrors,
+		openapi.Errors(fiber.StatusUnprocessableEntity),
+	)
+	api.Delete("/:orgId/teams/:teamId/members/:userId", controller.RemoveTeamMember,
+		openapi.Summary("Remove a member from a team"),
+		orgID, teamID, userID,
+		openapi.NoContent(),
+		memberErrors,
+	)
+
+	invitations := v1.Group("/invitations")
+	invitationToken := openapi.PathParam("token", "string", "Invitation token")
+	invitations.Post("/:token/accept", controller.AcceptInvitation,
+		openapi.Summary("Accept an invitation"),
+		openapi.Authenticated(),
+		invitationToken,
+		openapi.Returns[organizationsusecases.MemberOutput](fiber.StatusOK),
+		openapi.Errors(fiber.StatusForbidden, fiber.StatusNotFound, fiber.StatusUnprocessableEntity),
+	)
+	invitations.Post("/:token/decline", controller.DeclineInvitation,
+		openapi.Summary("Decline an invitation"),
+		invitationToken,
+		openapi.Returns[organizationsusecases.InvitationOutput](fiber.StatusOK),
+		openapi.Errors(fiber.StatusNotFound, fiber.StatusUnprocessableEntity),
+	)
+}
@@ -0,0 +1,123 @@
+package organizationspersistence
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"golang_boilerplate_module/internal/modules/organizations/organizationsdomain"
+	"golang_boilerplate_module/internal/modules/organizations/organizationsdomain/organizationsrepo"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/infra/persistence"
+	sharedrepo "golang_boilerplate_module/internal/shared/infra/persistence/repositories"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"gorm.io/gorm"
+)
+
+type GORMInvitationRepository struct {
+	*sharedrepo.GORMGenericRepository[organizationsdomain.Invitation, uint]
+	db *gorm.DB
+}
+
+func NewGORMInvitationRepository(db *gorm.DB) organizationsrepo.InvitationRepository {
+	return &GORMInvitationRepository{
+		GORMGenericRepository: sharedrepo.NewGORMGenericRepository[organizationsdomain.Invitation, uint](db),
+		db:                    db,
+	}
+}
+
+func (r *GORMInvitationRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*organizationsdomain.Invitation, error) {
+	ctx, span := dbTracer.Start(ctx, "GORMInvitationRepository.GetByTokenHash")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("db.operation", "GetByTokenHash"))
+
+	var invitation organizationsdomain.Invitation
+	err := sharedrepo.WithTenantSession(ctx, r.db, func(tx *gorm.DB) error {
+		return tx.Where("token_hash = ?", tokenHash).First(&invitation).Error
+	})
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		span.SetStatus(codes.Error, "not found")
+		return nil, exceptions.NewNotFoundException("Invitation not found", nil)
+	}
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+		return nil, sharedrepo.DatabaseError(err)
+	}
+
+	return &invitation, nil
+}
+
+func (r *GORMInvitationRepository) GetPendingByEmail(ctx context.Context, organizationID uint, email string, now time.Time) (*organizationsdomain.Invitation, error) {
+	ctx, span := dbTracer.Start(ctx, "GORMInvitationRepository.GetPendingByEmail")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.operation", "GetPendingByEmail"),
+		attribute.Int("organization.id", int(organizationID)),
+	)
+
+	var invitation organizationsdomain.Invitation
+	err := sharedrepo.WithTenantSession(ctx, r.db, func(tx *gorm.DB) error {
+		return tx.
+			Where("organization_id = ? AND LOWER(email) = LOWER(?) AND status = ? AND expires_at > ?",
+				organizationID, email, organizationsdomain.InvitationStatusPending, now).
+			First(&invitation).Error
+	})
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		span.SetStatus(codes.Error, "not found")
+		return nil, exceptions.NewNotFoundException("Invitation not found", nil)
+	}
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+		return nil, sharedrepo.DatabaseError(err)
+	}
+
+	return &invitation, nil
+}
+
+// Respond updates the invitation only while it is pending, so of two
+// concurrent responses the second affects no row and is refused; the row
+// lock the first one takes holds the second until it commits.
+func (r *GORMInvitationRepository) Respond(ctx context.Context, id uint, status organizationsdomain.InvitationStatus, respondedAt time.Time, fn func(ctx context.Context) error) error {
+	ctx, span := dbTracer.Start(ctx, "GORMInvitationRepository.Respond")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.operation", "Respond"),
+		attribute.Int("organization.invitation.id", int(id)),
+		attribute.String("organization.invitation.status", string(status)),
+	)
+
+	err := sharedrepo.WithTenantSession(ctx, r.db, func(tx *gorm.DB) error {
+		return tx.Transaction(func(tx *gorm.DB) error {
+			result := tx.Model(&organizationsdomain.Invitation{}).
+				Where("id = ? AND status = ?", id, organizationsdomain.InvitationStatusPending).
+				Updates(map[string]any{"status": status, "responded_at": respondedAt})
+			if result.Error != nil {
+				return sharedrepo.DatabaseError(result.Error)
+			}
+			if result.RowsAffected != 1 {
+				return exceptions.NewUnprocessableException("Invitation is no longer valid", nil)
+			}
+			if fn == nil {
+				return nil
+			}
+			return fn(persistence.WithTransaction(ctx, tx))
+		})
+	})
+	var domainErr *exceptions.DomainError
+	if err != nil && !errors.As(err, &domainErr) {
+		err = sharedrepo.DatabaseError(err)
+	}
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+	}
+
+	return err
+}
@@ -0,0 +1,161 @@
+package organizationspersistence
+
+import (
+	"context"
+	"errors"
+
+	"golang_boilerplate_module/internal/modules/organizations/organizationsdomain"
+	"golang_boilerplate_module/internal/modules/organizations/organizationsdomain/organizationsrepo"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/infra/persistence"
+	sharedrepo "golang_boilerplate_module/internal/shared/infra/persistence/repositories"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type GORMMembershipRepository struct {
+	*sharedrepo.GORMGenericRepository[organizationsdomain.Membership, uint]
+	db *gorm.DB
+}
+
+func NewGORMMembershipRepository(db *gorm.DB) organizationsrepo.MembershipRepository {
+	return &GORMMembershipRepository{
+		GORMGenericRepository: sharedrepo.NewGORMGenericRepository[organizationsdomain.Membership, uint](db),
+		db:                    db,
+	}
+}
+
+func (r *GORMMembershipRepository) GetByOrganizationAndUser(ctx context.Context, organizationID, userID uint) (*organizationsdomain.Membership, error) {
+	ctx, span := dbTracer.Start(ctx, "GORMMembershipRepository.GetByOrganizationAndUser")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.operation", "GetByOrganizationAndUser"),
+		attribute.Int("organization.id", int(organizationID)),
+		attribute.Int("user.id", int(userID)),
+	)
+
+	var membership organizationsdomain.Membership
+	err := sharedrepo.WithTenantSession(ctx, r.db, func(tx *gorm.DB) error {
+		return tx.Where("organization_id = ? AND user_id = ?", organizationID, userID).First(&membership).Error
+	})
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		span.SetStatus(codes.Error, "not found")
+		return nil, exceptions.NewNotFoundException("Membership not found", nil)
+	}
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+		return nil, sharedrepo.DatabaseError(err)
+	}
+
+	return &membership, nil
+}
+
+func (r *GORMMembershipRepository) ListByOrganization(ctx context.Context, organizationID uint) ([]organizationsdomain.Membership, error) {
+	ctx, span := dbTracer.Start(ctx, "GORMMembershipRepository.ListByOrganization")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.operation", "ListByOrganization"),
+		attribute.Int("organization.id", int(organizationID)),
+	)
+
+	var memberships []organizationsdomain.Membership
+	err := sharedrepo.WithTenantSession(ctx, r.db, func(tx *gorm.DB) error {
+		return tx.Where("organization_id = ?", organizationID).Order("id ASC").Find(&memberships).Error
+	})
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+		return nil, sharedrepo.DatabaseError(err)
+	}
+
+	return memberships, nil
+}
+
+func (r *GORMMembershipRepository) ListByUser(ctx context.Context, userID uint) ([]organizationsdomain.Membership, error) {
+	ctx, span := dbTracer.Start(ctx, "GORMMembershipRepository.ListByUser")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.operation", "ListByUser"),
+		attribute.Int("user.id", int(userID)),
+	)
+
+	var memberships []organizationsdomain.Membership
+	err := sharedrepo.WithTenantSession(ctx, r.db, func(tx *gorm.DB) error {
+		return tx.Where("user_id = ?", userID).Order("id ASC").Find(&memberships).Error
+	})
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+		return nil, sharedrepo.DatabaseError(err)
+	}
+
+	return memberships, nil
+}
+
+// WithOwnersLocked locks the owner rows with SELECT ... FOR UPDATE, in id
+// order so concurrent callers queue instead of deadlocking. SQLite ignores
+// the clause, but allows a single writer anyway.
+func (r *GORMMembershipRepository) WithOwnersLocked(ctx context.Context, organizationID uint, fn func(ctx context.Context, owners []organizationsdomain.Membership) error) error {
+	ctx, span := dbTracer.Start(ctx, "GORMMembershipRepository.WithOwnersLocked")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.operation", "WithOwnersLocked"),
+		attribute.Int("organization.id", int(organizationID)),
+	)
+
+	err := sharedrepo.WithTenantSession(ctx, r.db, func(tx *gorm.DB) error {
+		return tx.Transaction(func(tx *gorm.DB) error {
+			var owners []organizationsdomain.Membership
+			err := tx.Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate}).
+				Where("organization_id = ? AND role = ?", organizationID, organizationsdomain.RoleOwner).
+				Order("id").
+				Find(&owners).Error
+			if err != nil {
+				return sharedrepo.DatabaseError(err)
+			}
+			return fn(persistence.WithTransaction(ctx, tx), owners)
+		})
+	})
+	var domainErr *exceptions.DomainError
+	if err != nil && !errors.As(err, &domainErr) {
+		err = sharedrepo.DatabaseError(err)
+	}
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+	}
+
+	return err
+}
+
+func (r *GORMMembershipRepository) DeleteByUser(ctx context.Context, userID uint) (int64, error) {
+	ctx, span := dbTracer.Start(ctx, "GORMMembershipRepository.DeleteByUser")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.operation", "DeleteByUser"),
+		attribute.Int("user.id", int(userID)),
+	)
+
+	var affected int64
+	err := sharedrepo.WithTenantSession(ctx, r.db, func(tx *gorm.DB) error {
+		result := tx.Where("user_id = ?", userID).Delete(&organizationsdomain.Membership{})
+		affected = result.RowsAffected
+		return result.Error
+	})
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+		return 0, sharedrepo.DatabaseError(err)
+	}
+
+	return affected, nil
+}
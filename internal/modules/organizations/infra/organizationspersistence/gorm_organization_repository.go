@@ -0,0 +1,56 @@
+package organizationspersistence
+
+import (
+	"context"
+
+	"golang_boilerplate_module/internal/modules/organizations/organizationsdomain"
+	"golang_boilerplate_module/internal/modules/organizations/organizationsdomain/organizationsrepo"
+	sharedrepo "golang_boilerplate_module/internal/shared/infra/persistence/repositories"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"gorm.io/gorm"
+)
+
+var dbTracer = otel.Tracer("organizations.persistence")
+
+type GORMOrganizationRepository struct {
+	*sharedrepo.GORMGenericRepository[organizationsdomain.Organization, uint]
+	db *gorm.DB
+}
+
+func NewGORMOrganizationRepository(db *gorm.DB) organizationsrepo.OrganizationRepository {
+	return &GORMOrganizationRepository{
+		GORMGenericRepository: sharedrepo.NewGORMGenericRepository[organizationsdomain.Organization, uint](db),
+		db:                    db,
+	}
+}
+
+func (r *GORMOrganizationRepository) ListByUserID(ctx context.Context, userID uint) ([]organizationsdomain.Organization, error) {
+	ctx, span := dbTracer.Start(ctx, "GORMOrganizationRepository.ListByUserID")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.operation", "ListByUserID"),
+		attribute.Int("user.id", int(userID)),
+	)
+
+	var organizations []organizationsdomain.Organization
+	err := sharedrepo.WithTenantSession(ctx, r.db, func(tx *gorm.DB) error {
+		return tx.
+			Where("id IN (?)", tx.Session(&gorm.Session{NewDB: true}).
+				Model(&organizationsdomain.Membership{}).
+				Select("organization_id").
+				Where("user_id = ?", userID)).
+			Order("id ASC").
+			Find(&organizations).Error
+	})
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+		return nil, sharedrepo.DatabaseError(err)
+	}
+
+	return organizations, nil
+}
@@ -0,0 +1,139 @@
+package organizationspersistence
+
+import (
+	"context"
+	"errors"
+
+	"golang_boilerplate_module/internal/modules/organizations/organizationsdomain"
+	"golang_boilerplate_module/internal/modules/organizations/organizationsdomain/organizationsrepo"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	sharedrepo "golang_boilerplate_module/internal/shared/infra/persistence/repositories"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"gorm.io/gorm"
+)
+
+type GORMTeamRepository struct {
+	*sharedrepo.GORMGenericRepository[organizationsdomain.Team, uint]
+	db *gorm.DB
+}
+
+func NewGORMTeamRepository(db *gorm.DB) organizationsrepo.TeamRepository {
+	return &GORMTeamRepository{
+		GORMGenericRepository: sharedrepo.NewGORMGenericRepository[organizationsdomain.Team, uint](db),
+		db:                    db,
+	}
+}
+
+func (r *GORMTeamRepository) ListByOrganization(ctx context.Context, organizationID uint) ([]organizationsdomain.Team, error) {
+	ctx, span := dbTracer.Start(ctx, "GORMTeamRepository.ListByOrganization")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.operation", "ListByOrganization"),
+		attribute.Int("organization.id", int(organizationID)),
+	)
+
+	var teams []organizationsdomain.Team
+	err := sharedrepo.WithTenantSession(ctx, r.db, func(tx *gorm.DB) error {
+		return tx.Where("organization_id = ?", organizationID).Order("id ASC").Find(&teams).Error
+	})
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+		return nil, sharedrepo.DatabaseError(err)
+	}
+
+	return teams, nil
+}
+
+type GORMTeamMemberRepository struct {
+	*sharedrepo.GORMGenericRepository[organizationsdomain.TeamMember, uint]
+	db *gorm.DB
+}
+
+func NewGORMTeamMemberRepository(db *gorm.DB) organizationsrepo.TeamMemberRepository {
+	return &GORMTeamMemberRepository{
+		GORMGenericRepository: sharedrepo.NewGORMGenericRepository[organizationsdomain.TeamMember, uint](db),
+		db:                    db,
+	}
+}
+
+func (r *GORMTeamMemberRepository) GetByTeamAndUser(ctx context.Context, teamID, userID uint) (*organizationsdomain.TeamMember, error) {
+	ctx, span := dbTracer.Start(ctx, "GORMTeamMemberRepository.GetByTeamAndUser")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.operation", "GetByTeamAndUser"),
+		attribute.Int("team.id", int(teamID)),
+		attribute.Int("user.id", int(userID)),
+	)
+
+	var member organizationsdomain.TeamMember
+	err := sharedrepo.WithTenantSession(ctx, r.db, func(tx *gorm.DB) error {
+		return tx.Where("team_id = ? AND user_id = ?", teamID, userID).First(&member).Error
+	})
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		span.SetStatus(codes.Error, "not found")
+		return nil, exceptions.NewNotFoundException("Team member not found", nil)
+	}
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+		return nil, sharedrepo.DatabaseError(err)
+	}
+
+	return &member, nil
+}
+
+func (r *GORMTeamMemberRepository) DeleteByOrganizationAndUser(ctx context.Context, organizationID, userID uint) error {
+	ctx, span := dbTracer.Start(ctx, "GORMTeamMemberRepository.DeleteByOrganizationAndUser")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.operation", "DeleteByOrganizationAndUser"),
+		attribute.Int("organization.id", int(organizationID)),
+		attribute.Int("user.id", int(userID)),
+	)
+
+	err := sharedrepo.WithTenantSession(ctx, r.db, func(tx *gorm.DB) error {
+		return tx.
+			Where("user_id = ? AND team_id IN (?)", userID, tx.Session(&gorm.Session{NewDB: true}).
+				Model(&organizationsdomain.Team{}).
+				Select("id").
+				Where("organization_id = ?", organizationID)).
+			Delete(&organizationsdomain.TeamMember{}).Error
+	})
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+		return sharedrepo.DatabaseError(err)
+	}
+
+	return nil
+}
+
+func (r *GORMTeamMemberRepository) DeleteByUser(ctx context.Context, userID uint) (int64, error) {
+	ctx, span := dbTracer.Start(ctx, "GORMTeamMemberRepository.DeleteByUser")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.operation", "DeleteByUser"),
+		attribute.Int("user.id", int(userID)),
+	)
+
+	var affected int64
+	err := sharedrepo.WithTenantSession(ctx, r.db, func(tx *gorm.DB) error {
+		result := tx.Where("user_id = ?", userID).Delete(&organizationsdomain.TeamMember{})
+		affected = result.RowsAffected
+		return result.Error
+	})
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+		return 0, sharedrepo.DatabaseError(err)
+	}
+
+	return affected, nil
+}
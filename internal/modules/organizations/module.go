@@ -0,0 +1,56 @@
+package organizations
+
+import (
+	"golang_boilerplate_module/internal/modules/organizations/application/organizationsusecases"
+	"golang_boilerplate_module/internal/modules/organizations/infra/organizationshttp"
+	"golang_boilerplate_module/internal/modules/organizations/infra/organizationspersistence"
+	"golang_boilerplate_module/internal/shared/domain/privacy"
+	"golang_boilerplate_module/internal/shared/infra/platform"
+
+	"go.uber.org/fx"
+)
+
+var Module = platform.Module{
+	Name:         "organizations",
+	Description:  "Organizations, memberships, invitations and teams",
+	DependsOn:    []string{"users"},
+	ConfigPrefix: "ORGANIZATIONS_",
+	Config:       &organizationsusecases.Config{},
+	Migrations:   []string{"V4__create_organizations.sql"},
+	Providers: []any{
+		organizationspersistence.NewGORMOrganizationRepository,
+		organizationspersistence.NewGORMMembershipRepository,
+		organizationspersistence.NewGORMTeamRepository,
+		organizationspersistence.NewGORMTeamMemberRepository,
+		organizationspersistence.NewGORMInvitationRepository,
+		organizationsusecases.NewCreateOrganizationUseCase,
+		organizationsusecases.NewListOrganizationsUseCase,
+		organizationsusecases.NewGetOrganizationUseCase,
+		organizationsusecases.NewUpdateOrganizationUseCase,
+		organizationsusecases.NewDeleteOrganizationUseCase,
+		organizationsusecases.NewListMembersUseCase,
+		organizationsusecases.NewChangeMemberRoleUseCase,
+		organizationsusecases.NewRemoveMemberUseCase,
+		organizationsusecases.NewTransferOwnershipUseCase,
+		organizationsusecases.NewInviteMemberUseCase,
+		organizationsusecases.NewAcceptInvitationUseCase,
+		organizationsusecases.NewDeclineInvitationUseCase,
+		organizationsusecases.NewCreateTeamUseCase,
+		organizationsusecases.NewListTeamsUseCase,
+		organizationsusecases.NewDeleteTeamUseCase,
+		organizationsusecases.NewAddTeamMemberUseCase,
+		organizationsusecases.NewRemoveTeamMemberUseCase,
+		organizationshttp.NewOrganizationController,
+		fx.Annotate(
+			organizationsusecases.NewOrganizationPersonalDataProvider,
+			fx.As(new(privacy.PersonalDataExporter)),
+			fx.ResultTags(privacy.ExportersGroup),
+		),
+		fx.Annotate(
+			organizationsusecases.NewOrganizationPersonalDataProvider,
+			fx.As(new(privacy.PersonalDataEraser)),
+			fx.ResultTags(privacy.ErasersGroup),
+		),
+	},
+	Routes: []any{organizationshttp.RegisterRoutes},
+}
@@ -0,0 +1,55 @@
+package organizationsdomain
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+)
+
+type InvitationStatus string
+
+const (
+	InvitationStatusPending  InvitationStatus = "pending"
+	InvitationStatusAccepted InvitationStatus = "accepted"
+	InvitationStatusDeclined InvitationStatus = "declined"
+	InvitationStatusExpired  InvitationStatus = "expired"
+)
+
+type Invitation struct {
+	ID              uint             `json:"id" gorm:"primarykey"`
+	TenantID        string           `json:"tenant_id" gorm:"index"`
+	OrganizationID  uint             `json:"organization_id" gorm:"index"`
+	Email           string           `json:"email"`
+	Role            Role             `json:"role"`
+	TokenHash       string           `json:"-" gorm:"uniqueIndex"`
+	Status          InvitationStatus `json:"status"`
+	InvitedByUserID uint             `json:"invited_by_user_id"`
+	ExpiresAt       time.Time        `json:"expires_at"`
+	RespondedAt     *time.Time       `json:"responded_at"`
+	CreatedAt       time.Time        `json:"created_at"`
+	UpdatedAt       time.Time        `json:"updated_at"`
+}
+
+func (Invitation) TableName() string {
+	return "organization_invitations"
+}
+
+func (i *Invitation) IsExpiredAt(now time.Time) bool {
+	return now.After(i.ExpiresAt)
+}
+
+func NewInvitationToken() (token string, tokenHash string, err error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", "", err
+	}
+	token = base64.RawURLEncoding.EncodeToString(raw)
+	return token, HashInvitationToken(token), nil
+}
+
+func HashInvitationToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
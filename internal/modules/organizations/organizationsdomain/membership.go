@@ -0,0 +1,40 @@
+package organizationsdomain
+
+import "time"
+
+type Role string
+
+const (
+	RoleOwner  Role = "owner"
+	RoleAdmin  Role = "admin"
+	RoleMember Role = "member"
+)
+
+var roleRank = map[Role]int{
+	RoleMember: 1,
+	RoleAdmin:  2,
+	RoleOwner:  3,
+}
+
+func (r Role) IsValid() bool {
+	_, ok := roleRank[r]
+	return ok
+}
+
+func (r Role) AtLeast(other Role) bool {
+	return roleRank[r] >= roleRank[other]
+}
+
+type Membership struct {
+	ID             uint      `json:"id" gorm:"primarykey"`
+	TenantID       string    `json:"tenant_id" gorm:"index"`
+	OrganizationID uint      `json:"organization_id" gorm:"uniqueIndex:idx_memberships_org_user"`
+	UserID         uint      `json:"user_id" gorm:"uniqueIndex:idx_memberships_org_user"`
+	Role           Role      `json:"role"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+func (Membership) TableName() string {
+	return "organization_memberships"
+}
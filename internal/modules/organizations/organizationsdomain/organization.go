@@ -0,0 +1,11 @@
+package organizationsdomain
+
+import "time"
+
+type Organization struct {
+	ID        uint      `json:"id" gorm:"primarykey"`
+	TenantID  string    `json:"tenant_id" gorm:"index"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
@@ -0,0 +1,19 @@
+package organizationsrepo
+
+import (
+	"context"
+	"time"
+
+	"golang_boilerplate_module/internal/modules/organizations/organizationsdomain"
+	sharedrepo "golang_boilerplate_module/internal/shared/domain/repositories"
+)
+
+type InvitationRepository interface {
+	sharedrepo.GenericRepository[organizationsdomain.Invitation, uint]
+	GetByTokenHash(ctx context.Context, tokenHash string) (*organizationsdomain.Invitation, error)
+	GetPendingByEmail(ctx context.Context, organizationID uint, email string, now time.Time) (*organizationsdomain.Invitation, error)
+	// Respond moves a pending invitation to status and runs fn, when not nil,
+	// in the same transaction; repositories called with fn's ctx join it. It
+	// fails with UNPROCESSABLE when the invitation is no longer pending.
+	Respond(ctx context.Context, id uint, status organizationsdomain.InvitationStatus, respondedAt time.Time, fn func(ctx context.Context) error) error
+}
@@ -0,0 +1,19 @@
+package organizationsrepo
+
+import (
+	"context"
+
+	"golang_boilerplate_module/internal/modules/organizations/organizationsdomain"
+	sharedrepo "golang_boilerplate_module/internal/shared/domain/repositories"
+)
+
+type MembershipRepository interface {
+	sharedrepo.GenericRepository[organizationsdomain.Membership, uint]
+	GetByOrganizationAndUser(ctx context.Context, organizationID, userID uint) (*organizationsdomain.Membership, error)
+	ListByOrganization(ctx context.Context, organizationID uint) ([]organizationsdomain.Membership, error)
+	ListByUser(ctx context.Context, userID uint) ([]organizationsdomain.Membership, error)
+	// WithOwnersLocked runs fn in a transaction that holds the organization's
+	// owner rows locked; repositories called with fn's ctx join it.
+	WithOwnersLocked(ctx context.Context, organizationID uint, fn func(ctx context.Context, owners []organizationsdomain.Membership) error) error
+	DeleteByUser(ctx context.Context, userID uint) (int64, error)
+}
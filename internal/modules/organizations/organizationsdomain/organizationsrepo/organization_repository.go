@@ -0,0 +1,13 @@
+package organizationsrepo
+
+import (
+	"context"
+
+	"golang_boilerplate_module/internal/modules/organizations/organizationsdomain"
+	sharedrepo "golang_boilerplate_module/internal/shared/domain/repositories"
+)
+
+type OrganizationRepository interface {
+	sharedrepo.GenericRepository[organizationsdomain.Organization, uint]
+	ListByUserID(ctx context.Context, userID uint) ([]organizationsdomain.Organization, error)
+}
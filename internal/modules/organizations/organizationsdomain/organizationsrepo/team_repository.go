@@ -0,0 +1,20 @@
+package organizationsrepo
+
+import (
+	"context"
+
+	"golang_boilerplate_module/internal/modules/organizations/organizationsdomain"
+	sharedrepo "golang_boilerplate_module/internal/shared/domain/repositories"
+)
+
+type TeamRepository interface {
+	sharedrepo.GenericRepository[organizationsdomain.Team, uint]
+	ListByOrganization(ctx context.Context, organizationID uint) ([]organizationsdomain.Team, error)
+}
+
+type TeamMemberRepository interface {
+	sharedrepo.GenericRepository[organizationsdomain.TeamMember, uint]
+	GetByTeamAndUser(ctx context.Context, teamID, userID uint) (*organizationsdomain.TeamMember, error)
+	DeleteByOrganizationAndUser(ctx context.Context, organizationID, userID uint) error
+	DeleteByUser(ctx context.Context, userID uint) (int64, error)
+}
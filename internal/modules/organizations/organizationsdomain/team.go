@@ -0,0 +1,20 @@
+package organizationsdomain
+
+import "time"
+
+type Team struct {
+	ID             uint      `json:"id" gorm:"primarykey"`
+	TenantID       string    `json:"tenant_id" gorm:"index"`
+	OrganizationID uint      `json:"organization_id" gorm:"index"`
+	Name           string    `json:"name"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+type TeamMember struct {
+	ID        uint      `json:"id" gorm:"primarykey"`
+	TenantID  string    `json:"tenant_id" gorm:"index"`
+	TeamID    uint      `json:"team_id" gorm:"uniqueIndex:idx_team_members_team_user"`
+	UserID    uint      `json:"user_id" gorm:"uniqueIndex:idx_team_members_team_user"`
+	CreatedAt time.Time `json:"created_at"`
+}
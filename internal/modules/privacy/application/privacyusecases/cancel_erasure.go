@@ -0,0 +1,101 @@
+package privacyusecases
+
+import (
+	"context"
+	"time"
+
+	"golang_boilerplate_module/internal/config"
+	"golang_boilerplate_module/internal/modules/privacy/privacydomain"
+	"golang_boilerplate_module/internal/modules/privacy/privacydomain/privacyrepo"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+	"golang_boilerplate_module/internal/shared/infra/observability"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type CancelErasureUseCase struct {
+	erasureRepo privacyrepo.ErasureRequestRepository
+	auditRepo   privacyrepo.AuditRecordRepository
+	cfg         *config.Config
+	logger      providers.LoggerProvider
+}
+
+func NewCancelErasureUseCase(
+	erasureRepo privacyrepo.ErasureRequestRepository,
+	auditRepo privacyrepo.AuditRecordRepository,
+	cfg *config.Config,
+	logger providers.LoggerProvider,
+) *CancelErasureUseCase {
+	return &CancelErasureUseCase{erasureRepo: erasureRepo, auditRepo: auditRepo, cfg: cfg, logger: logger}
+}
+
+func (uc *CancelErasureUseCase) Execute(ctx context.Context, actorID, id uint) (ErasureRequestOutput, error) {
+	ctx, span := privacyTracer.Start(ctx, "CancelErasureUseCase.Execute")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("privacy.erasure_request.id", int(id)))
+
+	log := observability.LoggerWithTrace(ctx, uc.logger).With("usecase", "CancelErasure", "erasureRequestId", id)
+
+	request, err := uc.erasureRepo.GetByID(ctx, id)
+	if err != nil {
+		log.Warn("erasure request not found")
+		observability.RecordError(span, err)
+		return ErasureRequestOutput{}, err
+	}
+
+	if err := authorizeSubject(uc.cfg, actorID, request.UserID); err != nil {
+		log.Warn("erasure request access denied", "actorId", actorID)
+		observability.RecordError(span, err)
+		return ErasureRequestOutput{}, err
+	}
+
+	now := time.Now().UTC()
+	if !request.CanBeCancelledAt(now) {
+		return ErasureRequestOutput{}, uc.notCancellable(span, log, request)
+	}
+
+	// The job may claim the request after it was read; the conditional
+	// update decides which of the two wins.
+	cancelled, err := uc.erasureRepo.Cancel(ctx, id, now)
+	if err != nil {
+		log.Error("failed to cancel erasure request", "error", err.Error())
+		observability.RecordError(span, err)
+		return ErasureRequestOutput{}, err
+	}
+
+	updated, err := uc.erasureRepo.GetByID(ctx, id)
+	if err != nil {
+		log.Error("failed to reload erasure request", "error", err.Error())
+		observability.RecordError(span, err)
+		return ErasureRequestOutput{}, err
+	}
+	if !cancelled {
+		return ErasureRequestOutput{}, uc.notCancellable(span, log, updated)
+	}
+
+	if _, err := uc.auditRepo.Add(ctx, &privacydomain.AuditRecord{
+		ErasureRequestID: &updated.ID,
+		UserID:           updated.UserID,
+		Action:           privacydomain.AuditActionErasureCancelled,
+	}); err != nil {
+		log.Error("failed to record cancellation audit entry", "error", err.Error())
+		observability.RecordError(span, err)
+		return ErasureRequestOutput{}, err
+	}
+
+	log.Info("erasure request cancelled")
+	return toErasureRequestOutput(updated), nil
+}
+
+func (uc *CancelErasureUseCase) notCancellable(span trace.Span, log providers.LoggerProvider, request *privacydomain.ErasureRequest) error {
+	err := exceptions.NewUnprocessableException(
+		"Erasure request can no longer be cancelled",
+		map[string]any{"status": request.Status, "cancellableUntil": request.CancellableUntil},
+	)
+	log.Warn("erasure request not cancellable", "status", request.Status)
+	observability.RecordError(span, err)
+	return err
+}
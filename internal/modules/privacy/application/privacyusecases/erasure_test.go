@@ -0,0 +1,444 @@
+package privacyusecases_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"golang_boilerplate_module/internal/config"
+	"golang_boilerplate_module/internal/modules/privacy/application/privacyusecases"
+	"golang_boilerplate_module/internal/modules/privacy/privacydomain"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/domain/privacy"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+)
+
+func privacyConfig() *config.Config {
+	return &config.Config{Privacy: config.PrivacyConfig{
+		ErasureGracePeriod:        72 * time.Hour,
+		ErasureCancellationWindow: 48 * time.Hour,
+		ErasureClaimTimeout:       time.Hour,
+		ErasureMaxAttempts:        3,
+		ErasureRetryBackoff:       time.Minute,
+	}}
+}
+
+func TestRequestErasureUseCase_Success(t *testing.T) {
+	repo := &mockErasureRepo{
+		addFn: func(_ context.Context, r *privacydomain.ErasureRequest) (*privacydomain.ErasureRequest, error) {
+			r.ID = 1
+			return r, nil
+		},
+	}
+	audit := &mockAuditRepo{}
+
+	uc := privacyusecases.NewRequestErasureUseCase(repo, audit, &mockUserDirectory{}, privacyConfig(), &mockLogger{})
+	out, err := uc.Execute(context.Background(), privacyusecases.RequestErasureInput{ActorID: 5, UserID: 5})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if out.Status != privacydomain.ErasureStatusPending {
+		t.Fatalf("expected status=pending, got %q", out.Status)
+	}
+	if got := out.ScheduledFor.Sub(out.RequestedAt); got != 72*time.Hour {
+		t.Fatalf("expected grace period of 72h, got %s", got)
+	}
+	if got := out.CancellableUntil.Sub(out.RequestedAt); got != 48*time.Hour {
+		t.Fatalf("expected cancellation window of 48h, got %s", got)
+	}
+	if len(audit.records) != 1 || audit.records[0].Action != privacydomain.AuditActionErasureRequested {
+		t.Fatalf("expected erasure_requested audit record, got %+v", audit.records)
+	}
+}
+
+func TestRequestErasureUseCase_AlreadyOpen(t *testing.T) {
+	repo := &mockErasureRepo{
+		getOpenByUserIDFn: func(_ context.Context, userID uint) (*privacydomain.ErasureRequest, error) {
+			return &privacydomain.ErasureRequest{ID: 3, UserID: userID}, nil
+		},
+	}
+
+	uc := privacyusecases.NewRequestErasureUseCase(repo, &mockAuditRepo{}, &mockUserDirectory{}, privacyConfig(), &mockLogger{})
+	_, err := uc.Execute(context.Background(), privacyusecases.RequestErasureInput{ActorID: 5, UserID: 5})
+
+	var domainErr *exceptions.DomainError
+	if !errors.As(err, &domainErr) || domainErr.Code != exceptions.CodeUnprocessable {
+		t.Fatalf("expected UNPROCESSABLE, got %v", err)
+	}
+}
+
+func TestRequestErasureUseCase_OtherUserForbidden(t *testing.T) {
+	repo := &mockErasureRepo{}
+
+	uc := privacyusecases.NewRequestErasureUseCase(repo, &mockAuditRepo{}, &mockUserDirectory{}, privacyConfig(), &mockLogger{})
+	_, err := uc.Execute(context.Background(), privacyusecases.RequestErasureInput{ActorID: 6, UserID: 5})
+
+	var domainErr *exceptions.DomainError
+	if !errors.As(err, &domainErr) || domainErr.Code != exceptions.CodeForbidden {
+		t.Fatalf("expected FORBIDDEN, got %v", err)
+	}
+}
+
+func TestRequestErasureUseCase_UserNotFound(t *testing.T) {
+	cfg := privacyConfig()
+	cfg.Privacy.Admins = []string{"1"}
+	users := &mockUserDirectory{
+		getUserSummaryFn: func(_ context.Context, _ uint) (providers.UserSummary, error) {
+			return providers.UserSummary{}, exceptions.NewNotFoundException("", nil)
+		},
+	}
+
+	uc := privacyusecases.NewRequestErasureUseCase(&mockErasureRepo{}, &mockAuditRepo{}, users, cfg, &mockLogger{})
+	_, err := uc.Execute(context.Background(), privacyusecases.RequestErasureInput{ActorID: 1, UserID: 404})
+
+	var domainErr *exceptions.DomainError
+	if !errors.As(err, &domainErr) || domainErr.Code != exceptions.CodeNotFound {
+		t.Fatalf("expected NOT_FOUND, got %v", err)
+	}
+}
+
+func TestCancelErasureUseCase_WithinWindow(t *testing.T) {
+	status := privacydomain.ErasureStatusPending
+	repo := &mockErasureRepo{
+		getByIDFn: func(_ context.Context, id uint) (*privacydomain.ErasureRequest, error) {
+			return &privacydomain.ErasureRequest{
+				ID:               id,
+				UserID:           5,
+				Status:           status,
+				CancellableUntil: time.Now().Add(time.Hour),
+			}, nil
+		},
+		cancelFn: func(_ context.Context, _ uint, _ time.Time) (bool, error) {
+			status = privacydomain.ErasureStatusCancelled
+			return true, nil
+		},
+		updateFn: func(_ context.Context, _ uint, _ map[string]any) (*privacydomain.ErasureRequest, error) {
+			t.Fatal("the request must be cancelled with the conditional update")
+			return nil, nil
+		},
+	}
+	audit := &mockAuditRepo{}
+
+	uc := privacyusecases.NewCancelErasureUseCase(repo, audit, privacyConfig(), &mockLogger{})
+	out, err := uc.Execute(context.Background(), 5, 1)
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if out.Status != privacydomain.ErasureStatusCancelled {
+		t.Fatalf("expected status=cancelled, got %q", out.Status)
+	}
+	if len(audit.records) != 1 || audit.records[0].Action != privacydomain.AuditActionErasureCancelled {
+		t.Fatalf("expected erasure_cancelled audit record, got %+v", audit.records)
+	}
+}
+
+func TestCancelErasureUseCase_ClaimedAfterLoad(t *testing.T) {
+	status := privacydomain.ErasureStatusPending
+	repo := &mockErasureRepo{
+		getByIDFn: func(_ context.Context, id uint) (*privacydomain.ErasureRequest, error) {
+			request := &privacydomain.ErasureRequest{
+				ID:               id,
+				UserID:           5,
+				Status:           status,
+				CancellableUntil: time.Now().Add(time.Hour),
+			}
+			// The job claims the request right after the use case read it.
+			status = privacydomain.ErasureStatusProcessing
+			return request, nil
+		},
+		cancelFn: func(_ context.Context, _ uint, _ time.Time) (bool, error) {
+			return status == privacydomain.ErasureStatusPending, nil
+		},
+	}
+	audit := &mockAuditRepo{}
+
+	uc := privacyusecases.NewCancelErasureUseCase(repo, audit, privacyConfig(), &mockLogger{})
+	_, err := uc.Execute(context.Background(), 5, 1)
+
+	var domainErr *exceptions.DomainError
+	if !errors.As(err, &domainErr) || domainErr.Code != exceptions.CodeUnprocessable {
+		t.Fatalf("expected UNPROCESSABLE, got %v", err)
+	}
+	if domainErr.Metadata["status"] != privacydomain.ErasureStatusProcessing {
+		t.Fatalf("expected the claimed status to be reported, got %v", domainErr.Metadata["status"])
+	}
+	if len(audit.records) != 0 {
+		t.Fatalf("expected no cancellation audit record, got %+v", audit.records)
+	}
+}
+
+func TestCancelErasureUseCase_WindowElapsed(t *testing.T) {
+	repo := &mockErasureRepo{
+		getByIDFn: func(_ context.Context, id uint) (*privacydomain.ErasureRequest, error) {
+			return &privacydomain.ErasureRequest{
+				ID:               id,
+				UserID:           5,
+				Status:           privacydomain.ErasureStatusPending,
+				CancellableUntil: time.Now().Add(-time.Minute),
+			}, nil
+		},
+	}
+
+	uc := privacyusecases.NewCancelErasureUseCase(repo, &mockAuditRepo{}, privacyConfig(), &mockLogger{})
+	_, err := uc.Execute(context.Background(), 5, 1)
+
+	var domainErr *exceptions.DomainError
+	if !errors.As(err, &domainErr) || domainErr.Code != exceptions.CodeUnprocessable {
+		t.Fatalf("expected UNPROCESSABLE, got %v", err)
+	}
+}
+
+func TestProcessDueErasuresUseCase_CompletesAndCertifies(t *testing.T) {
+	var completed map[string]any
+	repo := &mockErasureRepo{
+		listDueFn: func(_ context.Context, _, _ time.Time, _ int) ([]privacydomain.ErasureRequest, error) {
+			return []privacydomain.ErasureRequest{{ID: 9, UserID: 5, Status: privacydomain.ErasureStatusPending}}, nil
+		},
+		updateFn: func(_ context.Context, id uint, updates map[string]any) (*privacydomain.ErasureRequest, error) {
+			if updates["status"] == privacydomain.ErasureStatusCompleted {
+				completed = updates
+			}
+			return &privacydomain.ErasureRequest{ID: id}, nil
+		},
+	}
+	audit := &mockAuditRepo{}
+
+	uc := privacyusecases.NewProcessDueErasuresUseCase(privacyusecases.ProcessDueErasuresParams{
+		Erasers: []privacy.PersonalDataEraser{
+			&mockEraser{module: "users"},
+			&mockEraser{
+				module: "orders",
+				eraseFn: func(_ context.Context, _ uint) (privacy.ErasureResult, error) {
+					return privacy.ErasureResult{}, exceptions.NewNotFoundException("", nil)
+				},
+			},
+		},
+		ErasureRepo: repo,
+		AuditRepo:   audit,
+		Config:      privacyConfig(),
+		Logger:      &mockLogger{},
+	})
+
+	out, err := uc.Execute(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if out.Completed != 1 || out.Failed != 0 {
+		t.Fatalf("expected 1 completed and 0 failed, got %+v", out)
+	}
+	if completed == nil || completed["certificate_digest"] == "" {
+		t.Fatalf("expected request to be completed with a certificate digest, got %+v", completed)
+	}
+	if last := audit.records[len(audit.records)-1]; last.Action != privacydomain.AuditActionErasureCompleted {
+		t.Fatalf("expected last audit action erasure_completed, got %q", last.Action)
+	}
+}
+
+func TestProcessDueErasuresUseCase_ModuleFailure(t *testing.T) {
+	tests := []struct {
+		name          string
+		attempts      int
+		wantStatus    privacydomain.ErasureStatus
+		wantScheduled bool
+	}{
+		{name: "retried after a backoff", attempts: 1, wantStatus: privacydomain.ErasureStatusPending, wantScheduled: true},
+		{name: "failed after the last attempt", attempts: 2, wantStatus: privacydomain.ErasureStatusFailed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var updates map[string]any
+			repo := &mockErasureRepo{
+				listDueFn: func(_ context.Context, _, _ time.Time, _ int) ([]privacydomain.ErasureRequest, error) {
+					return []privacydomain.ErasureRequest{{ID: 9, UserID: 5, Status: privacydomain.ErasureStatusPending, Attempts: tt.attempts}}, nil
+				},
+				updateFn: func(_ context.Context, id uint, u map[string]any) (*privacydomain.ErasureRequest, error) {
+					updates = u
+					return &privacydomain.ErasureRequest{ID: id}, nil
+				},
+			}
+			audit := &mockAuditRepo{}
+
+			uc := privacyusecases.NewProcessDueErasuresUseCase(privacyusecases.ProcessDueErasuresParams{
+				Erasers:     []privacy.PersonalDataEraser{failingEraser(errors.New("connection reset"))},
+				ErasureRepo: repo,
+				AuditRepo:   audit,
+				Config:      privacyConfig(),
+				Logger:      &mockLogger{},
+			})
+
+			before := time.Now().UTC()
+			out, err := uc.Execute(context.Background())
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if out.Failed != 1 {
+				t.Fatalf("expected 1 failed erasure, got %+v", out)
+			}
+			if updates["status"] != tt.wantStatus || updates["attempts"] != tt.attempts+1 {
+				t.Fatalf("expected status=%s and attempts=%d, got %+v", tt.wantStatus, tt.attempts+1, updates)
+			}
+			scheduledFor, scheduled := updates["scheduled_for"].(time.Time)
+			if scheduled != tt.wantScheduled {
+				t.Fatalf("expected scheduled_for set=%v, got %+v", tt.wantScheduled, updates)
+			}
+			if scheduled && scheduledFor.Before(before.Add(2*time.Minute)) {
+				t.Fatalf("expected the retry after a backoff of 2 attempts, got %s", scheduledFor)
+			}
+			if audit.records[0].Action != privacydomain.AuditActionModuleFailed {
+				t.Fatalf("expected module_failed audit record, got %q", audit.records[0].Action)
+			}
+		})
+	}
+}
+
+func TestProcessDueErasuresUseCase_ReturnsTheStatusUpdateError(t *testing.T) {
+	updateErr := errors.New("database unavailable")
+	repo := &mockErasureRepo{
+		listDueFn: func(_ context.Context, _, _ time.Time, _ int) ([]privacydomain.ErasureRequest, error) {
+			return []privacydomain.ErasureRequest{{ID: 9, UserID: 5, Status: privacydomain.ErasureStatusPending}}, nil
+		},
+		updateFn: func(_ context.Context, _ uint, _ map[string]any) (*privacydomain.ErasureRequest, error) {
+			return nil, updateErr
+		},
+	}
+	logger := &mockLogger{}
+
+	uc := privacyusecases.NewProcessDueErasuresUseCase(privacyusecases.ProcessDueErasuresParams{
+		Erasers:     []privacy.PersonalDataEraser{failingEraser(errors.New("connection reset"))},
+		ErasureRepo: repo,
+		AuditRepo:   &mockAuditRepo{},
+		Config:      privacyConfig(),
+		Logger:      logger,
+	})
+
+	out, err := uc.Execute(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if out.Failed != 1 {
+		t.Fatalf("expected 1 failed erasure, got %+v", out)
+	}
+	if !slices.Contains(logger.errors, "failed to record erasure failure") {
+		t.Fatalf("expected the status update error to be logged, got %q", logger.errors)
+	}
+}
+
+func failingEraser(err error) *mockEraser {
+	return &mockEraser{
+		module: "users",
+		eraseFn: func(_ context.Context, _ uint) (privacy.ErasureResult, error) {
+			return privacy.ErasureResult{}, err
+		},
+	}
+}
+
+func TestProcessDueErasuresUseCase_SkipsRequestsClaimedElsewhere(t *testing.T) {
+	repo := &mockErasureRepo{
+		listDueFn: func(_ context.Context, _, _ time.Time, _ int) ([]privacydomain.ErasureRequest, error) {
+			return []privacydomain.ErasureRequest{{ID: 9, UserID: 5, Status: privacydomain.ErasureStatusPending}}, nil
+		},
+		claimFn: func(_ context.Context, _ uint, _, _ time.Time) (bool, error) {
+			return false, nil
+		},
+	}
+	erased := false
+
+	uc := privacyusecases.NewProcessDueErasuresUseCase(privacyusecases.ProcessDueErasuresParams{
+		Erasers: []privacy.PersonalDataEraser{
+			&mockEraser{
+				module: "users",
+				eraseFn: func(_ context.Context, _ uint) (privacy.ErasureResult, error) {
+					erased = true
+					return privacy.ErasureResult{}, nil
+				},
+			},
+		},
+		ErasureRepo: repo,
+		AuditRepo:   &mockAuditRepo{},
+		Config:      privacyConfig(),
+		Logger:      &mockLogger{},
+	})
+
+	out, err := uc.Execute(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if out.Completed != 0 || out.Failed != 0 {
+		t.Fatalf("expected nothing completed or failed, got %+v", out)
+	}
+	if erased {
+		t.Fatal("expected no module to be erased without the claim")
+	}
+}
+
+func TestGetErasureCertificateUseCase_NotCompleted(t *testing.T) {
+	repo := &mockErasureRepo{
+		getByIDFn: func(_ context.Context, id uint) (*privacydomain.ErasureRequest, error) {
+			return &privacydomain.ErasureRequest{ID: id, UserID: 5, Status: privacydomain.ErasureStatusPending}, nil
+		},
+	}
+
+	uc := privacyusecases.NewGetErasureCertificateUseCase(repo, &mockAuditRepo{}, privacyConfig(), &mockLogger{})
+	_, err := uc.Execute(context.Background(), 5, 1)
+
+	var domainErr *exceptions.DomainError
+	if !errors.As(err, &domainErr) || domainErr.Code != exceptions.CodeUnprocessable {
+		t.Fatalf("expected UNPROCESSABLE, got %v", err)
+	}
+}
+
+func TestGetErasureCertificateUseCase_VerifiesDigest(t *testing.T) {
+	completedAt := time.Now().UTC()
+	request := &privacydomain.ErasureRequest{ID: 1, UserID: 5, Status: privacydomain.ErasureStatusCompleted, CompletedAt: &completedAt}
+	records := []privacydomain.AuditRecord{{Action: privacydomain.AuditActionModuleErased, Module: "users", Strategy: "anonymize", RecordsAffected: 1}}
+	request.CertificateDigest = privacydomain.NewErasureCertificate(request, completedAt, records).Digest
+
+	repo := &mockErasureRepo{
+		getByIDFn: func(_ context.Context, _ uint) (*privacydomain.ErasureRequest, error) {
+			return request, nil
+		},
+	}
+
+	uc := privacyusecases.NewGetErasureCertificateUseCase(repo, &mockAuditRepo{records: records}, privacyConfig(), &mockLogger{})
+	cert, err := uc.Execute(context.Background(), 5, 1)
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !cert.Verify() {
+		t.Fatal("expected certificate digest to verify")
+	}
+	if len(cert.Modules) != 1 || cert.Modules[0].Module != "users" {
+		t.Fatalf("expected users module in certificate, got %+v", cert.Modules)
+	}
+}
+
+func TestGetErasureCertificateUseCase_KeepsTheLastRunOfEachModule(t *testing.T) {
+	completedAt := time.Now().UTC()
+	request := &privacydomain.ErasureRequest{ID: 1, UserID: 5, Status: privacydomain.ErasureStatusCompleted, CompletedAt: &completedAt}
+	records := []privacydomain.AuditRecord{
+		{Action: privacydomain.AuditActionModuleErased, Module: "users", Strategy: "anonymize", RecordsAffected: 1},
+		{Action: privacydomain.AuditActionModuleErased, Module: "users", Strategy: "anonymize", RecordsAffected: 0},
+	}
+	request.CertificateDigest = privacydomain.NewErasureCertificate(request, completedAt, records).Digest
+
+	repo := &mockErasureRepo{
+		getByIDFn: func(_ context.Context, _ uint) (*privacydomain.ErasureRequest, error) {
+			return request, nil
+		},
+	}
+
+	uc := privacyusecases.NewGetErasureCertificateUseCase(repo, &mockAuditRepo{records: records}, privacyConfig(), &mockLogger{})
+	cert, err := uc.Execute(context.Background(), 5, 1)
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(cert.Modules) != 1 || cert.Modules[0].RecordsAffected != 0 {
+		t.Fatalf("expected the reclaimed run of users only, got %+v", cert.Modules)
+	}
+}
@@ -0,0 +1,104 @@
+package privacyusecases
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"golang_boilerplate_module/internal/config"
+	"golang_boilerplate_module/internal/modules/privacy/privacydomain"
+	"golang_boilerplate_module/internal/modules/privacy/privacydomain/privacyrepo"
+	"golang_boilerplate_module/internal/shared/domain/privacy"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+	"golang_boilerplate_module/internal/shared/infra/observability"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/fx"
+)
+
+var privacyTracer = otel.Tracer("privacy")
+
+type PersonalDataArchive struct {
+	SubjectID   uint           `json:"subject_id"`
+	GeneratedAt time.Time      `json:"generated_at"`
+	Modules     map[string]any `json:"modules"`
+}
+
+type ExportPersonalDataParams struct {
+	fx.In
+
+	Exporters []privacy.PersonalDataExporter `group:"privacy_exporters"`
+	AuditRepo privacyrepo.AuditRecordRepository
+	Config    *config.Config
+	Logger    providers.LoggerProvider
+}
+
+type ExportPersonalDataUseCase struct {
+	exporters []privacy.PersonalDataExporter
+	auditRepo privacyrepo.AuditRecordRepository
+	cfg       *config.Config
+	logger    providers.LoggerProvider
+}
+
+func NewExportPersonalDataUseCase(p ExportPersonalDataParams) *ExportPersonalDataUseCase {
+	return &ExportPersonalDataUseCase{
+		exporters: p.Exporters,
+		auditRepo: p.AuditRepo,
+		cfg:       p.Config,
+		logger:    p.Logger,
+	}
+}
+
+func (uc *ExportPersonalDataUseCase) Execute(ctx context.Context, actorID, userID uint) (PersonalDataArchive, error) {
+	ctx, span := privacyTracer.Start(ctx, "ExportPersonalDataUseCase.Execute")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("user.id", int(userID)),
+		attribute.Int("privacy.exporters", len(uc.exporters)),
+	)
+
+	log := observability.LoggerWithTrace(ctx, uc.logger).With("usecase", "ExportPersonalData", "userId", userID, "actorId", actorID)
+
+	if err := authorizeSubject(uc.cfg, actorID, userID); err != nil {
+		log.Warn("personal data export denied")
+		observability.RecordError(span, err)
+		return PersonalDataArchive{}, err
+	}
+
+	archive := PersonalDataArchive{
+		SubjectID:   userID,
+		GeneratedAt: time.Now().UTC(),
+		Modules:     make(map[string]any, len(uc.exporters)),
+	}
+
+	for _, exporter := range uc.exporters {
+		data, err := exporter.ExportPersonalData(ctx, userID)
+		if err != nil {
+			log.Warn("personal data export failed", "module", exporter.Module(), "error", err.Error())
+			observability.RecordError(span, err)
+			return PersonalDataArchive{}, err
+		}
+		archive.Modules[exporter.Module()] = data
+	}
+
+	modules := make([]string, 0, len(archive.Modules))
+	for name := range archive.Modules {
+		modules = append(modules, name)
+	}
+	details, _ := json.Marshal(map[string]any{"modules": modules})
+
+	if _, err := uc.auditRepo.Add(ctx, &privacydomain.AuditRecord{
+		UserID:  userID,
+		Action:  privacydomain.AuditActionDataExported,
+		Details: string(details),
+	}); err != nil {
+		log.Error("failed to record export audit entry", "error", err.Error())
+		observability.RecordError(span, err)
+		return PersonalDataArchive{}, err
+	}
+
+	log.Info("personal data exported", "modules", len(archive.Modules))
+	return archive, nil
+}
@@ -0,0 +1,90 @@
+package privacyusecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"golang_boilerplate_module/internal/modules/privacy/application/privacyusecases"
+	"golang_boilerplate_module/internal/modules/privacy/privacydomain"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/domain/privacy"
+)
+
+func TestExportPersonalDataUseCase_Success(t *testing.T) {
+	audit := &mockAuditRepo{}
+	uc := privacyusecases.NewExportPersonalDataUseCase(privacyusecases.ExportPersonalDataParams{
+		Exporters: []privacy.PersonalDataExporter{
+			&mockExporter{module: "users"},
+			&mockExporter{module: "orders"},
+		},
+		AuditRepo: audit,
+		Config:    privacyConfig(),
+		Logger:    &mockLogger{},
+	})
+
+	out, err := uc.Execute(context.Background(), 7, 7)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if out.SubjectID != 7 {
+		t.Fatalf("expected subject_id=7, got %d", out.SubjectID)
+	}
+	if len(out.Modules) != 2 {
+		t.Fatalf("expected 2 modules in archive, got %d", len(out.Modules))
+	}
+	if len(audit.records) != 1 || audit.records[0].Action != privacydomain.AuditActionDataExported {
+		t.Fatalf("expected one data_exported audit record, got %+v", audit.records)
+	}
+}
+
+func TestExportPersonalDataUseCase_ExporterError(t *testing.T) {
+	notFound := exceptions.NewNotFoundException("User not found", nil)
+	audit := &mockAuditRepo{}
+	uc := privacyusecases.NewExportPersonalDataUseCase(privacyusecases.ExportPersonalDataParams{
+		Exporters: []privacy.PersonalDataExporter{
+			&mockExporter{
+				module: "users",
+				exportFn: func(_ context.Context, _ uint) (any, error) {
+					return nil, notFound
+				},
+			},
+		},
+		AuditRepo: audit,
+		Config:    privacyConfig(),
+		Logger:    &mockLogger{},
+	})
+
+	_, err := uc.Execute(context.Background(), 7, 7)
+	if !errors.Is(err, notFound) {
+		t.Fatalf("expected exporter error, got %v", err)
+	}
+	if len(audit.records) != 0 {
+		t.Fatalf("expected no audit record on failure, got %d", len(audit.records))
+	}
+}
+
+func TestExportPersonalDataUseCase_OnlyTheUserOrAnAdministrator(t *testing.T) {
+	cfg := privacyConfig()
+	cfg.Privacy.Admins = []string{"1"}
+	audit := &mockAuditRepo{}
+	uc := privacyusecases.NewExportPersonalDataUseCase(privacyusecases.ExportPersonalDataParams{
+		Exporters: []privacy.PersonalDataExporter{&mockExporter{module: "users"}},
+		AuditRepo: audit,
+		Config:    cfg,
+		Logger:    &mockLogger{},
+	})
+
+	_, err := uc.Execute(context.Background(), 8, 7)
+	var domainErr *exceptions.DomainError
+	if !errors.As(err, &domainErr) || domainErr.Code != exceptions.CodeForbidden {
+		t.Fatalf("expected FORBIDDEN for another user, got %v", err)
+	}
+	if len(audit.records) != 0 {
+		t.Fatalf("expected no audit record for a denied export, got %d", len(audit.records))
+	}
+
+	if _, err := uc.Execute(context.Background(), 1, 7); err != nil {
+		t.Fatalf("expected an administrator to export, got %v", err)
+	}
+}
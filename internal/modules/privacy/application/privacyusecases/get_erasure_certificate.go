@@ -0,0 +1,81 @@
+package privacyusecases
+
+import (
+	"context"
+
+	"golang_boilerplate_module/internal/config"
+	"golang_boilerplate_module/internal/modules/privacy/privacydomain"
+	"golang_boilerplate_module/internal/modules/privacy/privacydomain/privacyrepo"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+	"golang_boilerplate_module/internal/shared/infra/observability"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type GetErasureCertificateUseCase struct {
+	erasureRepo privacyrepo.ErasureRequestRepository
+	auditRepo   privacyrepo.AuditRecordRepository
+	cfg         *config.Config
+	logger      providers.LoggerProvider
+}
+
+func NewGetErasureCertificateUseCase(
+	erasureRepo privacyrepo.ErasureRequestRepository,
+	auditRepo privacyrepo.AuditRecordRepository,
+	cfg *config.Config,
+	logger providers.LoggerProvider,
+) *GetErasureCertificateUseCase {
+	return &GetErasureCertificateUseCase{erasureRepo: erasureRepo, auditRepo: auditRepo, cfg: cfg, logger: logger}
+}
+
+func (uc *GetErasureCertificateUseCase) Execute(ctx context.Context, actorID, id uint) (privacydomain.ErasureCertificate, error) {
+	ctx, span := privacyTracer.Start(ctx, "GetErasureCertificateUseCase.Execute")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("privacy.erasure_request.id", int(id)))
+
+	log := observability.LoggerWithTrace(ctx, uc.logger).With("usecase", "GetErasureCertificate", "erasureRequestId", id)
+
+	request, err := uc.erasureRepo.GetByID(ctx, id)
+	if err != nil {
+		log.Warn("erasure request not found")
+		observability.RecordError(span, err)
+		return privacydomain.ErasureCertificate{}, err
+	}
+
+	if err := authorizeSubject(uc.cfg, actorID, request.UserID); err != nil {
+		log.Warn("erasure request access denied", "actorId", actorID)
+		observability.RecordError(span, err)
+		return privacydomain.ErasureCertificate{}, err
+	}
+
+	if request.Status != privacydomain.ErasureStatusCompleted || request.CompletedAt == nil {
+		err := exceptions.NewUnprocessableException(
+			"Erasure request has not been completed",
+			map[string]any{"status": request.Status},
+		)
+		observability.RecordError(span, err)
+		return privacydomain.ErasureCertificate{}, err
+	}
+
+	records, err := uc.auditRepo.ListByErasureRequestID(ctx, id)
+	if err != nil {
+		log.Error("failed to load erasure audit trail", "error", err.Error())
+		observability.RecordError(span, err)
+		return privacydomain.ErasureCertificate{}, err
+	}
+
+	certificate := privacydomain.NewErasureCertificate(request, *request.CompletedAt, records)
+	if certificate.Digest != request.CertificateDigest {
+		err := exceptions.NewInternalException(map[string]any{
+			"error":            "erasure certificate digest mismatch",
+			"erasureRequestId": id,
+		})
+		log.Error("erasure audit trail does not match recorded certificate digest")
+		observability.RecordError(span, err)
+		return privacydomain.ErasureCertificate{}, err
+	}
+
+	return certificate, nil
+}
@@ -0,0 +1,46 @@
+package privacyusecases
+
+import (
+	"context"
+
+	"golang_boilerplate_module/internal/config"
+	"golang_boilerplate_module/internal/modules/privacy/privacydomain/privacyrepo"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+	"golang_boilerplate_module/internal/shared/infra/observability"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type GetErasureRequestUseCase struct {
+	erasureRepo privacyrepo.ErasureRequestRepository
+	cfg         *config.Config
+	logger      providers.LoggerProvider
+}
+
+func NewGetErasureRequestUseCase(erasureRepo privacyrepo.ErasureRequestRepository, cfg *config.Config, logger providers.LoggerProvider) *GetErasureRequestUseCase {
+	return &GetErasureRequestUseCase{erasureRepo: erasureRepo, cfg: cfg, logger: logger}
+}
+
+func (uc *GetErasureRequestUseCase) Execute(ctx context.Context, actorID, id uint) (ErasureRequestOutput, error) {
+	ctx, span := privacyTracer.Start(ctx, "GetErasureRequestUseCase.Execute")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("privacy.erasure_request.id", int(id)))
+
+	log := observability.LoggerWithTrace(ctx, uc.logger).With("usecase", "GetErasureRequest", "erasureRequestId", id)
+
+	request, err := uc.erasureRepo.GetByID(ctx, id)
+	if err != nil {
+		log.Warn("erasure request not found")
+		observability.RecordError(span, err)
+		return ErasureRequestOutput{}, err
+	}
+
+	if err := authorizeSubject(uc.cfg, actorID, request.UserID); err != nil {
+		log.Warn("erasure request access denied", "actorId", actorID)
+		observability.RecordError(span, err)
+		return ErasureRequestOutput{}, err
+	}
+
+	return toErasureRequestOutput(request), nil
+}
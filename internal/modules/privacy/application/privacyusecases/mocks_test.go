@@ -0,0 +1,165 @@
+package privacyusecases_test
+
+import (
+	"context"
+	"time"
+
+	"golang_boilerplate_module/internal/modules/privacy/privacydomain"
+	"golang_boilerplate_module/internal/shared/domain/privacy"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+)
+
+type mockErasureRepo struct {
+	addFn             func(ctx context.Context, r *privacydomain.ErasureRequest) (*privacydomain.ErasureRequest, error)
+	getByIDFn         func(ctx context.Context, id uint) (*privacydomain.ErasureRequest, error)
+	updateFn          func(ctx context.Context, id uint, updates map[string]any) (*privacydomain.ErasureRequest, error)
+	getOpenByUserIDFn func(ctx context.Context, userID uint) (*privacydomain.ErasureRequest, error)
+	listDueFn         func(ctx context.Context, now, staleBefore time.Time, limit int) ([]privacydomain.ErasureRequest, error)
+	claimFn           func(ctx context.Context, id uint, now, staleBefore time.Time) (bool, error)
+	cancelFn          func(ctx context.Context, id uint, now time.Time) (bool, error)
+}
+
+func (m *mockErasureRepo) Add(ctx context.Context, r *privacydomain.ErasureRequest) (*privacydomain.ErasureRequest, error) {
+	if m.addFn != nil {
+		return m.addFn(ctx, r)
+	}
+	return r, nil
+}
+
+func (m *mockErasureRepo) GetByID(ctx context.Context, id uint) (*privacydomain.ErasureRequest, error) {
+	if m.getByIDFn != nil {
+		return m.getByIDFn(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *mockErasureRepo) UpdateByID(ctx context.Context, id uint, updates map[string]any) (*privacydomain.ErasureRequest, error) {
+	if m.updateFn != nil {
+		return m.updateFn(ctx, id, updates)
+	}
+	return &privacydomain.ErasureRequest{ID: id}, nil
+}
+
+func (m *mockErasureRepo) DeleteByID(ctx context.Context, id uint) error {
+	return nil
+}
+
+func (m *mockErasureRepo) DeleteAll(ctx context.Context) error {
+	return nil
+}
+
+func (m *mockErasureRepo) GetOpenByUserID(ctx context.Context, userID uint) (*privacydomain.ErasureRequest, error) {
+	if m.getOpenByUserIDFn != nil {
+		return m.getOpenByUserIDFn(ctx, userID)
+	}
+	return nil, nil
+}
+
+func (m *mockErasureRepo) ListDue(ctx context.Context, now, staleBefore time.Time, limit int) ([]privacydomain.ErasureRequest, error) {
+	if m.listDueFn != nil {
+		return m.listDueFn(ctx, now, staleBefore, limit)
+	}
+	return nil, nil
+}
+
+func (m *mockErasureRepo) Claim(ctx context.Context, id uint, now, staleBefore time.Time) (bool, error) {
+	if m.claimFn != nil {
+		return m.claimFn(ctx, id, now, staleBefore)
+	}
+	return true, nil
+}
+
+func (m *mockErasureRepo) Cancel(ctx context.Context, id uint, now time.Time) (bool, error) {
+	if m.cancelFn != nil {
+		return m.cancelFn(ctx, id, now)
+	}
+	return true, nil
+}
+
+type mockAuditRepo struct {
+	records  []privacydomain.AuditRecord
+	listByFn func(ctx context.Context, erasureRequestID uint) ([]privacydomain.AuditRecord, error)
+}
+
+func (m *mockAuditRepo) Add(_ context.Context, r *privacydomain.AuditRecord) (*privacydomain.AuditRecord, error) {
+	r.ID = uint(len(m.records) + 1)
+	m.records = append(m.records, *r)
+	return r, nil
+}
+
+func (m *mockAuditRepo) GetByID(ctx context.Context, id uint) (*privacydomain.AuditRecord, error) {
+	return nil, nil
+}
+
+func (m *mockAuditRepo) UpdateByID(ctx context.Context, id uint, updates map[string]any) (*privacydomain.AuditRecord, error) {
+	return nil, nil
+}
+
+func (m *mockAuditRepo) DeleteByID(ctx context.Context, id uint) error {
+	return nil
+}
+
+func (m *mockAuditRepo) DeleteAll(ctx context.Context) error {
+	return nil
+}
+
+func (m *mockAuditRepo) ListByErasureRequestID(ctx context.Context, erasureRequestID uint) ([]privacydomain.AuditRecord, error) {
+	if m.listByFn != nil {
+		return m.listByFn(ctx, erasureRequestID)
+	}
+	return m.records, nil
+}
+
+type mockExporter struct {
+	module   string
+	exportFn func(ctx context.Context, userID uint) (any, error)
+}
+
+func (m *mockExporter) Module() string { return m.module }
+
+func (m *mockExporter) ExportPersonalData(ctx context.Context, userID uint) (any, error) {
+	if m.exportFn != nil {
+		return m.exportFn(ctx, userID)
+	}
+	return map[string]any{"user_id": userID}, nil
+}
+
+type mockEraser struct {
+	module  string
+	eraseFn func(ctx context.Context, userID uint) (privacy.ErasureResult, error)
+}
+
+func (m *mockEraser) Module() string { return m.module }
+
+func (m *mockEraser) ErasePersonalData(ctx context.Context, userID uint) (privacy.ErasureResult, error) {
+	if m.eraseFn != nil {
+		return m.eraseFn(ctx, userID)
+	}
+	return privacy.ErasureResult{Strategy: privacy.ErasureStrategyAnonymize, RecordsAffected: 1}, nil
+}
+
+type mockUserDirectory struct {
+	getUserSummaryFn func(ctx context.Context, userID uint) (providers.UserSummary, error)
+}
+
+func (m *mockUserDirectory) GetUserSummary(ctx context.Context, userID uint) (providers.UserSummary, error) {
+	if m.getUserSummaryFn != nil {
+		return m.getUserSummaryFn(ctx, userID)
+	}
+	return providers.UserSummary{ID: userID}, nil
+}
+
+func (m *mockUserDirectory) FindUserByEmail(ctx context.Context, email string) (providers.UserSummary, error) {
+	return providers.UserSummary{}, nil
+}
+
+type mockLogger struct {
+	errors []string
+}
+
+func (l *mockLogger) Info(msg string, fields ...any)            {}
+func (l *mockLogger) Warn(msg string, fields ...any)            {}
+func (l *mockLogger) Error(msg string, fields ...any)           { l.errors = append(l.errors, msg) }
+func (l *mockLogger) Debug(msg string, fields ...any)           {}
+func (l *mockLogger) Sync() error                               { return nil }
+func (l *mockLogger) With(args ...any) providers.LoggerProvider { return l }
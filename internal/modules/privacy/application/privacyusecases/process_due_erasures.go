@@ -0,0 +1,222 @@
+package privacyusecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"golang_boilerplate_module/internal/config"
+	"golang_boilerplate_module/internal/modules/privacy/privacydomain"
+	"golang_boilerplate_module/internal/modules/privacy/privacydomain/privacyrepo"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/domain/privacy"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+	"golang_boilerplate_module/internal/shared/domain/tenancy"
+	"golang_boilerplate_module/internal/shared/infra/observability"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/fx"
+)
+
+const erasureBatchSize = 50
+
+// errNotClaimed means another run claimed the request first.
+var errNotClaimed = errors.New("erasure request claimed by another run")
+
+type ProcessDueErasuresOutput struct {
+	Completed int `json:"completed"`
+	Failed    int `json:"failed"`
+}
+
+type ProcessDueErasuresParams struct {
+	fx.In
+
+	Erasers     []privacy.PersonalDataEraser `group:"privacy_erasers"`
+	ErasureRepo privacyrepo.ErasureRequestRepository
+	AuditRepo   privacyrepo.AuditRecordRepository
+	Config      *config.Config
+	Logger      providers.LoggerProvider
+}
+
+type ProcessDueErasuresUseCase struct {
+	erasers     []privacy.PersonalDataEraser
+	erasureRepo privacyrepo.ErasureRequestRepository
+	auditRepo   privacyrepo.AuditRecordRepository
+	cfg         *config.Config
+	logger      providers.LoggerProvider
+}
+
+func NewProcessDueErasuresUseCase(p ProcessDueErasuresParams) *ProcessDueErasuresUseCase {
+	return &ProcessDueErasuresUseCase{
+		erasers:     p.Erasers,
+		erasureRepo: p.ErasureRepo,
+		auditRepo:   p.AuditRepo,
+		cfg:         p.Config,
+		logger:      p.Logger,
+	}
+}
+
+func (uc *ProcessDueErasuresUseCase) Execute(ctx context.Context) (ProcessDueErasuresOutput, error) {
+	ctx, span := privacyTracer.Start(ctx, "ProcessDueErasuresUseCase.Execute")
+	defer span.End()
+
+	log := observability.LoggerWithTrace(ctx, uc.logger).With("usecase", "ProcessDueErasures")
+
+	now := time.Now().UTC()
+	staleBefore := now.Add(-uc.cfg.Privacy.ErasureClaimTimeout)
+	due, err := uc.erasureRepo.ListDue(ctx, now, staleBefore, erasureBatchSize)
+	if err != nil {
+		log.Error("failed to list due erasure requests", "error", err.Error())
+		observability.RecordError(span, err)
+		return ProcessDueErasuresOutput{}, err
+	}
+
+	var output ProcessDueErasuresOutput
+	for i := range due {
+		err := uc.process(ctx, &due[i], now, staleBefore, log)
+		if errors.Is(err, errNotClaimed) {
+			continue
+		}
+		if err != nil {
+			output.Failed++
+			continue
+		}
+		output.Completed++
+	}
+
+	span.SetAttributes(
+		attribute.Int("privacy.erasure.completed", output.Completed),
+		attribute.Int("privacy.erasure.failed", output.Failed),
+	)
+	if len(due) > 0 {
+		log.Info("due erasure requests processed", "completed", output.Completed, "failed", output.Failed)
+	}
+
+	return output, nil
+}
+
+func (uc *ProcessDueErasuresUseCase) process(ctx context.Context, request *privacydomain.ErasureRequest, now, staleBefore time.Time, log providers.LoggerProvider) error {
+	ctx = tenancy.WithTenantID(ctx, request.TenantID)
+	ctx, span := privacyTracer.Start(ctx, "ProcessDueErasuresUseCase.process")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("privacy.erasure_request.id", int(request.ID)),
+		attribute.Int("user.id", int(request.UserID)),
+	)
+
+	log = log.With("erasureRequestId", request.ID, "userId", request.UserID, "tenantId", request.TenantID)
+
+	claimed, err := uc.erasureRepo.Claim(ctx, request.ID, now, staleBefore)
+	if err != nil {
+		log.Error("failed to claim erasure request", "error", err.Error())
+		observability.RecordError(span, err)
+		return err
+	}
+	if !claimed {
+		log.Debug("erasure request claimed by another run")
+		return errNotClaimed
+	}
+	if request.Status == privacydomain.ErasureStatusProcessing {
+		log.Warn("erasure request claimed again after its run did not finish", "claimedAt", request.ClaimedAt)
+	}
+
+	records := make([]privacydomain.AuditRecord, 0, len(uc.erasers))
+	for _, eraser := range uc.erasers {
+		result, err := eraser.ErasePersonalData(ctx, request.UserID)
+		if isNotFound(err) {
+			result, err = privacy.ErasureResult{Strategy: privacy.ErasureStrategyDelete}, nil
+		}
+
+		record := privacydomain.AuditRecord{
+			ErasureRequestID: &request.ID,
+			UserID:           request.UserID,
+			Module:           eraser.Module(),
+			Action:           privacydomain.AuditActionModuleErased,
+			Strategy:         string(result.Strategy),
+			RecordsAffected:  result.RecordsAffected,
+		}
+		if err != nil {
+			record.Action = privacydomain.AuditActionModuleFailed
+			record.Details = err.Error()
+		}
+
+		saved, auditErr := uc.auditRepo.Add(ctx, &record)
+		if auditErr != nil {
+			log.Error("failed to record erasure audit entry", "module", eraser.Module(), "error", auditErr.Error())
+			observability.RecordError(span, auditErr)
+			return auditErr
+		}
+
+		if err != nil {
+			log.Error("module erasure failed", "module", eraser.Module(), "error", err.Error())
+			observability.RecordError(span, err)
+			if updateErr := uc.recordFailure(ctx, request, log); updateErr != nil {
+				observability.RecordError(span, updateErr)
+				return updateErr
+			}
+			return err
+		}
+
+		records = append(records, *saved)
+	}
+
+	completedAt := time.Now().UTC().Truncate(time.Microsecond)
+	certificate := privacydomain.NewErasureCertificate(request, completedAt, records)
+
+	if _, err := uc.erasureRepo.UpdateByID(ctx, request.ID, map[string]any{
+		"status":             privacydomain.ErasureStatusCompleted,
+		"completed_at":       completedAt,
+		"certificate_digest": certificate.Digest,
+	}); err != nil {
+		log.Error("failed to mark erasure request as completed", "error", err.Error())
+		observability.RecordError(span, err)
+		return err
+	}
+
+	if _, err := uc.auditRepo.Add(ctx, &privacydomain.AuditRecord{
+		ErasureRequestID: &request.ID,
+		UserID:           request.UserID,
+		Action:           privacydomain.AuditActionErasureCompleted,
+		Details:          certificate.Digest,
+	}); err != nil {
+		log.Error("failed to record completion audit entry", "error", err.Error())
+		observability.RecordError(span, err)
+		return err
+	}
+
+	log.Info("erasure request completed", "modules", len(records))
+	return nil
+}
+
+// recordFailure puts the request back in pending to run again after a backoff
+// that grows with each attempt; the erasers are idempotent, so the modules
+// erased before the failure are erased again. After ErasureMaxAttempts runs
+// the request is marked failed.
+func (uc *ProcessDueErasuresUseCase) recordFailure(ctx context.Context, request *privacydomain.ErasureRequest, log providers.LoggerProvider) error {
+	attempts := request.Attempts + 1
+	updates := map[string]any{
+		"status":     privacydomain.ErasureStatusFailed,
+		"attempts":   attempts,
+		"claimed_at": nil,
+	}
+	if attempts < uc.cfg.Privacy.ErasureMaxAttempts {
+		retryAt := time.Now().UTC().Add(uc.cfg.Privacy.ErasureRetryBackoff * time.Duration(attempts))
+		updates["status"] = privacydomain.ErasureStatusPending
+		updates["scheduled_for"] = retryAt
+		log.Warn("erasure request scheduled to run again", "attempts", attempts, "retryAt", retryAt)
+	} else {
+		log.Error("erasure request failed after its last attempt", "attempts", attempts)
+	}
+
+	if _, err := uc.erasureRepo.UpdateByID(ctx, request.ID, updates); err != nil {
+		log.Error("failed to record erasure failure", "status", updates["status"], "error", err.Error())
+		return err
+	}
+	return nil
+}
+
+func isNotFound(err error) bool {
+	var domainErr *exceptions.DomainError
+	return errors.As(err, &domainErr) && domainErr.Code == exceptions.CodeNotFound
+}
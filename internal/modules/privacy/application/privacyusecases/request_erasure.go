@@ -0,0 +1,150 @@
+package privacyusecases
+
+import (
+	"context"
+	"time"
+
+	"golang_boilerplate_module/internal/config"
+	"golang_boilerplate_module/internal/modules/privacy/privacydomain"
+	"golang_boilerplate_module/internal/modules/privacy/privacydomain/privacyrepo"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+	"golang_boilerplate_module/internal/shared/infra/observability"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type RequestErasureInput struct {
+	ActorID uint   `json:"-"`
+	UserID  uint   `json:"-"`
+	Reason  string `json:"reason"`
+}
+
+type ErasureRequestOutput struct {
+	ID               uint                        `json:"id"`
+	UserID           uint                        `json:"user_id"`
+	Status           privacydomain.ErasureStatus `json:"status"`
+	Reason           string                      `json:"reason"`
+	RequestedAt      time.Time                   `json:"requested_at"`
+	ScheduledFor     time.Time                   `json:"scheduled_for"`
+	CancellableUntil time.Time                   `json:"cancellable_until"`
+	CancelledAt      *time.Time                  `json:"cancelled_at,omitempty"`
+	CompletedAt      *time.Time                  `json:"completed_at,omitempty"`
+}
+
+func toErasureRequestOutput(r *privacydomain.ErasureRequest) ErasureRequestOutput {
+	return ErasureRequestOutput{
+		ID:               r.ID,
+		UserID:           r.UserID,
+		Status:           r.Status,
+		Reason:           r.Reason,
+		RequestedAt:      r.RequestedAt,
+		ScheduledFor:     r.ScheduledFor,
+		CancellableUntil: r.CancellableUntil,
+		CancelledAt:      r.CancelledAt,
+		CompletedAt:      r.CompletedAt,
+	}
+}
+
+type RequestErasureUseCase struct {
+	erasureRepo privacyrepo.ErasureRequestRepository
+	auditRepo   privacyrepo.AuditRecordRepository
+	users       providers.UserDirectory
+	cfg         *config.Config
+	logger      providers.LoggerProvider
+}
+
+func NewRequestErasureUseCase(
+	erasureRepo privacyrepo.ErasureRequestRepository,
+	auditRepo privacyrepo.AuditRecordRepository,
+	users providers.UserDirectory,
+	cfg *config.Config,
+	logger providers.LoggerProvider,
+) *RequestErasureUseCase {
+	return &RequestErasureUseCase{
+		erasureRepo: erasureRepo,
+		auditRepo:   auditRepo,
+		users:       users,
+		cfg:         cfg,
+		logger:      logger,
+	}
+}
+
+func (uc *RequestErasureUseCase) Execute(ctx context.Context, input RequestErasureInput) (ErasureRequestOutput, error) {
+	ctx, span := privacyTracer.Start(ctx, "RequestErasureUseCase.Execute")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("user.id", int(input.UserID)))
+
+	log := observability.LoggerWithTrace(ctx, uc.logger).With("usecase", "RequestErasure", "userId", input.UserID, "actorId", input.ActorID)
+
+	if input.UserID == 0 {
+		err := exceptions.NewBadRequestException("User ID is required", nil)
+		observability.RecordError(span, err)
+		return ErasureRequestOutput{}, err
+	}
+
+	if err := authorizeSubject(uc.cfg, input.ActorID, input.UserID); err != nil {
+		log.Warn("erasure request denied")
+		observability.RecordError(span, err)
+		return ErasureRequestOutput{}, err
+	}
+
+	if _, err := uc.users.GetUserSummary(ctx, input.UserID); err != nil {
+		if isNotFound(err) {
+			err = exceptions.NewNotFoundException("User not found", nil)
+		}
+		log.Warn("erasure requested for an unknown user", "error", err.Error())
+		observability.RecordError(span, err)
+		return ErasureRequestOutput{}, err
+	}
+
+	existing, err := uc.erasureRepo.GetOpenByUserID(ctx, input.UserID)
+	if err != nil && !isNotFound(err) {
+		log.Error("failed to look up open erasure requests", "error", err.Error())
+		observability.RecordError(span, err)
+		return ErasureRequestOutput{}, err
+	}
+	if existing != nil {
+		err := exceptions.NewUnprocessableException(
+			"An erasure request is already open for this user",
+			map[string]any{"erasureRequestId": existing.ID},
+		)
+		log.Warn("erasure already requested", "erasureRequestId", existing.ID)
+		observability.RecordError(span, err)
+		return ErasureRequestOutput{}, err
+	}
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	request := &privacydomain.ErasureRequest{
+		UserID:           input.UserID,
+		Status:           privacydomain.ErasureStatusPending,
+		Reason:           input.Reason,
+		RequestedAt:      now,
+		ScheduledFor:     now.Add(uc.cfg.Privacy.ErasureGracePeriod),
+		CancellableUntil: now.Add(uc.cfg.Privacy.ErasureCancellationWindow),
+	}
+
+	created, err := uc.erasureRepo.Add(ctx, request)
+	if err != nil {
+		log.Error("failed to create erasure request", "error", err.Error())
+		observability.RecordError(span, err)
+		return ErasureRequestOutput{}, err
+	}
+
+	if _, err := uc.auditRepo.Add(ctx, &privacydomain.AuditRecord{
+		ErasureRequestID: &created.ID,
+		UserID:           created.UserID,
+		Action:           privacydomain.AuditActionErasureRequested,
+		Details:          created.Reason,
+	}); err != nil {
+		log.Error("failed to record erasure audit entry", "error", err.Error())
+		observability.RecordError(span, err)
+		return ErasureRequestOutput{}, err
+	}
+
+	span.SetAttributes(attribute.Int("privacy.erasure_request.id", int(created.ID)))
+	log.Info("erasure requested", "erasureRequestId", created.ID, "scheduledFor", created.ScheduledFor)
+
+	return toErasureRequestOutput(created), nil
+}
@@ -0,0 +1,21 @@
+package privacyusecases
+
+import (
+	"slices"
+	"strconv"
+
+	"golang_boilerplate_module/internal/config"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+)
+
+// authorizeSubject lets users act on their own personal data and privacy
+// administrators on anyone's.
+func authorizeSubject(cfg *config.Config, actorID, userID uint) error {
+	if actorID != 0 && actorID == userID {
+		return nil
+	}
+	if slices.Contains(cfg.Privacy.Admins, strconv.FormatUint(uint64(actorID), 10)) {
+		return nil
+	}
+	return exceptions.NewForbiddenException("Only the user or a privacy administrator can access this personal data", nil)
+}
@@ -0,0 +1,202 @@
+package privacyhttp
+
+import (
+	"fmt"
+	"strconv"
+
+	"golang_boilerplate_module/internal/modules/privacy/application/privacyusecases"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+	"golang_boilerplate_module/internal/shared/infra/http/middleware"
+	"golang_boilerplate_module/internal/shared/infra/observability"
+
+	"github.com/gofiber/fiber/v2"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	oteltrace "go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("privacy.http")
+
+type PrivacyController struct {
+	exportPersonalData    *privacyusecases.ExportPersonalDataUseCase
+	requestErasure        *privacyusecases.RequestErasureUseCase
+	cancelErasure         *privacyusecases.CancelErasureUseCase
+	getErasureRequest     *privacyusecases.GetErasureRequestUseCase
+	getErasureCertificate *privacyusecases.GetErasureCertificateUseCase
+	logger                providers.LoggerProvider
+}
+
+func NewPrivacyController(
+	exportPersonalData *privacyusecases.ExportPersonalDataUseCase,
+	requestErasure *privacyusecases.RequestErasureUseCase,
+	cancelErasure *privacyusecases.CancelErasureUseCase,
+	getErasureRequest *privacyusecases.GetErasureRequestUseCase,
+	getErasureCertificate *privacyusecases.GetErasureCertificateUseCase,
+	logger providers.LoggerProvider,
+) *PrivacyController {
+	return &PrivacyController{
+		exportPersonalData:    exportPersonalData,
+		requestErasure:        requestErasure,
+		cancelErasure:         cancelErasure,
+		getErasureRequest:     getErasureRequest,
+		getErasureCertificate: getErasureCertificate,
+		logger:                logger,
+	}
+}
+
+func (ctrl *PrivacyController) ExportPersonalData(c *fiber.Ctx) error {
+	ctx, span := tracer.Start(c.UserContext(), "PrivacyController.ExportPersonalData")
+	defer span.End()
+
+	log := middleware.LoggerFromLocals(c, ctrl.logger).With("handler", "PrivacyController.ExportPersonalData")
+
+	actorID, err := middleware.RequireCurrentUserID(c)
+	if err != nil {
+		observability.RecordError(span, err)
+		return err
+	}
+
+	userID, err := parseIDParam(c, "id", "Invalid user ID", span, log)
+	if err != nil {
+		return err
+	}
+
+	archive, err := ctrl.exportPersonalData.Execute(ctx, actorID, userID)
+	if err != nil {
+		observability.RecordError(span, err)
+		return err
+	}
+
+	c.Attachment(fmt.Sprintf("personal-data-%d.json", userID))
+	return c.JSON(archive)
+}
+
+func (ctrl *PrivacyController) RequestErasure(c *fiber.Ctx) error {
+	ctx, span := tracer.Start(c.UserContext(), "PrivacyController.RequestErasure")
+	defer span.End()
+
+	log := middleware.LoggerFromLocals(c, ctrl.logger).With("handler", "PrivacyController.RequestErasure")
+
+	actorID, err := middleware.RequireCurrentUserID(c)
+	if err != nil {
+		observability.RecordError(span, err)
+		return err
+	}
+
+	userID, err := parseIDParam(c, "id", "Invalid user ID", span, log)
+	if err != nil {
+		return err
+	}
+
+	var input privacyusecases.RequestErasureInput
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&input); err != nil {
+			domainErr := exceptions.NewBadRequestException("Invalid request body", nil)
+			log.Warn("failed to parse request body", "error", err.Error())
+			observability.RecordError(span, domainErr)
+			return domainErr
+		}
+	}
+	input.ActorID = actorID
+	input.UserID = userID
+
+	output, err := ctrl.requestErasure.Execute(ctx, input)
+	if err != nil {
+		observability.RecordError(span, err)
+		return err
+	}
+
+	span.SetAttributes(attribute.Int("privacy.erasure_request.id", int(output.ID)))
+	return c.Status(fiber.StatusAccepted).JSON(output)
+}
+
+func (ctrl *PrivacyController) GetErasureRequest(c *fiber.Ctx) error {
+	ctx, span := tracer.Start(c.UserContext(), "PrivacyController.GetErasureRequest")
+	defer span.End()
+
+	log := middleware.LoggerFromLocals(c, ctrl.logger).With("handler", "PrivacyController.GetErasureRequest")
+
+	actorID, err := middleware.RequireCurrentUserID(c)
+	if err != nil {
+		observability.RecordError(span, err)
+		return err
+	}
+
+	id, err := parseIDParam(c, "id", "Invalid erasure request ID", span, log)
+	if err != nil {
+		return err
+	}
+
+	output, err := ctrl.getErasureRequest.Execute(ctx, actorID, id)
+	if err != nil {
+		observability.RecordError(span, err)
+		return err
+	}
+
+	return c.JSON(output)
+}
+
+func (ctrl *PrivacyController) CancelErasure(c *fiber.Ctx) error {
+	ctx, span := tracer.Start(c.UserContext(), "PrivacyController.CancelErasure")
+	defer span.End()
+
+	log := middleware.LoggerFromLocals(c, ctrl.logger).With("handler", "PrivacyController.CancelErasure")
+
+	actorID, err := middleware.RequireCurrentUserID(c)
+	if err != nil {
+		observability.RecordError(span, err)
+		return err
+	}
+
+	id, err := parseIDParam(c, "id", "Invalid erasure request ID", span, log)
+	if err != nil {
+		return err
+	}
+
+	output, err := ctrl.cancelErasure.Execute(ctx, actorID, id)
+	if err != nil {
+		observability.RecordError(span, err)
+		return err
+	}
+
+	return c.JSON(output)
+}
+
+func (ctrl *PrivacyController) GetErasureCertificate(c *fiber.Ctx) error {
+	ctx, span := tracer.Start(c.UserContext(), "PrivacyController.GetErasureCertificate")
+	defer span.End()
+
+	log := middleware.LoggerFromLocals(c, ctrl.logger).With("handler", "PrivacyController.GetErasureCertificate")
+
+	actorID, err := middleware.RequireCurrentUserID(c)
+	if err != nil {
+		observability.RecordError(span, err)
+		return err
+	}
+
+	id, err := parseIDParam(c, "id", "Invalid erasure request ID", span, log)
+	if err != nil {
+		return err
+	}
+
+	certificate, err := ctrl.getErasureCertificate.Execute(ctx, actorID, id)
+	if err != nil {
+		observability.RecordError(span, err)
+		return err
+	}
+
+	return c.JSON(certificate)
+}
+
+func parseIDParam(c *fiber.Ctx, name, message string, span oteltrace.Span, log providers.LoggerProvider) (uint, error) {
+	raw := c.Params(name)
+	id, err := strconv.ParseUint(raw, 10, 64)
+	if err != nil {
+		domainErr := exceptions.NewBadRequestException(message, nil)
+		log.Warn("invalid id param", "param", name, "value", raw)
+		observability.RecordError(span, domainErr)
+		return 0, domainErr
+	}
+	return uint(id), nil
+}
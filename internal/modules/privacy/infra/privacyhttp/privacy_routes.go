@@ -0,0 +1,46 @@
+package privacyhttp
+
+import (
+	"golang_boilerplate_module/internal/modules/privacy/application/privacyusecases"
+	"golang_boilerplate_module/internal/modules/privacy/privacydomain"
+	"golang_boilerplate_module/internal/shared/infra/http/openapi"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func RegisterRoutes(router *openapi.Router, controller *PrivacyController) {
+	api := router.Version("v1").Group("/privacy", openapi.Tags("Privacy"), openapi.Authenticated())
+	api.Get("/users/:id/export", controller.ExportPersonalData,
+		openapi.Summary("Export every piece of personal data held about a user"),
+		openapi.Description("Allowed for the user themself and privacy administrators (PRIVACY_ADMINS)."),
+		openapi.PathParam("id", "integer", "User ID"),
+		openapi.Returns[privacyusecases.PersonalDataArchive](fiber.StatusOK),
+		openapi.Errors(fiber.StatusBadRequest, fiber.StatusForbidden, fiber.StatusNotFound),
+	)
+	api.Post("/users/:id/erasure", controller.RequestErasure,
+		openapi.Summary("Schedule the erasure of a user's personal data"),
+		openapi.Description("Allowed for the user themself and privacy administrators (PRIVACY_ADMINS)."),
+		openapi.PathParam("id", "integer", "User ID"),
+		openapi.OptionalBody[privacyusecases.RequestErasureInput](),
+		openapi.Returns[privacyusecases.ErasureRequestOutput](fiber.StatusAccepted),
+		openapi.Errors(fiber.StatusBadRequest, fiber.StatusForbidden, fiber.StatusNotFound, fiber.StatusUnprocessableEntity),
+	)
+	api.Get("/erasures/:id", controller.GetErasureRequest,
+		openapi.Summary("Get an erasure request"),
+		openapi.PathParam("id", "integer", "Erasure request ID"),
+		openapi.Returns[privacyusecases.ErasureRequestOutput](fiber.StatusOK),
+		openapi.Errors(fiber.StatusBadRequest, fiber.StatusForbidden, fiber.StatusNotFound),
+	)
+	api.Post("/erasures/:id/cancel", controller.CancelErasure,
+		openapi.Summary("Cancel a pending erasure request"),
+		openapi.PathParam("id", "integer", "Erasure request ID"),
+		openapi.Returns[privacyusecases.ErasureRequestOutput](fiber.StatusOK),
+		openapi.Errors(fiber.StatusBadRequest, fiber.StatusForbidden, fiber.StatusNotFound, fiber.StatusUnprocessableEntity),
+	)
+	api.Get("/erasures/:id/certificate", controller.GetErasureCertificate,
+		openapi.Summary("Get the certificate of a completed erasure"),
+		openapi.PathParam("id", "integer", "Erasure request ID"),
+		openapi.Returns[privacydomain.ErasureCertificate](fiber.StatusOK),
+		openapi.Errors(fiber.StatusBadRequest, fiber.StatusForbidden, fiber.StatusNotFound, fiber.StatusUnprocessableEntity),
+	)
+}
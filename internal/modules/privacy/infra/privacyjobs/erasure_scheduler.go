@@ -0,0 +1,20 @@
+package privacyjobs
+
+import (
+	"context"
+
+	"golang_boilerplate_module/internal/config"
+	"golang_boilerplate_module/internal/modules/privacy/application/privacyusecases"
+	"golang_boilerplate_module/internal/shared/infra/platform"
+)
+
+func NewErasureSchedulerJob(cfg *config.Config, processDueErasures *privacyusecases.ProcessDueErasuresUseCase) platform.Job {
+	return platform.Job{
+		Name:     "erasure_scheduler",
+		Interval: cfg.Privacy.ErasureCheckInterval,
+		Run: func(ctx context.Context) error {
+			_, err := processDueErasures.Execute(ctx)
+			return err
+		},
+	}
+}
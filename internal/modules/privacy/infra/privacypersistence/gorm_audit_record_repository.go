@@ -0,0 +1,50 @@
+package privacypersistence
+
+import (
+	"context"
+
+	"golang_boilerplate_module/internal/modules/privacy/privacydomain"
+	"golang_boilerplate_module/internal/modules/privacy/privacydomain/privacyrepo"
+	sharedrepo "golang_boilerplate_module/internal/shared/infra/persistence/repositories"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"gorm.io/gorm"
+)
+
+type GORMAuditRecordRepository struct {
+	*sharedrepo.GORMGenericRepository[privacydomain.AuditRecord, uint]
+	db *gorm.DB
+}
+
+func NewGORMAuditRecordRepository(db *gorm.DB) privacyrepo.AuditRecordRepository {
+	return &GORMAuditRecordRepository{
+		GORMGenericRepository: sharedrepo.NewGORMGenericRepository[privacydomain.AuditRecord, uint](db),
+		db:                    db,
+	}
+}
+
+func (r *GORMAuditRecordRepository) ListByErasureRequestID(ctx context.Context, erasureRequestID uint) ([]privacydomain.AuditRecord, error) {
+	ctx, span := dbTracer.Start(ctx, "GORMAuditRecordRepository.ListByErasureRequestID")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.operation", "ListByErasureRequestID"),
+		attribute.Int("privacy.erasure_request.id", int(erasureRequestID)),
+	)
+
+	var records []privacydomain.AuditRecord
+	err := sharedrepo.WithTenantSession(ctx, r.db, func(tx *gorm.DB) error {
+		return tx.
+			Where("erasure_request_id = ?", erasureRequestID).
+			Order("id ASC").
+			Find(&records).Error
+	})
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+		return nil, sharedrepo.DatabaseError(err)
+	}
+
+	return records, nil
+}
@@ -0,0 +1,149 @@
+package privacypersistence
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"golang_boilerplate_module/internal/modules/privacy/privacydomain"
+	"golang_boilerplate_module/internal/modules/privacy/privacydomain/privacyrepo"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/domain/tenancy"
+	sharedrepo "golang_boilerplate_module/internal/shared/infra/persistence/repositories"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"gorm.io/gorm"
+)
+
+var dbTracer = otel.Tracer("privacy.persistence")
+
+type GORMErasureRequestRepository struct {
+	*sharedrepo.GORMGenericRepository[privacydomain.ErasureRequest, uint]
+	db *gorm.DB
+}
+
+func NewGORMErasureRequestRepository(db *gorm.DB) privacyrepo.ErasureRequestRepository {
+	return &GORMErasureRequestRepository{
+		GORMGenericRepository: sharedrepo.NewGORMGenericRepository[privacydomain.ErasureRequest, uint](db),
+		db:                    db,
+	}
+}
+
+func (r *GORMErasureRequestRepository) GetOpenByUserID(ctx context.Context, userID uint) (*privacydomain.ErasureRequest, error) {
+	ctx, span := dbTracer.Start(ctx, "GORMErasureRequestRepository.GetOpenByUserID")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.operation", "GetOpenByUserID"),
+		attribute.Int("user.id", int(userID)),
+	)
+
+	var request privacydomain.ErasureRequest
+	err := sharedrepo.WithTenantSession(ctx, r.db, func(tx *gorm.DB) error {
+		return tx.
+			Where("user_id = ? AND status IN ?", userID, []privacydomain.ErasureStatus{
+				privacydomain.ErasureStatusPending,
+				privacydomain.ErasureStatusProcessing,
+			}).
+			First(&request).Error
+	})
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		span.SetStatus(codes.Error, "not found")
+		return nil, exceptions.NewNotFoundException("Erasure request not found", nil)
+	}
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+		return nil, sharedrepo.DatabaseError(err)
+	}
+
+	return &request, nil
+}
+
+// ListDue returns the pending requests scheduled by now and the processing
+// ones claimed before staleBefore, whose run did not finish.
+func (r *GORMErasureRequestRepository) ListDue(ctx context.Context, now, staleBefore time.Time, limit int) ([]privacydomain.ErasureRequest, error) {
+	ctx, span := dbTracer.Start(ctx, "GORMErasureRequestRepository.ListDue")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("db.operation", "ListDue"))
+
+	var requests []privacydomain.ErasureRequest
+	err := r.db.WithContext(tenancy.WithSystemScope(ctx)).
+		Where("(status = ? AND scheduled_for <= ?) OR (status = ? AND claimed_at < ?)",
+			privacydomain.ErasureStatusPending, now, privacydomain.ErasureStatusProcessing, staleBefore).
+		Order("scheduled_for ASC").
+		Limit(limit).
+		Find(&requests).Error
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+		return nil, sharedrepo.DatabaseError(err)
+	}
+
+	span.SetAttributes(attribute.Int("privacy.erasure.due", len(requests)))
+	return requests, nil
+}
+
+// Claim moves the request to processing if it is still pending, or if its
+// claim is older than staleBefore. Only one of the callers racing for the
+// same request gets true.
+func (r *GORMErasureRequestRepository) Claim(ctx context.Context, id uint, now, staleBefore time.Time) (bool, error) {
+	ctx, span := dbTracer.Start(ctx, "GORMErasureRequestRepository.Claim")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.operation", "Claim"),
+		attribute.Int("privacy.erasure_request.id", int(id)),
+	)
+
+	var claimed int64
+	err := sharedrepo.WithTenantSession(ctx, r.db, func(tx *gorm.DB) error {
+		result := tx.Model(&privacydomain.ErasureRequest{}).
+			Where("id = ? AND (status = ? OR (status = ? AND claimed_at < ?))",
+				id, privacydomain.ErasureStatusPending, privacydomain.ErasureStatusProcessing, staleBefore).
+			Updates(map[string]any{"status": privacydomain.ErasureStatusProcessing, "claimed_at": now})
+		claimed = result.RowsAffected
+		return result.Error
+	})
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+		return false, sharedrepo.DatabaseError(err)
+	}
+
+	span.SetAttributes(attribute.Bool("privacy.erasure.claimed", claimed == 1))
+	return claimed == 1, nil
+}
+
+// Cancel moves the request to cancelled if it is still pending and within
+// its cancellation window, so a cancellation racing the job's Claim either
+// wins or reports false.
+func (r *GORMErasureRequestRepository) Cancel(ctx context.Context, id uint, now time.Time) (bool, error) {
+	ctx, span := dbTracer.Start(ctx, "GORMErasureRequestRepository.Cancel")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.operation", "Cancel"),
+		attribute.Int("privacy.erasure_request.id", int(id)),
+	)
+
+	var cancelled int64
+	err := sharedrepo.WithTenantSession(ctx, r.db, func(tx *gorm.DB) error {
+		result := tx.Model(&privacydomain.ErasureRequest{}).
+			Where("id = ? AND status = ? AND cancellable_until >= ?", id, privacydomain.ErasureStatusPending, now).
+			Updates(map[string]any{"status": privacydomain.ErasureStatusCancelled, "cancelled_at": now})
+		cancelled = result.RowsAffected
+		return result.Error
+	})
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+		return false, sharedrepo.DatabaseError(err)
+	}
+
+	span.SetAttributes(attribute.Bool("privacy.erasure.cancelled", cancelled == 1))
+	return cancelled == 1, nil
+}
@@ -0,0 +1,30 @@
+package privacy
+
+import (
+	"golang_boilerplate_module/internal/modules/privacy/application/privacyusecases"
+	"golang_boilerplate_module/internal/modules/privacy/infra/privacyhttp"
+	"golang_boilerplate_module/internal/modules/privacy/infra/privacyjobs"
+	"golang_boilerplate_module/internal/modules/privacy/infra/privacypersistence"
+	"golang_boilerplate_module/internal/shared/infra/platform"
+)
+
+var Module = platform.Module{
+	Name:         "privacy",
+	Description:  "Personal data export and erasure (LGPD / GDPR)",
+	DependsOn:    []string{"users"},
+	ConfigPrefix: "PRIVACY_",
+	Migrations:   []string{"V2__create_privacy.sql", "V9__add_privacy_erasure_claims.sql", "V10__add_privacy_erasure_attempts.sql"},
+	Providers: []any{
+		privacypersistence.NewGORMErasureRequestRepository,
+		privacypersistence.NewGORMAuditRecordRepository,
+		privacyusecases.NewExportPersonalDataUseCase,
+		privacyusecases.NewRequestErasureUseCase,
+		privacyusecases.NewCancelErasureUseCase,
+		privacyusecases.NewGetErasureRequestUseCase,
+		privacyusecases.NewGetErasureCertificateUseCase,
+		privacyusecases.NewProcessDueErasuresUseCase,
+		privacyhttp.NewPrivacyController,
+	},
+	Routes: []any{privacyhttp.RegisterRoutes},
+	Jobs:   []any{privacyjobs.NewErasureSchedulerJob},
+}
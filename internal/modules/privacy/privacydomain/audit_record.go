@@ -0,0 +1,31 @@
+package privacydomain
+
+import "time"
+
+type AuditAction string
+
+const (
+	AuditActionErasureRequested AuditAction = "erasure_requested"
+	AuditActionErasureCancelled AuditAction = "erasure_cancelled"
+	AuditActionModuleErased     AuditAction = "module_erased"
+	AuditActionModuleFailed     AuditAction = "module_failed"
+	AuditActionErasureCompleted AuditAction = "erasure_completed"
+	AuditActionDataExported     AuditAction = "data_exported"
+)
+
+type AuditRecord struct {
+	ID               uint        `json:"id" gorm:"primarykey"`
+	TenantID         string      `json:"tenant_id" gorm:"index"`
+	ErasureRequestID *uint       `json:"erasure_request_id" gorm:"index"`
+	UserID           uint        `json:"user_id" gorm:"index"`
+	Action           AuditAction `json:"action"`
+	Module           string      `json:"module"`
+	Strategy         string      `json:"strategy"`
+	RecordsAffected  int64       `json:"records_affected"`
+	Details          string      `json:"details"`
+	CreatedAt        time.Time   `json:"created_at"`
+}
+
+func (AuditRecord) TableName() string {
+	return "privacy_audit_records"
+}
@@ -0,0 +1,68 @@
+package privacydomain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+)
+
+type CertifiedModule struct {
+	Module          string `json:"module"`
+	Strategy        string `json:"strategy"`
+	RecordsAffected int64  `json:"records_affected"`
+}
+
+type ErasureCertificate struct {
+	ErasureRequestID uint              `json:"erasure_request_id"`
+	UserID           uint              `json:"user_id"`
+	RequestedAt      time.Time         `json:"requested_at"`
+	CompletedAt      time.Time         `json:"completed_at"`
+	Modules          []CertifiedModule `json:"modules"`
+	Digest           string            `json:"digest"`
+}
+
+// NewErasureCertificate certifies the last erasure of each module, since a
+// run that was claimed again erases the modules of the earlier run again.
+func NewErasureCertificate(request *ErasureRequest, completedAt time.Time, records []AuditRecord) ErasureCertificate {
+	cert := ErasureCertificate{
+		ErasureRequestID: request.ID,
+		UserID:           request.UserID,
+		RequestedAt:      request.RequestedAt.UTC(),
+		CompletedAt:      completedAt.UTC(),
+		Modules:          []CertifiedModule{},
+	}
+
+	positions := make(map[string]int)
+	for _, record := range records {
+		if record.Action != AuditActionModuleErased {
+			continue
+		}
+		module := CertifiedModule{
+			Module:          record.Module,
+			Strategy:        record.Strategy,
+			RecordsAffected: record.RecordsAffected,
+		}
+		if i, ok := positions[record.Module]; ok {
+			cert.Modules[i] = module
+			continue
+		}
+		positions[record.Module] = len(cert.Modules)
+		cert.Modules = append(cert.Modules, module)
+	}
+
+	cert.Digest = cert.computeDigest()
+	return cert
+}
+
+func (c ErasureCertificate) Verify() bool {
+	return c.Digest == c.computeDigest()
+}
+
+func (c ErasureCertificate) computeDigest() string {
+	unsigned := c
+	unsigned.Digest = ""
+	payload, _ := json.Marshal(unsigned)
+	sum := sha256.Sum256(payload)
+	return hex.EncodeToString(sum[:])
+}
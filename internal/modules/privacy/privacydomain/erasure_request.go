@@ -0,0 +1,43 @@
+package privacydomain
+
+import "time"
+
+type ErasureStatus string
+
+const (
+	ErasureStatusPending    ErasureStatus = "pending"
+	ErasureStatusCancelled  ErasureStatus = "cancelled"
+	ErasureStatusProcessing ErasureStatus = "processing"
+	ErasureStatusCompleted  ErasureStatus = "completed"
+	ErasureStatusFailed     ErasureStatus = "failed"
+)
+
+type ErasureRequest struct {
+	ID                uint          `json:"id" gorm:"primarykey"`
+	TenantID          string        `json:"tenant_id" gorm:"index"`
+	UserID            uint          `json:"user_id" gorm:"index"`
+	Status            ErasureStatus `json:"status"`
+	Reason            string        `json:"reason"`
+	RequestedAt       time.Time     `json:"requested_at"`
+	ScheduledFor      time.Time     `json:"scheduled_for"`
+	CancellableUntil  time.Time     `json:"cancellable_until"`
+	ClaimedAt         *time.Time    `json:"claimed_at"`
+	CancelledAt       *time.Time    `json:"cancelled_at"`
+	CompletedAt       *time.Time    `json:"completed_at"`
+	CertificateDigest string        `json:"certificate_digest"`
+	Attempts          int           `json:"attempts"`
+	CreatedAt         time.Time     `json:"created_at"`
+	UpdatedAt         time.Time     `json:"updated_at"`
+}
+
+func (ErasureRequest) TableName() string {
+	return "privacy_erasure_requests"
+}
+
+func (r *ErasureRequest) CanBeCancelledAt(now time.Time) bool {
+	return r.Status == ErasureStatusPending && !now.After(r.CancellableUntil)
+}
+
+func (r *ErasureRequest) IsDueAt(now time.Time) bool {
+	return r.Status == ErasureStatusPending && !now.Before(r.ScheduledFor)
+}
@@ -0,0 +1,13 @@
+package privacyrepo
+
+import (
+	"context"
+
+	"golang_boilerplate_module/internal/modules/privacy/privacydomain"
+	sharedrepo "golang_boilerplate_module/internal/shared/domain/repositories"
+)
+
+type AuditRecordRepository interface {
+	sharedrepo.GenericRepository[privacydomain.AuditRecord, uint]
+	ListByErasureRequestID(ctx context.Context, erasureRequestID uint) ([]privacydomain.AuditRecord, error)
+}
@@ -0,0 +1,17 @@
+package privacyrepo
+
+import (
+	"context"
+	"time"
+
+	"golang_boilerplate_module/internal/modules/privacy/privacydomain"
+	sharedrepo "golang_boilerplate_module/internal/shared/domain/repositories"
+)
+
+type ErasureRequestRepository interface {
+	sharedrepo.GenericRepository[privacydomain.ErasureRequest, uint]
+	GetOpenByUserID(ctx context.Context, userID uint) (*privacydomain.ErasureRequest, error)
+	ListDue(ctx context.Context, now, staleBefore time.Time, limit int) ([]privacydomain.ErasureRequest, error)
+	Claim(ctx context.Context, id uint, now, staleBefore time.Time) (bool, error)
+	Cancel(ctx context.Context, id uint, now time.Time) (bool, error)
+}
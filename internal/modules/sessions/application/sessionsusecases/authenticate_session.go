@@ -0,0 +1,87 @@
+package sessionsusecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"golang_boilerplate_module/internal/config"
+	"golang_boilerplate_module/internal/modules/sessions/sessionsdomain"
+	"golang_boilerplate_module/internal/modules/sessions/sessionsdomain/sessionsrepo"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+	"golang_boilerplate_module/internal/shared/domain/tenancy"
+	"golang_boilerplate_module/internal/shared/infra/observability"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const touchInterval = time.Minute
+
+type AuthenticateSessionUseCase struct {
+	store  sessionsrepo.SessionStore
+	cfg    *config.Config
+	logger providers.LoggerProvider
+}
+
+func NewAuthenticateSessionUseCase(store sessionsrepo.SessionStore, cfg *config.Config, logger providers.LoggerProvider) *AuthenticateSessionUseCase {
+	return &AuthenticateSessionUseCase{store: store, cfg: cfg, logger: logger}
+}
+
+func (uc *AuthenticateSessionUseCase) AuthenticateSession(ctx context.Context, token string) (providers.AuthenticatedSession, error) {
+	ctx, span := sessionTracer.Start(ctx, "AuthenticateSessionUseCase.AuthenticateSession")
+	defer span.End()
+
+	log := observability.LoggerWithTrace(ctx, uc.logger).With("usecase", "AuthenticateSession")
+
+	// Sessions are looked up before the tenant is resolved, which then comes
+	// from the session.
+	session, err := uc.store.Get(tenancy.WithSystemScope(ctx), sessionsdomain.HashSessionToken(token))
+	if tenantID, ok := tenancy.TenantIDFromContext(ctx); err == nil && ok && tenantID != session.TenantID {
+		err = exceptions.NewNotFoundException("Session not found", nil)
+	}
+	if err != nil {
+		if isNotFound(err) {
+			err = exceptions.NewUnauthorizedException("Session expired or revoked", nil)
+		}
+		log.Warn("session lookup failed", "error", err.Error())
+		observability.RecordError(span, err)
+		return providers.AuthenticatedSession{}, err
+	}
+	ctx = tenancy.WithTenantID(ctx, session.TenantID)
+
+	span.SetAttributes(attribute.Int("user.id", int(session.UserID)))
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	if !session.IsActiveAt(now) {
+		err := exceptions.NewUnauthorizedException("Session expired or revoked", nil)
+		log.Info("rejected inactive session", "userId", session.UserID)
+		observability.RecordError(span, err)
+		return providers.AuthenticatedSession{}, err
+	}
+
+	refreshed := false
+	if now.Sub(session.LastSeenAt) >= touchInterval {
+		expiresAt := session.RollingExpiry(now, uc.cfg.Sessions.IdleTimeout)
+		if err := uc.store.Touch(ctx, session.ID, now, expiresAt); err != nil {
+			log.Warn("failed to extend session", "error", err.Error())
+		} else {
+			session.ExpiresAt = expiresAt
+			refreshed = true
+		}
+	}
+
+	return providers.AuthenticatedSession{
+		ID:        session.ID,
+		TenantID:  session.TenantID,
+		UserID:    session.UserID,
+		CSRFToken: session.CSRFToken,
+		ExpiresAt: session.ExpiresAt,
+		Refreshed: refreshed,
+	}, nil
+}
+
+func isNotFound(err error) bool {
+	var domainErr *exceptions.DomainError
+	return errors.As(err, &domainErr) && domainErr.Code == exceptions.CodeNotFound
+}
@@ -0,0 +1,134 @@
+package sessionsusecases
+
+import (
+	"context"
+	"time"
+
+	"golang_boilerplate_module/internal/config"
+	"golang_boilerplate_module/internal/modules/sessions/sessionsdomain"
+	"golang_boilerplate_module/internal/modules/sessions/sessionsdomain/sessionsrepo"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+	"golang_boilerplate_module/internal/shared/infra/observability"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+var sessionTracer = otel.Tracer("sessions")
+
+type CreateSessionInput struct {
+	UserID    uint
+	UserAgent string
+	IPAddress string
+}
+
+type SessionOutput struct {
+	ID         string    `json:"id"`
+	UserAgent  string    `json:"user_agent"`
+	IPAddress  string    `json:"ip_address"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastSeenAt time.Time `json:"last_seen_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+	Current    bool      `json:"current"`
+}
+
+func toSessionOutput(s *sessionsdomain.Session, currentID string) SessionOutput {
+	return SessionOutput{
+		ID:         s.ID,
+		UserAgent:  s.UserAgent,
+		IPAddress:  s.IPAddress,
+		CreatedAt:  s.CreatedAt,
+		LastSeenAt: s.LastSeenAt,
+		ExpiresAt:  s.ExpiresAt,
+		Current:    s.ID == currentID,
+	}
+}
+
+type CreatedSession struct {
+	Token     string
+	CSRFToken string
+	Session   SessionOutput
+}
+
+type CreateSessionUseCase struct {
+	store  sessionsrepo.SessionStore
+	cfg    *config.Config
+	logger providers.LoggerProvider
+}
+
+func NewCreateSessionUseCase(store sessionsrepo.SessionStore, cfg *config.Config, logger providers.LoggerProvider) *CreateSessionUseCase {
+	return &CreateSessionUseCase{store: store, cfg: cfg, logger: logger}
+}
+
+func (uc *CreateSessionUseCase) Execute(ctx context.Context, input CreateSessionInput) (CreatedSession, error) {
+	ctx, span := sessionTracer.Start(ctx, "CreateSessionUseCase.Execute")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("user.id", int(input.UserID)))
+
+	log := observability.LoggerWithTrace(ctx, uc.logger).With("usecase", "CreateSession", "userId", input.UserID)
+
+	if input.UserID == 0 {
+		err := exceptions.NewBadRequestException("A user is required to open a session", nil)
+		log.Warn("validation failed — missing user")
+		observability.RecordError(span, err)
+		return CreatedSession{}, err
+	}
+
+	token, sessionID, err := sessionsdomain.NewSessionToken()
+	if err != nil {
+		domainErr := exceptions.NewInternalException(map[string]any{"error": err.Error()})
+		log.Error("failed to generate session token", "error", err.Error())
+		observability.RecordError(span, domainErr)
+		return CreatedSession{}, domainErr
+	}
+
+	csrfToken, err := sessionsdomain.NewCSRFToken()
+	if err != nil {
+		domainErr := exceptions.NewInternalException(map[string]any{"error": err.Error()})
+		log.Error("failed to generate csrf token", "error", err.Error())
+		observability.RecordError(span, domainErr)
+		return CreatedSession{}, domainErr
+	}
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	session := &sessionsdomain.Session{
+		ID:                sessionID,
+		UserID:            input.UserID,
+		CSRFToken:         csrfToken,
+		UserAgent:         input.UserAgent,
+		IPAddress:         input.IPAddress,
+		CreatedAt:         now,
+		LastSeenAt:        now,
+		AbsoluteExpiresAt: now.Add(uc.cfg.Sessions.AbsoluteTimeout),
+	}
+	session.ExpiresAt = session.RollingExpiry(now, uc.cfg.Sessions.IdleTimeout)
+
+	if err := uc.store.Create(ctx, session); err != nil {
+		log.Error("failed to store session", "error", err.Error())
+		observability.RecordError(span, err)
+		return CreatedSession{}, err
+	}
+
+	log.Info("session created")
+	return CreatedSession{
+		Token:     token,
+		CSRFToken: csrfToken,
+		Session:   toSessionOutput(session, session.ID),
+	}, nil
+}
+
+func (uc *CreateSessionUseCase) IssueSession(ctx context.Context, userID uint, userAgent, ipAddress string) (providers.IssuedSession, error) {
+	created, err := uc.Execute(ctx, CreateSessionInput{UserID: userID, UserAgent: userAgent, IPAddress: ipAddress})
+	if err != nil {
+		return providers.IssuedSession{}, err
+	}
+
+	return providers.IssuedSession{
+		ID:        created.Session.ID,
+		Token:     created.Token,
+		CSRFToken: created.CSRFToken,
+		ExpiresAt: created.Session.ExpiresAt,
+	}, nil
+}
@@ -0,0 +1,141 @@
+package sessionsusecases
+
+import (
+	"context"
+	"time"
+
+	"golang_boilerplate_module/internal/modules/sessions/sessionsdomain/sessionsrepo"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+	"golang_boilerplate_module/internal/shared/domain/tenancy"
+	"golang_boilerplate_module/internal/shared/infra/observability"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type ListSessionsUseCase struct {
+	store  sessionsrepo.SessionStore
+	logger providers.LoggerProvider
+}
+
+func NewListSessionsUseCase(store sessionsrepo.SessionStore, logger providers.LoggerProvider) *ListSessionsUseCase {
+	return &ListSessionsUseCase{store: store, logger: logger}
+}
+
+func (uc *ListSessionsUseCase) Execute(ctx context.Context, userID uint, currentID string) ([]SessionOutput, error) {
+	ctx, span := sessionTracer.Start(ctx, "ListSessionsUseCase.Execute")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("user.id", int(userID)))
+
+	log := observability.LoggerWithTrace(ctx, uc.logger).With("usecase", "ListSessions", "userId", userID)
+
+	sessions, err := uc.store.ListActiveByUser(ctx, userID, time.Now().UTC())
+	if err != nil {
+		log.Error("failed to list sessions", "error", err.Error())
+		observability.RecordError(span, err)
+		return nil, err
+	}
+
+	outputs := make([]SessionOutput, 0, len(sessions))
+	for i := range sessions {
+		outputs = append(outputs, toSessionOutput(&sessions[i], currentID))
+	}
+
+	return outputs, nil
+}
+
+type RevokeSessionUseCase struct {
+	store  sessionsrepo.SessionStore
+	logger providers.LoggerProvider
+}
+
+func NewRevokeSessionUseCase(store sessionsrepo.SessionStore, logger providers.LoggerProvider) *RevokeSessionUseCase {
+	return &RevokeSessionUseCase{store: store, logger: logger}
+}
+
+func (uc *RevokeSessionUseCase) Execute(ctx context.Context, userID uint, sessionID string) error {
+	ctx, span := sessionTracer.Start(ctx, "RevokeSessionUseCase.Execute")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("user.id", int(userID)))
+
+	log := observability.LoggerWithTrace(ctx, uc.logger).With("usecase", "RevokeSession", "userId", userID)
+
+	session, err := uc.store.Get(ctx, sessionID)
+	if err == nil && session.UserID != userID {
+		err = exceptions.NewNotFoundException("Session not found", nil)
+	}
+	if err != nil {
+		log.Warn("session not found", "error", err.Error())
+		observability.RecordError(span, err)
+		return err
+	}
+
+	if err := uc.store.Revoke(ctx, sessionID, time.Now().UTC().Truncate(time.Microsecond)); err != nil {
+		log.Warn("failed to revoke session", "error", err.Error())
+		observability.RecordError(span, err)
+		return err
+	}
+
+	log.Info("session revoked")
+	return nil
+}
+
+type RevokeOtherSessionsUseCase struct {
+	store  sessionsrepo.SessionStore
+	logger providers.LoggerProvider
+}
+
+func NewRevokeOtherSessionsUseCase(store sessionsrepo.SessionStore, logger providers.LoggerProvider) *RevokeOtherSessionsUseCase {
+	return &RevokeOtherSessionsUseCase{store: store, logger: logger}
+}
+
+func (uc *RevokeOtherSessionsUseCase) Execute(ctx context.Context, userID uint, currentID string) (int64, error) {
+	ctx, span := sessionTracer.Start(ctx, "RevokeOtherSessionsUseCase.Execute")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("user.id", int(userID)))
+
+	log := observability.LoggerWithTrace(ctx, uc.logger).With("usecase", "RevokeOtherSessions", "userId", userID)
+
+	revoked, err := uc.store.RevokeByUser(ctx, userID, currentID, time.Now().UTC().Truncate(time.Microsecond))
+	if err != nil {
+		log.Error("failed to revoke sessions", "error", err.Error())
+		observability.RecordError(span, err)
+		return 0, err
+	}
+
+	span.SetAttributes(attribute.Int64("sessions.revoked", revoked))
+	log.Info("other sessions revoked", "count", revoked)
+	return revoked, nil
+}
+
+type CleanupExpiredSessionsUseCase struct {
+	store  sessionsrepo.SessionStore
+	logger providers.LoggerProvider
+}
+
+func NewCleanupExpiredSessionsUseCase(store sessionsrepo.SessionStore, logger providers.LoggerProvider) *CleanupExpiredSessionsUseCase {
+	return &CleanupExpiredSessionsUseCase{store: store, logger: logger}
+}
+
+func (uc *CleanupExpiredSessionsUseCase) Execute(ctx context.Context) (int64, error) {
+	ctx, span := sessionTracer.Start(tenancy.WithSystemScope(ctx), "CleanupExpiredSessionsUseCase.Execute")
+	defer span.End()
+
+	log := observability.LoggerWithTrace(ctx, uc.logger).With("usecase", "CleanupExpiredSessions")
+
+	deleted, err := uc.store.DeleteExpired(ctx, time.Now().UTC())
+	if err != nil {
+		log.Error("failed to delete expired sessions", "error", err.Error())
+		observability.RecordError(span, err)
+		return 0, err
+	}
+
+	span.SetAttributes(attribute.Int64("sessions.deleted", deleted))
+	if deleted > 0 {
+		log.Info("expired sessions deleted", "count", deleted)
+	}
+	return deleted, nil
+}
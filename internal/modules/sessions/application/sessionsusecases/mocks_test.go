@@ -0,0 +1,14 @@
+package sessionsusecases_test
+
+import (
+	"golang_boilerplate_module/internal/shared/domain/providers"
+)
+
+type mockLogger struct{}
+
+func (l *mockLogger) Info(msg string, fields ...any)            {}
+func (l *mockLogger) Warn(msg string, fields ...any)            {}
+func (l *mockLogger) Error(msg string, fields ...any)           {}
+func (l *mockLogger) Debug(msg string, fields ...any)           {}
+func (l *mockLogger) Sync() error                               { return nil }
+func (l *mockLogger) With(args ...any) providers.LoggerProvider { return l }
@@ -0,0 +1,72 @@
+package sessionsusecases
+
+import (
+	"context"
+	"time"
+
+	"golang_boilerplate_module/internal/modules/sessions/sessionsdomain/sessionsrepo"
+	"golang_boilerplate_module/internal/shared/domain/privacy"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+	"golang_boilerplate_module/internal/shared/infra/observability"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type SessionPersonalDataProvider struct {
+	store  sessionsrepo.SessionStore
+	logger providers.LoggerProvider
+}
+
+func NewSessionPersonalDataProvider(store sessionsrepo.SessionStore, logger providers.LoggerProvider) *SessionPersonalDataProvider {
+	return &SessionPersonalDataProvider{store: store, logger: logger}
+}
+
+func (p *SessionPersonalDataProvider) Module() string {
+	return "sessions"
+}
+
+func (p *SessionPersonalDataProvider) ExportPersonalData(ctx context.Context, userID uint) (any, error) {
+	ctx, span := sessionTracer.Start(ctx, "SessionPersonalDataProvider.ExportPersonalData")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("user.id", int(userID)))
+
+	log := observability.LoggerWithTrace(ctx, p.logger).With("provider", "SessionPersonalData", "userId", userID)
+
+	sessions, err := p.store.ListActiveByUser(ctx, userID, time.Now().UTC())
+	if err != nil {
+		log.Warn("failed to load sessions for export", "error", err.Error())
+		observability.RecordError(span, err)
+		return nil, err
+	}
+
+	outputs := make([]SessionOutput, 0, len(sessions))
+	for i := range sessions {
+		outputs = append(outputs, toSessionOutput(&sessions[i], ""))
+	}
+
+	return outputs, nil
+}
+
+func (p *SessionPersonalDataProvider) ErasePersonalData(ctx context.Context, userID uint) (privacy.ErasureResult, error) {
+	ctx, span := sessionTracer.Start(ctx, "SessionPersonalDataProvider.ErasePersonalData")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("user.id", int(userID)))
+
+	log := observability.LoggerWithTrace(ctx, p.logger).With("provider", "SessionPersonalData", "userId", userID)
+
+	deleted, err := p.store.DeleteByUser(ctx, userID)
+	if err != nil {
+		log.Error("failed to delete sessions", "error", err.Error())
+		observability.RecordError(span, err)
+		return privacy.ErasureResult{}, err
+	}
+
+	log.Info("sessions deleted", "count", deleted)
+
+	return privacy.ErasureResult{
+		Strategy:        privacy.ErasureStrategyDelete,
+		RecordsAffected: deleted,
+	}, nil
+}
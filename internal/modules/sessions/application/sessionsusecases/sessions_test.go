@@ -0,0 +1,211 @@
+package sessionsusecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"golang_boilerplate_module/internal/config"
+	"golang_boilerplate_module/internal/modules/sessions/application/sessionsusecases"
+	"golang_boilerplate_module/internal/modules/sessions/infra/sessionspersistence"
+	"golang_boilerplate_module/internal/modules/sessions/sessionsdomain/sessionsrepo"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/domain/tenancy"
+)
+
+func sessionsConfig() *config.Config {
+	return &config.Config{Sessions: config.SessionsConfig{
+		IdleTimeout:     30 * time.Minute,
+		AbsoluteTimeout: 2 * time.Hour,
+	}}
+}
+
+func tenantCtx(tenantID string) context.Context {
+	return tenancy.WithTenantID(context.Background(), tenantID)
+}
+
+func openSession(t *testing.T, store sessionsrepo.SessionStore, ctx context.Context, userID uint) sessionsusecases.CreatedSession {
+	t.Helper()
+
+	uc := sessionsusecases.NewCreateSessionUseCase(store, sessionsConfig(), &mockLogger{})
+	created, err := uc.Execute(ctx, sessionsusecases.CreateSessionInput{UserID: userID, UserAgent: "test"})
+	if err != nil {
+		t.Fatalf("create session: %v", err)
+	}
+	return created
+}
+
+func expectCode(t *testing.T, err error, code exceptions.ExceptionCode) {
+	t.Helper()
+	var domainErr *exceptions.DomainError
+	if !errors.As(err, &domainErr) {
+		t.Fatalf("expected DomainError, got %T (%v)", err, err)
+	}
+	if domainErr.Code != code {
+		t.Fatalf("expected code=%s, got %s", code, domainErr.Code)
+	}
+}
+
+func TestCreateSessionUseCase_StoresOnlyTokenHash(t *testing.T) {
+	store := sessionspersistence.NewMemorySessionStore()
+	ctx := tenantCtx("default")
+
+	created := openSession(t, store, ctx, 1)
+
+	if created.Token == "" || created.CSRFToken == "" {
+		t.Fatalf("expected session and csrf tokens")
+	}
+	if created.Session.ID == created.Token {
+		t.Fatalf("expected the session id to be a hash of the token")
+	}
+	if got := created.Session.ExpiresAt.Sub(created.Session.CreatedAt); got != 30*time.Minute {
+		t.Fatalf("expected idle expiry of 30m, got %s", got)
+	}
+}
+
+func TestAuthenticateSessionUseCase_Success(t *testing.T) {
+	store := sessionspersistence.NewMemorySessionStore()
+	ctx := tenantCtx("default")
+	created := openSession(t, store, ctx, 7)
+
+	uc := sessionsusecases.NewAuthenticateSessionUseCase(store, sessionsConfig(), &mockLogger{})
+	session, err := uc.AuthenticateSession(ctx, created.Token)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if session.UserID != 7 || session.CSRFToken != created.CSRFToken {
+		t.Fatalf("unexpected session %+v", session)
+	}
+	if session.Refreshed {
+		t.Fatalf("expected no refresh for a freshly created session")
+	}
+}
+
+func TestAuthenticateSessionUseCase_UnknownToken(t *testing.T) {
+	uc := sessionsusecases.NewAuthenticateSessionUseCase(sessionspersistence.NewMemorySessionStore(), sessionsConfig(), &mockLogger{})
+
+	_, err := uc.AuthenticateSession(tenantCtx("default"), "bogus")
+	expectCode(t, err, exceptions.CodeUnauthorized)
+}
+
+func TestAuthenticateSessionUseCase_OtherTenant(t *testing.T) {
+	store := sessionspersistence.NewMemorySessionStore()
+	created := openSession(t, store, tenantCtx("acme"), 7)
+
+	uc := sessionsusecases.NewAuthenticateSessionUseCase(store, sessionsConfig(), &mockLogger{})
+	_, err := uc.AuthenticateSession(tenantCtx("globex"), created.Token)
+	expectCode(t, err, exceptions.CodeUnauthorized)
+}
+
+func TestAuthenticateSessionUseCase_ResolvesTheTenant(t *testing.T) {
+	store := sessionspersistence.NewMemorySessionStore()
+	created := openSession(t, store, tenantCtx("acme"), 7)
+
+	uc := sessionsusecases.NewAuthenticateSessionUseCase(store, sessionsConfig(), &mockLogger{})
+	session, err := uc.AuthenticateSession(context.Background(), created.Token)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if session.TenantID != "acme" {
+		t.Fatalf("expected tenant acme from the session, got %q", session.TenantID)
+	}
+}
+
+func TestAuthenticateSessionUseCase_IdleExpired(t *testing.T) {
+	store := sessionspersistence.NewMemorySessionStore()
+	ctx := tenantCtx("default")
+	created := openSession(t, store, ctx, 7)
+
+	past := time.Now().UTC().Add(-time.Hour)
+	_ = store.Touch(ctx, created.Session.ID, past, past.Add(30*time.Minute))
+
+	uc := sessionsusecases.NewAuthenticateSessionUseCase(store, sessionsConfig(), &mockLogger{})
+	_, err := uc.AuthenticateSession(ctx, created.Token)
+	expectCode(t, err, exceptions.CodeUnauthorized)
+}
+
+func TestAuthenticateSessionUseCase_RollingExpiryCappedByAbsoluteTimeout(t *testing.T) {
+	store := sessionspersistence.NewMemorySessionStore()
+	ctx := tenantCtx("default")
+	created := openSession(t, store, ctx, 7)
+
+	lastSeen := time.Now().UTC().Add(-10 * time.Minute)
+	_ = store.Touch(ctx, created.Session.ID, lastSeen, lastSeen.Add(30*time.Minute))
+
+	uc := sessionsusecases.NewAuthenticateSessionUseCase(store, sessionsConfig(), &mockLogger{})
+	session, err := uc.AuthenticateSession(ctx, created.Token)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !session.Refreshed {
+		t.Fatalf("expected the session expiry to roll forward")
+	}
+	if remaining := time.Until(session.ExpiresAt); remaining < 29*time.Minute {
+		t.Fatalf("expected expiry ~30m from now, got %s", remaining)
+	}
+
+	absolute := created.Session.CreatedAt.Add(2 * time.Hour)
+	if session.ExpiresAt.After(absolute) {
+		t.Fatalf("expected expiry capped at absolute timeout %s, got %s", absolute, session.ExpiresAt)
+	}
+}
+
+func TestRevokeOtherSessionsUseCase_KeepsCurrent(t *testing.T) {
+	store := sessionspersistence.NewMemorySessionStore()
+	ctx := tenantCtx("default")
+	current := openSession(t, store, ctx, 7)
+	openSession(t, store, ctx, 7)
+	openSession(t, store, ctx, 7)
+	other := openSession(t, store, ctx, 8)
+
+	uc := sessionsusecases.NewRevokeOtherSessionsUseCase(store, &mockLogger{})
+	revoked, err := uc.Execute(ctx, 7, current.Session.ID)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if revoked != 2 {
+		t.Fatalf("expected 2 revoked sessions, got %d", revoked)
+	}
+
+	list := sessionsusecases.NewListSessionsUseCase(store, &mockLogger{})
+	sessions, _ := list.Execute(ctx, 7, current.Session.ID)
+	if len(sessions) != 1 || !sessions[0].Current {
+		t.Fatalf("expected only the current session to remain, got %+v", sessions)
+	}
+
+	auth := sessionsusecases.NewAuthenticateSessionUseCase(store, sessionsConfig(), &mockLogger{})
+	if _, err := auth.AuthenticateSession(ctx, other.Token); err != nil {
+		t.Fatalf("expected other user's session untouched, got %v", err)
+	}
+}
+
+func TestRevokeSessionUseCase_CannotRevokeAnotherUsersSession(t *testing.T) {
+	store := sessionspersistence.NewMemorySessionStore()
+	ctx := tenantCtx("default")
+	other := openSession(t, store, ctx, 8)
+
+	uc := sessionsusecases.NewRevokeSessionUseCase(store, &mockLogger{})
+	err := uc.Execute(ctx, 7, other.Session.ID)
+	expectCode(t, err, exceptions.CodeNotFound)
+}
+
+func TestCleanupExpiredSessionsUseCase_RemovesRevokedAndExpired(t *testing.T) {
+	store := sessionspersistence.NewMemorySessionStore()
+	ctx := tenantCtx("default")
+	active := openSession(t, store, ctx, 7)
+	revoked := openSession(t, store, ctx, 7)
+	_ = store.Revoke(ctx, revoked.Session.ID, time.Now().UTC())
+
+	uc := sessionsusecases.NewCleanupExpiredSessionsUseCase(store, &mockLogger{})
+	deleted, err := uc.Execute(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if deleted != 1 {
+		t.Fatalf("expected 1 deleted session, got %d", deleted)
+	}
+	if _, err := store.Get(ctx, active.Session.ID); err != nil {
+		t.Fatalf("expected active session to remain, got %v", err)
+	}
+}
@@ -0,0 +1,130 @@
+package sessionshttp
+
+import (
+	"golang_boilerplate_module/internal/config"
+	"golang_boilerplate_module/internal/modules/sessions/application/sessionsusecases"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+	"golang_boilerplate_module/internal/shared/infra/http/middleware"
+	"golang_boilerplate_module/internal/shared/infra/observability"
+
+	"github.com/gofiber/fiber/v2"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+var tracer = otel.Tracer("sessions.http")
+
+type RevokeOtherSessionsResponse struct {
+	Revoked int64 `json:"revoked"`
+}
+
+type SessionController struct {
+	listSessions        *sessionsusecases.ListSessionsUseCase
+	revokeSession       *sessionsusecases.RevokeSessionUseCase
+	revokeOtherSessions *sessionsusecases.RevokeOtherSessionsUseCase
+	cfg                 *config.Config
+	logger              providers.LoggerProvider
+}
+
+func NewSessionController(
+	listSessions *sessionsusecases.ListSessionsUseCase,
+	revokeSession *sessionsusecases.RevokeSessionUseCase,
+	revokeOtherSessions *sessionsusecases.RevokeOtherSessionsUseCase,
+	cfg *config.Config,
+	logger providers.LoggerProvider,
+) *SessionController {
+	return &SessionController{
+		listSessions:        listSessions,
+		revokeSession:       revokeSession,
+		revokeOtherSessions: revokeOtherSessions,
+		cfg:                 cfg,
+		logger:              logger,
+	}
+}
+
+func (ctrl *SessionController) ListSessions(c *fiber.Ctx) error {
+	ctx, span := tracer.Start(c.UserContext(), "SessionController.ListSessions")
+	defer span.End()
+
+	userID, err := middleware.RequireCurrentUserID(c)
+	if err != nil {
+		observability.RecordError(span, err)
+		return err
+	}
+
+	current, _ := middleware.CurrentSession(c)
+	outputs, err := ctrl.listSessions.Execute(ctx, userID, current.ID)
+	if err != nil {
+		observability.RecordError(span, err)
+		return err
+	}
+
+	return c.JSON(outputs)
+}
+
+func (ctrl *SessionController) Logout(c *fiber.Ctx) error {
+	ctx, span := tracer.Start(c.UserContext(), "SessionController.Logout")
+	defer span.End()
+
+	log := middleware.LoggerFromLocals(c, ctrl.logger).With("handler", "SessionController.Logout")
+
+	current, ok := middleware.CurrentSession(c)
+	if !ok {
+		err := exceptions.NewUnauthorizedException("No active session", nil)
+		log.Warn("logout without session cookie")
+		observability.RecordError(span, err)
+		return err
+	}
+
+	if err := ctrl.revokeSession.Execute(ctx, current.UserID, current.ID); err != nil {
+		observability.RecordError(span, err)
+		return err
+	}
+
+	middleware.ClearSessionCookies(c, ctrl.cfg)
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func (ctrl *SessionController) RevokeSession(c *fiber.Ctx) error {
+	ctx, span := tracer.Start(c.UserContext(), "SessionController.RevokeSession")
+	defer span.End()
+
+	userID, err := middleware.RequireCurrentUserID(c)
+	if err != nil {
+		observability.RecordError(span, err)
+		return err
+	}
+
+	sessionID := c.Params("id")
+	if err := ctrl.revokeSession.Execute(ctx, userID, sessionID); err != nil {
+		observability.RecordError(span, err)
+		return err
+	}
+
+	if current, ok := middleware.CurrentSession(c); ok && current.ID == sessionID {
+		middleware.ClearSessionCookies(c, ctrl.cfg)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func (ctrl *SessionController) RevokeOtherSessions(c *fiber.Ctx) error {
+	ctx, span := tracer.Start(c.UserContext(), "SessionController.RevokeOtherSessions")
+	defer span.End()
+
+	userID, err := middleware.RequireCurrentUserID(c)
+	if err != nil {
+		observability.RecordError(span, err)
+		return err
+	}
+
+	current, _ := middleware.CurrentSession(c)
+	revoked, err := ctrl.revokeOtherSessions.Execute(ctx, userID, current.ID)
+	if err != nil {
+		observability.RecordError(span, err)
+		return err
+	}
+
+	span.SetAttributes(attribute.Int64("sessions.revoked", revoked))
+	return c.JSON(RevokeOtherSessionsResponse{Revoked: revoked})
+}
@@ -0,0 +1,31 @@
+package sessionshttp
+
+import (
+	"golang_boilerplate_module/internal/modules/sessions/application/sessionsusecases"
+	"golang_boilerplate_module/internal/shared/infra/http/openapi"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func RegisterRoutes(router *openapi.Router, controller *SessionController) {
+	api := router.Version("v1").Group("/sessions", openapi.Tags("Sessions"), openapi.Authenticated())
+	api.Get("/", controller.ListSessions,
+		openapi.Summary("List the active sessions of the current user"),
+		openapi.Returns[[]sessionsusecases.SessionOutput](fiber.StatusOK),
+	)
+	api.Post("/revoke-others", controller.RevokeOtherSessions,
+		openapi.Summary("Revoke every session except the current one"),
+		openapi.Returns[RevokeOtherSessionsResponse](fiber.StatusOK),
+		openapi.Errors(fiber.StatusForbidden),
+	)
+	api.Delete("/current", controller.Logout,
+		openapi.Summary("Log out and clear the session cookies"),
+		openapi.NoContent(),
+		openapi.Errors(fiber.StatusForbidden),
+	)
+	api.Delete("/:id", controller.RevokeSession,
+		openapi.Summary("Revoke a session of the current user"),
+		openapi.NoContent(),
+		openapi.Errors(fiber.StatusForbidden, fiber.StatusNotFound),
+	)
+}
@@ -0,0 +1,20 @@
+package sessionsjobs
+
+import (
+	"context"
+
+	"golang_boilerplate_module/internal/config"
+	"golang_boilerplate_module/internal/modules/sessions/application/sessionsusecases"
+	"golang_boilerplate_module/internal/shared/infra/platform"
+)
+
+func NewSessionCleanupJob(cfg *config.Config, cleanupExpiredSessions *sessionsusecases.CleanupExpiredSessionsUseCase) platform.Job {
+	return platform.Job{
+		Name:     "session_cleanup",
+		Interval: cfg.Sessions.CleanupInterval,
+		Run: func(ctx context.Context) error {
+			_, err := cleanupExpiredSessions.Execute(ctx)
+			return err
+		},
+	}
+}
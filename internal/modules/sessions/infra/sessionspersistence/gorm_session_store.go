@@ -0,0 +1,207 @@
+package sessionspersistence
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"golang_boilerplate_module/internal/modules/sessions/sessionsdomain"
+	"golang_boilerplate_module/internal/modules/sessions/sessionsdomain/sessionsrepo"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	sharedrepo "golang_boilerplate_module/internal/shared/infra/persistence/repositories"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	oteltrace "go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+var dbTracer = otel.Tracer("sessions.persistence")
+
+type GORMSessionStore struct {
+	db *gorm.DB
+}
+
+func NewGORMSessionStore(db *gorm.DB) sessionsrepo.SessionStore {
+	return &GORMSessionStore{db: db}
+}
+
+func (s *GORMSessionStore) Create(ctx context.Context, session *sessionsdomain.Session) error {
+	ctx, span := dbTracer.Start(ctx, "GORMSessionStore.Create")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.operation", "Create"),
+		attribute.Int("user.id", int(session.UserID)),
+	)
+
+	err := sharedrepo.WithTenantSession(ctx, s.db, func(tx *gorm.DB) error {
+		return tx.Create(session).Error
+	})
+	if err != nil {
+		return internalError(span, err)
+	}
+
+	return nil
+}
+
+func (s *GORMSessionStore) Get(ctx context.Context, id string) (*sessionsdomain.Session, error) {
+	ctx, span := dbTracer.Start(ctx, "GORMSessionStore.Get")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("db.operation", "Get"))
+
+	var session sessionsdomain.Session
+	err := sharedrepo.WithTenantSession(ctx, s.db, func(tx *gorm.DB) error {
+		return tx.Where("id = ?", id).First(&session).Error
+	})
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		span.SetStatus(codes.Error, "not found")
+		return nil, exceptions.NewNotFoundException("Session not found", nil)
+	}
+	if err != nil {
+		return nil, internalError(span, err)
+	}
+
+	return &session, nil
+}
+
+func (s *GORMSessionStore) Touch(ctx context.Context, id string, lastSeenAt, expiresAt time.Time) error {
+	ctx, span := dbTracer.Start(ctx, "GORMSessionStore.Touch")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("db.operation", "Touch"))
+
+	err := sharedrepo.WithTenantSession(ctx, s.db, func(tx *gorm.DB) error {
+		return tx.Model(&sessionsdomain.Session{}).
+			Where("id = ? AND revoked_at IS NULL", id).
+			Updates(map[string]any{"last_seen_at": lastSeenAt, "expires_at": expiresAt}).Error
+	})
+	if err != nil {
+		return internalError(span, err)
+	}
+
+	return nil
+}
+
+func (s *GORMSessionStore) ListActiveByUser(ctx context.Context, userID uint, now time.Time) ([]sessionsdomain.Session, error) {
+	ctx, span := dbTracer.Start(ctx, "GORMSessionStore.ListActiveByUser")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.operation", "ListActiveByUser"),
+		attribute.Int("user.id", int(userID)),
+	)
+
+	var sessions []sessionsdomain.Session
+	err := sharedrepo.WithTenantSession(ctx, s.db, func(tx *gorm.DB) error {
+		return tx.
+			Where("user_id = ? AND revoked_at IS NULL AND expires_at > ? AND absolute_expires_at > ?", userID, now, now).
+			Order("last_seen_at DESC").
+			Find(&sessions).Error
+	})
+	if err != nil {
+		return nil, internalError(span, err)
+	}
+
+	return sessions, nil
+}
+
+func (s *GORMSessionStore) Revoke(ctx context.Context, id string, revokedAt time.Time) error {
+	ctx, span := dbTracer.Start(ctx, "GORMSessionStore.Revoke")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("db.operation", "Revoke"))
+
+	var affected int64
+	err := sharedrepo.WithTenantSession(ctx, s.db, func(tx *gorm.DB) error {
+		result := tx.Model(&sessionsdomain.Session{}).
+			Where("id = ? AND revoked_at IS NULL", id).
+			Update("revoked_at", revokedAt)
+		affected = result.RowsAffected
+		return result.Error
+	})
+	if err != nil {
+		return internalError(span, err)
+	}
+	if affected == 0 {
+		span.SetStatus(codes.Error, "not found")
+		return exceptions.NewNotFoundException("Session not found", nil)
+	}
+
+	return nil
+}
+
+func (s *GORMSessionStore) RevokeByUser(ctx context.Context, userID uint, exceptID string, revokedAt time.Time) (int64, error) {
+	ctx, span := dbTracer.Start(ctx, "GORMSessionStore.RevokeByUser")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.operation", "RevokeByUser"),
+		attribute.Int("user.id", int(userID)),
+	)
+
+	var affected int64
+	err := sharedrepo.WithTenantSession(ctx, s.db, func(tx *gorm.DB) error {
+		result := tx.Model(&sessionsdomain.Session{}).
+			Where("user_id = ? AND id <> ? AND revoked_at IS NULL", userID, exceptID).
+			Update("revoked_at", revokedAt)
+		affected = result.RowsAffected
+		return result.Error
+	})
+	if err != nil {
+		return 0, internalError(span, err)
+	}
+
+	return affected, nil
+}
+
+func (s *GORMSessionStore) DeleteByUser(ctx context.Context, userID uint) (int64, error) {
+	ctx, span := dbTracer.Start(ctx, "GORMSessionStore.DeleteByUser")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.operation", "DeleteByUser"),
+		attribute.Int("user.id", int(userID)),
+	)
+
+	var affected int64
+	err := sharedrepo.WithTenantSession(ctx, s.db, func(tx *gorm.DB) error {
+		result := tx.Where("user_id = ?", userID).Delete(&sessionsdomain.Session{})
+		affected = result.RowsAffected
+		return result.Error
+	})
+	if err != nil {
+		return 0, internalError(span, err)
+	}
+
+	return affected, nil
+}
+
+func (s *GORMSessionStore) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	ctx, span := dbTracer.Start(ctx, "GORMSessionStore.DeleteExpired")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("db.operation", "DeleteExpired"))
+
+	var affected int64
+	err := sharedrepo.WithTenantSession(ctx, s.db, func(tx *gorm.DB) error {
+		result := tx.
+			Where("expires_at <= ? OR absolute_expires_at <= ? OR revoked_at IS NOT NULL", now, now).
+			Delete(&sessionsdomain.Session{})
+		affected = result.RowsAffected
+		return result.Error
+	})
+	if err != nil {
+		return 0, internalError(span, err)
+	}
+
+	return affected, nil
+}
+
+func internalError(span oteltrace.Span, err error) error {
+	span.SetStatus(codes.Error, err.Error())
+	span.RecordError(err)
+	return sharedrepo.DatabaseError(err)
+}
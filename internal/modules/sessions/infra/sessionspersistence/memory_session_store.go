@@ -0,0 +1,146 @@
+package sessionspersistence
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"golang_boilerplate_module/internal/modules/sessions/sessionsdomain"
+	"golang_boilerplate_module/internal/modules/sessions/sessionsdomain/sessionsrepo"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/domain/tenancy"
+	sharedrepo "golang_boilerplate_module/internal/shared/infra/persistence/repositories"
+)
+
+type MemorySessionStore struct {
+	mu       sync.RWMutex
+	sessions map[string]sessionsdomain.Session
+}
+
+func NewMemorySessionStore() sessionsrepo.SessionStore {
+	return &MemorySessionStore{sessions: make(map[string]sessionsdomain.Session)}
+}
+
+func (s *MemorySessionStore) Create(ctx context.Context, session *sessionsdomain.Session) error {
+	tenantID, ok := tenancy.TenantIDFromContext(ctx)
+	if !ok && !tenancy.IsSystemScope(ctx) {
+		return sharedrepo.DatabaseError(tenancy.ErrTenantRequired)
+	}
+	if ok {
+		session.TenantID = tenantID
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.sessions[session.ID]; exists {
+		return exceptions.NewInternalException(map[string]any{"error": "duplicate session id"})
+	}
+	s.sessions[session.ID] = *session
+	return nil
+}
+
+func (s *MemorySessionStore) Get(ctx context.Context, id string) (*sessionsdomain.Session, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	session, ok := s.sessions[id]
+	if !ok || !visible(ctx, &session) {
+		return nil, exceptions.NewNotFoundException("Session not found", nil)
+	}
+	return &session, nil
+}
+
+func (s *MemorySessionStore) Touch(ctx context.Context, id string, lastSeenAt, expiresAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, ok := s.sessions[id]
+	if ok && visible(ctx, &session) && session.RevokedAt == nil {
+		session.LastSeenAt = lastSeenAt
+		session.ExpiresAt = expiresAt
+		s.sessions[id] = session
+	}
+	return nil
+}
+
+func (s *MemorySessionStore) ListActiveByUser(ctx context.Context, userID uint, now time.Time) ([]sessionsdomain.Session, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var result []sessionsdomain.Session
+	for _, session := range s.sessions {
+		if session.UserID == userID && visible(ctx, &session) && session.IsActiveAt(now) {
+			result = append(result, session)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].LastSeenAt.After(result[j].LastSeenAt)
+	})
+	return result, nil
+}
+
+func (s *MemorySessionStore) Revoke(ctx context.Context, id string, revokedAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, ok := s.sessions[id]
+	if !ok || !visible(ctx, &session) || session.RevokedAt != nil {
+		return exceptions.NewNotFoundException("Session not found", nil)
+	}
+	session.RevokedAt = &revokedAt
+	s.sessions[id] = session
+	return nil
+}
+
+func (s *MemorySessionStore) RevokeByUser(ctx context.Context, userID uint, exceptID string, revokedAt time.Time) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var affected int64
+	for id, session := range s.sessions {
+		if session.UserID == userID && id != exceptID && session.RevokedAt == nil && visible(ctx, &session) {
+			session.RevokedAt = &revokedAt
+			s.sessions[id] = session
+			affected++
+		}
+	}
+	return affected, nil
+}
+
+func (s *MemorySessionStore) DeleteByUser(ctx context.Context, userID uint) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var affected int64
+	for id, session := range s.sessions {
+		if session.UserID == userID && visible(ctx, &session) {
+			delete(s.sessions, id)
+			affected++
+		}
+	}
+	return affected, nil
+}
+
+func (s *MemorySessionStore) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var affected int64
+	for id, session := range s.sessions {
+		if !session.IsActiveAt(now) && visible(ctx, &session) {
+			delete(s.sessions, id)
+			affected++
+		}
+	}
+	return affected, nil
+}
+
+func visible(ctx context.Context, session *sessionsdomain.Session) bool {
+	if tenancy.IsSystemScope(ctx) {
+		return true
+	}
+	tenantID, ok := tenancy.TenantIDFromContext(ctx)
+	return ok && tenantID == session.TenantID
+}
@@ -0,0 +1,15 @@
+package sessionspersistence
+
+import (
+	"golang_boilerplate_module/internal/config"
+	"golang_boilerplate_module/internal/modules/sessions/sessionsdomain/sessionsrepo"
+
+	"gorm.io/gorm"
+)
+
+func NewSessionStore(cfg *config.Config, db *gorm.DB) sessionsrepo.SessionStore {
+	if cfg.Sessions.Store == "memory" {
+		return NewMemorySessionStore()
+	}
+	return NewGORMSessionStore(db)
+}
@@ -0,0 +1,50 @@
+package sessions
+
+import (
+	"golang_boilerplate_module/internal/modules/sessions/application/sessionsusecases"
+	"golang_boilerplate_module/internal/modules/sessions/infra/sessionshttp"
+	"golang_boilerplate_module/internal/modules/sessions/infra/sessionsjobs"
+	"golang_boilerplate_module/internal/modules/sessions/infra/sessionspersistence"
+	"golang_boilerplate_module/internal/shared/domain/privacy"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+	"golang_boilerplate_module/internal/shared/infra/platform"
+
+	"go.uber.org/fx"
+)
+
+var Module = platform.Module{
+	Name:         "sessions",
+	Description:  "Cookie sessions, CSRF protection and session management",
+	Required:     true,
+	ConfigPrefix: "SESSION_",
+	Migrations:   []string{"V5__create_sessions.sql"},
+	Providers: []any{
+		sessionspersistence.NewSessionStore,
+		fx.Annotate(
+			sessionsusecases.NewCreateSessionUseCase,
+			fx.As(fx.Self()),
+			fx.As(new(providers.SessionIssuer)),
+		),
+		sessionsusecases.NewListSessionsUseCase,
+		sessionsusecases.NewRevokeSessionUseCase,
+		sessionsusecases.NewRevokeOtherSessionsUseCase,
+		sessionsusecases.NewCleanupExpiredSessionsUseCase,
+		sessionshttp.NewSessionController,
+		fx.Annotate(
+			sessionsusecases.NewAuthenticateSessionUseCase,
+			fx.As(new(providers.SessionAuthenticator)),
+		),
+		fx.Annotate(
+			sessionsusecases.NewSessionPersonalDataProvider,
+			fx.As(new(privacy.PersonalDataExporter)),
+			fx.ResultTags(privacy.ExportersGroup),
+		),
+		fx.Annotate(
+			sessionsusecases.NewSessionPersonalDataProvider,
+			fx.As(new(privacy.PersonalDataEraser)),
+			fx.ResultTags(privacy.ErasersGroup),
+		),
+	},
+	Routes: []any{sessionshttp.RegisterRoutes},
+	Jobs:   []any{sessionsjobs.NewSessionCleanupJob},
+}
@@ -0,0 +1,60 @@
+package sessionsdomain
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+)
+
+type Session struct {
+	ID                string     `json:"id" gorm:"primarykey"`
+	TenantID          string     `json:"tenant_id" gorm:"index"`
+	UserID            uint       `json:"user_id" gorm:"index"`
+	CSRFToken         string     `json:"-"`
+	UserAgent         string     `json:"user_agent"`
+	IPAddress         string     `json:"ip_address"`
+	CreatedAt         time.Time  `json:"created_at"`
+	LastSeenAt        time.Time  `json:"last_seen_at"`
+	ExpiresAt         time.Time  `json:"expires_at"`
+	AbsoluteExpiresAt time.Time  `json:"absolute_expires_at"`
+	RevokedAt         *time.Time `json:"revoked_at"`
+}
+
+func (s *Session) IsActiveAt(now time.Time) bool {
+	return s.RevokedAt == nil && now.Before(s.ExpiresAt) && now.Before(s.AbsoluteExpiresAt)
+}
+
+func (s *Session) RollingExpiry(now time.Time, idleTimeout time.Duration) time.Time {
+	expiresAt := now.Add(idleTimeout)
+	if expiresAt.After(s.AbsoluteExpiresAt) {
+		return s.AbsoluteExpiresAt
+	}
+	return expiresAt
+}
+
+func NewSessionToken() (token string, sessionID string, err error) {
+	token, err = randomToken()
+	if err != nil {
+		return "", "", err
+	}
+	return token, HashSessionToken(token), nil
+}
+
+func NewCSRFToken() (string, error) {
+	return randomToken()
+}
+
+func HashSessionToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func randomToken() (string, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
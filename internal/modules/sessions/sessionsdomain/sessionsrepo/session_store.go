@@ -0,0 +1,19 @@
+package sessionsrepo
+
+import (
+	"context"
+	"time"
+
+	"golang_boilerplate_module/internal/modules/sessions/sessionsdomain"
+)
+
+type SessionStore interface {
+	Create(ctx context.Context, session *sessionsdomain.Session) error
+	Get(ctx context.Context, id string) (*sessionsdomain.Session, error)
+	Touch(ctx context.Context, id string, lastSeenAt, expiresAt time.Time) error
+	ListActiveByUser(ctx context.Context, userID uint, now time.Time) ([]sessionsdomain.Session, error)
+	Revoke(ctx context.Context, id string, revokedAt time.Time) error
+	RevokeByUser(ctx context.Context, userID uint, exceptID string, revokedAt time.Time) (int64, error)
+	DeleteByUser(ctx context.Context, userID uint) (int64, error)
+	DeleteExpired(ctx context.Context, now time.Time) (int64, error)
+}
@@ -0,0 +1,101 @@
+package tenantsusecases
+
+import (
+	"context"
+	"strings"
+
+	"golang_boilerplate_module/internal/config"
+	"golang_boilerplate_module/internal/modules/tenants/tenantsdomain"
+	"golang_boilerplate_module/internal/modules/tenants/tenantsdomain/tenantsrepo"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+	"golang_boilerplate_module/internal/shared/infra/observability"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+var tenantTracer = otel.Tracer("tenants")
+
+type CreateTenantInput struct {
+	ID        string `json:"id" validate:"required,max=63" doc:"Lowercase slug identifying the tenant"`
+	Name      string `json:"name" validate:"required"`
+	Subdomain string `json:"subdomain" doc:"Subdomain resolved to this tenant"`
+	ActorID   uint   `json:"-"`
+}
+
+type TenantOutput struct {
+	ID        string  `json:"id"`
+	Name      string  `json:"name"`
+	Subdomain *string `json:"subdomain"`
+	Active    bool    `json:"active"`
+}
+
+func toTenantOutput(t *tenantsdomain.Tenant) TenantOutput {
+	return TenantOutput{ID: t.ID, Name: t.Name, Subdomain: t.Subdomain, Active: t.Active}
+}
+
+type CreateTenantUseCase struct {
+	tenantRepo tenantsrepo.TenantRepository
+	cfg        *config.Config
+	logger     providers.LoggerProvider
+}
+
+func NewCreateTenantUseCase(tenantRepo tenantsrepo.TenantRepository, cfg *config.Config, logger providers.LoggerProvider) *CreateTenantUseCase {
+	return &CreateTenantUseCase{tenantRepo: tenantRepo, cfg: cfg, logger: logger}
+}
+
+func (uc *CreateTenantUseCase) Execute(ctx context.Context, input CreateTenantInput) (TenantOutput, error) {
+	ctx, span := tenantTracer.Start(ctx, "CreateTenantUseCase.Execute")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("tenant.id", input.ID))
+
+	log := observability.LoggerWithTrace(ctx, uc.logger).With("usecase", "CreateTenant", "tenantId", input.ID, "actorId", input.ActorID)
+
+	if err := requireTenantAdmin(uc.cfg, input.ActorID); err != nil {
+		log.Warn("tenant creation denied")
+		observability.RecordError(span, err)
+		return TenantOutput{}, err
+	}
+
+	if input.Name == "" || !tenantsdomain.IsValidTenantID(input.ID) {
+		err := exceptions.NewBadRequestException("A valid tenant id (lowercase slug) and name are required", nil)
+		log.Warn("validation failed — invalid tenant id or empty name")
+		observability.RecordError(span, err)
+		return TenantOutput{}, err
+	}
+
+	existing, _ := uc.tenantRepo.GetByID(ctx, input.ID)
+	if existing != nil {
+		err := exceptions.NewUnprocessableException("Tenant already exists", map[string]any{"id": input.ID})
+		log.Warn("tenant already exists")
+		observability.RecordError(span, err)
+		return TenantOutput{}, err
+	}
+
+	tenant := &tenantsdomain.Tenant{
+		ID:     input.ID,
+		Name:   input.Name,
+		Active: true,
+	}
+	if subdomain := strings.ToLower(strings.TrimSpace(input.Subdomain)); subdomain != "" {
+		if taken, _ := uc.tenantRepo.GetBySubdomain(ctx, subdomain); taken != nil {
+			err := exceptions.NewUnprocessableException("Subdomain already in use", map[string]any{"subdomain": subdomain})
+			log.Warn("subdomain already in use", "subdomain", subdomain)
+			observability.RecordError(span, err)
+			return TenantOutput{}, err
+		}
+		tenant.Subdomain = &subdomain
+	}
+
+	created, err := uc.tenantRepo.Add(ctx, tenant)
+	if err != nil {
+		log.Error("failed to create tenant", "error", err.Error())
+		observability.RecordError(span, err)
+		return TenantOutput{}, err
+	}
+
+	log.Info("tenant created")
+	return toTenantOutput(created), nil
+}
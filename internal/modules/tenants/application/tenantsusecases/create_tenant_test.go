@@ -0,0 +1,100 @@
+package tenantsusecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"golang_boilerplate_module/internal/config"
+	"golang_boilerplate_module/internal/modules/tenants/application/tenantsusecases"
+	"golang_boilerplate_module/internal/modules/tenants/tenantsdomain"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+)
+
+const testTenantAdminID = 7
+
+func tenantsConfig() *config.Config {
+	return &config.Config{Tenancy: config.TenancyConfig{Admins: []string{"7"}}}
+}
+
+func TestCreateTenantUseCase_Success(t *testing.T) {
+	uc := tenantsusecases.NewCreateTenantUseCase(&mockTenantRepo{}, tenantsConfig(), &mockLogger{})
+
+	out, err := uc.Execute(context.Background(), tenantsusecases.CreateTenantInput{
+		ID:        "acme",
+		Name:      "Acme Corp",
+		Subdomain: "Acme",
+		ActorID:   testTenantAdminID,
+	})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !out.Active {
+		t.Fatal("expected new tenant to be active")
+	}
+	if out.Subdomain == nil || *out.Subdomain != "acme" {
+		t.Fatalf("expected normalized subdomain=acme, got %v", out.Subdomain)
+	}
+}
+
+func TestCreateTenantUseCase_InvalidID(t *testing.T) {
+	uc := tenantsusecases.NewCreateTenantUseCase(&mockTenantRepo{}, tenantsConfig(), &mockLogger{})
+
+	_, err := uc.Execute(context.Background(), tenantsusecases.CreateTenantInput{ID: "Not Valid!", Name: "x", ActorID: testTenantAdminID})
+
+	var domainErr *exceptions.DomainError
+	if !errors.As(err, &domainErr) || domainErr.Code != exceptions.CodeBadRequest {
+		t.Fatalf("expected BAD_REQUEST, got %v", err)
+	}
+}
+
+func TestCreateTenantUseCase_AlreadyExists(t *testing.T) {
+	repo := &mockTenantRepo{
+		getByIDFn: func(_ context.Context, id string) (*tenantsdomain.Tenant, error) {
+			return &tenantsdomain.Tenant{ID: id}, nil
+		},
+	}
+	uc := tenantsusecases.NewCreateTenantUseCase(repo, tenantsConfig(), &mockLogger{})
+
+	_, err := uc.Execute(context.Background(), tenantsusecases.CreateTenantInput{ID: "acme", Name: "Acme", ActorID: testTenantAdminID})
+
+	var domainErr *exceptions.DomainError
+	if !errors.As(err, &domainErr) || domainErr.Code != exceptions.CodeUnprocessable {
+		t.Fatalf("expected UNPROCESSABLE, got %v", err)
+	}
+}
+
+func TestCreateTenantUseCase_RequiresAdmin(t *testing.T) {
+	repo := &mockTenantRepo{
+		addFn: func(_ context.Context, _ *tenantsdomain.Tenant) (*tenantsdomain.Tenant, error) {
+			t.Fatal("a tenant must not be added without an administrator")
+			return nil, nil
+		},
+	}
+	uc := tenantsusecases.NewCreateTenantUseCase(repo, tenantsConfig(), &mockLogger{})
+
+	for _, actorID := range []uint{0, 8} {
+		_, err := uc.Execute(context.Background(), tenantsusecases.CreateTenantInput{ID: "acme", Name: "Acme", ActorID: actorID})
+
+		var domainErr *exceptions.DomainError
+		if !errors.As(err, &domainErr) || domainErr.Code != exceptions.CodeForbidden {
+			t.Fatalf("actor %d: expected FORBIDDEN, got %v", actorID, err)
+		}
+	}
+}
+
+func TestGetTenantUseCase_RequiresAdmin(t *testing.T) {
+	repo := &mockTenantRepo{}
+	uc := tenantsusecases.NewGetTenantUseCase(repo, tenantsConfig(), &mockLogger{})
+
+	_, err := uc.Execute(context.Background(), 8, "acme")
+
+	var domainErr *exceptions.DomainError
+	if !errors.As(err, &domainErr) || domainErr.Code != exceptions.CodeForbidden {
+		t.Fatalf("expected FORBIDDEN, got %v", err)
+	}
+	if repo.getByIDCalls != 0 {
+		t.Fatal("expected the tenant not to be looked up")
+	}
+}
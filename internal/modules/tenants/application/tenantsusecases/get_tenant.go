@@ -0,0 +1,46 @@
+package tenantsusecases
+
+import (
+	"context"
+
+	"golang_boilerplate_module/internal/config"
+	"golang_boilerplate_module/internal/modules/tenants/tenantsdomain/tenantsrepo"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+	"golang_boilerplate_module/internal/shared/infra/observability"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type GetTenantUseCase struct {
+	tenantRepo tenantsrepo.TenantRepository
+	cfg        *config.Config
+	logger     providers.LoggerProvider
+}
+
+func NewGetTenantUseCase(tenantRepo tenantsrepo.TenantRepository, cfg *config.Config, logger providers.LoggerProvider) *GetTenantUseCase {
+	return &GetTenantUseCase{tenantRepo: tenantRepo, cfg: cfg, logger: logger}
+}
+
+func (uc *GetTenantUseCase) Execute(ctx context.Context, actorID uint, id string) (TenantOutput, error) {
+	ctx, span := tenantTracer.Start(ctx, "GetTenantUseCase.Execute")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("tenant.id", id))
+
+	log := observability.LoggerWithTrace(ctx, uc.logger).With("usecase", "GetTenant", "tenantId", id, "actorId", actorID)
+
+	if err := requireTenantAdmin(uc.cfg, actorID); err != nil {
+		log.Warn("tenant lookup denied")
+		observability.RecordError(span, err)
+		return TenantOutput{}, err
+	}
+
+	tenant, err := uc.tenantRepo.GetByID(ctx, id)
+	if err != nil {
+		log.Warn("tenant not found")
+		observability.RecordError(span, err)
+		return TenantOutput{}, err
+	}
+
+	return toTenantOutput(tenant), nil
+}
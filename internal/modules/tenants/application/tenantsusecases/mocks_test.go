@@ -0,0 +1,58 @@
+package tenantsusecases_test
+
+import (
+	"context"
+
+	"golang_boilerplate_module/internal/modules/tenants/tenantsdomain"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+)
+
+type mockTenantRepo struct {
+	addFn            func(ctx context.Context, t *tenantsdomain.Tenant) (*tenantsdomain.Tenant, error)
+	getByIDFn        func(ctx context.Context, id string) (*tenantsdomain.Tenant, error)
+	getBySubdomainFn func(ctx context.Context, subdomain string) (*tenantsdomain.Tenant, error)
+	getByIDCalls     int
+}
+
+func (m *mockTenantRepo) Add(ctx context.Context, t *tenantsdomain.Tenant) (*tenantsdomain.Tenant, error) {
+	if m.addFn != nil {
+		return m.addFn(ctx, t)
+	}
+	return t, nil
+}
+
+func (m *mockTenantRepo) GetByID(ctx context.Context, id string) (*tenantsdomain.Tenant, error) {
+	m.getByIDCalls++
+	if m.getByIDFn != nil {
+		return m.getByIDFn(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *mockTenantRepo) UpdateByID(ctx context.Context, id string, updates map[string]any) (*tenantsdomain.Tenant, error) {
+	return nil, nil
+}
+
+func (m *mockTenantRepo) DeleteByID(ctx context.Context, id string) error {
+	return nil
+}
+
+func (m *mockTenantRepo) DeleteAll(ctx context.Context) error {
+	return nil
+}
+
+func (m *mockTenantRepo) GetBySubdomain(ctx context.Context, subdomain string) (*tenantsdomain.Tenant, error) {
+	if m.getBySubdomainFn != nil {
+		return m.getBySubdomainFn(ctx, subdomain)
+	}
+	return nil, nil
+}
+
+type mockLogger struct{}
+
+func (l *mockLogger) Info(msg string, fields ...any)            {}
+func (l *mockLogger) Warn(msg string, fields ...any)            {}
+func (l *mockLogger) Error(msg string, fields ...any)           {}
+func (l *mockLogger) Debug(msg string, fields ...any)           {}
+func (l *mockLogger) Sync() error                               { return nil }
+func (l *mockLogger) With(args ...any) providers.LoggerProvider { return l }
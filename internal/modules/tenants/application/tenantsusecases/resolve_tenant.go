@@ -0,0 +1,91 @@
+package tenantsusecases
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"golang_boilerplate_module/internal/modules/tenants/tenantsdomain"
+	"golang_boilerplate_module/internal/modules/tenants/tenantsdomain/tenantsrepo"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+	"golang_boilerplate_module/internal/shared/domain/tenancy"
+	"golang_boilerplate_module/internal/shared/infra/observability"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const resolvedTenantTTL = 30 * time.Second
+
+type resolvedTenant struct {
+	tenantID  string
+	expiresAt time.Time
+}
+
+type ResolveTenantUseCase struct {
+	tenantRepo tenantsrepo.TenantRepository
+	logger     providers.LoggerProvider
+
+	mu    sync.RWMutex
+	cache map[tenancy.TenantRef]resolvedTenant
+}
+
+func NewResolveTenantUseCase(tenantRepo tenantsrepo.TenantRepository, logger providers.LoggerProvider) *ResolveTenantUseCase {
+	return &ResolveTenantUseCase{
+		tenantRepo: tenantRepo,
+		logger:     logger,
+		cache:      make(map[tenancy.TenantRef]resolvedTenant),
+	}
+}
+
+func (uc *ResolveTenantUseCase) FindActiveTenantID(ctx context.Context, ref tenancy.TenantRef) (string, error) {
+	if tenantID, ok := uc.cached(ref); ok {
+		return tenantID, nil
+	}
+
+	ctx, span := tenantTracer.Start(ctx, "ResolveTenantUseCase.FindActiveTenantID")
+	defer span.End()
+
+	log := observability.LoggerWithTrace(ctx, uc.logger).With("usecase", "ResolveTenant")
+
+	var (
+		tenant *tenantsdomain.Tenant
+		err    error
+	)
+	if ref.ID != "" {
+		tenant, err = uc.tenantRepo.GetByID(ctx, ref.ID)
+	} else {
+		tenant, err = uc.tenantRepo.GetBySubdomain(ctx, ref.Subdomain)
+	}
+	if err != nil {
+		log.Warn("tenant could not be resolved", "id", ref.ID, "subdomain", ref.Subdomain)
+		observability.RecordError(span, err)
+		return "", err
+	}
+
+	if !tenant.Active {
+		err := exceptions.NewForbiddenException("Tenant is not active", map[string]any{"tenantId": tenant.ID})
+		log.Warn("inactive tenant", "tenantId", tenant.ID)
+		observability.RecordError(span, err)
+		return "", err
+	}
+
+	span.SetAttributes(attribute.String("tenant.id", tenant.ID))
+
+	uc.mu.Lock()
+	uc.cache[ref] = resolvedTenant{tenantID: tenant.ID, expiresAt: time.Now().Add(resolvedTenantTTL)}
+	uc.mu.Unlock()
+
+	return tenant.ID, nil
+}
+
+func (uc *ResolveTenantUseCase) cached(ref tenancy.TenantRef) (string, bool) {
+	uc.mu.RLock()
+	defer uc.mu.RUnlock()
+
+	entry, ok := uc.cache[ref]
+	if !ok || time.Now().After(entry.expiresAt) {
+		return "", false
+	}
+	return entry.tenantID, true
+}
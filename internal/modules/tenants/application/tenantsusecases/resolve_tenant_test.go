@@ -0,0 +1,67 @@
+package tenantsusecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"golang_boilerplate_module/internal/modules/tenants/application/tenantsusecases"
+	"golang_boilerplate_module/internal/modules/tenants/tenantsdomain"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/domain/tenancy"
+)
+
+func TestResolveTenantUseCase_ByIDIsCached(t *testing.T) {
+	repo := &mockTenantRepo{
+		getByIDFn: func(_ context.Context, id string) (*tenantsdomain.Tenant, error) {
+			return &tenantsdomain.Tenant{ID: id, Active: true}, nil
+		},
+	}
+	uc := tenantsusecases.NewResolveTenantUseCase(repo, &mockLogger{})
+
+	for i := 0; i < 3; i++ {
+		id, err := uc.FindActiveTenantID(context.Background(), tenancy.TenantRef{ID: "acme"})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if id != "acme" {
+			t.Fatalf("expected tenant acme, got %q", id)
+		}
+	}
+	if repo.getByIDCalls != 1 {
+		t.Fatalf("expected 1 repository lookup, got %d", repo.getByIDCalls)
+	}
+}
+
+func TestResolveTenantUseCase_BySubdomain(t *testing.T) {
+	repo := &mockTenantRepo{
+		getBySubdomainFn: func(_ context.Context, subdomain string) (*tenantsdomain.Tenant, error) {
+			return &tenantsdomain.Tenant{ID: "acme", Subdomain: &subdomain, Active: true}, nil
+		},
+	}
+	uc := tenantsusecases.NewResolveTenantUseCase(repo, &mockLogger{})
+
+	id, err := uc.FindActiveTenantID(context.Background(), tenancy.TenantRef{Subdomain: "acme-co"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if id != "acme" {
+		t.Fatalf("expected tenant acme, got %q", id)
+	}
+}
+
+func TestResolveTenantUseCase_Inactive(t *testing.T) {
+	repo := &mockTenantRepo{
+		getByIDFn: func(_ context.Context, id string) (*tenantsdomain.Tenant, error) {
+			return &tenantsdomain.Tenant{ID: id, Active: false}, nil
+		},
+	}
+	uc := tenantsusecases.NewResolveTenantUseCase(repo, &mockLogger{})
+
+	_, err := uc.FindActiveTenantID(context.Background(), tenancy.TenantRef{ID: "acme"})
+
+	var domainErr *exceptions.DomainError
+	if !errors.As(err, &domainErr) || domainErr.Code != exceptions.CodeForbidden {
+		t.Fatalf("expected FORBIDDEN, got %v", err)
+	}
+}
@@ -0,0 +1,18 @@
+package tenantsusecases
+
+import (
+	"slices"
+	"strconv"
+
+	"golang_boilerplate_module/internal/config"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+)
+
+// requireTenantAdmin lets only the platform administrators manage the tenant
+// registry.
+func requireTenantAdmin(cfg *config.Config, actorID uint) error {
+	if actorID != 0 && slices.Contains(cfg.Tenancy.Admins, strconv.FormatUint(uint64(actorID), 10)) {
+		return nil
+	}
+	return exceptions.NewForbiddenException("Managing tenants requires a tenancy administrator", nil)
+}
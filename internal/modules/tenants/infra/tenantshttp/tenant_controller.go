@@ -0,0 +1,84 @@
+package tenantshttp
+
+import (
+	"golang_boilerplate_module/internal/modules/tenants/application/tenantsusecases"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+	"golang_boilerplate_module/internal/shared/infra/http/middleware"
+	"golang_boilerplate_module/internal/shared/infra/observability"
+
+	"github.com/gofiber/fiber/v2"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+var tracer = otel.Tracer("tenants.http")
+
+type TenantController struct {
+	createTenant *tenantsusecases.CreateTenantUseCase
+	getTenant    *tenantsusecases.GetTenantUseCase
+	logger       providers.LoggerProvider
+}
+
+func NewTenantController(
+	createTenant *tenantsusecases.CreateTenantUseCase,
+	getTenant *tenantsusecases.GetTenantUseCase,
+	logger providers.LoggerProvider,
+) *TenantController {
+	return &TenantController{
+		createTenant: createTenant,
+		getTenant:    getTenant,
+		logger:       logger,
+	}
+}
+
+func (ctrl *TenantController) Create(c *fiber.Ctx) error {
+	ctx, span := tracer.Start(c.UserContext(), "TenantController.Create")
+	defer span.End()
+
+	log := middleware.LoggerFromLocals(c, ctrl.logger).With("handler", "TenantController.Create")
+
+	actorID, err := middleware.RequireCurrentUserID(c)
+	if err != nil {
+		observability.RecordError(span, err)
+		return err
+	}
+
+	var input tenantsusecases.CreateTenantInput
+	if err := c.BodyParser(&input); err != nil {
+		domainErr := exceptions.NewBadRequestException("Invalid request body", nil)
+		log.Warn("failed to parse request body", "error", err.Error())
+		observability.RecordError(span, domainErr)
+		return domainErr
+	}
+
+	input.ActorID = actorID
+
+	output, err := ctrl.createTenant.Execute(ctx, input)
+	if err != nil {
+		observability.RecordError(span, err)
+		return err
+	}
+
+	span.SetAttributes(attribute.String("tenant.id", output.ID))
+	return c.Status(fiber.StatusCreated).JSON(output)
+}
+
+func (ctrl *TenantController) GetByID(c *fiber.Ctx) error {
+	ctx, span := tracer.Start(c.UserContext(), "TenantController.GetByID")
+	defer span.End()
+
+	actorID, err := middleware.RequireCurrentUserID(c)
+	if err != nil {
+		observability.RecordError(span, err)
+		return err
+	}
+
+	output, err := ctrl.getTenant.Execute(ctx, actorID, c.Params("id"))
+	if err != nil {
+		observability.RecordError(span, err)
+		return err
+	}
+
+	return c.JSON(output)
+}
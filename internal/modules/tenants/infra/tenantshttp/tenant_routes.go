@@ -0,0 +1,26 @@
+package tenantshttp
+
+import (
+	"golang_boilerplate_module/internal/modules/tenants/application/tenantsusecases"
+	"golang_boilerplate_module/internal/shared/infra/http/openapi"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func RegisterRoutes(router *openapi.Router, controller *TenantController) {
+	api := router.Version("v1", openapi.Tags("Tenants"), openapi.Authenticated())
+	api.Post("/tenants", controller.Create,
+		openapi.Summary("Create a tenant"),
+		openapi.Description("Requires a tenancy administrator (TENANCY_ADMINS)."),
+		openapi.Body[tenantsusecases.CreateTenantInput](),
+		openapi.Returns[tenantsusecases.TenantOutput](fiber.StatusCreated),
+		openapi.Errors(fiber.StatusBadRequest, fiber.StatusForbidden, fiber.StatusUnprocessableEntity),
+	)
+	api.Get("/tenants/:id", controller.GetByID,
+		openapi.Summary("Get a tenant by ID"),
+		openapi.Description("Requires a tenancy administrator (TENANCY_ADMINS)."),
+		openapi.PathParam("id", "string", "Tenant ID"),
+		openapi.Returns[tenantsusecases.TenantOutput](fiber.StatusOK),
+		openapi.Errors(fiber.StatusForbidden, fiber.StatusNotFound),
+	)
+}
@@ -0,0 +1,52 @@
+package tenantspersistence
+
+import (
+	"context"
+	"errors"
+
+	"golang_boilerplate_module/internal/modules/tenants/tenantsdomain"
+	"golang_boilerplate_module/internal/modules/tenants/tenantsdomain/tenantsrepo"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	sharedrepo "golang_boilerplate_module/internal/shared/infra/persistence/repositories"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"gorm.io/gorm"
+)
+
+var dbTracer = otel.Tracer("tenants.persistence")
+
+type GORMTenantRepository struct {
+	*sharedrepo.GORMGenericRepository[tenantsdomain.Tenant, string]
+	db *gorm.DB
+}
+
+func NewGORMTenantRepository(db *gorm.DB) tenantsrepo.TenantRepository {
+	return &GORMTenantRepository{
+		GORMGenericRepository: sharedrepo.NewGORMGenericRepository[tenantsdomain.Tenant, string](db),
+		db:                    db,
+	}
+}
+
+func (r *GORMTenantRepository) GetBySubdomain(ctx context.Context, subdomain string) (*tenantsdomain.Tenant, error) {
+	ctx, span := dbTracer.Start(ctx, "GORMTenantRepository.GetBySubdomain")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("db.operation", "GetBySubdomain"))
+
+	var tenant tenantsdomain.Tenant
+	err := r.db.WithContext(ctx).Where("subdomain = ?", subdomain).First(&tenant).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		span.SetStatus(codes.Error, "not found")
+		return nil, exceptions.NewNotFoundException("Tenant not found", nil)
+	}
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+		return nil, sharedrepo.DatabaseError(err)
+	}
+
+	span.SetAttributes(attribute.String("tenant.id", tenant.ID))
+	return &tenant, nil
+}
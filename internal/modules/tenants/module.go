@@ -0,0 +1,30 @@
+package tenants
+
+import (
+	"golang_boilerplate_module/internal/modules/tenants/application/tenantsusecases"
+	"golang_boilerplate_module/internal/modules/tenants/infra/tenantshttp"
+	"golang_boilerplate_module/internal/modules/tenants/infra/tenantspersistence"
+	"golang_boilerplate_module/internal/shared/domain/tenancy"
+	"golang_boilerplate_module/internal/shared/infra/platform"
+
+	"go.uber.org/fx"
+)
+
+var Module = platform.Module{
+	Name:         "tenants",
+	Description:  "Tenant registry and per-request tenant resolution",
+	Required:     true,
+	ConfigPrefix: "TENANCY_",
+	Migrations:   []string{"V3__create_tenants.sql"},
+	Providers: []any{
+		tenantspersistence.NewGORMTenantRepository,
+		tenantsusecases.NewCreateTenantUseCase,
+		tenantsusecases.NewGetTenantUseCase,
+		fx.Annotate(
+			tenantsusecases.NewResolveTenantUseCase,
+			fx.As(new(tenancy.TenantLookup)),
+		),
+		tenantshttp.NewTenantController,
+	},
+	Routes: []any{tenantshttp.RegisterRoutes},
+}
@@ -0,0 +1,21 @@
+package tenantsdomain
+
+import (
+	"regexp"
+	"time"
+)
+
+var tenantIDPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{1,62}$`)
+
+type Tenant struct {
+	ID        string    `json:"id" gorm:"primarykey"`
+	Name      string    `json:"name"`
+	Subdomain *string   `json:"subdomain" gorm:"uniqueIndex"`
+	Active    bool      `json:"active"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func IsValidTenantID(id string) bool {
+	return tenantIDPattern.MatchString(id)
+}
@@ -0,0 +1,13 @@
+package tenantsrepo
+
+import (
+	"context"
+
+	"golang_boilerplate_module/internal/modules/tenants/tenantsdomain"
+	sharedrepo "golang_boilerplate_module/internal/shared/domain/repositories"
+)
+
+type TenantRepository interface {
+	sharedrepo.GenericRepository[tenantsdomain.Tenant, string]
+	GetBySubdomain(ctx context.Context, subdomain string) (*tenantsdomain.Tenant, error)
+}
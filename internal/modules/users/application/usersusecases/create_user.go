@@ -2,22 +2,24 @@ package usersusecases
 
 import (
 	"context"
+	"time"
 
 	"golang_boilerplate_module/internal/modules/users/usersdomain"
 	"golang_boilerplate_module/internal/modules/users/usersdomain/usersrepo"
+	"golang_boilerplate_module/internal/shared/domain/bus"
 	"golang_boilerplate_module/internal/shared/domain/exceptions"
 	"golang_boilerplate_module/internal/shared/domain/providers"
-	"golang_boilerplate_module/internal/shared/infra/observability"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
+	oteltrace "go.opentelemetry.io/otel/trace"
 )
 
 var userTracer = otel.Tracer("users")
 
 type CreateUserInput struct {
-	Name  string `json:"name"`
-	Email string `json:"email"`
+	Name  string `json:"name" validate:"required,max=255"`
+	Email string `json:"email" validate:"required,email,max=255"`
 }
 
 type UserOutput struct {
@@ -28,37 +30,31 @@ type UserOutput struct {
 
 type CreateUserUseCase struct {
 	userRepo usersrepo.UserRepository
+	events   usersdomain.UserEventPublisher
 	logger   providers.LoggerProvider
 }
 
-func NewCreateUserUseCase(userRepo usersrepo.UserRepository, logger providers.LoggerProvider) *CreateUserUseCase {
-	return &CreateUserUseCase{userRepo: userRepo, logger: logger}
+func NewCreateUserUseCase(userRepo usersrepo.UserRepository, events usersdomain.UserEventPublisher, logger providers.LoggerProvider) *CreateUserUseCase {
+	return &CreateUserUseCase{userRepo: userRepo, events: events, logger: logger}
 }
 
-func (uc *CreateUserUseCase) Execute(ctx context.Context, input CreateUserInput) (UserOutput, error) {
-	ctx, span := userTracer.Start(ctx, "CreateUserUseCase.Execute")
-	defer span.End()
+func NewCreateUserHandler(b *bus.Bus, uc *CreateUserUseCase) bus.Handler[CreateUserInput, UserOutput] {
+	return bus.Command[CreateUserInput, UserOutput](b, "CreateUser", uc, bus.Transactional())
+}
 
+func (uc *CreateUserUseCase) Handle(ctx context.Context, input CreateUserInput) (UserOutput, error) {
+	span := oteltrace.SpanFromContext(ctx)
 	span.SetAttributes(attribute.String("user.email", input.Email))
 
-	log := observability.LoggerWithTrace(ctx, uc.logger).With("usecase", "CreateUser", "email", input.Email)
-
-	if input.Name == "" || input.Email == "" {
-		err := exceptions.NewBadRequestException("Name and email are required", nil)
-		log.Warn("validation failed — name or email is empty")
-		observability.RecordError(span, err)
-		return UserOutput{}, err
-	}
+	log := bus.Logger(ctx, uc.logger).With("email", input.Email)
 
 	existing, _ := uc.userRepo.GetByEmail(ctx, input.Email)
 	if existing != nil {
-		err := exceptions.NewUnprocessableException(
+		log.Warn("email already in use", "email", input.Email)
+		return UserOutput{}, exceptions.NewUnprocessableException(
 			"Email already in use",
 			map[string]any{"email": input.Email},
 		)
-		log.Warn("email already in use", "email", input.Email)
-		observability.RecordError(span, err)
-		return UserOutput{}, err
 	}
 
 	user := &usersdomain.User{
@@ -68,17 +64,21 @@ func (uc *CreateUserUseCase) Execute(ctx context.Context, input CreateUserInput)
 
 	created, err := uc.userRepo.Add(ctx, user)
 	if err != nil {
-		log.Error("failed to create user", "error", err.Error())
-		observability.RecordError(span, err)
 		return UserOutput{}, err
 	}
 
 	span.SetAttributes(attribute.Int("user.id", int(created.ID)))
 	log.Info("user created successfully", "userId", created.ID)
 
+	uc.events.Publish(ctx, usersdomain.UserEvent{Type: usersdomain.UserCreated, User: *created, OccurredAt: time.Now()})
+
+	return toUserOutput(created), nil
+}
+
+func toUserOutput(user *usersdomain.User) UserOutput {
 	return UserOutput{
-		ID:    created.ID,
-		Name:  created.Name,
-		Email: created.Email,
-	}, nil
+		ID:    user.ID,
+		Name:  user.Name,
+		Email: user.Email,
+	}
 }
@@ -3,13 +3,21 @@ package usersusecases_test
 import (
 	"context"
 	"errors"
+	"slices"
+	"strings"
 	"testing"
 
 	"golang_boilerplate_module/internal/modules/users/application/usersusecases"
 	"golang_boilerplate_module/internal/modules/users/usersdomain"
+	"golang_boilerplate_module/internal/shared/domain/bus"
 	"golang_boilerplate_module/internal/shared/domain/exceptions"
 )
 
+// newCreateUserHandler dispatches through a bus so the input validation runs.
+func newCreateUserHandler(repo *mockUserRepo, events *mockEventPublisher, logger *mockLogger) bus.Handler[usersusecases.CreateUserInput, usersusecases.UserOutput] {
+	return usersusecases.NewCreateUserHandler(bus.New(bus.Validation()), usersusecases.NewCreateUserUseCase(repo, events, logger))
+}
+
 func TestCreateUserUseCase_Success(t *testing.T) {
 	repo := &mockUserRepo{
 		getByEmailFn: func(_ context.Context, _ string) (*usersdomain.User, error) {
@@ -21,8 +29,9 @@ func TestCreateUserUseCase_Success(t *testing.T) {
 		},
 	}
 
-	uc := usersusecases.NewCreateUserUseCase(repo, &mockLogger{})
-	out, err := uc.Execute(context.Background(), usersusecases.CreateUserInput{
+	events := &mockEventPublisher{}
+	uc := newCreateUserHandler(repo, events, &mockLogger{})
+	out, err := uc.Handle(context.Background(), usersusecases.CreateUserInput{
 		Name:  "João Silva",
 		Email: "joao@example.com",
 	})
@@ -39,12 +48,15 @@ func TestCreateUserUseCase_Success(t *testing.T) {
 	if out.Email != "joao@example.com" {
 		t.Fatalf("expected email=joao@example.com, got %q", out.Email)
 	}
+	if len(events.events) != 1 || events.events[0].Type != usersdomain.UserCreated {
+		t.Fatalf("expected one UserCreated event, got %+v", events.events)
+	}
 }
 
 func TestCreateUserUseCase_MissingName(t *testing.T) {
-	uc := usersusecases.NewCreateUserUseCase(&mockUserRepo{}, &mockLogger{})
+	uc := newCreateUserHandler(&mockUserRepo{}, &mockEventPublisher{}, &mockLogger{})
 
-	_, err := uc.Execute(context.Background(), usersusecases.CreateUserInput{
+	_, err := uc.Handle(context.Background(), usersusecases.CreateUserInput{
 		Name:  "",
 		Email: "joao@example.com",
 	})
@@ -63,9 +75,9 @@ func TestCreateUserUseCase_MissingName(t *testing.T) {
 }
 
 func TestCreateUserUseCase_MissingEmail(t *testing.T) {
-	uc := usersusecases.NewCreateUserUseCase(&mockUserRepo{}, &mockLogger{})
+	uc := newCreateUserHandler(&mockUserRepo{}, &mockEventPublisher{}, &mockLogger{})
 
-	_, err := uc.Execute(context.Background(), usersusecases.CreateUserInput{
+	_, err := uc.Handle(context.Background(), usersusecases.CreateUserInput{
 		Name:  "João",
 		Email: "",
 	})
@@ -83,6 +95,55 @@ func TestCreateUserUseCase_MissingEmail(t *testing.T) {
 	}
 }
 
+func TestCreateUserUseCase_InvalidInput(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     usersusecases.CreateUserInput
+		violation string
+	}{
+		{"invalid email", usersusecases.CreateUserInput{Name: "João", Email: "not-an-email"}, "email: must be a valid email address"},
+		{"email with a display name", usersusecases.CreateUserInput{Name: "João", Email: "João <joao@example.com>"}, "email: must be a valid email address"},
+		{"name too long", usersusecases.CreateUserInput{Name: strings.Repeat("a", 256), Email: "joao@example.com"}, "name: must be at most 255 characters"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mockUserRepo{
+				addFn: func(_ context.Context, _ *usersdomain.User) (*usersdomain.User, error) {
+					t.Fatal("expected the handler not to run")
+					return nil, nil
+				},
+			}
+			uc := newCreateUserHandler(repo, &mockEventPublisher{}, &mockLogger{})
+
+			_, err := uc.Handle(context.Background(), tt.input)
+
+			var domainErr *exceptions.DomainError
+			if !errors.As(err, &domainErr) {
+				t.Fatalf("expected DomainError, got %v", err)
+			}
+			if domainErr.Code != exceptions.CodeBadRequest {
+				t.Fatalf("expected BAD_REQUEST, got %s", domainErr.Code)
+			}
+			violations, _ := domainErr.Metadata["violations"].([]string)
+			if !slices.Contains(violations, tt.violation) {
+				t.Fatalf("expected violation %q, got %v", tt.violation, violations)
+			}
+		})
+	}
+}
+
+func TestCreateUserUseCase_NameOfMultibyteCharactersAtTheLimit(t *testing.T) {
+	uc := newCreateUserHandler(&mockUserRepo{}, &mockEventPublisher{}, &mockLogger{})
+
+	if _, err := uc.Handle(context.Background(), usersusecases.CreateUserInput{
+		Name:  strings.Repeat("ã", 255),
+		Email: "joao@example.com",
+	}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
 func TestCreateUserUseCase_DuplicateEmail(t *testing.T) {
 	existing := &usersdomain.User{ID: 99, Name: "Outro", Email: "dup@example.com"}
 
@@ -92,8 +153,8 @@ func TestCreateUserUseCase_DuplicateEmail(t *testing.T) {
 		},
 	}
 
-	uc := usersusecases.NewCreateUserUseCase(repo, &mockLogger{})
-	_, err := uc.Execute(context.Background(), usersusecases.CreateUserInput{
+	uc := newCreateUserHandler(repo, &mockEventPublisher{}, &mockLogger{})
+	_, err := uc.Handle(context.Background(), usersusecases.CreateUserInput{
 		Name:  "Novo",
 		Email: "dup@example.com",
 	})
@@ -123,8 +184,8 @@ func TestCreateUserUseCase_RepositoryError(t *testing.T) {
 		},
 	}
 
-	uc := usersusecases.NewCreateUserUseCase(repo, &mockLogger{})
-	_, err := uc.Execute(context.Background(), usersusecases.CreateUserInput{
+	uc := newCreateUserHandler(repo, &mockEventPublisher{}, &mockLogger{})
+	_, err := uc.Handle(context.Background(), usersusecases.CreateUserInput{
 		Name:  "Teste",
 		Email: "teste@example.com",
 	})
@@ -136,3 +197,20 @@ func TestCreateUserUseCase_RepositoryError(t *testing.T) {
 		t.Fatalf("expected wrapped repoErr, got %v", err)
 	}
 }
+
+func TestCreateUserUseCase_DispatchedAsTransactionalCommand(t *testing.T) {
+	var got bus.Request
+	recorder := func(ctx context.Context, req bus.Request, next bus.Next) (any, error) {
+		got = req
+		return next(ctx)
+	}
+
+	uc := usersusecases.NewCreateUserHandler(bus.New(recorder), usersusecases.NewCreateUserUseCase(&mockUserRepo{}, &mockEventPublisher{}, &mockLogger{}))
+	if _, err := uc.Handle(context.Background(), usersusecases.CreateUserInput{Name: "Ana", Email: "ana@example.com"}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if got.Name != "CreateUser" || got.Kind != bus.KindCommand || !got.Transactional {
+		t.Fatalf("expected a transactional CreateUser command, got %+v", got)
+	}
+}
@@ -0,0 +1,59 @@
+package usersusecases
+
+import (
+	"context"
+	"time"
+
+	"golang_boilerplate_module/internal/modules/users/usersdomain"
+	"golang_boilerplate_module/internal/modules/users/usersdomain/usersrepo"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+	"golang_boilerplate_module/internal/shared/infra/observability"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type DeleteUserUseCase struct {
+	userRepo usersrepo.UserRepository
+	events   usersdomain.UserEventPublisher
+	access   *UserAccess
+	logger   providers.LoggerProvider
+}
+
+func NewDeleteUserUseCase(userRepo usersrepo.UserRepository, events usersdomain.UserEventPublisher, access *UserAccess, logger providers.LoggerProvider) *DeleteUserUseCase {
+	return &DeleteUserUseCase{userRepo: userRepo, events: events, access: access, logger: logger}
+}
+
+// Execute deletes the user id on behalf of actorID, who must be that user or
+// a users administrator.
+func (uc *DeleteUserUseCase) Execute(ctx context.Context, actorID, id uint) error {
+	ctx, span := userTracer.Start(ctx, "DeleteUserUseCase.Execute")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("user.id", int(id)))
+
+	log := observability.LoggerWithTrace(ctx, uc.logger).With("usecase", "DeleteUser", "userId", id)
+
+	if err := uc.access.AuthorizeChange(actorID, id); err != nil {
+		log.Warn("delete refused", "actorId", actorID)
+		observability.RecordError(span, err)
+		return err
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, id)
+	if err != nil {
+		log.Warn("user not found", "userId", id)
+		observability.RecordError(span, err)
+		return err
+	}
+
+	if err := uc.userRepo.DeleteByID(ctx, id); err != nil {
+		log.Error("failed to delete user", "error", err.Error())
+		observability.RecordError(span, err)
+		return err
+	}
+
+	log.Info("user deleted")
+	uc.events.Publish(ctx, usersdomain.UserEvent{Type: usersdomain.UserDeleted, User: *user, OccurredAt: time.Now()})
+
+	return nil
+}
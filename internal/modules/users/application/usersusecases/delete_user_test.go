@@ -0,0 +1,77 @@
+package usersusecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"golang_boilerplate_module/internal/modules/users/application/usersusecases"
+	"golang_boilerplate_module/internal/modules/users/usersdomain"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+)
+
+func TestDeleteUserUseCase_Success(t *testing.T) {
+	var deletedID uint
+
+	repo := &mockUserRepo{
+		getByIDFn: func(_ context.Context, id uint) (*usersdomain.User, error) {
+			return &usersdomain.User{ID: id, Name: "Ana", Email: "ana@example.com"}, nil
+		},
+		deleteFn: func(_ context.Context, id uint) error {
+			deletedID = id
+			return nil
+		},
+	}
+
+	events := &mockEventPublisher{}
+	uc := usersusecases.NewDeleteUserUseCase(repo, events, userAccess(), &mockLogger{})
+
+	if err := uc.Execute(context.Background(), 5, 5); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if deletedID != 5 {
+		t.Fatalf("expected user 5 to be deleted, got %d", deletedID)
+	}
+	if len(events.events) != 1 || events.events[0].Type != usersdomain.UserDeleted || events.events[0].User.Email != "ana@example.com" {
+		t.Fatalf("expected one UserDeleted event carrying the user, got %+v", events.events)
+	}
+}
+
+func TestDeleteUserUseCase_NotFound(t *testing.T) {
+	repo := &mockUserRepo{
+		getByIDFn: func(_ context.Context, _ uint) (*usersdomain.User, error) {
+			return nil, exceptions.NewNotFoundException("user not found", nil)
+		},
+		deleteFn: func(_ context.Context, _ uint) error {
+			t.Fatal("delete must not be called for a missing user")
+			return nil
+		},
+	}
+
+	events := &mockEventPublisher{}
+	err := usersusecases.NewDeleteUserUseCase(repo, events, userAccess(), &mockLogger{}).Execute(context.Background(), testUsersAdminID, 5)
+
+	var domainErr *exceptions.DomainError
+	if !errors.As(err, &domainErr) || domainErr.Code != exceptions.CodeNotFound {
+		t.Fatalf("expected NOT_FOUND, got %v", err)
+	}
+	if len(events.events) != 0 {
+		t.Fatalf("expected no events, got %+v", events.events)
+	}
+}
+
+func TestDeleteUserUseCase_OtherUserIsForbidden(t *testing.T) {
+	repo := &mockUserRepo{
+		getByIDFn: func(_ context.Context, _ uint) (*usersdomain.User, error) {
+			t.Fatal("a refused actor must not learn whether the user exists")
+			return nil, nil
+		},
+	}
+
+	err := usersusecases.NewDeleteUserUseCase(repo, &mockEventPublisher{}, userAccess(), &mockLogger{}).Execute(context.Background(), 6, 5)
+
+	var domainErr *exceptions.DomainError
+	if !errors.As(err, &domainErr) || domainErr.Code != exceptions.CodeForbidden {
+		t.Fatalf("expected FORBIDDEN, got %v", err)
+	}
+}
@@ -4,10 +4,11 @@ import (
 	"context"
 
 	"golang_boilerplate_module/internal/modules/users/usersdomain/usersrepo"
+	"golang_boilerplate_module/internal/shared/domain/bus"
 	"golang_boilerplate_module/internal/shared/domain/providers"
-	"golang_boilerplate_module/internal/shared/infra/observability"
 
 	"go.opentelemetry.io/otel/attribute"
+	oteltrace "go.opentelemetry.io/otel/trace"
 )
 
 type GetUserUseCase struct {
@@ -19,26 +20,22 @@ func NewGetUserUseCase(userRepo usersrepo.UserRepository, logger providers.Logge
 	return &GetUserUseCase{userRepo: userRepo, logger: logger}
 }
 
-func (uc *GetUserUseCase) Execute(ctx context.Context, id uint) (UserOutput, error) {
-	ctx, span := userTracer.Start(ctx, "GetUserUseCase.Execute")
-	defer span.End()
+func NewGetUserHandler(b *bus.Bus, uc *GetUserUseCase) bus.Handler[uint, UserOutput] {
+	return bus.Query[uint, UserOutput](b, "GetUser", uc)
+}
 
-	span.SetAttributes(attribute.Int("user.id", int(id)))
+func (uc *GetUserUseCase) Handle(ctx context.Context, id uint) (UserOutput, error) {
+	oteltrace.SpanFromContext(ctx).SetAttributes(attribute.Int("user.id", int(id)))
 
-	log := observability.LoggerWithTrace(ctx, uc.logger).With("usecase", "GetUser", "userId", id)
+	log := bus.Logger(ctx, uc.logger).With("userId", id)
 
 	user, err := uc.userRepo.GetByID(ctx, id)
 	if err != nil {
 		log.Warn("user not found", "userId", id)
-		observability.RecordError(span, err)
 		return UserOutput{}, err
 	}
 
 	log.Info("user retrieved", "userId", user.ID)
 
-	return UserOutput{
-		ID:    user.ID,
-		Name:  user.Name,
-		Email: user.Email,
-	}, nil
+	return toUserOutput(user), nil
 }
@@ -7,6 +7,7 @@ import (
 
 	"golang_boilerplate_module/internal/modules/users/application/usersusecases"
 	"golang_boilerplate_module/internal/modules/users/usersdomain"
+	"golang_boilerplate_module/internal/shared/domain/bus"
 	"golang_boilerplate_module/internal/shared/domain/exceptions"
 )
 
@@ -23,7 +24,7 @@ func TestGetUserUseCase_Success(t *testing.T) {
 	}
 
 	uc := usersusecases.NewGetUserUseCase(repo, &mockLogger{})
-	out, err := uc.Execute(context.Background(), 42)
+	out, err := uc.Handle(context.Background(), 42)
 
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
@@ -49,7 +50,7 @@ func TestGetUserUseCase_NotFound(t *testing.T) {
 	}
 
 	uc := usersusecases.NewGetUserUseCase(repo, &mockLogger{})
-	_, err := uc.Execute(context.Background(), 999)
+	_, err := uc.Handle(context.Background(), 999)
 
 	if err == nil {
 		t.Fatal("expected not-found error, got nil")
@@ -74,7 +75,7 @@ func TestGetUserUseCase_RepositoryError(t *testing.T) {
 	}
 
 	uc := usersusecases.NewGetUserUseCase(repo, &mockLogger{})
-	_, err := uc.Execute(context.Background(), 1)
+	_, err := uc.Handle(context.Background(), 1)
 
 	if err == nil {
 		t.Fatal("expected repository error, got nil")
@@ -83,3 +84,44 @@ func TestGetUserUseCase_RepositoryError(t *testing.T) {
 		t.Fatalf("expected repoErr, got %v", err)
 	}
 }
+
+func TestGetUserUseCase_RetriesTransientErrors(t *testing.T) {
+	calls := 0
+	repo := &mockUserRepo{
+		getByIDFn: func(_ context.Context, id uint) (*usersdomain.User, error) {
+			calls++
+			if calls == 1 {
+				return nil, exceptions.NewInternalException(map[string]any{"error": "connection reset"})
+			}
+			return &usersdomain.User{ID: id, Name: "Ana"}, nil
+		},
+	}
+
+	uc := usersusecases.NewGetUserHandler(bus.New(bus.Retry(3, 0)), usersusecases.NewGetUserUseCase(repo, &mockLogger{}))
+	out, err := uc.Handle(context.Background(), 42)
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if calls != 2 || out.ID != 42 {
+		t.Fatalf("expected a single retry, got calls=%d output=%+v", calls, out)
+	}
+}
+
+func TestGetUserUseCase_DoesNotRetryNotFound(t *testing.T) {
+	calls := 0
+	repo := &mockUserRepo{
+		getByIDFn: func(_ context.Context, _ uint) (*usersdomain.User, error) {
+			calls++
+			return nil, exceptions.NewNotFoundException("user not found", nil)
+		},
+	}
+
+	uc := usersusecases.NewGetUserHandler(bus.New(bus.Retry(3, 0)), usersusecases.NewGetUserUseCase(repo, &mockLogger{}))
+	if _, err := uc.Handle(context.Background(), 42); err == nil {
+		t.Fatal("expected not-found error, got nil")
+	}
+	if calls != 1 {
+		t.Fatalf("expected no retry, got %d calls", calls)
+	}
+}
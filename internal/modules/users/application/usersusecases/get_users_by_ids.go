@@ -0,0 +1,45 @@
+package usersusecases
+
+import (
+	"context"
+
+	"golang_boilerplate_module/internal/modules/users/usersdomain/usersrepo"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+	"golang_boilerplate_module/internal/shared/infra/observability"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type GetUsersByIDsUseCase struct {
+	userRepo usersrepo.UserRepository
+	logger   providers.LoggerProvider
+}
+
+func NewGetUsersByIDsUseCase(userRepo usersrepo.UserRepository, logger providers.LoggerProvider) *GetUsersByIDsUseCase {
+	return &GetUsersByIDsUseCase{userRepo: userRepo, logger: logger}
+}
+
+func (uc *GetUsersByIDsUseCase) Execute(ctx context.Context, ids []uint) (map[uint]UserOutput, error) {
+	ctx, span := userTracer.Start(ctx, "GetUsersByIDsUseCase.Execute")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("users.requested", len(ids)))
+
+	log := observability.LoggerWithTrace(ctx, uc.logger).With("usecase", "GetUsersByIDs")
+
+	users, err := uc.userRepo.GetByIDs(ctx, ids)
+	if err != nil {
+		log.Error("failed to load users", "error", err.Error())
+		observability.RecordError(span, err)
+		return nil, err
+	}
+
+	outputs := make(map[uint]UserOutput, len(users))
+	for i := range users {
+		outputs[users[i].ID] = toUserOutput(&users[i])
+	}
+
+	span.SetAttributes(attribute.Int("users.found", len(outputs)))
+	log.Info("users retrieved", "requested", len(ids), "found", len(outputs))
+	return outputs, nil
+}
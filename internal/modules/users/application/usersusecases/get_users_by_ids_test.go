@@ -0,0 +1,55 @@
+package usersusecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"golang_boilerplate_module/internal/modules/users/application/usersusecases"
+	"golang_boilerplate_module/internal/modules/users/usersdomain"
+)
+
+func TestGetUsersByIDsUseCase_IndexesFoundUsersByID(t *testing.T) {
+	var gotIDs []uint
+
+	repo := &mockUserRepo{
+		getByIDsFn: func(_ context.Context, ids []uint) ([]usersdomain.User, error) {
+			gotIDs = ids
+			return []usersdomain.User{
+				{ID: 3, Name: "Bia", Email: "bia@example.com"},
+				{ID: 1, Name: "Ana", Email: "ana@example.com"},
+			}, nil
+		},
+	}
+
+	uc := usersusecases.NewGetUsersByIDsUseCase(repo, &mockLogger{})
+	out, err := uc.Execute(context.Background(), []uint{1, 2, 3})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(gotIDs) != 3 {
+		t.Fatalf("expected a single repository call with 3 ids, got %v", gotIDs)
+	}
+	if len(out) != 2 || out[1].Name != "Ana" || out[3].Name != "Bia" {
+		t.Fatalf("unexpected output %+v", out)
+	}
+	if _, ok := out[2]; ok {
+		t.Fatal("expected missing user 2 to be absent")
+	}
+}
+
+func TestGetUsersByIDsUseCase_RepositoryError(t *testing.T) {
+	repoErr := errors.New("connection reset")
+
+	repo := &mockUserRepo{
+		getByIDsFn: func(_ context.Context, _ []uint) ([]usersdomain.User, error) {
+			return nil, repoErr
+		},
+	}
+
+	_, err := usersusecases.NewGetUsersByIDsUseCase(repo, &mockLogger{}).Execute(context.Background(), []uint{1})
+	if !errors.Is(err, repoErr) {
+		t.Fatalf("expected repoErr, got %v", err)
+	}
+}
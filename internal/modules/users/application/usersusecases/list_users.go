@@ -0,0 +1,72 @@
+package usersusecases
+
+import (
+	"context"
+
+	"golang_boilerplate_module/internal/modules/users/usersdomain/usersrepo"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+	"golang_boilerplate_module/internal/shared/infra/observability"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	DefaultListUsersLimit = 50
+	MaxListUsersLimit     = 500
+)
+
+type ListUsersInput struct {
+	AfterID uint
+	Limit   int
+}
+
+type ListUsersOutput struct {
+	Users       []UserOutput
+	NextAfterID uint
+}
+
+type ListUsersUseCase struct {
+	userRepo usersrepo.UserRepository
+	logger   providers.LoggerProvider
+}
+
+func NewListUsersUseCase(userRepo usersrepo.UserRepository, logger providers.LoggerProvider) *ListUsersUseCase {
+	return &ListUsersUseCase{userRepo: userRepo, logger: logger}
+}
+
+func (uc *ListUsersUseCase) Execute(ctx context.Context, input ListUsersInput) (ListUsersOutput, error) {
+	ctx, span := userTracer.Start(ctx, "ListUsersUseCase.Execute")
+	defer span.End()
+
+	limit := input.Limit
+	if limit <= 0 {
+		limit = DefaultListUsersLimit
+	}
+	limit = min(limit, MaxListUsersLimit)
+
+	span.SetAttributes(
+		attribute.Int("users.after_id", int(input.AfterID)),
+		attribute.Int("users.limit", limit),
+	)
+
+	log := observability.LoggerWithTrace(ctx, uc.logger).With("usecase", "ListUsers", "afterId", input.AfterID)
+
+	users, err := uc.userRepo.List(ctx, input.AfterID, limit+1)
+	if err != nil {
+		log.Error("failed to list users", "error", err.Error())
+		observability.RecordError(span, err)
+		return ListUsersOutput{}, err
+	}
+
+	output := ListUsersOutput{Users: make([]UserOutput, 0, min(len(users), limit))}
+	for i := range users {
+		if i == limit {
+			output.NextAfterID = users[i-1].ID
+			break
+		}
+		output.Users = append(output.Users, toUserOutput(&users[i]))
+	}
+
+	log.Info("users listed", "count", len(output.Users))
+	return output, nil
+}
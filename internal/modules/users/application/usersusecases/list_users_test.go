@@ -0,0 +1,104 @@
+package usersusecases_test
+
+import (
+	"context"
+	"testing"
+
+	"golang_boilerplate_module/internal/modules/users/application/usersusecases"
+	"golang_boilerplate_module/internal/modules/users/usersdomain"
+	"golang_boilerplate_module/internal/shared/domain/tenancy"
+	"golang_boilerplate_module/internal/test/inmemory"
+)
+
+func TestListUsersUseCase_ReturnsNextCursorWhenMoreRowsExist(t *testing.T) {
+	var gotAfterID uint
+	var gotLimit int
+
+	repo := &mockUserRepo{
+		listFn: func(_ context.Context, afterID uint, limit int) ([]usersdomain.User, error) {
+			gotAfterID, gotLimit = afterID, limit
+			return []usersdomain.User{{ID: 11}, {ID: 12}, {ID: 13}}, nil
+		},
+	}
+
+	uc := usersusecases.NewListUsersUseCase(repo, &mockLogger{})
+	out, err := uc.Execute(context.Background(), usersusecases.ListUsersInput{AfterID: 10, Limit: 2})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if gotAfterID != 10 || gotLimit != 3 {
+		t.Fatalf("expected repository call with afterID=10 limit=3, got afterID=%d limit=%d", gotAfterID, gotLimit)
+	}
+	if len(out.Users) != 2 {
+		t.Fatalf("expected 2 users, got %d", len(out.Users))
+	}
+	if out.NextAfterID != 12 {
+		t.Fatalf("expected NextAfterID=12, got %d", out.NextAfterID)
+	}
+}
+
+func TestListUsersUseCase_LastPageHasNoCursor(t *testing.T) {
+	repo := &mockUserRepo{
+		listFn: func(_ context.Context, _ uint, _ int) ([]usersdomain.User, error) {
+			return []usersdomain.User{{ID: 1}}, nil
+		},
+	}
+
+	uc := usersusecases.NewListUsersUseCase(repo, &mockLogger{})
+	out, err := uc.Execute(context.Background(), usersusecases.ListUsersInput{})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(out.Users) != 1 || out.NextAfterID != 0 {
+		t.Fatalf("expected a single user and no cursor, got %+v", out)
+	}
+}
+
+func TestListUsersUseCase_ClampsLimit(t *testing.T) {
+	var gotLimit int
+	repo := &mockUserRepo{
+		listFn: func(_ context.Context, _ uint, limit int) ([]usersdomain.User, error) {
+			gotLimit = limit
+			return nil, nil
+		},
+	}
+
+	uc := usersusecases.NewListUsersUseCase(repo, &mockLogger{})
+	if _, err := uc.Execute(context.Background(), usersusecases.ListUsersInput{Limit: 10_000}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if gotLimit != usersusecases.MaxListUsersLimit+1 {
+		t.Fatalf("expected limit=%d, got %d", usersusecases.MaxListUsersLimit+1, gotLimit)
+	}
+}
+
+func TestListUsersUseCase_PagesThroughStoredUsers(t *testing.T) {
+	ctx := tenancy.WithTenantID(context.Background(), "default")
+	repo := inmemory.NewUserRepository()
+	if _, err := repo.AddMany(ctx, []usersdomain.User{
+		{Name: "Ada", Email: "ada@example.com"},
+		{Name: "Grace", Email: "grace@example.com"},
+		{Name: "Linus", Email: "linus@example.com"},
+	}); err != nil {
+		t.Fatalf("seed users: %v", err)
+	}
+
+	uc := usersusecases.NewListUsersUseCase(repo, &mockLogger{})
+	first, err := uc.Execute(ctx, usersusecases.ListUsersInput{Limit: 2})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(first.Users) != 2 || first.NextAfterID != first.Users[1].ID {
+		t.Fatalf("expected a first page of 2 users with a cursor, got %+v", first)
+	}
+
+	last, err := uc.Execute(ctx, usersusecases.ListUsersInput{AfterID: first.NextAfterID, Limit: 2})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(last.Users) != 1 || last.Users[0].Email != "linus@example.com" || last.NextAfterID != 0 {
+		t.Fatalf("expected the last user and no cursor, got %+v", last)
+	}
+}
@@ -2,6 +2,7 @@ package usersusecases_test
 
 import (
 	"context"
+	"time"
 
 	"golang_boilerplate_module/internal/modules/users/usersdomain"
 	"golang_boilerplate_module/internal/shared/domain/providers"
@@ -11,9 +12,11 @@ type mockUserRepo struct {
 	addFn        func(ctx context.Context, u *usersdomain.User) (*usersdomain.User, error)
 	getByIDFn    func(ctx context.Context, id uint) (*usersdomain.User, error)
 	getByEmailFn func(ctx context.Context, email string) (*usersdomain.User, error)
+	getByIDsFn   func(ctx context.Context, ids []uint) ([]usersdomain.User, error)
 	updateFn     func(ctx context.Context, id uint, updates map[string]any) (*usersdomain.User, error)
 	deleteFn     func(ctx context.Context, id uint) error
 	deleteAllFn  func(ctx context.Context) error
+	listFn       func(ctx context.Context, afterID uint, limit int) ([]usersdomain.User, error)
 }
 
 func (m *mockUserRepo) Add(ctx context.Context, u *usersdomain.User) (*usersdomain.User, error) {
@@ -37,6 +40,13 @@ func (m *mockUserRepo) GetByEmail(ctx context.Context, email string) (*usersdoma
 	return nil, nil
 }
 
+func (m *mockUserRepo) GetByIDs(ctx context.Context, ids []uint) ([]usersdomain.User, error) {
+	if m.getByIDsFn != nil {
+		return m.getByIDsFn(ctx, ids)
+	}
+	return nil, nil
+}
+
 func (m *mockUserRepo) UpdateByID(ctx context.Context, id uint, updates map[string]any) (*usersdomain.User, error) {
 	if m.updateFn != nil {
 		return m.updateFn(ctx, id, updates)
@@ -58,6 +68,64 @@ func (m *mockUserRepo) DeleteAll(ctx context.Context) error {
 	return nil
 }
 
+func (m *mockUserRepo) List(ctx context.Context, afterID uint, limit int) ([]usersdomain.User, error) {
+	if m.listFn != nil {
+		return m.listFn(ctx, afterID, limit)
+	}
+	return nil, nil
+}
+
+type mockEventPublisher struct {
+	events []usersdomain.UserEvent
+}
+
+func (m *mockEventPublisher) Publish(_ context.Context, event usersdomain.UserEvent) {
+	m.events = append(m.events, event)
+}
+
+type mockEventSubscriber struct {
+	ch chan usersdomain.UserEvent
+}
+
+func (m *mockEventSubscriber) Subscribe(_ context.Context) <-chan usersdomain.UserEvent {
+	return m.ch
+}
+
+type mockEventLog struct {
+	listSinceFn      func(ctx context.Context, afterID uint64, limit int) ([]usersdomain.UserEvent, error)
+	listByUserIDFn   func(ctx context.Context, userID uint) ([]usersdomain.UserEvent, error)
+	deleteBeforeFn   func(ctx context.Context, before time.Time) (int64, error)
+	deleteByUserIDFn func(ctx context.Context, userID uint) (int64, error)
+}
+
+func (m *mockEventLog) ListSince(ctx context.Context, afterID uint64, limit int) ([]usersdomain.UserEvent, error) {
+	if m.listSinceFn != nil {
+		return m.listSinceFn(ctx, afterID, limit)
+	}
+	return nil, nil
+}
+
+func (m *mockEventLog) ListByUserID(ctx context.Context, userID uint) ([]usersdomain.UserEvent, error) {
+	if m.listByUserIDFn != nil {
+		return m.listByUserIDFn(ctx, userID)
+	}
+	return nil, nil
+}
+
+func (m *mockEventLog) DeleteBefore(ctx context.Context, before time.Time) (int64, error) {
+	if m.deleteBeforeFn != nil {
+		return m.deleteBeforeFn(ctx, before)
+	}
+	return 0, nil
+}
+
+func (m *mockEventLog) DeleteByUserID(ctx context.Context, userID uint) (int64, error) {
+	if m.deleteByUserIDFn != nil {
+		return m.deleteByUserIDFn(ctx, userID)
+	}
+	return 0, nil
+}
+
 type mockLogger struct{}
 
 func (l *mockLogger) Info(msg string, fields ...any)            {}
@@ -0,0 +1,44 @@
+package usersusecases
+
+import (
+	"context"
+	"time"
+
+	"golang_boilerplate_module/internal/config"
+	"golang_boilerplate_module/internal/modules/users/usersdomain"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+	"golang_boilerplate_module/internal/shared/domain/tenancy"
+	"golang_boilerplate_module/internal/shared/infra/observability"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type PruneUserEventsUseCase struct {
+	eventLog usersdomain.UserEventLog
+	cfg      *config.Config
+	logger   providers.LoggerProvider
+}
+
+func NewPruneUserEventsUseCase(eventLog usersdomain.UserEventLog, cfg *config.Config, logger providers.LoggerProvider) *PruneUserEventsUseCase {
+	return &PruneUserEventsUseCase{eventLog: eventLog, cfg: cfg, logger: logger}
+}
+
+func (uc *PruneUserEventsUseCase) Execute(ctx context.Context) (int64, error) {
+	ctx, span := userTracer.Start(tenancy.WithSystemScope(ctx), "PruneUserEventsUseCase.Execute")
+	defer span.End()
+
+	log := observability.LoggerWithTrace(ctx, uc.logger).With("usecase", "PruneUserEvents")
+
+	deleted, err := uc.eventLog.DeleteBefore(ctx, time.Now().UTC().Add(-uc.cfg.Streams.EventRetention))
+	if err != nil {
+		log.Error("failed to prune user events", "error", err.Error())
+		observability.RecordError(span, err)
+		return 0, err
+	}
+
+	span.SetAttributes(attribute.Int64("user.events.deleted", deleted))
+	if deleted > 0 {
+		log.Info("user events pruned", "count", deleted)
+	}
+	return deleted, nil
+}
@@ -0,0 +1,36 @@
+package usersusecases_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"golang_boilerplate_module/internal/config"
+	"golang_boilerplate_module/internal/modules/users/application/usersusecases"
+	"golang_boilerplate_module/internal/shared/domain/tenancy"
+)
+
+func TestPruneUserEventsUseCase_DeletesEventsOlderThanRetention(t *testing.T) {
+	var cutoff time.Time
+	eventLog := &mockEventLog{
+		deleteBeforeFn: func(ctx context.Context, before time.Time) (int64, error) {
+			if !tenancy.IsSystemScope(ctx) {
+				t.Fatal("expected pruning to run in system scope")
+			}
+			cutoff = before
+			return 4, nil
+		},
+	}
+	cfg := &config.Config{Streams: config.StreamsConfig{EventRetention: time.Hour}}
+
+	deleted, err := usersusecases.NewPruneUserEventsUseCase(eventLog, cfg, &mockLogger{}).Execute(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if deleted != 4 {
+		t.Fatalf("expected 4 deleted events, got %d", deleted)
+	}
+	if age := time.Since(cutoff); age < time.Hour || age > time.Hour+time.Minute {
+		t.Fatalf("expected a cutoff one hour ago, got %s", age)
+	}
+}
@@ -0,0 +1,91 @@
+package usersusecases
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"golang_boilerplate_module/internal/modules/users/usersdomain"
+	"golang_boilerplate_module/internal/modules/users/usersdomain/usersrepo"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+	"golang_boilerplate_module/internal/shared/infra/observability"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type UpdateUserInput struct {
+	Name  *string
+	Email *string
+}
+
+type UpdateUserUseCase struct {
+	userRepo usersrepo.UserRepository
+	events   usersdomain.UserEventPublisher
+	access   *UserAccess
+	logger   providers.LoggerProvider
+}
+
+func NewUpdateUserUseCase(userRepo usersrepo.UserRepository, events usersdomain.UserEventPublisher, access *UserAccess, logger providers.LoggerProvider) *UpdateUserUseCase {
+	return &UpdateUserUseCase{userRepo: userRepo, events: events, access: access, logger: logger}
+}
+
+// Execute changes the user id on behalf of actorID, who must be that user or
+// a users administrator.
+func (uc *UpdateUserUseCase) Execute(ctx context.Context, actorID, id uint, input UpdateUserInput) (UserOutput, error) {
+	ctx, span := userTracer.Start(ctx, "UpdateUserUseCase.Execute")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("user.id", int(id)))
+
+	log := observability.LoggerWithTrace(ctx, uc.logger).With("usecase", "UpdateUser", "userId", id)
+
+	if err := uc.access.AuthorizeChange(actorID, id); err != nil {
+		log.Warn("update refused", "actorId", actorID)
+		observability.RecordError(span, err)
+		return UserOutput{}, err
+	}
+
+	updates := make(map[string]any)
+	if input.Name != nil {
+		updates["name"] = strings.TrimSpace(*input.Name)
+	}
+	if input.Email != nil {
+		updates["email"] = strings.TrimSpace(*input.Email)
+	}
+	for _, value := range updates {
+		if value == "" {
+			err := exceptions.NewBadRequestException("Name and email cannot be empty", nil)
+			log.Warn("validation failed — empty name or email")
+			observability.RecordError(span, err)
+			return UserOutput{}, err
+		}
+	}
+	if len(updates) == 0 {
+		err := exceptions.NewBadRequestException("Nothing to update", nil)
+		observability.RecordError(span, err)
+		return UserOutput{}, err
+	}
+
+	if email, ok := updates["email"].(string); ok {
+		existing, _ := uc.userRepo.GetByEmail(ctx, email)
+		if existing != nil && existing.ID != id {
+			err := exceptions.NewUnprocessableException("Email already in use", map[string]any{"email": email})
+			log.Warn("email already in use", "email", email)
+			observability.RecordError(span, err)
+			return UserOutput{}, err
+		}
+	}
+
+	updated, err := uc.userRepo.UpdateByID(ctx, id, updates)
+	if err != nil {
+		log.Warn("failed to update user", "error", err.Error())
+		observability.RecordError(span, err)
+		return UserOutput{}, err
+	}
+
+	log.Info("user updated")
+	uc.events.Publish(ctx, usersdomain.UserEvent{Type: usersdomain.UserUpdated, User: *updated, OccurredAt: time.Now()})
+
+	return toUserOutput(updated), nil
+}
@@ -0,0 +1,106 @@
+package usersusecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"golang_boilerplate_module/internal/modules/users/application/usersusecases"
+	"golang_boilerplate_module/internal/modules/users/usersdomain"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+)
+
+func TestUpdateUserUseCase_Success(t *testing.T) {
+	var gotUpdates map[string]any
+
+	repo := &mockUserRepo{
+		updateFn: func(_ context.Context, id uint, updates map[string]any) (*usersdomain.User, error) {
+			gotUpdates = updates
+			return &usersdomain.User{ID: id, Name: "Ana Maria", Email: "ana@example.com"}, nil
+		},
+	}
+
+	name := "  Ana Maria "
+	events := &mockEventPublisher{}
+	uc := usersusecases.NewUpdateUserUseCase(repo, events, userAccess(), &mockLogger{})
+	out, err := uc.Execute(context.Background(), 7, 7, usersusecases.UpdateUserInput{Name: &name})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(gotUpdates) != 1 || gotUpdates["name"] != "Ana Maria" {
+		t.Fatalf("expected only the trimmed name to be updated, got %v", gotUpdates)
+	}
+	if out.ID != 7 || out.Name != "Ana Maria" {
+		t.Fatalf("unexpected output %+v", out)
+	}
+	if len(events.events) != 1 || events.events[0].Type != usersdomain.UserUpdated {
+		t.Fatalf("expected one UserUpdated event, got %+v", events.events)
+	}
+}
+
+func TestUpdateUserUseCase_NothingToUpdate(t *testing.T) {
+	uc := usersusecases.NewUpdateUserUseCase(&mockUserRepo{}, &mockEventPublisher{}, userAccess(), &mockLogger{})
+
+	_, err := uc.Execute(context.Background(), 7, 7, usersusecases.UpdateUserInput{})
+
+	var domainErr *exceptions.DomainError
+	if !errors.As(err, &domainErr) || domainErr.Code != exceptions.CodeBadRequest {
+		t.Fatalf("expected BAD_REQUEST, got %v", err)
+	}
+}
+
+func TestUpdateUserUseCase_EmailTakenByAnotherUser(t *testing.T) {
+	repo := &mockUserRepo{
+		getByEmailFn: func(_ context.Context, _ string) (*usersdomain.User, error) {
+			return &usersdomain.User{ID: 99, Email: "dup@example.com"}, nil
+		},
+	}
+
+	email := "dup@example.com"
+	uc := usersusecases.NewUpdateUserUseCase(repo, &mockEventPublisher{}, userAccess(), &mockLogger{})
+	_, err := uc.Execute(context.Background(), 7, 7, usersusecases.UpdateUserInput{Email: &email})
+
+	var domainErr *exceptions.DomainError
+	if !errors.As(err, &domainErr) || domainErr.Code != exceptions.CodeUnprocessable {
+		t.Fatalf("expected UNPROCESSABLE, got %v", err)
+	}
+}
+
+func TestUpdateUserUseCase_RequiresTheUserOrAnAdmin(t *testing.T) {
+	name := "Ana"
+	tests := []struct {
+		name    string
+		actorID uint
+		allowed bool
+	}{
+		{name: "anonymous", actorID: 0},
+		{name: "other user", actorID: 8},
+		{name: "admin", actorID: testUsersAdminID, allowed: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mockUserRepo{
+				updateFn: func(_ context.Context, id uint, _ map[string]any) (*usersdomain.User, error) {
+					if !tt.allowed {
+						t.Fatal("update must not be called for a refused actor")
+					}
+					return &usersdomain.User{ID: id, Name: name}, nil
+				},
+			}
+			uc := usersusecases.NewUpdateUserUseCase(repo, &mockEventPublisher{}, userAccess(), &mockLogger{})
+
+			_, err := uc.Execute(context.Background(), tt.actorID, 7, usersusecases.UpdateUserInput{Name: &name})
+			if tt.allowed {
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+				return
+			}
+			var domainErr *exceptions.DomainError
+			if !errors.As(err, &domainErr) || domainErr.Code != exceptions.CodeForbidden {
+				t.Fatalf("expected FORBIDDEN, got %v", err)
+			}
+		})
+	}
+}
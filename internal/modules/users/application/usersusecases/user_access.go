@@ -0,0 +1,29 @@
+package usersusecases
+
+import (
+	"slices"
+	"strconv"
+
+	"golang_boilerplate_module/internal/config"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+)
+
+// UserAccess decides who may change a user: the user themself and the users
+// administrators.
+type UserAccess struct {
+	admins []string
+}
+
+func NewUserAccess(cfg *config.Config) *UserAccess {
+	return &UserAccess{admins: cfg.Users.Admins}
+}
+
+func (a *UserAccess) AuthorizeChange(actorID, userID uint) error {
+	if actorID != 0 && actorID == userID {
+		return nil
+	}
+	if actorID != 0 && slices.Contains(a.admins, strconv.FormatUint(uint64(actorID), 10)) {
+		return nil
+	}
+	return exceptions.NewForbiddenException("Only the user or a users administrator can change this user", nil)
+}
@@ -0,0 +1,47 @@
+package usersusecases_test
+
+import (
+	"errors"
+	"testing"
+
+	"golang_boilerplate_module/internal/config"
+	"golang_boilerplate_module/internal/modules/users/application/usersusecases"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+)
+
+const testUsersAdminID = 9
+
+func userAccess() *usersusecases.UserAccess {
+	return usersusecases.NewUserAccess(&config.Config{Users: config.UsersConfig{Admins: []string{"9"}}})
+}
+
+func TestUserAccess_AuthorizeChange(t *testing.T) {
+	access := userAccess()
+
+	tests := []struct {
+		name    string
+		actorID uint
+		userID  uint
+		allowed bool
+	}{
+		{name: "self", actorID: 5, userID: 5, allowed: true},
+		{name: "admin", actorID: testUsersAdminID, userID: 5, allowed: true},
+		{name: "other user", actorID: 6, userID: 5},
+		{name: "anonymous", actorID: 0, userID: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := access.AuthorizeChange(tt.actorID, tt.userID)
+			if tt.allowed {
+				if err != nil {
+					t.Fatalf("expected the change to be allowed, got %v", err)
+				}
+				return
+			}
+			var domainErr *exceptions.DomainError
+			if !errors.As(err, &domainErr) || domainErr.Code != exceptions.CodeForbidden {
+				t.Fatalf("expected FORBIDDEN, got %v", err)
+			}
+		})
+	}
+}
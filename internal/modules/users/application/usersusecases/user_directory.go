@@ -0,0 +1,42 @@
+package usersusecases
+
+import (
+	"context"
+
+	"golang_boilerplate_module/internal/modules/users/usersdomain/usersrepo"
+	"golang_boilerplate_module/internal/shared/domain/bus"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+)
+
+type UserDirectory struct {
+	userRepo   usersrepo.UserRepository
+	createUser bus.Handler[CreateUserInput, UserOutput]
+}
+
+func NewUserDirectory(userRepo usersrepo.UserRepository, createUser bus.Handler[CreateUserInput, UserOutput]) *UserDirectory {
+	return &UserDirectory{userRepo: userRepo, createUser: createUser}
+}
+
+func (d *UserDirectory) GetUserSummary(ctx context.Context, userID uint) (providers.UserSummary, error) {
+	user, err := d.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return providers.UserSummary{}, err
+	}
+	return providers.UserSummary{ID: user.ID, Name: user.Name, Email: user.Email}, nil
+}
+
+func (d *UserDirectory) FindUserByEmail(ctx context.Context, email string) (providers.UserSummary, error) {
+	user, err := d.userRepo.GetByEmail(ctx, email)
+	if err != nil {
+		return providers.UserSummary{}, err
+	}
+	return providers.UserSummary{ID: user.ID, Name: user.Name, Email: user.Email}, nil
+}
+
+func (d *UserDirectory) ProvisionUser(ctx context.Context, name, email string) (providers.UserSummary, error) {
+	output, err := d.createUser.Handle(ctx, CreateUserInput{Name: name, Email: email})
+	if err != nil {
+		return providers.UserSummary{}, err
+	}
+	return providers.UserSummary{ID: output.ID, Name: output.Name, Email: output.Email}, nil
+}
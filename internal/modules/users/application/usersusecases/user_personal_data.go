@@ -0,0 +1,124 @@
+package usersusecases
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"golang_boilerplate_module/internal/modules/users/usersdomain"
+	"golang_boilerplate_module/internal/modules/users/usersdomain/usersrepo"
+	"golang_boilerplate_module/internal/shared/domain/privacy"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+	"golang_boilerplate_module/internal/shared/infra/observability"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const anonymizedUserName = "Deleted user"
+
+type UserPersonalData struct {
+	ID        uint                    `json:"id"`
+	Name      string                  `json:"name"`
+	Email     string                  `json:"email"`
+	CreatedAt time.Time               `json:"created_at"`
+	UpdatedAt time.Time               `json:"updated_at"`
+	Events    []UserPersonalDataEvent `json:"events"`
+}
+
+// UserPersonalDataEvent is a change of the user still kept for the streams.
+type UserPersonalDataEvent struct {
+	Type       string    `json:"type"`
+	Name       string    `json:"name"`
+	Email      string    `json:"email"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+type UserPersonalDataProvider struct {
+	userRepo usersrepo.UserRepository
+	eventLog usersdomain.UserEventLog
+	logger   providers.LoggerProvider
+}
+
+func NewUserPersonalDataProvider(userRepo usersrepo.UserRepository, eventLog usersdomain.UserEventLog, logger providers.LoggerProvider) *UserPersonalDataProvider {
+	return &UserPersonalDataProvider{userRepo: userRepo, eventLog: eventLog, logger: logger}
+}
+
+func (p *UserPersonalDataProvider) Module() string {
+	return "users"
+}
+
+func (p *UserPersonalDataProvider) ExportPersonalData(ctx context.Context, userID uint) (any, error) {
+	ctx, span := userTracer.Start(ctx, "UserPersonalDataProvider.ExportPersonalData")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("user.id", int(userID)))
+
+	log := observability.LoggerWithTrace(ctx, p.logger).With("provider", "UserPersonalData", "userId", userID)
+
+	user, err := p.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		log.Warn("failed to load user for export", "error", err.Error())
+		observability.RecordError(span, err)
+		return nil, err
+	}
+
+	events, err := p.eventLog.ListByUserID(ctx, userID)
+	if err != nil {
+		log.Warn("failed to load user events for export", "error", err.Error())
+		observability.RecordError(span, err)
+		return nil, err
+	}
+
+	data := UserPersonalData{
+		ID:        user.ID,
+		Name:      user.Name,
+		Email:     user.Email,
+		CreatedAt: user.CreatedAt,
+		UpdatedAt: user.UpdatedAt,
+		Events:    make([]UserPersonalDataEvent, 0, len(events)),
+	}
+	for _, event := range events {
+		data.Events = append(data.Events, UserPersonalDataEvent{
+			Type:       string(event.Type),
+			Name:       event.User.Name,
+			Email:      event.User.Email,
+			OccurredAt: event.OccurredAt,
+		})
+	}
+	return data, nil
+}
+
+func (p *UserPersonalDataProvider) ErasePersonalData(ctx context.Context, userID uint) (privacy.ErasureResult, error) {
+	ctx, span := userTracer.Start(ctx, "UserPersonalDataProvider.ErasePersonalData")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("user.id", int(userID)))
+
+	log := observability.LoggerWithTrace(ctx, p.logger).With("provider", "UserPersonalData", "userId", userID)
+
+	_, err := p.userRepo.UpdateByID(ctx, userID, map[string]any{
+		"name":  anonymizedUserName,
+		"email": fmt.Sprintf("erased-%d@anonymized.invalid", userID),
+	})
+	if err != nil {
+		log.Error("failed to anonymize user", "error", err.Error())
+		observability.RecordError(span, err)
+		return privacy.ErasureResult{}, err
+	}
+
+	// The stream log keeps the name and email of every change; resuming
+	// clients would otherwise be replayed the erased data.
+	deletedEvents, err := p.eventLog.DeleteByUserID(ctx, userID)
+	if err != nil {
+		log.Error("failed to delete user events", "error", err.Error())
+		observability.RecordError(span, err)
+		return privacy.ErasureResult{}, err
+	}
+
+	log.Info("user anonymized", "deletedEvents", deletedEvents)
+
+	return privacy.ErasureResult{
+		Strategy:        privacy.ErasureStrategyAnonymize,
+		RecordsAffected: 1 + deletedEvents,
+	}, nil
+}
@@ -0,0 +1,68 @@
+package usersusecases_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"golang_boilerplate_module/internal/modules/users/application/usersusecases"
+	"golang_boilerplate_module/internal/modules/users/usersdomain"
+	"golang_boilerplate_module/internal/shared/domain/privacy"
+)
+
+func TestUserPersonalDataProvider_ExportIncludesEvents(t *testing.T) {
+	repo := &mockUserRepo{
+		getByIDFn: func(_ context.Context, id uint) (*usersdomain.User, error) {
+			return &usersdomain.User{ID: id, Name: "Ana Maria", Email: "ana@example.com"}, nil
+		},
+	}
+	eventLog := &mockEventLog{
+		listByUserIDFn: func(_ context.Context, userID uint) ([]usersdomain.UserEvent, error) {
+			return []usersdomain.UserEvent{
+				{ID: 1, Type: usersdomain.UserCreated, User: usersdomain.User{ID: userID, Name: "Ana", Email: "ana@example.com"}, OccurredAt: time.Now()},
+			}, nil
+		},
+	}
+
+	data, err := usersusecases.NewUserPersonalDataProvider(repo, eventLog, &mockLogger{}).ExportPersonalData(context.Background(), 5)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	exported := data.(usersusecases.UserPersonalData)
+	if len(exported.Events) != 1 || exported.Events[0].Type != "created" || exported.Events[0].Name != "Ana" {
+		t.Fatalf("expected the created event with its name, got %+v", exported.Events)
+	}
+}
+
+func TestUserPersonalDataProvider_EraseDeletesEvents(t *testing.T) {
+	var updates map[string]any
+	repo := &mockUserRepo{
+		updateFn: func(_ context.Context, id uint, u map[string]any) (*usersdomain.User, error) {
+			updates = u
+			return &usersdomain.User{ID: id}, nil
+		},
+	}
+	var deletedFor uint
+	eventLog := &mockEventLog{
+		deleteByUserIDFn: func(_ context.Context, userID uint) (int64, error) {
+			deletedFor = userID
+			return 3, nil
+		},
+	}
+
+	result, err := usersusecases.NewUserPersonalDataProvider(repo, eventLog, &mockLogger{}).ErasePersonalData(context.Background(), 5)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if updates["email"] != "erased-5@anonymized.invalid" {
+		t.Fatalf("expected the user to be anonymized, got %v", updates)
+	}
+	if deletedFor != 5 {
+		t.Fatalf("expected the events of user 5 to be deleted, got user %d", deletedFor)
+	}
+	if result.Strategy != privacy.ErasureStrategyAnonymize || result.RecordsAffected != 4 {
+		t.Fatalf("expected the user and its 3 events to be counted, got %+v", result)
+	}
+}
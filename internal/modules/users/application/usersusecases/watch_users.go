@@ -0,0 +1,120 @@
+package usersusecases
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"golang_boilerplate_module/internal/modules/users/usersdomain"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+	"golang_boilerplate_module/internal/shared/infra/observability"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const watchReplayBatchSize = 500
+
+var watchableEventTypes = []usersdomain.UserEventType{
+	usersdomain.UserCreated,
+	usersdomain.UserUpdated,
+	usersdomain.UserDeleted,
+}
+
+type WatchUsersInput struct {
+	LastEventID uint64
+	Types       []usersdomain.UserEventType
+	UserIDs     []uint
+}
+
+func (in WatchUsersInput) matches(event usersdomain.UserEvent) bool {
+	if len(in.Types) > 0 && !slices.Contains(in.Types, event.Type) {
+		return false
+	}
+	return len(in.UserIDs) == 0 || slices.Contains(in.UserIDs, event.User.ID)
+}
+
+type WatchUsersUseCase struct {
+	events   usersdomain.UserEventSubscriber
+	eventLog usersdomain.UserEventLog
+	logger   providers.LoggerProvider
+}
+
+func NewWatchUsersUseCase(events usersdomain.UserEventSubscriber, eventLog usersdomain.UserEventLog, logger providers.LoggerProvider) *WatchUsersUseCase {
+	return &WatchUsersUseCase{events: events, eventLog: eventLog, logger: logger}
+}
+
+// Execute replays the events stored after input.LastEventID and then follows
+// new ones. The channel is closed when ctx ends or when the watcher falls too
+// far behind; in the latter case the caller can resume from the last event.
+func (uc *WatchUsersUseCase) Execute(ctx context.Context, input WatchUsersInput) (<-chan usersdomain.UserEvent, error) {
+	ctx, span := userTracer.Start(ctx, "WatchUsersUseCase.Execute")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int64("user.event.last_id", int64(input.LastEventID)))
+
+	log := observability.LoggerWithTrace(ctx, uc.logger).With("usecase", "WatchUsers", "lastEventId", input.LastEventID)
+
+	for _, eventType := range input.Types {
+		if !slices.Contains(watchableEventTypes, eventType) {
+			domainErr := exceptions.NewBadRequestException(fmt.Sprintf("Unknown user event type %q", eventType), nil)
+			observability.RecordError(span, domainErr)
+			return nil, domainErr
+		}
+	}
+
+	live := uc.events.Subscribe(ctx)
+
+	var missed []usersdomain.UserEvent
+	for afterID := input.LastEventID; afterID > 0; {
+		page, err := uc.eventLog.ListSince(ctx, afterID, watchReplayBatchSize)
+		if err != nil {
+			log.Error("failed to replay user events", "error", err.Error())
+			observability.RecordError(span, err)
+			return nil, err
+		}
+		missed = append(missed, page...)
+		if len(page) < watchReplayBatchSize {
+			break
+		}
+		afterID = page[len(page)-1].ID
+	}
+
+	span.SetAttributes(attribute.Int("user.event.replayed", len(missed)))
+	log.Info("user watch started", "replayed", len(missed))
+
+	out := make(chan usersdomain.UserEvent)
+	go func() {
+		defer close(out)
+
+		replayedUpTo := input.LastEventID
+		send := func(event usersdomain.UserEvent) bool {
+			if !input.matches(event) {
+				return true
+			}
+			select {
+			case out <- event:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		for _, event := range missed {
+			replayedUpTo = event.ID
+			if !send(event) {
+				return
+			}
+		}
+		for event := range live {
+			if event.ID <= replayedUpTo {
+				continue
+			}
+			if !send(event) {
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
@@ -0,0 +1,134 @@
+package usersusecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"golang_boilerplate_module/internal/modules/users/application/usersusecases"
+	"golang_boilerplate_module/internal/modules/users/usersdomain"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+)
+
+func userEvent(id uint64, eventType usersdomain.UserEventType, userID uint) usersdomain.UserEvent {
+	return usersdomain.UserEvent{ID: id, Type: eventType, User: usersdomain.User{ID: userID}}
+}
+
+func receive(t *testing.T, events <-chan usersdomain.UserEvent) usersdomain.UserEvent {
+	t.Helper()
+	select {
+	case event, ok := <-events:
+		if !ok {
+			t.Fatal("expected an event, channel closed")
+		}
+		return event
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for an event")
+	}
+	return usersdomain.UserEvent{}
+}
+
+func TestWatchUsersUseCase_ReplaysMissedEventsThenFollowsLiveOnes(t *testing.T) {
+	live := make(chan usersdomain.UserEvent, 3)
+	eventLog := &mockEventLog{
+		listSinceFn: func(_ context.Context, afterID uint64, _ int) ([]usersdomain.UserEvent, error) {
+			if afterID != 10 {
+				t.Fatalf("expected replay after 10, got %d", afterID)
+			}
+			return []usersdomain.UserEvent{userEvent(11, usersdomain.UserCreated, 1), userEvent(12, usersdomain.UserUpdated, 1)}, nil
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	uc := usersusecases.NewWatchUsersUseCase(&mockEventSubscriber{ch: live}, eventLog, &mockLogger{})
+	events, err := uc.Execute(ctx, usersusecases.WatchUsersInput{LastEventID: 10})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	live <- userEvent(12, usersdomain.UserUpdated, 1)
+	live <- userEvent(13, usersdomain.UserDeleted, 1)
+
+	for _, want := range []uint64{11, 12, 13} {
+		if got := receive(t, events).ID; got != want {
+			t.Fatalf("expected event %d, got %d", want, got)
+		}
+	}
+}
+
+func TestWatchUsersUseCase_AppliesFilters(t *testing.T) {
+	live := make(chan usersdomain.UserEvent, 3)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	uc := usersusecases.NewWatchUsersUseCase(&mockEventSubscriber{ch: live}, &mockEventLog{}, &mockLogger{})
+	events, err := uc.Execute(ctx, usersusecases.WatchUsersInput{
+		Types:   []usersdomain.UserEventType{usersdomain.UserUpdated},
+		UserIDs: []uint{7},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	live <- userEvent(1, usersdomain.UserCreated, 7)
+	live <- userEvent(2, usersdomain.UserUpdated, 8)
+	live <- userEvent(3, usersdomain.UserUpdated, 7)
+
+	if got := receive(t, events).ID; got != 3 {
+		t.Fatalf("expected only event 3, got %d", got)
+	}
+}
+
+func TestWatchUsersUseCase_ClosesWhenSubscriptionEnds(t *testing.T) {
+	live := make(chan usersdomain.UserEvent)
+	uc := usersusecases.NewWatchUsersUseCase(&mockEventSubscriber{ch: live}, &mockEventLog{}, &mockLogger{})
+
+	events, err := uc.Execute(context.Background(), usersusecases.WatchUsersInput{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	close(live)
+
+	select {
+	case _, ok := <-events:
+		if ok {
+			t.Fatal("expected the channel to be closed")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for the channel to close")
+	}
+}
+
+func TestWatchUsersUseCase_RejectsUnknownEventType(t *testing.T) {
+	uc := usersusecases.NewWatchUsersUseCase(&mockEventSubscriber{}, &mockEventLog{}, &mockLogger{})
+
+	_, err := uc.Execute(context.Background(), usersusecases.WatchUsersInput{
+		Types: []usersdomain.UserEventType{"renamed"},
+	})
+
+	var domainErr *exceptions.DomainError
+	if !errors.As(err, &domainErr) || domainErr.Code != exceptions.CodeBadRequest {
+		t.Fatalf("expected BAD_REQUEST, got %v", err)
+	}
+}
+
+func TestWatchUsersUseCase_ReplayError(t *testing.T) {
+	eventLog := &mockEventLog{
+		listSinceFn: func(_ context.Context, _ uint64, _ int) ([]usersdomain.UserEvent, error) {
+			return nil, exceptions.NewInternalException(nil)
+		},
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	uc := usersusecases.NewWatchUsersUseCase(&mockEventSubscriber{ch: make(chan usersdomain.UserEvent)}, eventLog, &mockLogger{})
+	_, err := uc.Execute(ctx, usersusecases.WatchUsersInput{LastEventID: 1})
+
+	var domainErr *exceptions.DomainError
+	if !errors.As(err, &domainErr) || domainErr.Code != exceptions.CodeInternal {
+		t.Fatalf("expected INTERNAL, got %v", err)
+	}
+}
@@ -0,0 +1,112 @@
+package usersevents
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"golang_boilerplate_module/internal/config"
+	"golang_boilerplate_module/internal/modules/users/usersdomain"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+	"golang_boilerplate_module/internal/shared/domain/tenancy"
+)
+
+const inMemoryHistorySize = 1000
+
+// InMemoryUserEventBus delivers events within a single process and keeps the
+// most recent ones for resumption. Use it for local development and tests.
+type InMemoryUserEventBus struct {
+	subscribers *userEventSubscribers
+
+	mu      sync.Mutex
+	lastID  uint64
+	history []usersdomain.UserEvent
+}
+
+func NewInMemoryUserEventBus(cfg *config.Config, logger providers.LoggerProvider) *InMemoryUserEventBus {
+	return &InMemoryUserEventBus{
+		subscribers: newUserEventSubscribers(cfg.Streams.SubscriberBuffer, logger),
+	}
+}
+
+func (b *InMemoryUserEventBus) Publish(_ context.Context, event usersdomain.UserEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.lastID++
+	event.ID = b.lastID
+	b.history = append(b.history, event)
+	if len(b.history) > inMemoryHistorySize {
+		b.history = b.history[len(b.history)-inMemoryHistorySize:]
+	}
+	b.subscribers.broadcast(event)
+}
+
+func (b *InMemoryUserEventBus) Subscribe(ctx context.Context) <-chan usersdomain.UserEvent {
+	return b.subscribers.subscribe(ctx)
+}
+
+func (b *InMemoryUserEventBus) ListSince(ctx context.Context, afterID uint64, limit int) ([]usersdomain.UserEvent, error) {
+	tenantID, _ := tenancy.TenantIDFromContext(ctx)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var events []usersdomain.UserEvent
+	for _, event := range b.history {
+		if len(events) == limit {
+			break
+		}
+		if event.ID > afterID && event.User.TenantID == tenantID {
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
+
+func (b *InMemoryUserEventBus) ListByUserID(ctx context.Context, userID uint) ([]usersdomain.UserEvent, error) {
+	tenantID, _ := tenancy.TenantIDFromContext(ctx)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var events []usersdomain.UserEvent
+	for _, event := range b.history {
+		if event.User.ID == userID && event.User.TenantID == tenantID {
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
+
+func (b *InMemoryUserEventBus) DeleteBefore(_ context.Context, before time.Time) (int64, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	kept := b.history[:0]
+	for _, event := range b.history {
+		if !event.OccurredAt.Before(before) {
+			kept = append(kept, event)
+		}
+	}
+	deleted := int64(len(b.history) - len(kept))
+	b.history = kept
+	return deleted, nil
+}
+
+func (b *InMemoryUserEventBus) DeleteByUserID(ctx context.Context, userID uint) (int64, error) {
+	tenantID, _ := tenancy.TenantIDFromContext(ctx)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	kept := b.history[:0]
+	for _, event := range b.history {
+		if event.User.ID != userID || event.User.TenantID != tenantID {
+			kept = append(kept, event)
+		}
+	}
+	deleted := int64(len(b.history) - len(kept))
+	b.history = kept
+	return deleted, nil
+}
@@ -0,0 +1,329 @@
+package usersevents
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"slices"
+	"time"
+
+	"golang_boilerplate_module/internal/config"
+	"golang_boilerplate_module/internal/modules/users/usersdomain"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+	"golang_boilerplate_module/internal/shared/infra/observability"
+	sharedrepo "golang_boilerplate_module/internal/shared/infra/persistence/repositories"
+
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"gorm.io/gorm"
+)
+
+const (
+	notifyChannel      = "user_events"
+	listenerRetryDelay = 2 * time.Second
+	catchUpBatchSize   = 500
+	// Ids are taken when a transaction inserts but become visible when it
+	// commits, so an id below the last one delivered may still show up. Missing
+	// ids are re-read for this long before they count as rolled back.
+	gapGracePeriod = 5 * time.Minute
+	maxTrackedGaps = catchUpBatchSize
+)
+
+var dbTracer = otel.Tracer("users.events")
+
+type userEventRecord struct {
+	ID         uint64    `json:"id" gorm:"primarykey"`
+	TenantID   string    `json:"tenant_id"`
+	Type       string    `json:"type"`
+	UserID     uint      `json:"user_id"`
+	Name       string    `json:"name"`
+	Email      string    `json:"email"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+func (userEventRecord) TableName() string {
+	return "user_events"
+}
+
+func (r userEventRecord) toDomain() usersdomain.UserEvent {
+	return usersdomain.UserEvent{
+		ID:   r.ID,
+		Type: usersdomain.UserEventType(r.Type),
+		User: usersdomain.User{
+			ID:       r.UserID,
+			TenantID: r.TenantID,
+			Name:     r.Name,
+			Email:    r.Email,
+		},
+		OccurredAt: r.OccurredAt,
+	}
+}
+
+// PostgresUserEventBus stores events in the user_events table and relies on
+// its NOTIFY trigger to reach the subscribers of every replica, including the
+// one that published the event.
+type PostgresUserEventBus struct {
+	db          *gorm.DB
+	databaseURL string
+	subscribers *userEventSubscribers
+	logger      providers.LoggerProvider
+
+	listening     bool
+	lastDelivered uint64
+	gaps          map[uint64]time.Time
+}
+
+func NewPostgresUserEventBus(cfg *config.Config, db *gorm.DB, logger providers.LoggerProvider) *PostgresUserEventBus {
+	return &PostgresUserEventBus{
+		db:          db,
+		databaseURL: cfg.Database.URL,
+		subscribers: newUserEventSubscribers(cfg.Streams.SubscriberBuffer, logger),
+		logger:      logger,
+		gaps:        make(map[uint64]time.Time),
+	}
+}
+
+func (b *PostgresUserEventBus) Publish(ctx context.Context, event usersdomain.UserEvent) {
+	ctx, span := dbTracer.Start(ctx, "PostgresUserEventBus.Publish")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.operation", "Publish"),
+		attribute.String("user.event.type", string(event.Type)),
+		attribute.Int("user.id", int(event.User.ID)),
+	)
+
+	record := userEventRecord{
+		TenantID:   event.User.TenantID,
+		Type:       string(event.Type),
+		UserID:     event.User.ID,
+		Name:       event.User.Name,
+		Email:      event.User.Email,
+		OccurredAt: event.OccurredAt,
+	}
+	err := sharedrepo.WithTenantSession(ctx, b.db, func(tx *gorm.DB) error {
+		return tx.Create(&record).Error
+	})
+	if err != nil {
+		observability.RecordError(span, err)
+		observability.LoggerWithTrace(ctx, b.logger).Error("failed to publish user event",
+			"type", event.Type,
+			"userId", event.User.ID,
+			"error", err.Error(),
+		)
+	}
+}
+
+func (b *PostgresUserEventBus) Subscribe(ctx context.Context) <-chan usersdomain.UserEvent {
+	return b.subscribers.subscribe(ctx)
+}
+
+func (b *PostgresUserEventBus) ListSince(ctx context.Context, afterID uint64, limit int) ([]usersdomain.UserEvent, error) {
+	ctx, span := dbTracer.Start(ctx, "PostgresUserEventBus.ListSince")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.operation", "ListSince"),
+		attribute.Int64("user.event.after_id", int64(afterID)),
+	)
+
+	var records []userEventRecord
+	err := sharedrepo.WithTenantSession(ctx, b.db, func(tx *gorm.DB) error {
+		return tx.Where("id > ?", afterID).Order("id").Limit(limit).Find(&records).Error
+	})
+	if err != nil {
+		observability.RecordError(span, err)
+		return nil, exceptions.NewInternalException(map[string]any{"error": err.Error()})
+	}
+
+	events := make([]usersdomain.UserEvent, 0, len(records))
+	for _, record := range records {
+		events = append(events, record.toDomain())
+	}
+	return events, nil
+}
+
+func (b *PostgresUserEventBus) ListByUserID(ctx context.Context, userID uint) ([]usersdomain.UserEvent, error) {
+	ctx, span := dbTracer.Start(ctx, "PostgresUserEventBus.ListByUserID")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.operation", "ListByUserID"),
+		attribute.Int("user.id", int(userID)),
+	)
+
+	var records []userEventRecord
+	err := sharedrepo.WithTenantSession(ctx, b.db, func(tx *gorm.DB) error {
+		return tx.Where("user_id = ?", userID).Order("id").Find(&records).Error
+	})
+	if err != nil {
+		observability.RecordError(span, err)
+		return nil, exceptions.NewInternalException(map[string]any{"error": err.Error()})
+	}
+
+	events := make([]usersdomain.UserEvent, 0, len(records))
+	for _, record := range records {
+		events = append(events, record.toDomain())
+	}
+	return events, nil
+}
+
+func (b *PostgresUserEventBus) DeleteBefore(ctx context.Context, before time.Time) (int64, error) {
+	ctx, span := dbTracer.Start(ctx, "PostgresUserEventBus.DeleteBefore")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("db.operation", "DeleteBefore"))
+
+	var deleted int64
+	err := sharedrepo.WithSystemSession(ctx, b.db, func(tx *gorm.DB) error {
+		result := tx.Where("occurred_at < ?", before).Delete(&userEventRecord{})
+		deleted = result.RowsAffected
+		return result.Error
+	})
+	if err != nil {
+		observability.RecordError(span, err)
+		return 0, exceptions.NewInternalException(map[string]any{"error": err.Error()})
+	}
+	return deleted, nil
+}
+
+func (b *PostgresUserEventBus) DeleteByUserID(ctx context.Context, userID uint) (int64, error) {
+	ctx, span := dbTracer.Start(ctx, "PostgresUserEventBus.DeleteByUserID")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.operation", "DeleteByUserID"),
+		attribute.Int("user.id", int(userID)),
+	)
+
+	var deleted int64
+	err := sharedrepo.WithTenantSession(ctx, b.db, func(tx *gorm.DB) error {
+		result := tx.Where("user_id = ?", userID).Delete(&userEventRecord{})
+		deleted = result.RowsAffected
+		return result.Error
+	})
+	if err != nil {
+		observability.RecordError(span, err)
+		return 0, exceptions.NewInternalException(map[string]any{"error": err.Error()})
+	}
+	return deleted, nil
+}
+
+// Listen delivers notifications to local subscribers until ctx is canceled,
+// reconnecting after failures and replaying the events missed meanwhile.
+func (b *PostgresUserEventBus) Listen(ctx context.Context) {
+	for {
+		err := b.listenOnce(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		b.logger.Error("user event listener disconnected", "error", err.Error(), "retryIn", listenerRetryDelay.String())
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(listenerRetryDelay):
+		}
+	}
+}
+
+func (b *PostgresUserEventBus) listenOnce(ctx context.Context) error {
+	conn, err := pgx.Connect(ctx, b.databaseURL)
+	if err != nil {
+		return err
+	}
+	defer conn.Close(context.Background())
+
+	if _, err := conn.Exec(ctx, "LISTEN "+notifyChannel); err != nil {
+		return err
+	}
+	if err := b.catchUp(ctx); err != nil {
+		return err
+	}
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+
+		var record userEventRecord
+		if err := json.Unmarshal([]byte(notification.Payload), &record); err != nil {
+			b.logger.Error("invalid user event notification", "error", err.Error())
+			continue
+		}
+		b.deliver(record)
+	}
+}
+
+// catchUp delivers the events stored while the listener was disconnected,
+// including those that filled a gap below the last delivered id. On the first
+// connection it only records where the log currently ends.
+func (b *PostgresUserEventBus) catchUp(ctx context.Context) error {
+	return sharedrepo.WithSystemSession(ctx, b.db, func(db *gorm.DB) error {
+		if !b.listening {
+			if err := db.Model(&userEventRecord{}).Select("COALESCE(MAX(id), 0)").Scan(&b.lastDelivered).Error; err != nil {
+				return err
+			}
+			b.listening = true
+			return nil
+		}
+
+		b.expireGaps(time.Now())
+		if len(b.gaps) > 0 {
+			var records []userEventRecord
+			if err := db.Where("id IN ?", slices.Sorted(maps.Keys(b.gaps))).Order("id").Find(&records).Error; err != nil {
+				return err
+			}
+			for _, record := range records {
+				b.deliver(record)
+			}
+		}
+
+		for {
+			var records []userEventRecord
+			if err := db.Where("id > ?", b.lastDelivered).Order("id").Limit(catchUpBatchSize).Find(&records).Error; err != nil {
+				return err
+			}
+			for _, record := range records {
+				b.deliver(record)
+			}
+			if len(records) < catchUpBatchSize {
+				return nil
+			}
+		}
+	})
+}
+
+// deliver broadcasts record unless it was delivered before, and remembers the
+// ids skipped on the way to it as gaps.
+func (b *PostgresUserEventBus) deliver(record userEventRecord) {
+	switch {
+	case record.ID > b.lastDelivered:
+		now := time.Now()
+		for id := max(b.lastDelivered+1, record.ID-min(record.ID, maxTrackedGaps)); id < record.ID; id++ {
+			b.gaps[id] = now
+		}
+		b.lastDelivered = record.ID
+	case b.gaps[record.ID].IsZero():
+		return
+	default:
+		delete(b.gaps, record.ID)
+	}
+	b.subscribers.broadcast(record.toDomain())
+}
+
+func (b *PostgresUserEventBus) expireGaps(now time.Time) {
+	for id, seen := range b.gaps {
+		if now.Sub(seen) > gapGracePeriod {
+			delete(b.gaps, id)
+		}
+	}
+	if excess := len(b.gaps) - maxTrackedGaps; excess > 0 {
+		for _, id := range slices.Sorted(maps.Keys(b.gaps))[:excess] {
+			delete(b.gaps, id)
+		}
+	}
+}
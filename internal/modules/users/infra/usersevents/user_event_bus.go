@@ -0,0 +1,57 @@
+package usersevents
+
+import (
+	"context"
+
+	"golang_boilerplate_module/internal/config"
+	"golang_boilerplate_module/internal/modules/users/usersdomain"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+	"golang_boilerplate_module/internal/shared/infra/persistence"
+
+	"go.uber.org/fx"
+	"gorm.io/gorm"
+)
+
+type UserEventBus interface {
+	usersdomain.UserEventPublisher
+	usersdomain.UserEventSubscriber
+	usersdomain.UserEventLog
+}
+
+// NewUserEventBus falls back to the in-memory bus on a database without
+// LISTEN/NOTIFY, which the postgres bus delivers through.
+func NewUserEventBus(cfg *config.Config, db *gorm.DB, logger providers.LoggerProvider) UserEventBus {
+	if cfg.Streams.EventBus == "memory" || !persistence.Supports(db, persistence.ListenNotify) {
+		return NewInMemoryUserEventBus(cfg, logger)
+	}
+	return NewPostgresUserEventBus(cfg, db, logger)
+}
+
+func RegisterUserEventListener(lc fx.Lifecycle, bus UserEventBus, logger providers.LoggerProvider) {
+	listener, ok := bus.(*PostgresUserEventBus)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	lc.Append(fx.Hook{
+		OnStart: func(_ context.Context) error {
+			go func() {
+				defer close(done)
+				listener.Listen(ctx)
+			}()
+			logger.Info("User event listener started", "channel", notifyChannel)
+			return nil
+		},
+		OnStop: func(stopCtx context.Context) error {
+			cancel()
+			select {
+			case <-done:
+			case <-stopCtx.Done():
+			}
+			return nil
+		},
+	})
+}
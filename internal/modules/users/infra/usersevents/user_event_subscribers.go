@@ -0,0 +1,72 @@
+package usersevents
+
+import (
+	"context"
+	"sync"
+
+	"golang_boilerplate_module/internal/modules/users/usersdomain"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+	"golang_boilerplate_module/internal/shared/domain/tenancy"
+)
+
+// userEventSubscribers fans events out to the local subscribers of each
+// tenant. A subscriber whose buffer is full is disconnected instead of
+// blocking the publisher; it can resume from the event log.
+type userEventSubscribers struct {
+	mu       sync.Mutex
+	buffer   int
+	channels map[chan usersdomain.UserEvent]string
+	logger   providers.LoggerProvider
+}
+
+func newUserEventSubscribers(buffer int, logger providers.LoggerProvider) *userEventSubscribers {
+	return &userEventSubscribers{
+		buffer:   buffer,
+		channels: make(map[chan usersdomain.UserEvent]string),
+		logger:   logger,
+	}
+}
+
+func (s *userEventSubscribers) subscribe(ctx context.Context) <-chan usersdomain.UserEvent {
+	tenantID, _ := tenancy.TenantIDFromContext(ctx)
+	ch := make(chan usersdomain.UserEvent, s.buffer)
+
+	s.mu.Lock()
+	s.channels[ch] = tenantID
+	s.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		s.remove(ch)
+	}()
+
+	return ch
+}
+
+func (s *userEventSubscribers) broadcast(event usersdomain.UserEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for ch, tenantID := range s.channels {
+		if tenantID != event.User.TenantID {
+			continue
+		}
+		select {
+		case ch <- event:
+		default:
+			s.logger.Warn("user event subscriber disconnected for falling behind", "eventId", event.ID, "tenantId", tenantID)
+			delete(s.channels, ch)
+			close(ch)
+		}
+	}
+}
+
+func (s *userEventSubscribers) remove(ch chan usersdomain.UserEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.channels[ch]; ok {
+		delete(s.channels, ch)
+		close(ch)
+	}
+}
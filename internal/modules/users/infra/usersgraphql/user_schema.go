@@ -0,0 +1,225 @@
+package usersgraphql
+
+import (
+	"context"
+	"strconv"
+
+	"golang_boilerplate_module/internal/modules/users/application/usersusecases"
+	"golang_boilerplate_module/internal/shared/domain/bus"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/infra/http/graphqlapi"
+
+	"github.com/graphql-go/graphql"
+)
+
+const (
+	userLoaderName  = "users.byID"
+	defaultPageSize = 20
+)
+
+var userType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "User",
+	Fields: graphql.Fields{
+		"id":    &graphql.Field{Type: graphql.NewNonNull(graphql.ID)},
+		"name":  &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+		"email": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+	},
+})
+
+var userConnectionType = graphqlapi.ConnectionType(userType)
+
+var createUserInputType = graphql.NewInputObject(graphql.InputObjectConfig{
+	Name: "CreateUserInput",
+	Fields: graphql.InputObjectConfigFieldMap{
+		"name":  &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+		"email": &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+	},
+})
+
+var updateUserInputType = graphql.NewInputObject(graphql.InputObjectConfig{
+	Name: "UpdateUserInput",
+	Fields: graphql.InputObjectConfigFieldMap{
+		"name":  &graphql.InputObjectFieldConfig{Type: graphql.String},
+		"email": &graphql.InputObjectFieldConfig{Type: graphql.String},
+	},
+})
+
+type UserSchema struct {
+	createUser    bus.Handler[usersusecases.CreateUserInput, usersusecases.UserOutput]
+	getUsersByIDs *usersusecases.GetUsersByIDsUseCase
+	listUsers     *usersusecases.ListUsersUseCase
+	updateUser    *usersusecases.UpdateUserUseCase
+	deleteUser    *usersusecases.DeleteUserUseCase
+}
+
+func NewUserSchema(
+	createUser bus.Handler[usersusecases.CreateUserInput, usersusecases.UserOutput],
+	getUsersByIDs *usersusecases.GetUsersByIDsUseCase,
+	listUsers *usersusecases.ListUsersUseCase,
+	updateUser *usersusecases.UpdateUserUseCase,
+	deleteUser *usersusecases.DeleteUserUseCase,
+) *UserSchema {
+	return &UserSchema{
+		createUser:    createUser,
+		getUsersByIDs: getUsersByIDs,
+		listUsers:     listUsers,
+		updateUser:    updateUser,
+		deleteUser:    deleteUser,
+	}
+}
+
+func (s *UserSchema) QueryFields() graphql.Fields {
+	return graphql.Fields{
+		"user": &graphql.Field{
+			Type:        userType,
+			Description: "Fetches a user by ID; lookups in the same request are batched into one query",
+			Args: graphql.FieldConfigArgument{
+				"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+			},
+			Resolve: s.resolveUser,
+		},
+		"users": &graphql.Field{
+			Type:        graphql.NewNonNull(userConnectionType),
+			Description: "Lists users ordered by ID",
+			Args:        graphqlapi.ConnectionArgs(defaultPageSize),
+			Resolve:     s.resolveUsers,
+		},
+	}
+}
+
+func (s *UserSchema) MutationFields() graphql.Fields {
+	return graphql.Fields{
+		"createUser": &graphql.Field{
+			Type: graphql.NewNonNull(userType),
+			Args: graphql.FieldConfigArgument{
+				"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(createUserInputType)},
+			},
+			Resolve: s.resolveCreateUser,
+		},
+		"updateUser": &graphql.Field{
+			Type:        graphql.NewNonNull(userType),
+			Description: "Requires a session of the user or of an administrator (USERS_ADMINS)",
+			Args: graphql.FieldConfigArgument{
+				"id":    &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+				"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(updateUserInputType)},
+			},
+			Resolve: s.resolveUpdateUser,
+		},
+		"deleteUser": &graphql.Field{
+			Type:        graphql.NewNonNull(graphql.Boolean),
+			Description: "Requires a session of the user or of an administrator (USERS_ADMINS)",
+			Args: graphql.FieldConfigArgument{
+				"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+			},
+			Resolve: s.resolveDeleteUser,
+		},
+	}
+}
+
+func (s *UserSchema) resolveUser(p graphql.ResolveParams) (any, error) {
+	id, err := parseUserID(p.Args["id"])
+	if err != nil {
+		return nil, err
+	}
+	return graphqlapi.LoaderFor(p.Context, userLoaderName, s.loadUsers).Load(p.Context, id), nil
+}
+
+func (s *UserSchema) loadUsers(ctx context.Context, ids []uint) (map[uint]usersusecases.UserOutput, error) {
+	return s.getUsersByIDs.Execute(ctx, ids)
+}
+
+func (s *UserSchema) resolveUsers(p graphql.ResolveParams) (any, error) {
+	input := usersusecases.ListUsersInput{}
+	if first, ok := p.Args["first"].(int); ok {
+		input.Limit = first
+	}
+	if after, ok := p.Args["after"].(string); ok && after != "" {
+		value, err := graphqlapi.DecodeCursor(after)
+		if err != nil {
+			return nil, err
+		}
+		afterID, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return nil, exceptions.NewBadRequestException("Invalid cursor", nil)
+		}
+		input.AfterID = uint(afterID)
+	}
+
+	output, err := s.listUsers.Execute(p.Context, input)
+	if err != nil {
+		return nil, err
+	}
+
+	connection := graphqlapi.Connection{Edges: make([]graphqlapi.Edge, 0, len(output.Users))}
+	for _, user := range output.Users {
+		connection.Edges = append(connection.Edges, graphqlapi.Edge{
+			Cursor: graphqlapi.EncodeCursor(strconv.FormatUint(uint64(user.ID), 10)),
+			Node:   user,
+		})
+	}
+	if n := len(connection.Edges); n > 0 {
+		connection.PageInfo.EndCursor = &connection.Edges[n-1].Cursor
+	}
+	connection.PageInfo.HasNextPage = output.NextAfterID != 0
+	return connection, nil
+}
+
+func (s *UserSchema) resolveCreateUser(p graphql.ResolveParams) (any, error) {
+	input, _ := p.Args["input"].(map[string]any)
+	name, _ := input["name"].(string)
+	email, _ := input["email"].(string)
+
+	return s.createUser.Handle(p.Context, usersusecases.CreateUserInput{Name: name, Email: email})
+}
+
+func (s *UserSchema) resolveUpdateUser(p graphql.ResolveParams) (any, error) {
+	actorID, id, err := changeTarget(p)
+	if err != nil {
+		return nil, err
+	}
+
+	input, _ := p.Args["input"].(map[string]any)
+	var update usersusecases.UpdateUserInput
+	if name, ok := input["name"].(string); ok {
+		update.Name = &name
+	}
+	if email, ok := input["email"].(string); ok {
+		update.Email = &email
+	}
+
+	return s.updateUser.Execute(p.Context, actorID, id, update)
+}
+
+func (s *UserSchema) resolveDeleteUser(p graphql.ResolveParams) (any, error) {
+	actorID, id, err := changeTarget(p)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.deleteUser.Execute(p.Context, actorID, id); err != nil {
+		return nil, err
+	}
+	return true, nil
+}
+
+// changeTarget returns the session's user and the ID of the user a mutation
+// changes; the use cases decide whether the one may change the other.
+func changeTarget(p graphql.ResolveParams) (actorID, id uint, err error) {
+	actorID, err = graphqlapi.RequireCurrentUserID(p.Context)
+	if err != nil {
+		return 0, 0, err
+	}
+	id, err = parseUserID(p.Args["id"])
+	if err != nil {
+		return 0, 0, err
+	}
+	return actorID, id, nil
+}
+
+func parseUserID(value any) (uint, error) {
+	raw, _ := value.(string)
+	id, err := strconv.ParseUint(raw, 10, 64)
+	if err != nil {
+		return 0, exceptions.NewBadRequestException("Invalid user ID", nil)
+	}
+	return uint(id), nil
+}
@@ -0,0 +1,244 @@
+package usersgrpc
+
+import (
+	"context"
+	"strconv"
+
+	"golang_boilerplate_module/internal/modules/users/application/usersusecases"
+	"golang_boilerplate_module/internal/modules/users/infra/usersgrpc/userspb"
+	"golang_boilerplate_module/internal/modules/users/usersdomain"
+	"golang_boilerplate_module/internal/shared/domain/bus"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+	"golang_boilerplate_module/internal/shared/infra/grpcserver"
+	"golang_boilerplate_module/internal/shared/infra/observability"
+	"golang_boilerplate_module/internal/shared/infra/streaming"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var tracer = otel.Tracer("users.grpc")
+
+const userStreamName = "users"
+
+var eventTypes = map[usersdomain.UserEventType]userspb.UserEventType{
+	usersdomain.UserCreated: userspb.UserEventType_USER_EVENT_TYPE_CREATED,
+	usersdomain.UserUpdated: userspb.UserEventType_USER_EVENT_TYPE_UPDATED,
+	usersdomain.UserDeleted: userspb.UserEventType_USER_EVENT_TYPE_DELETED,
+}
+
+type UserServer struct {
+	userspb.UnimplementedUserServiceServer
+
+	createUser bus.Handler[usersusecases.CreateUserInput, usersusecases.UserOutput]
+	getUser    bus.Handler[uint, usersusecases.UserOutput]
+	listUsers  *usersusecases.ListUsersUseCase
+	updateUser *usersusecases.UpdateUserUseCase
+	deleteUser *usersusecases.DeleteUserUseCase
+	watchUsers *usersusecases.WatchUsersUseCase
+	streams    *streaming.Manager
+	logger     providers.LoggerProvider
+}
+
+func NewUserServer(
+	createUser bus.Handler[usersusecases.CreateUserInput, usersusecases.UserOutput],
+	getUser bus.Handler[uint, usersusecases.UserOutput],
+	listUsers *usersusecases.ListUsersUseCase,
+	updateUser *usersusecases.UpdateUserUseCase,
+	deleteUser *usersusecases.DeleteUserUseCase,
+	watchUsers *usersusecases.WatchUsersUseCase,
+	streams *streaming.Manager,
+	logger providers.LoggerProvider,
+) *UserServer {
+	return &UserServer{
+		createUser: createUser,
+		getUser:    getUser,
+		listUsers:  listUsers,
+		updateUser: updateUser,
+		deleteUser: deleteUser,
+		watchUsers: watchUsers,
+		streams:    streams,
+		logger:     logger,
+	}
+}
+
+func (s *UserServer) Register(registrar grpc.ServiceRegistrar) {
+	userspb.RegisterUserServiceServer(registrar, s)
+}
+
+// AuthenticatedMethods are refused without a session, like their HTTP and
+// GraphQL counterparts.
+func (s *UserServer) AuthenticatedMethods() []string {
+	return []string{
+		userspb.UserService_UpdateUser_FullMethodName,
+		userspb.UserService_DeleteUser_FullMethodName,
+		userspb.UserService_WatchUsers_FullMethodName,
+	}
+}
+
+func (s *UserServer) CreateUser(ctx context.Context, req *userspb.CreateUserRequest) (*userspb.CreateUserResponse, error) {
+	ctx, span := tracer.Start(ctx, "UserServer.CreateUser")
+	defer span.End()
+
+	output, err := s.createUser.Handle(ctx, usersusecases.CreateUserInput{
+		Name:  req.GetName(),
+		Email: req.GetEmail(),
+	})
+	if err != nil {
+		observability.RecordError(span, err)
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.Int("user.id", int(output.ID)))
+	return &userspb.CreateUserResponse{User: toProtoUser(output)}, nil
+}
+
+func (s *UserServer) GetUser(ctx context.Context, req *userspb.GetUserRequest) (*userspb.GetUserResponse, error) {
+	ctx, span := tracer.Start(ctx, "UserServer.GetUser")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("user.id", int(req.GetId())))
+
+	output, err := s.getUser.Handle(ctx, uint(req.GetId()))
+	if err != nil {
+		observability.RecordError(span, err)
+		return nil, err
+	}
+
+	return &userspb.GetUserResponse{User: toProtoUser(output)}, nil
+}
+
+func (s *UserServer) ListUsers(ctx context.Context, req *userspb.ListUsersRequest) (*userspb.ListUsersResponse, error) {
+	ctx, span := tracer.Start(ctx, "UserServer.ListUsers")
+	defer span.End()
+
+	log := grpcserver.LoggerFromContext(ctx, s.logger).With("handler", "UserServer.ListUsers")
+
+	var afterID uint64
+	if token := req.GetPageToken(); token != "" {
+		var err error
+		afterID, err = strconv.ParseUint(token, 10, 64)
+		if err != nil {
+			domainErr := exceptions.NewBadRequestException("Invalid page token", nil)
+			log.Warn("invalid page token", "pageToken", token)
+			observability.RecordError(span, domainErr)
+			return nil, domainErr
+		}
+	}
+
+	output, err := s.listUsers.Execute(ctx, usersusecases.ListUsersInput{
+		AfterID: uint(afterID),
+		Limit:   int(req.GetPageSize()),
+	})
+	if err != nil {
+		observability.RecordError(span, err)
+		return nil, err
+	}
+
+	resp := &userspb.ListUsersResponse{Users: make([]*userspb.User, 0, len(output.Users))}
+	for _, user := range output.Users {
+		resp.Users = append(resp.Users, toProtoUser(user))
+	}
+	if output.NextAfterID != 0 {
+		resp.NextPageToken = strconv.FormatUint(uint64(output.NextAfterID), 10)
+	}
+	return resp, nil
+}
+
+func (s *UserServer) UpdateUser(ctx context.Context, req *userspb.UpdateUserRequest) (*userspb.UpdateUserResponse, error) {
+	ctx, span := tracer.Start(ctx, "UserServer.UpdateUser")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("user.id", int(req.GetId())))
+
+	actorID, err := grpcserver.RequireCurrentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	output, err := s.updateUser.Execute(ctx, actorID, uint(req.GetId()), usersusecases.UpdateUserInput{
+		Name:  req.Name,
+		Email: req.Email,
+	})
+	if err != nil {
+		observability.RecordError(span, err)
+		return nil, err
+	}
+
+	return &userspb.UpdateUserResponse{User: toProtoUser(output)}, nil
+}
+
+func (s *UserServer) DeleteUser(ctx context.Context, req *userspb.DeleteUserRequest) (*userspb.DeleteUserResponse, error) {
+	ctx, span := tracer.Start(ctx, "UserServer.DeleteUser")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("user.id", int(req.GetId())))
+
+	actorID, err := grpcserver.RequireCurrentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.deleteUser.Execute(ctx, actorID, uint(req.GetId())); err != nil {
+		observability.RecordError(span, err)
+		return nil, err
+	}
+
+	return &userspb.DeleteUserResponse{}, nil
+}
+
+func (s *UserServer) WatchUsers(_ *userspb.WatchUsersRequest, stream grpc.ServerStreamingServer[userspb.WatchUsersResponse]) error {
+	ctx := stream.Context()
+	log := grpcserver.LoggerFromContext(ctx, s.logger).With("handler", "UserServer.WatchUsers")
+
+	conn, err := s.streams.Open(ctx, userStreamName, streaming.TransportGRPC)
+	if err != nil {
+		return err
+	}
+
+	events, err := s.watchUsers.Execute(conn.Context(), usersusecases.WatchUsersInput{})
+	if err != nil {
+		conn.Close(streaming.DisconnectError)
+		return err
+	}
+	if err := stream.SendHeader(nil); err != nil {
+		conn.Close(streaming.DisconnectError)
+		return err
+	}
+
+	log.Info("user watch started")
+	defer log.Info("user watch ended")
+
+	for event := range events {
+		err := stream.Send(&userspb.WatchUsersResponse{
+			Type: eventTypes[event.Type],
+			User: &userspb.User{
+				Id:    uint64(event.User.ID),
+				Name:  event.User.Name,
+				Email: event.User.Email,
+			},
+			OccurredAt: timestamppb.New(event.OccurredAt),
+		})
+		if err != nil {
+			conn.Close(streaming.DisconnectClient)
+			return err
+		}
+		conn.Delivered()
+	}
+
+	reason := conn.EndReason()
+	conn.Close(reason)
+	if reason == streaming.DisconnectSlowConsumer {
+		return exceptions.NewServiceUnavailableException("Stream consumer fell behind", nil)
+	}
+	return ctx.Err()
+}
+
+func toProtoUser(user usersusecases.UserOutput) *userspb.User {
+	return &userspb.User{
+		Id:    uint64(user.ID),
+		Name:  user.Name,
+		Email: user.Email,
+	}
+}
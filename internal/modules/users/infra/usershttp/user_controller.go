@@ -4,6 +4,7 @@ import (
 	"strconv"
 
 	"golang_boilerplate_module/internal/modules/users/application/usersusecases"
+	"golang_boilerplate_module/internal/shared/domain/bus"
 	"golang_boilerplate_module/internal/shared/domain/exceptions"
 	"golang_boilerplate_module/internal/shared/domain/providers"
 	"golang_boilerplate_module/internal/shared/infra/http/middleware"
@@ -17,14 +18,14 @@ import (
 var tracer = otel.Tracer("users.http")
 
 type UserController struct {
-	createUser *usersusecases.CreateUserUseCase
-	getUser    *usersusecases.GetUserUseCase
+	createUser bus.Handler[usersusecases.CreateUserInput, usersusecases.UserOutput]
+	getUser    bus.Handler[uint, usersusecases.UserOutput]
 	logger     providers.LoggerProvider
 }
 
 func NewUserController(
-	createUser *usersusecases.CreateUserUseCase,
-	getUser *usersusecases.GetUserUseCase,
+	createUser bus.Handler[usersusecases.CreateUserInput, usersusecases.UserOutput],
+	getUser bus.Handler[uint, usersusecases.UserOutput],
 	logger providers.LoggerProvider,
 ) *UserController {
 	return &UserController{
@@ -48,7 +49,7 @@ func (ctrl *UserController) Create(c *fiber.Ctx) error {
 		return domainErr
 	}
 
-	output, err := ctrl.createUser.Execute(ctx, input)
+	output, err := ctrl.createUser.Handle(ctx, input)
 	if err != nil {
 		observability.RecordError(span, err)
 		return err
@@ -75,7 +76,7 @@ func (ctrl *UserController) GetByID(c *fiber.Ctx) error {
 
 	span.SetAttributes(attribute.Int("user.id", int(id)))
 
-	output, err := ctrl.getUser.Execute(ctx, uint(id))
+	output, err := ctrl.getUser.Handle(ctx, uint(id))
 	if err != nil {
 		observability.RecordError(span, err)
 		return err
@@ -1,9 +1,42 @@
 package usershttp
 
-import "github.com/gofiber/fiber/v2"
+import (
+	"golang_boilerplate_module/internal/modules/users/application/usersusecases"
+	"golang_boilerplate_module/internal/shared/infra/http/openapi"
 
-func RegisterRoutes(app *fiber.App, controller *UserController) {
-	api := app.Group("/api")
-	api.Post("/users", controller.Create)
-	api.Get("/users/:id", controller.GetByID)
+	"github.com/gofiber/fiber/v2"
+)
+
+func RegisterRoutes(router *openapi.Router, controller *UserController, streamController *UserStreamController) {
+	api := router.Version("v1", openapi.Tags("Users"))
+	api.Post("/users", controller.Create,
+		openapi.Summary("Create a user"),
+		openapi.Body[usersusecases.CreateUserInput](),
+		openapi.Returns[usersusecases.UserOutput](fiber.StatusCreated),
+		openapi.Errors(fiber.StatusBadRequest, fiber.StatusUnprocessableEntity),
+	)
+	api.Get("/users/stream", streamController.Events,
+		openapi.Summary("Stream user changes as Server-Sent Events"),
+		openapi.QueryParam("last_event_id", "integer", "Resume after this event ID; the Last-Event-ID header takes precedence"),
+		openapi.QueryParam("types", "string", "Comma-separated event types to include"),
+		openapi.QueryParam("user_ids", "string", "Comma-separated user IDs to include"),
+		openapi.EventStream[UserChangeOutput]("User change events"),
+		openapi.Authenticated(),
+		openapi.Errors(fiber.StatusBadRequest, fiber.StatusServiceUnavailable),
+	)
+	api.Get("/users/ws", streamController.WebSocket,
+		openapi.Summary("Stream user changes over a WebSocket"),
+		openapi.QueryParam("last_event_id", "integer", "Resume after this event ID"),
+		openapi.QueryParam("types", "string", "Comma-separated event types to include"),
+		openapi.QueryParam("user_ids", "string", "Comma-separated user IDs to include"),
+		openapi.WebSocket("Switches to a WebSocket carrying user change events"),
+		openapi.Authenticated(),
+		openapi.Errors(fiber.StatusBadRequest, fiber.StatusServiceUnavailable),
+	)
+	api.Get("/users/:id", controller.GetByID,
+		openapi.Summary("Get a user by ID"),
+		openapi.PathParam("id", "integer", "User ID"),
+		openapi.Returns[usersusecases.UserOutput](fiber.StatusOK),
+		openapi.Errors(fiber.StatusBadRequest, fiber.StatusNotFound),
+	)
 }
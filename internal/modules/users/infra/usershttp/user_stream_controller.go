@@ -0,0 +1,143 @@
+package usershttp
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang_boilerplate_module/internal/modules/users/application/usersusecases"
+	"golang_boilerplate_module/internal/modules/users/usersdomain"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+	"golang_boilerplate_module/internal/shared/infra/http/middleware"
+	"golang_boilerplate_module/internal/shared/infra/observability"
+	"golang_boilerplate_module/internal/shared/infra/streaming"
+
+	"github.com/gofiber/contrib/websocket"
+	"github.com/gofiber/fiber/v2"
+)
+
+const userStreamName = "users"
+
+type UserChangeOutput struct {
+	Type       usersdomain.UserEventType `json:"type" validate:"required"`
+	User       usersusecases.UserOutput  `json:"user" validate:"required"`
+	OccurredAt time.Time                 `json:"occurred_at" validate:"required"`
+}
+
+type UserStreamController struct {
+	watchUsers *usersusecases.WatchUsersUseCase
+	streams    *streaming.Manager
+	logger     providers.LoggerProvider
+}
+
+func NewUserStreamController(
+	watchUsers *usersusecases.WatchUsersUseCase,
+	streams *streaming.Manager,
+	logger providers.LoggerProvider,
+) *UserStreamController {
+	return &UserStreamController{
+		watchUsers: watchUsers,
+		streams:    streams,
+		logger:     logger,
+	}
+}
+
+func (ctrl *UserStreamController) Events(c *fiber.Ctx) error {
+	return ctrl.serve(c, streaming.TransportSSE)
+}
+
+func (ctrl *UserStreamController) WebSocket(c *fiber.Ctx) error {
+	if !websocket.IsWebSocketUpgrade(c) {
+		return exceptions.NewBadRequestException("WebSocket upgrade required", nil)
+	}
+	return ctrl.serve(c, streaming.TransportWebSocket)
+}
+
+func (ctrl *UserStreamController) serve(c *fiber.Ctx, transport string) error {
+	_, span := tracer.Start(c.UserContext(), "UserStreamController.Open")
+	defer span.End()
+
+	log := middleware.LoggerFromLocals(c, ctrl.logger).With("handler", "UserStreamController", "transport", transport)
+
+	input, err := parseWatchInput(c)
+	if err != nil {
+		log.Warn("invalid stream parameters", "error", err.Error())
+		observability.RecordError(span, err)
+		return err
+	}
+
+	// The stream outlives the handler, whose context the tracing middleware
+	// cancels on return; a client that goes away surfaces as a failed write.
+	conn, err := ctrl.streams.Open(context.WithoutCancel(c.UserContext()), userStreamName, transport)
+	if err != nil {
+		observability.RecordError(span, err)
+		return err
+	}
+
+	events, err := ctrl.watchUsers.Execute(conn.Context(), input)
+	if err != nil {
+		conn.Close(streaming.DisconnectError)
+		observability.RecordError(span, err)
+		return err
+	}
+
+	if transport == streaming.TransportWebSocket {
+		return streaming.UpgradeWebSocket(c, conn, events, toStreamMessage)
+	}
+	return streaming.ServeSSE(c, conn, events, toStreamMessage)
+}
+
+func parseWatchInput(c *fiber.Ctx) (usersusecases.WatchUsersInput, error) {
+	var input usersusecases.WatchUsersInput
+
+	lastEventID := c.Get("Last-Event-ID", c.Query("last_event_id"))
+	if lastEventID != "" {
+		id, err := strconv.ParseUint(lastEventID, 10, 64)
+		if err != nil {
+			return input, exceptions.NewBadRequestException("Invalid last event ID", nil)
+		}
+		input.LastEventID = id
+	}
+
+	for _, eventType := range splitQuery(c.Query("types")) {
+		input.Types = append(input.Types, usersdomain.UserEventType(eventType))
+	}
+
+	for _, raw := range splitQuery(c.Query("user_ids")) {
+		id, err := strconv.ParseUint(raw, 10, 64)
+		if err != nil {
+			return input, exceptions.NewBadRequestException("Invalid user ID filter", map[string]any{"user_id": raw})
+		}
+		input.UserIDs = append(input.UserIDs, uint(id))
+	}
+
+	return input, nil
+}
+
+func splitQuery(value string) []string {
+	var parts []string
+	for _, part := range strings.Split(value, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return parts
+}
+
+func toStreamMessage(event usersdomain.UserEvent) streaming.Message {
+	return streaming.Message{
+		ID:    strconv.FormatUint(event.ID, 10),
+		Event: string(event.Type),
+		Data: UserChangeOutput{
+			Type: event.Type,
+			User: usersusecases.UserOutput{
+				ID:    event.User.ID,
+				Name:  event.User.Name,
+				Email: event.User.Email,
+			},
+			OccurredAt: event.OccurredAt,
+		},
+	}
+}
@@ -0,0 +1,20 @@
+package usersjobs
+
+import (
+	"context"
+
+	"golang_boilerplate_module/internal/config"
+	"golang_boilerplate_module/internal/modules/users/application/usersusecases"
+	"golang_boilerplate_module/internal/shared/infra/platform"
+)
+
+func NewUserEventCleanupJob(cfg *config.Config, pruneUserEvents *usersusecases.PruneUserEventsUseCase) platform.Job {
+	return platform.Job{
+		Name:     "user_event_cleanup",
+		Interval: cfg.Streams.CleanupInterval,
+		Run: func(ctx context.Context) error {
+			_, err := pruneUserEvents.Execute(ctx)
+			return err
+		},
+	}
+}
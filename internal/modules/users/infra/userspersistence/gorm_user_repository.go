@@ -36,7 +36,9 @@ func (r *GORMUserRepository) GetByEmail(ctx context.Context, email string) (*use
 	span.SetAttributes(attribute.String("db.operation", "GetByEmail"))
 
 	var user usersdomain.User
-	err := r.db.WithContext(ctx).Where("email = ?", email).First(&user).Error
+	err := sharedrepo.WithTenantReadSession(ctx, r.db, func(tx *gorm.DB) error {
+		return tx.Where("email = ?", email).First(&user).Error
+	})
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		notFound := exceptions.NewNotFoundException("User not found", nil)
 		span.SetStatus(codes.Error, "not found")
@@ -45,9 +47,31 @@ func (r *GORMUserRepository) GetByEmail(ctx context.Context, email string) (*use
 	if err != nil {
 		span.SetStatus(codes.Error, err.Error())
 		span.RecordError(err)
-		return nil, exceptions.NewInternalException(map[string]any{"error": err.Error()})
+		return nil, sharedrepo.DatabaseError(err)
 	}
 
 	span.SetAttributes(attribute.Int("user.id", int(user.ID)))
 	return &user, nil
 }
+
+func (r *GORMUserRepository) List(ctx context.Context, afterID uint, limit int) ([]usersdomain.User, error) {
+	ctx, span := dbTracer.Start(ctx, "GORMUserRepository.List")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.operation", "List"),
+		attribute.Int("db.limit", limit),
+	)
+
+	var users []usersdomain.User
+	err := sharedrepo.WithTenantReadSession(ctx, r.db, func(tx *gorm.DB) error {
+		return tx.Where("id > ?", afterID).Order("id ASC").Limit(limit).Find(&users).Error
+	})
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+		return nil, sharedrepo.DatabaseError(err)
+	}
+
+	return users, nil
+}
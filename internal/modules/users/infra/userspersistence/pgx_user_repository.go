@@ -0,0 +1,65 @@
+package userspersistence
+
+import (
+	"context"
+
+	"golang_boilerplate_module/internal/modules/users/usersdomain"
+	"golang_boilerplate_module/internal/modules/users/usersdomain/usersrepo"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	sharedrepo "golang_boilerplate_module/internal/shared/infra/persistence/repositories"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"gorm.io/gorm"
+)
+
+type PgxUserRepository struct {
+	*sharedrepo.PgxGenericRepository[usersdomain.User, uint]
+}
+
+func NewPgxUserRepository(db *gorm.DB) usersrepo.UserRepository {
+	return &PgxUserRepository{
+		PgxGenericRepository: sharedrepo.NewPgxGenericRepository[usersdomain.User, uint](db),
+	}
+}
+
+func (r *PgxUserRepository) GetByEmail(ctx context.Context, email string) (*usersdomain.User, error) {
+	ctx, span := dbTracer.Start(ctx, "PgxUserRepository.GetByEmail")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("db.operation", "GetByEmail"))
+
+	users, err := r.Select(ctx, "email = $1", "ORDER BY id LIMIT 1", email)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+		return nil, sharedrepo.DatabaseError(err)
+	}
+	if len(users) == 0 {
+		notFound := exceptions.NewNotFoundException("User not found", nil)
+		span.SetStatus(codes.Error, "not found")
+		return nil, notFound
+	}
+
+	span.SetAttributes(attribute.Int("user.id", int(users[0].ID)))
+	return &users[0], nil
+}
+
+func (r *PgxUserRepository) List(ctx context.Context, afterID uint, limit int) ([]usersdomain.User, error) {
+	ctx, span := dbTracer.Start(ctx, "PgxUserRepository.List")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.operation", "List"),
+		attribute.Int("db.limit", limit),
+	)
+
+	users, err := r.Select(ctx, "id > $1", "ORDER BY id ASC LIMIT $2", afterID, limit)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+		return nil, sharedrepo.DatabaseError(err)
+	}
+
+	return users, nil
+}
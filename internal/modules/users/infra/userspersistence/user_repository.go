@@ -0,0 +1,17 @@
+package userspersistence
+
+import (
+	"golang_boilerplate_module/internal/config"
+	"golang_boilerplate_module/internal/modules/users/usersdomain/usersrepo"
+
+	"gorm.io/gorm"
+)
+
+// NewUserRepository returns the implementation selected by
+// DATABASE_REPOSITORIES.
+func NewUserRepository(cfg *config.Config, db *gorm.DB) usersrepo.UserRepository {
+	if cfg.Database.Repositories == "pgx" {
+		return NewPgxUserRepository(db)
+	}
+	return NewGORMUserRepository(db)
+}
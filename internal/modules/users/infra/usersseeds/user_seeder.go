@@ -0,0 +1,42 @@
+package usersseeds
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"golang_boilerplate_module/internal/modules/users/application/usersusecases"
+	"golang_boilerplate_module/internal/shared/domain/bus"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/infra/platform"
+)
+
+// NewUserSeeder creates the users listed in fixtures/users.json, skipping
+// emails that are already taken.
+func NewUserSeeder(createUser bus.Handler[usersusecases.CreateUserInput, usersusecases.UserOutput]) platform.Seeder {
+	return platform.Seeder{
+		Name:    "users",
+		Fixture: "users.json",
+		Seed: func(ctx context.Context, fixture []byte) (int, error) {
+			var users []usersusecases.CreateUserInput
+			if err := json.Unmarshal(fixture, &users); err != nil {
+				return 0, fmt.Errorf("users.json: %w", err)
+			}
+
+			created := 0
+			for _, user := range users {
+				_, err := createUser.Handle(ctx, user)
+				var domainErr *exceptions.DomainError
+				if errors.As(err, &domainErr) && domainErr.Code == exceptions.CodeUnprocessable {
+					continue
+				}
+				if err != nil {
+					return created, fmt.Errorf("user %s: %w", user.Email, err)
+				}
+				created++
+			}
+			return created, nil
+		},
+	}
+}
@@ -2,18 +2,78 @@ package users
 
 import (
 	"golang_boilerplate_module/internal/modules/users/application/usersusecases"
+	"golang_boilerplate_module/internal/modules/users/infra/usersevents"
+	"golang_boilerplate_module/internal/modules/users/infra/usersgraphql"
+	"golang_boilerplate_module/internal/modules/users/infra/usersgrpc"
 	"golang_boilerplate_module/internal/modules/users/infra/usershttp"
+	"golang_boilerplate_module/internal/modules/users/infra/usersjobs"
 	"golang_boilerplate_module/internal/modules/users/infra/userspersistence"
+	"golang_boilerplate_module/internal/modules/users/infra/usersseeds"
+	"golang_boilerplate_module/internal/modules/users/usersdomain"
+	"golang_boilerplate_module/internal/shared/domain/privacy"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+	"golang_boilerplate_module/internal/shared/infra/grpcserver"
+	"golang_boilerplate_module/internal/shared/infra/http/graphqlapi"
+	"golang_boilerplate_module/internal/shared/infra/platform"
 
 	"go.uber.org/fx"
 )
 
-var Module = fx.Module("users",
-	fx.Provide(
-		userspersistence.NewGORMUserRepository,
+var Module = platform.Module{
+	Name:         "users",
+	Description:  "User management over HTTP, gRPC and GraphQL, with change streams",
+	ConfigPrefix: "STREAMS_",
+	Migrations:   []string{"V1__create_users.sql", "V7__create_user_events.sql"},
+	Providers: []any{
+		userspersistence.NewUserRepository,
+		fx.Annotate(
+			usersevents.NewUserEventBus,
+			fx.As(fx.Self()),
+			fx.As(new(usersdomain.UserEventPublisher)),
+			fx.As(new(usersdomain.UserEventSubscriber)),
+			fx.As(new(usersdomain.UserEventLog)),
+		),
 		usersusecases.NewCreateUserUseCase,
+		usersusecases.NewCreateUserHandler,
 		usersusecases.NewGetUserUseCase,
+		usersusecases.NewGetUserHandler,
+		usersusecases.NewGetUsersByIDsUseCase,
+		usersusecases.NewListUsersUseCase,
+		usersusecases.NewUpdateUserUseCase,
+		usersusecases.NewDeleteUserUseCase,
+		usersusecases.NewUserAccess,
+		usersusecases.NewWatchUsersUseCase,
+		usersusecases.NewPruneUserEventsUseCase,
 		usershttp.NewUserController,
-	),
-	fx.Invoke(usershttp.RegisterRoutes),
-)
+		usershttp.NewUserStreamController,
+		fx.Annotate(
+			usersgrpc.NewUserServer,
+			fx.As(new(grpcserver.Service)),
+			fx.ResultTags(grpcserver.ServicesGroup),
+		),
+		fx.Annotate(
+			usersgraphql.NewUserSchema,
+			fx.As(new(graphqlapi.Contributor)),
+			fx.ResultTags(graphqlapi.ContributorsGroup),
+		),
+		fx.Annotate(
+			usersusecases.NewUserDirectory,
+			fx.As(new(providers.UserDirectory)),
+			fx.As(new(providers.UserProvisioner)),
+		),
+		fx.Annotate(
+			usersusecases.NewUserPersonalDataProvider,
+			fx.As(new(privacy.PersonalDataExporter)),
+			fx.ResultTags(privacy.ExportersGroup),
+		),
+		fx.Annotate(
+			usersusecases.NewUserPersonalDataProvider,
+			fx.As(new(privacy.PersonalDataEraser)),
+			fx.ResultTags(privacy.ErasersGroup),
+		),
+	},
+	Routes:        []any{usershttp.RegisterRoutes},
+	EventHandlers: []any{usersevents.RegisterUserEventListener},
+	Jobs:          []any{usersjobs.NewUserEventCleanupJob},
+	Seeders:       []any{usersseeds.NewUserSeeder},
+}
@@ -4,8 +4,9 @@ import "time"
 
 type User struct {
 	ID        uint      `json:"id" gorm:"primarykey"`
+	TenantID  string    `json:"tenant_id" gorm:"uniqueIndex:idx_users_tenant_email"`
 	Name      string    `json:"name"`
-	Email     string    `json:"email" gorm:"uniqueIndex"`
+	Email     string    `json:"email" gorm:"uniqueIndex:idx_users_tenant_email"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 }
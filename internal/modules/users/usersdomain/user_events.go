@@ -0,0 +1,42 @@
+package usersdomain
+
+import (
+	"context"
+	"time"
+)
+
+type UserEventType string
+
+const (
+	UserCreated UserEventType = "created"
+	UserUpdated UserEventType = "updated"
+	UserDeleted UserEventType = "deleted"
+)
+
+type UserEvent struct {
+	ID         uint64
+	Type       UserEventType
+	User       User
+	OccurredAt time.Time
+}
+
+type UserEventPublisher interface {
+	Publish(ctx context.Context, event UserEvent)
+}
+
+// UserEventSubscriber delivers events published after Subscribe to the
+// subscriber's tenant. The channel is closed when ctx ends or when the
+// subscriber falls too far behind.
+type UserEventSubscriber interface {
+	Subscribe(ctx context.Context) <-chan UserEvent
+}
+
+// UserEventLog keeps past events so that subscribers can resume from the last
+// event they saw. ListSince and the ByUserID methods are scoped to the current
+// tenant.
+type UserEventLog interface {
+	ListSince(ctx context.Context, afterID uint64, limit int) ([]UserEvent, error)
+	ListByUserID(ctx context.Context, userID uint) ([]UserEvent, error)
+	DeleteBefore(ctx context.Context, before time.Time) (int64, error)
+	DeleteByUserID(ctx context.Context, userID uint) (int64, error)
+}
@@ -10,4 +10,6 @@ import (
 type UserRepository interface {
 	sharedrepo.GenericRepository[usersdomain.User, uint]
 	GetByEmail(ctx context.Context, email string) (*usersdomain.User, error)
+	GetByIDs(ctx context.Context, ids []uint) ([]usersdomain.User, error)
+	List(ctx context.Context, afterID uint, limit int) ([]usersdomain.User, error)
 }
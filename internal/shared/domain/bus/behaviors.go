@@ -0,0 +1,79 @@
+package bus
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/domain/validation"
+)
+
+// Validator is implemented by inputs that can check themselves.
+type Validator interface {
+	Validate() error
+}
+
+// Authorizer is implemented by handlers that restrict who may run them.
+type Authorizer[In any] interface {
+	Authorize(ctx context.Context, input In) error
+}
+
+// Validation rejects inputs that break their validate tags or Validate method.
+func Validation() Behavior {
+	return func(ctx context.Context, req Request, next Next) (any, error) {
+		if violations := validation.Struct(req.Input); len(violations) > 0 {
+			return nil, exceptions.NewBadRequestException("Invalid input: "+strings.Join(violations, "; "), map[string]any{
+				"violations": violations,
+			})
+		}
+		if validator, ok := req.Input.(Validator); ok {
+			if err := validator.Validate(); err != nil {
+				return nil, err
+			}
+		}
+		return next(ctx)
+	}
+}
+
+// Authorization runs the handler's Authorize method before it is called.
+func Authorization() Behavior {
+	return func(ctx context.Context, req Request, next Next) (any, error) {
+		if err := req.Authorize(ctx); err != nil {
+			return nil, err
+		}
+		return next(ctx)
+	}
+}
+
+// Retry runs failed queries again while the error is transient.
+func Retry(attempts int, backoff time.Duration) Behavior {
+	return func(ctx context.Context, req Request, next Next) (any, error) {
+		if req.Kind != KindQuery || req.NoRetry {
+			return next(ctx)
+		}
+
+		for attempt := 1; ; attempt++ {
+			result, err := next(ctx)
+			if err == nil || attempt >= attempts || !Transient(err) {
+				return result, err
+			}
+
+			select {
+			case <-ctx.Done():
+				return nil, err
+			case <-time.After(backoff * time.Duration(attempt)):
+			}
+		}
+	}
+}
+
+// Transient reports whether err is not the request's own fault.
+func Transient(err error) bool {
+	var domainErr *exceptions.DomainError
+	if !errors.As(err, &domainErr) {
+		return true
+	}
+	return domainErr.Code == exceptions.CodeInternal || domainErr.Code == exceptions.CodeServiceUnavailable
+}
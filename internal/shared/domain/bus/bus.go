@@ -0,0 +1,132 @@
+// Package bus dispatches commands and queries through a pipeline of behaviors.
+package bus
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"golang_boilerplate_module/internal/shared/domain/validation"
+)
+
+// Handler is implemented by use cases.
+type Handler[In, Out any] interface {
+	Handle(ctx context.Context, input In) (Out, error)
+}
+
+type HandlerFunc[In, Out any] func(ctx context.Context, input In) (Out, error)
+
+func (f HandlerFunc[In, Out]) Handle(ctx context.Context, input In) (Out, error) {
+	return f(ctx, input)
+}
+
+type Kind string
+
+const (
+	KindCommand Kind = "command"
+	KindQuery   Kind = "query"
+)
+
+// Request describes the message being dispatched to the behaviors.
+type Request struct {
+	Name          string
+	Kind          Kind
+	Input         any
+	Transactional bool
+	NoRetry       bool
+
+	authorize func(ctx context.Context) error
+}
+
+// Authorize runs the handler's authorization check, if it has one.
+func (r Request) Authorize(ctx context.Context) error {
+	if r.authorize == nil {
+		return nil
+	}
+	return r.authorize(ctx)
+}
+
+type Next func(ctx context.Context) (any, error)
+
+// Behavior wraps the dispatch of every request.
+type Behavior func(ctx context.Context, req Request, next Next) (any, error)
+
+type Bus struct {
+	behaviors []Behavior
+}
+
+// New builds a bus whose first behavior is the outermost.
+func New(behaviors ...Behavior) *Bus {
+	return &Bus{behaviors: behaviors}
+}
+
+type Option func(*Request)
+
+// Transactional runs the command inside a database transaction.
+func Transactional() Option {
+	return func(r *Request) { r.Transactional = true }
+}
+
+// WithoutRetry keeps the retry behavior from running a query again.
+func WithoutRetry() Option {
+	return func(r *Request) { r.NoRetry = true }
+}
+
+// Command exposes a state-changing handler through the bus. It panics when
+// the validate tags of In name a rule the validation behavior cannot apply.
+func Command[In, Out any](b *Bus, name string, h Handler[In, Out], opts ...Option) Handler[In, Out] {
+	verify[In](name)
+	return dispatcher[In, Out]{bus: b, name: name, kind: KindCommand, handler: h, opts: opts}
+}
+
+// Query exposes a read-only handler through the bus. It panics like Command.
+func Query[In, Out any](b *Bus, name string, h Handler[In, Out], opts ...Option) Handler[In, Out] {
+	verify[In](name)
+	return dispatcher[In, Out]{bus: b, name: name, kind: KindQuery, handler: h, opts: opts}
+}
+
+func verify[In any](name string) {
+	if err := validation.Verify(reflect.TypeFor[In]()); err != nil {
+		panic(fmt.Sprintf("bus: %s: %v", name, err))
+	}
+}
+
+type dispatcher[In, Out any] struct {
+	bus     *Bus
+	name    string
+	kind    Kind
+	handler Handler[In, Out]
+	opts    []Option
+}
+
+func (d dispatcher[In, Out]) Handle(ctx context.Context, input In) (Out, error) {
+	req := Request{Name: d.name, Kind: d.kind, Input: input}
+	for _, opt := range d.opts {
+		opt(&req)
+	}
+	if authorizer, ok := d.handler.(Authorizer[In]); ok {
+		req.authorize = func(ctx context.Context) error { return authorizer.Authorize(ctx, input) }
+	}
+
+	next := func(ctx context.Context) (any, error) {
+		return d.handler.Handle(ctx, input)
+	}
+	for i := len(d.bus.behaviors) - 1; i >= 0; i-- {
+		behavior, inner := d.bus.behaviors[i], next
+		next = func(ctx context.Context) (any, error) {
+			return behavior(ctx, req, inner)
+		}
+	}
+
+	result, err := next(ctx)
+	if err != nil {
+		var zero Out
+		return zero, err
+	}
+	output, ok := result.(Out)
+	if !ok {
+		var zero Out
+		return zero, fmt.Errorf("bus: %s returned %T, want %T", d.name, result, zero)
+	}
+	return output, nil
+}
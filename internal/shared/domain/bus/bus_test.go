@@ -0,0 +1,200 @@
+package bus_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"golang_boilerplate_module/internal/shared/domain/bus"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+)
+
+type greetInput struct {
+	Name string `json:"name" validate:"required"`
+}
+
+type greeter struct {
+	calls  int
+	errs   []error
+	denied error
+}
+
+func (g *greeter) Handle(_ context.Context, input greetInput) (string, error) {
+	g.calls++
+	if len(g.errs) > 0 {
+		err := g.errs[0]
+		g.errs = g.errs[1:]
+		return "", err
+	}
+	return "hello " + input.Name, nil
+}
+
+type guardedGreeter struct {
+	*greeter
+}
+
+func (g guardedGreeter) Authorize(context.Context, greetInput) error {
+	return g.denied
+}
+
+func record(order *[]string, name string) bus.Behavior {
+	return func(ctx context.Context, req bus.Request, next bus.Next) (any, error) {
+		*order = append(*order, name+" before "+req.Name)
+		result, err := next(ctx)
+		*order = append(*order, name+" after "+req.Name)
+		return result, err
+	}
+}
+
+func TestBus_RunsBehaviorsOutermostFirst(t *testing.T) {
+	var order []string
+	b := bus.New(record(&order, "first"), record(&order, "second"))
+	handler := bus.Command[greetInput, string](b, "Greet", &greeter{})
+
+	result, err := handler.Handle(context.Background(), greetInput{Name: "Ana"})
+	if err != nil || result != "hello Ana" {
+		t.Fatalf("expected hello Ana, got %q, %v", result, err)
+	}
+
+	want := []string{"first before Greet", "second before Greet", "second after Greet", "first after Greet"}
+	if !slices.Equal(order, want) {
+		t.Fatalf("expected %q, got %q", want, order)
+	}
+}
+
+func TestRetry(t *testing.T) {
+	transient := errors.New("connection reset")
+	notFound := exceptions.NewNotFoundException("User not found", nil)
+	unavailable := exceptions.NewServiceUnavailableException("Database unavailable", nil)
+
+	tests := []struct {
+		name      string
+		register  func(*bus.Bus, *greeter) bus.Handler[greetInput, string]
+		errs      []error
+		wantCalls int
+		wantErr   error
+	}{
+		{
+			name:      "query with a transient error",
+			register:  queryGreeter(),
+			errs:      []error{transient, transient},
+			wantCalls: 3,
+		},
+		{
+			name:      "query with an unavailable dependency",
+			register:  queryGreeter(),
+			errs:      []error{unavailable},
+			wantCalls: 2,
+		},
+		{
+			name:      "query that keeps failing",
+			register:  queryGreeter(),
+			errs:      []error{transient, transient, transient, transient},
+			wantCalls: 3,
+			wantErr:   transient,
+		},
+		{
+			name:      "query with a domain error",
+			register:  queryGreeter(),
+			errs:      []error{notFound},
+			wantCalls: 1,
+			wantErr:   notFound,
+		},
+		{
+			name:      "query without retry",
+			register:  queryGreeter(bus.WithoutRetry()),
+			errs:      []error{transient},
+			wantCalls: 1,
+			wantErr:   transient,
+		},
+		{
+			name: "command with a transient error",
+			register: func(b *bus.Bus, g *greeter) bus.Handler[greetInput, string] {
+				return bus.Command[greetInput, string](b, "Greet", g)
+			},
+			errs:      []error{transient},
+			wantCalls: 1,
+			wantErr:   transient,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &greeter{errs: tt.errs}
+			handler := tt.register(bus.New(bus.Retry(3, 0)), g)
+
+			_, err := handler.Handle(context.Background(), greetInput{Name: "Ana"})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+			if g.calls != tt.wantCalls {
+				t.Fatalf("expected %d calls, got %d", tt.wantCalls, g.calls)
+			}
+		})
+	}
+}
+
+func queryGreeter(opts ...bus.Option) func(*bus.Bus, *greeter) bus.Handler[greetInput, string] {
+	return func(b *bus.Bus, g *greeter) bus.Handler[greetInput, string] {
+		return bus.Query[greetInput, string](b, "Greet", g, opts...)
+	}
+}
+
+func TestAuthorization_DeniedBeforeTheHandler(t *testing.T) {
+	denied := exceptions.NewForbiddenException("Not allowed", nil)
+	g := &greeter{denied: denied}
+	handler := bus.Command[greetInput, string](bus.New(bus.Authorization()), "Greet", guardedGreeter{g})
+
+	_, err := handler.Handle(context.Background(), greetInput{Name: "Ana"})
+	if !errors.Is(err, denied) {
+		t.Fatalf("expected the authorization error, got %v", err)
+	}
+	if g.calls != 0 {
+		t.Fatalf("expected the handler not to run, got %d calls", g.calls)
+	}
+}
+
+func TestValidation_RejectsBrokenTags(t *testing.T) {
+	g := &greeter{}
+	handler := bus.Command[greetInput, string](bus.New(bus.Validation()), "Greet", g)
+
+	_, err := handler.Handle(context.Background(), greetInput{})
+
+	var domainErr *exceptions.DomainError
+	if !errors.As(err, &domainErr) || domainErr.Code != exceptions.CodeBadRequest {
+		t.Fatalf("expected BAD_REQUEST, got %v", err)
+	}
+	if g.calls != 0 {
+		t.Fatalf("expected the handler not to run, got %d calls", g.calls)
+	}
+}
+
+func TestBus_PanicsOnAnUnknownRule(t *testing.T) {
+	type typoInput struct {
+		Email string `validate:"required,emial"`
+	}
+
+	defer func() {
+		message, _ := recover().(string)
+		if !strings.Contains(message, `bus: Typo: field Email: unknown rule "emial"`) {
+			t.Fatalf("expected a registration panic, got %q", message)
+		}
+	}()
+	bus.Query[typoInput, string](bus.New(), "Typo", bus.HandlerFunc[typoInput, string](
+		func(context.Context, typoInput) (string, error) { return "", nil },
+	))
+}
+
+func TestBus_RejectsAResultOfTheWrongType(t *testing.T) {
+	swap := func(context.Context, bus.Request, bus.Next) (any, error) { return 42, nil }
+	handler := bus.Query[greetInput, string](bus.New(swap), "Greet", &greeter{})
+
+	result, err := handler.Handle(context.Background(), greetInput{Name: "Ana"})
+	if err == nil || err.Error() != "bus: Greet returned int, want string" {
+		t.Fatalf("expected a type mismatch error, got %v", err)
+	}
+	if result != "" {
+		t.Fatalf("expected the zero result, got %q", result)
+	}
+}
@@ -0,0 +1,22 @@
+package bus
+
+import (
+	"context"
+
+	"golang_boilerplate_module/internal/shared/domain/providers"
+)
+
+type loggerContextKey struct{}
+
+// WithLogger stores the request-scoped logger built by the logging behavior.
+func WithLogger(ctx context.Context, logger providers.LoggerProvider) context.Context {
+	return context.WithValue(ctx, loggerContextKey{}, logger)
+}
+
+// Logger returns the request-scoped logger, or fallback outside the bus.
+func Logger(ctx context.Context, fallback providers.LoggerProvider) providers.LoggerProvider {
+	if logger, ok := ctx.Value(loggerContextKey{}).(providers.LoggerProvider); ok {
+		return logger
+	}
+	return fallback
+}
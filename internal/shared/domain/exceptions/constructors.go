@@ -64,6 +64,17 @@ func NewInternalException(metadata map[string]any) *DomainError {
 	}
 }
 
+func NewTooManyRequestsException(message string, metadata map[string]any) *DomainError {
+	if message == "" {
+		message = "Too many requests"
+	}
+	return &DomainError{
+		Code:     CodeTooManyRequests,
+		Message:  message,
+		Metadata: metadata,
+	}
+}
+
 func NewServiceUnavailableException(message string, metadata map[string]any) *DomainError {
 	if message == "" {
 		message = "Service unavailable"
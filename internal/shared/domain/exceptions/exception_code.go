@@ -9,5 +9,6 @@ const (
 	CodeNotFound           ExceptionCode = "NOT_FOUND"
 	CodeUnprocessable      ExceptionCode = "UNPROCESSABLE"
 	CodeInternal           ExceptionCode = "INTERNAL"
+	CodeTooManyRequests    ExceptionCode = "TOO_MANY_REQUESTS"
 	CodeServiceUnavailable ExceptionCode = "SERVICE_UNAVAILABLE"
 )
@@ -0,0 +1,30 @@
+package privacy
+
+import "context"
+
+const (
+	ExportersGroup = `group:"privacy_exporters"`
+	ErasersGroup   = `group:"privacy_erasers"`
+)
+
+type ErasureStrategy string
+
+const (
+	ErasureStrategyAnonymize ErasureStrategy = "anonymize"
+	ErasureStrategyDelete    ErasureStrategy = "delete"
+)
+
+type ErasureResult struct {
+	Strategy        ErasureStrategy `json:"strategy"`
+	RecordsAffected int64           `json:"records_affected"`
+}
+
+type PersonalDataExporter interface {
+	Module() string
+	ExportPersonalData(ctx context.Context, userID uint) (any, error)
+}
+
+type PersonalDataEraser interface {
+	Module() string
+	ErasePersonalData(ctx context.Context, userID uint) (ErasureResult, error)
+}
@@ -0,0 +1,20 @@
+package providers
+
+import "context"
+
+// FlagSubject is who a flag is evaluated for. Rollouts bucket on UserID, or
+// on TenantID when there is no user; TenantID defaults to the tenant of the
+// context. Attributes are matched by targeting rules next to user_id and
+// tenant_id.
+type FlagSubject struct {
+	UserID     string
+	TenantID   string
+	Attributes map[string]string
+}
+
+// FeatureFlags evaluates flags. Unknown flags and failures to load them
+// return fallback, so callers never fail because of a flag.
+type FeatureFlags interface {
+	Bool(ctx context.Context, key string, subject FlagSubject, fallback bool) bool
+	Variant(ctx context.Context, key string, subject FlagSubject, fallback string) string
+}
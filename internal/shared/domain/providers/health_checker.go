@@ -0,0 +1,26 @@
+package providers
+
+import (
+	"context"
+	"errors"
+)
+
+const HealthCheckersGroup = `group:"health_checkers"`
+
+// ErrDegraded, wrapped in a health check's error, reports a dependency that
+// still serves with reduced capacity. Readiness reports it without failing.
+var ErrDegraded = errors.New("degraded")
+
+// HealthChecker reports whether a dependency the application needs to serve
+// traffic is available. Check returns nil when it is.
+type HealthChecker interface {
+	Name() string
+	Check(ctx context.Context) error
+}
+
+// DetailedHealthChecker is a HealthChecker that also describes what it
+// found, such as the lag of each read replica.
+type DetailedHealthChecker interface {
+	HealthChecker
+	CheckDetails(ctx context.Context) (map[string]any, error)
+}
@@ -0,0 +1,30 @@
+package providers
+
+import (
+	"context"
+	"time"
+)
+
+type AuthenticatedSession struct {
+	ID        string
+	TenantID  string
+	UserID    uint
+	CSRFToken string
+	ExpiresAt time.Time
+	Refreshed bool
+}
+
+type SessionAuthenticator interface {
+	AuthenticateSession(ctx context.Context, token string) (AuthenticatedSession, error)
+}
+
+type IssuedSession struct {
+	ID        string
+	Token     string
+	CSRFToken string
+	ExpiresAt time.Time
+}
+
+type SessionIssuer interface {
+	IssueSession(ctx context.Context, userID uint, userAgent, ipAddress string) (IssuedSession, error)
+}
@@ -0,0 +1,18 @@
+package providers
+
+import "context"
+
+type UserSummary struct {
+	ID    uint
+	Name  string
+	Email string
+}
+
+type UserDirectory interface {
+	GetUserSummary(ctx context.Context, userID uint) (UserSummary, error)
+	FindUserByEmail(ctx context.Context, email string) (UserSummary, error)
+}
+
+type UserProvisioner interface {
+	ProvisionUser(ctx context.Context, name, email string) (UserSummary, error)
+}
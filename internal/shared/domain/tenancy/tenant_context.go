@@ -0,0 +1,39 @@
+package tenancy
+
+import (
+	"context"
+	"errors"
+)
+
+var ErrTenantRequired = errors.New("tenant context is required")
+
+type tenantContextKey struct{}
+
+type systemScopeContextKey struct{}
+
+type TenantRef struct {
+	ID        string
+	Subdomain string
+}
+
+type TenantLookup interface {
+	FindActiveTenantID(ctx context.Context, ref TenantRef) (string, error)
+}
+
+func WithTenantID(ctx context.Context, tenantID string) context.Context {
+	return context.WithValue(ctx, tenantContextKey{}, tenantID)
+}
+
+func TenantIDFromContext(ctx context.Context) (string, bool) {
+	tenantID, ok := ctx.Value(tenantContextKey{}).(string)
+	return tenantID, ok && tenantID != ""
+}
+
+func WithSystemScope(ctx context.Context) context.Context {
+	return context.WithValue(ctx, systemScopeContextKey{}, true)
+}
+
+func IsSystemScope(ctx context.Context) bool {
+	system, _ := ctx.Value(systemScopeContextKey{}).(bool)
+	return system
+}
@@ -0,0 +1,250 @@
+// Package validation applies the validate struct tags of the configuration
+// and of the bus inputs, the same tags the OpenAPI schemas are built from.
+package validation
+
+import (
+	"fmt"
+	"net/mail"
+	"net/url"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+var (
+	uuidPattern  = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	durationType = reflect.TypeFor[time.Duration]()
+)
+
+// Struct applies the validate tags of the exported fields of a struct, or of
+// the struct a pointer refers to, and names each field by its JSON name.
+func Struct(input any) []string {
+	v := reflect.ValueOf(input)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var violations []string
+	for i := range v.NumField() {
+		field := v.Type().Field(i)
+		tag := field.Tag.Get("validate")
+		if tag == "" || !field.IsExported() {
+			continue
+		}
+		for _, problem := range Value(tag, v.Field(i)) {
+			violations = append(violations, fieldName(field)+": "+problem)
+		}
+	}
+	return violations
+}
+
+// Value applies the comma-separated rules of a validate tag: required, email,
+// url, uuid, oneof=a b c (each entry, for lists), and min, max, gt and len,
+// which bound the length of strings and lists and the value of numbers and
+// durations. Empty strings and lists are only checked by required. An unknown
+// rule, or one that does not apply to the value, panics.
+func Value(rules string, v reflect.Value) []string {
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			if slices.Contains(ruleNames(rules), "required") {
+				return []string{"is required"}
+			}
+			return nil
+		}
+		v = v.Elem()
+	}
+
+	var problems []string
+	for rule := range strings.SplitSeq(rules, ",") {
+		name, arg, _ := strings.Cut(strings.TrimSpace(rule), "=")
+		if name == "" {
+			continue
+		}
+		if name == "required" {
+			if v.IsZero() || (v.Kind() == reflect.Slice && v.Len() == 0) {
+				return []string{"is required"}
+			}
+			continue
+		}
+		if err := checkRule(name, arg, v.Type()); err != nil {
+			panic("validation: " + err.Error())
+		}
+		if (v.Kind() == reflect.String || v.Kind() == reflect.Slice) && v.Len() == 0 {
+			continue
+		}
+		if problem := apply(name, arg, v); problem != "" {
+			problems = append(problems, problem)
+		}
+	}
+	return problems
+}
+
+// Verify reports the first validate tag of a struct type that names an
+// unknown rule or one that does not apply to its field, so that a typo fails
+// when a handler is registered instead of skipping the check.
+func Verify(t reflect.Type) error {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+
+	for i := range t.NumField() {
+		field := t.Field(i)
+		tag := field.Tag.Get("validate")
+		if tag == "" || !field.IsExported() {
+			continue
+		}
+		fieldType := field.Type
+		for fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+		for rule := range strings.SplitSeq(tag, ",") {
+			name, arg, _ := strings.Cut(strings.TrimSpace(rule), "=")
+			if name == "" || name == "required" {
+				continue
+			}
+			if err := checkRule(name, arg, fieldType); err != nil {
+				return fmt.Errorf("field %s: %w", field.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+func checkRule(name, arg string, t reflect.Type) error {
+	switch name {
+	case "email", "url", "uuid":
+		if t.Kind() != reflect.String {
+			return fmt.Errorf("rule %s does not apply to %s", name, t)
+		}
+	case "oneof":
+		if arg == "" {
+			return fmt.Errorf("rule oneof needs the allowed values")
+		}
+	case "min", "max", "gt", "len":
+		if _, err := limit(name, arg, t); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unknown rule %q", name)
+	}
+	return nil
+}
+
+func apply(name, arg string, v reflect.Value) string {
+	switch name {
+	case "email":
+		if address, err := mail.ParseAddress(v.String()); err != nil || address.Address != v.String() {
+			return "must be a valid email address"
+		}
+	case "url":
+		if parsed, err := url.Parse(v.String()); err != nil || parsed.Scheme == "" {
+			return "must be a valid URL"
+		}
+	case "uuid":
+		if !uuidPattern.MatchString(v.String()) {
+			return "must be a valid UUID"
+		}
+	case "oneof":
+		allowed := strings.Fields(arg)
+		for _, value := range values(v) {
+			if !slices.Contains(allowed, value) {
+				return fmt.Sprintf("must be one of %s, got %q", strings.Join(allowed, ", "), value)
+			}
+		}
+	default:
+		return compare(name, arg, v)
+	}
+	return ""
+}
+
+func values(v reflect.Value) []string {
+	if v.Kind() != reflect.Slice {
+		return []string{fmt.Sprint(v.Interface())}
+	}
+	entries := make([]string, 0, v.Len())
+	for i := range v.Len() {
+		entries = append(entries, fmt.Sprint(v.Index(i).Interface()))
+	}
+	return entries
+}
+
+// limit parses the argument of a bound: a duration for durations, a number
+// otherwise.
+func limit(rule, arg string, t reflect.Type) (float64, error) {
+	switch {
+	case t == durationType:
+		d, err := time.ParseDuration(arg)
+		if err != nil {
+			return 0, fmt.Errorf("rule %s=%s needs a duration", rule, arg)
+		}
+		return float64(d), nil
+	case t.Kind() == reflect.String, t.Kind() == reflect.Slice,
+		t.Kind() >= reflect.Int && t.Kind() <= reflect.Float64:
+		n, err := strconv.ParseFloat(arg, 64)
+		if err != nil {
+			return 0, fmt.Errorf("rule %s=%s needs a number", rule, arg)
+		}
+		return n, nil
+	}
+	return 0, fmt.Errorf("rule %s does not apply to %s", rule, t)
+}
+
+func compare(rule, arg string, v reflect.Value) string {
+	bound, _ := limit(rule, arg, v.Type())
+
+	var value float64
+	unit := ""
+	switch {
+	case v.Kind() == reflect.String:
+		value, unit = float64(utf8.RuneCountInString(v.String())), " characters"
+	case v.Kind() == reflect.Slice:
+		value, unit = float64(v.Len()), " items"
+	case v.CanInt():
+		value = float64(v.Int())
+	case v.CanUint():
+		value = float64(v.Uint())
+	default:
+		value = v.Float()
+	}
+
+	switch {
+	case rule == "min" && value < bound:
+		return "must be at least " + arg + unit
+	case rule == "max" && value > bound:
+		return "must be at most " + arg + unit
+	case rule == "gt" && value <= bound:
+		return "must be greater than " + arg + unit
+	case rule == "len" && value != bound:
+		return "must be exactly " + arg + unit
+	}
+	return ""
+}
+
+func ruleNames(rules string) []string {
+	var names []string
+	for rule := range strings.SplitSeq(rules, ",") {
+		name, _, _ := strings.Cut(strings.TrimSpace(rule), "=")
+		names = append(names, name)
+	}
+	return names
+}
+
+func fieldName(field reflect.StructField) string {
+	if name, _, _ := strings.Cut(field.Tag.Get("json"), ","); name != "" && name != "-" {
+		return name
+	}
+	return field.Name
+}
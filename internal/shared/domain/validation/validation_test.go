@@ -0,0 +1,156 @@
+package validation_test
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"golang_boilerplate_module/internal/shared/domain/validation"
+)
+
+type signup struct {
+	Name    string        `json:"name" validate:"required,max=5"`
+	Email   string        `json:"email" validate:"required,email"`
+	Website string        `json:"website,omitempty" validate:"url"`
+	Role    string        `json:"role" validate:"oneof=admin member"`
+	Tags    []string      `json:"tags" validate:"max=2,oneof=a b c"`
+	Age     *int          `json:"age" validate:"gt=17"`
+	Timeout time.Duration `json:"timeout" validate:"min=1s"`
+	Code    string        `validate:"len=3"`
+	ignored string        `validate:"required"`
+}
+
+func TestStruct(t *testing.T) {
+	age := func(n int) *int { return &n }
+
+	tests := []struct {
+		name  string
+		input any
+		want  []string
+	}{
+		{
+			name:  "valid",
+			input: signup{Name: "Ana", Email: "ana@example.com", Timeout: time.Second},
+		},
+		{
+			name:  "optional fields are only checked when set",
+			input: &signup{Name: "Ana", Email: "ana@example.com", Role: "", Tags: nil, Age: nil, Timeout: time.Second},
+		},
+		{
+			name:  "required stops the other rules of the field",
+			input: signup{Timeout: time.Second},
+			want:  []string{"name: is required", "email: is required"},
+		},
+		{
+			name: "every broken rule is reported by its JSON name",
+			input: signup{
+				Name:    "Anastasia",
+				Email:   "Ana <ana@example.com>",
+				Website: "example.com",
+				Role:    "owner",
+				Tags:    []string{"a", "d", "b"},
+				Age:     age(17),
+				Timeout: time.Millisecond,
+				Code:    "ab",
+			},
+			want: []string{
+				"name: must be at most 5 characters",
+				"email: must be a valid email address",
+				"website: must be a valid URL",
+				`role: must be one of admin, member, got "owner"`,
+				"tags: must be at most 2 items",
+				`tags: must be one of a, b, c, got "d"`,
+				"age: must be greater than 17",
+				"timeout: must be at least 1s",
+				"Code: must be exactly 3 characters",
+			},
+		},
+		{
+			name:  "nil pointer",
+			input: (*signup)(nil),
+		},
+		{
+			name:  "not a struct",
+			input: "input",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := validation.Struct(tt.input)
+			if !slices.Equal(got, tt.want) {
+				t.Fatalf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestValue_RequiredPointer(t *testing.T) {
+	var missing *string
+	if got := validation.Value("required,uuid", reflect.ValueOf(missing)); !slices.Equal(got, []string{"is required"}) {
+		t.Fatalf("expected is required, got %q", got)
+	}
+}
+
+func TestValue_PanicsOnAnUnusableRule(t *testing.T) {
+	tests := []struct {
+		name  string
+		rules string
+		value any
+		want  string
+	}{
+		{name: "unknown rule", rules: "required,emial", value: "ana@example.com", want: `unknown rule "emial"`},
+		{name: "string rule on a number", rules: "uuid", value: 3, want: "rule uuid does not apply to int"},
+		{name: "bound without a number", rules: "max=ten", value: "x", want: "rule max=ten needs a number"},
+		{name: "duration bound without a duration", rules: "min=5", value: time.Second, want: "rule min=5 needs a duration"},
+		{name: "oneof without values", rules: "oneof=", value: "x", want: "rule oneof needs the allowed values"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				recovered := recover()
+				message, _ := recovered.(string)
+				if !strings.Contains(message, tt.want) {
+					t.Fatalf("expected a panic containing %q, got %v", tt.want, recovered)
+				}
+			}()
+			validation.Value(tt.rules, reflect.ValueOf(tt.value))
+		})
+	}
+}
+
+func TestVerify(t *testing.T) {
+	type typo struct {
+		Email string `validate:"required,emial"`
+	}
+	type misapplied struct {
+		Count *int `validate:"email"`
+	}
+
+	tests := []struct {
+		name  string
+		input reflect.Type
+		want  string
+	}{
+		{name: "valid", input: reflect.TypeFor[signup]()},
+		{name: "pointer to a valid struct", input: reflect.TypeFor[*signup]()},
+		{name: "not a struct", input: reflect.TypeFor[string]()},
+		{name: "unknown rule", input: reflect.TypeFor[typo](), want: `field Email: unknown rule "emial"`},
+		{name: "misapplied rule", input: reflect.TypeFor[misapplied](), want: "field Count: rule email does not apply to int"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validation.Verify(tt.input)
+			if tt.want == "" {
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.want {
+				t.Fatalf("expected %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package grpcserver
+
+import (
+	"context"
+	"strings"
+
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+
+	"go.opentelemetry.io/otel/attribute"
+	oteltrace "go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// authorizationHeader carries the session token as "Bearer <token>", the
+// same token the session cookie holds over HTTP.
+const authorizationHeader = "authorization"
+
+// AuthenticatedService is implemented by services whose listed methods
+// (full names, such as /users.v1.UserService/UpdateUser) need a session.
+type AuthenticatedService interface {
+	Service
+	AuthenticatedMethods() []string
+}
+
+type currentSessionContextKey struct{}
+
+// CurrentSession returns the session the call's authorization metadata named.
+func CurrentSession(ctx context.Context) (providers.AuthenticatedSession, bool) {
+	session, ok := ctx.Value(currentSessionContextKey{}).(providers.AuthenticatedSession)
+	return session, ok
+}
+
+// RequireCurrentUserID returns the user of the call's session.
+func RequireCurrentUserID(ctx context.Context) (uint, error) {
+	session, ok := CurrentSession(ctx)
+	if !ok || session.UserID == 0 {
+		return 0, exceptions.NewUnauthorizedException("Authentication required", nil)
+	}
+	return session.UserID, nil
+}
+
+// UnaryAuthentication refuses calls to the authenticated methods that carry
+// no session. It runs after UnaryRequestContext, which resolves the session.
+func UnaryAuthentication(methods map[string]bool, rootLogger providers.LoggerProvider) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if _, err := requireSession(ctx, info.FullMethod, methods); err != nil {
+			return nil, toStatusError(ctx, err, rootLogger)
+		}
+		return handler(ctx, req)
+	}
+}
+
+func StreamAuthentication(methods map[string]bool, rootLogger providers.LoggerProvider) grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if _, err := requireSession(stream.Context(), info.FullMethod, methods); err != nil {
+			return toStatusError(stream.Context(), err, rootLogger)
+		}
+		return handler(srv, stream)
+	}
+}
+
+func requireSession(ctx context.Context, method string, methods map[string]bool) (uint, error) {
+	if !methods[method] {
+		return 0, nil
+	}
+	return RequireCurrentUserID(ctx)
+}
+
+// authenticate resolves the session named by the authorization metadata, if
+// any. Like the HTTP session middleware, it runs before the tenant is resolved.
+func authenticate(ctx context.Context, md metadata.MD, sessions providers.SessionAuthenticator) (context.Context, error) {
+	token, ok := strings.CutPrefix(firstValue(md, authorizationHeader), "Bearer ")
+	token = strings.TrimSpace(token)
+	if !ok || token == "" {
+		return ctx, nil
+	}
+
+	session, err := sessions.AuthenticateSession(ctx, token)
+	if err != nil {
+		return ctx, err
+	}
+
+	oteltrace.SpanFromContext(ctx).SetAttributes(attribute.Int("user.id", int(session.UserID)))
+	ctx = context.WithValue(ctx, currentSessionContextKey{}, session)
+	if logger, ok := ctx.Value(loggerContextKey{}).(providers.LoggerProvider); ok {
+		ctx = context.WithValue(ctx, loggerContextKey{}, logger.With("userId", session.UserID))
+	}
+	return ctx, nil
+}
+
+// authenticatedMethods collects the methods the services restrict to sessions.
+func authenticatedMethods(services []Service) map[string]bool {
+	methods := make(map[string]bool)
+	for _, service := range services {
+		if authenticated, ok := service.(AuthenticatedService); ok {
+			for _, method := range authenticated.AuthenticatedMethods() {
+				methods[method] = true
+			}
+		}
+	}
+	return methods
+}
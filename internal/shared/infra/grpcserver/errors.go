@@ -0,0 +1,72 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var exceptionGRPCCodes = map[exceptions.ExceptionCode]codes.Code{
+	exceptions.CodeBadRequest:         codes.InvalidArgument,
+	exceptions.CodeUnauthorized:       codes.Unauthenticated,
+	exceptions.CodeForbidden:          codes.PermissionDenied,
+	exceptions.CodeNotFound:           codes.NotFound,
+	exceptions.CodeUnprocessable:      codes.FailedPrecondition,
+	exceptions.CodeInternal:           codes.Internal,
+	exceptions.CodeTooManyRequests:    codes.ResourceExhausted,
+	exceptions.CodeServiceUnavailable: codes.Unavailable,
+}
+
+func UnaryErrors(rootLogger providers.LoggerProvider) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return nil, toStatusError(ctx, err, rootLogger)
+		}
+		return resp, nil
+	}
+}
+
+func StreamErrors(rootLogger providers.LoggerProvider) grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := handler(srv, stream); err != nil {
+			return toStatusError(stream.Context(), err, rootLogger)
+		}
+		return nil
+	}
+}
+
+func toStatusError(ctx context.Context, err error, rootLogger providers.LoggerProvider) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err).Err()
+	}
+
+	var domainErr *exceptions.DomainError
+	if !errors.As(err, &domainErr) {
+		domainErr = exceptions.NewInternalException(map[string]any{
+			"error": err.Error(),
+		})
+	}
+
+	if domainErr.Reportable {
+		LoggerFromContext(ctx, rootLogger).Error(domainErr.Message,
+			"code", domainErr.Code,
+			"metadata", domainErr.Metadata,
+		)
+	}
+
+	code, ok := exceptionGRPCCodes[domainErr.Code]
+	if !ok {
+		code = codes.Internal
+	}
+	return status.Error(code, domainErr.Message)
+}
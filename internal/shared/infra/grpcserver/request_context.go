@@ -0,0 +1,148 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	"golang_boilerplate_module/internal/config"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+	"golang_boilerplate_module/internal/shared/domain/tenancy"
+	"golang_boilerplate_module/internal/shared/infra/persistence"
+	"golang_boilerplate_module/internal/shared/infra/telemetry"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	oteltrace "go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const requestIDHeader = "x-request-id"
+
+var infrastructureServices = []string{
+	"/grpc.health.v1.",
+	"/grpc.reflection.",
+}
+
+type loggerContextKey struct{}
+
+func LoggerFromContext(ctx context.Context, fallback providers.LoggerProvider) providers.LoggerProvider {
+	if l, ok := ctx.Value(loggerContextKey{}).(providers.LoggerProvider); ok {
+		return l
+	}
+	return fallback
+}
+
+func UnaryRequestContext(cfg *config.Config, lookup tenancy.TenantLookup, sessions providers.SessionAuthenticator, rootLogger providers.LoggerProvider) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := requestContext(ctx, info.FullMethod, cfg, lookup, sessions, rootLogger)
+		if err != nil {
+			return nil, toStatusError(ctx, err, rootLogger)
+		}
+		return handler(ctx, req)
+	}
+}
+
+func StreamRequestContext(cfg *config.Config, lookup tenancy.TenantLookup, sessions providers.SessionAuthenticator, rootLogger providers.LoggerProvider) grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := requestContext(stream.Context(), info.FullMethod, cfg, lookup, sessions, rootLogger)
+		if err != nil {
+			return toStatusError(ctx, err, rootLogger)
+		}
+		return handler(srv, &contextStream{ServerStream: stream, ctx: ctx})
+	}
+}
+
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
+
+func requestContext(ctx context.Context, method string, cfg *config.Config, lookup tenancy.TenantLookup, sessions providers.SessionAuthenticator, rootLogger providers.LoggerProvider) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	reqID := firstValue(md, requestIDHeader)
+	if reqID == "" {
+		reqID = uuid.NewString()
+	}
+	_ = grpc.SetHeader(ctx, metadata.Pairs(requestIDHeader, reqID))
+
+	fields := []any{"requestId", reqID, "grpcMethod", method}
+	span := oteltrace.SpanFromContext(ctx)
+	if spanCtx := span.SpanContext(); spanCtx.IsValid() {
+		fields = append(fields,
+			"traceId", spanCtx.TraceID().String(),
+			"spanId", spanCtx.SpanID().String(),
+		)
+	}
+	requestLogger := rootLogger.With(fields...)
+
+	ctx = context.WithValue(ctx, telemetry.RequestIDContextKey, reqID)
+	ctx = context.WithValue(ctx, loggerContextKey{}, requestLogger)
+	ctx = persistence.TrackWrites(ctx)
+
+	for _, prefix := range infrastructureServices {
+		if strings.HasPrefix(method, prefix) {
+			return ctx, nil
+		}
+	}
+
+	ctx, err := authenticate(ctx, md, sessions)
+	if err != nil {
+		return ctx, err
+	}
+	session, hasSession := CurrentSession(ctx)
+
+	// The header applies only where it is enabled; otherwise the tenant is the
+	// session's, or the default one.
+	var ref tenancy.TenantRef
+	source := "header"
+	if slices.Contains(cfg.Tenancy.Resolvers, "header") {
+		ref.ID = firstValue(md, strings.ToLower(cfg.Tenancy.Header))
+	}
+	if ref.ID == "" && hasSession {
+		ref, source = tenancy.TenantRef{ID: session.TenantID}, "session"
+	}
+	if ref.ID == "" {
+		if cfg.Tenancy.DefaultTenant == "" {
+			return ctx, exceptions.NewBadRequestException("Tenant could not be resolved", nil)
+		}
+		ref, source = tenancy.TenantRef{ID: cfg.Tenancy.DefaultTenant}, "default"
+	}
+
+	tenantID, err := lookup.FindActiveTenantID(ctx, ref)
+	if err != nil {
+		var domainErr *exceptions.DomainError
+		if errors.As(err, &domainErr) && domainErr.Code == exceptions.CodeNotFound {
+			return ctx, exceptions.NewNotFoundException("Tenant not found", nil)
+		}
+		return ctx, err
+	}
+	// A session belongs to one tenant; the header cannot switch it.
+	if hasSession && session.TenantID != tenantID {
+		return ctx, exceptions.NewForbiddenException("Tenant does not match the session", nil)
+	}
+
+	ctx = tenancy.WithTenantID(ctx, tenantID)
+	span.SetAttributes(
+		attribute.String("tenant.id", tenantID),
+		attribute.String("tenant.source", source),
+	)
+	ctx = context.WithValue(ctx, loggerContextKey{}, LoggerFromContext(ctx, requestLogger).With("tenantId", tenantID))
+
+	return ctx, nil
+}
+
+func firstValue(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return strings.TrimSpace(values[0])
+	}
+	return ""
+}
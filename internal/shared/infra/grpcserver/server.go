@@ -0,0 +1,110 @@
+package grpcserver
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"golang_boilerplate_module/internal/config"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+	"golang_boilerplate_module/internal/shared/domain/tenancy"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.uber.org/fx"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+)
+
+const ServicesGroup = `group:"grpc_services"`
+
+type Service interface {
+	Register(registrar grpc.ServiceRegistrar)
+}
+
+type ServerParams struct {
+	fx.In
+
+	Config   *config.Config
+	Tenants  tenancy.TenantLookup
+	Sessions providers.SessionAuthenticator
+	Services []Service `group:"grpc_services"`
+	Logger   providers.LoggerProvider
+}
+
+type Server struct {
+	*grpc.Server
+	health   *health.Server
+	listener net.Listener
+}
+
+func NewServer(p ServerParams) *Server {
+	authenticated := authenticatedMethods(p.Services)
+	server := grpc.NewServer(
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(
+			UnaryRequestContext(p.Config, p.Tenants, p.Sessions, p.Logger),
+			UnaryAuthentication(authenticated, p.Logger),
+			UnaryErrors(p.Logger),
+		),
+		grpc.ChainStreamInterceptor(
+			StreamRequestContext(p.Config, p.Tenants, p.Sessions, p.Logger),
+			StreamAuthentication(authenticated, p.Logger),
+			StreamErrors(p.Logger),
+		),
+	)
+
+	for _, service := range p.Services {
+		service.Register(server)
+	}
+
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(server, healthServer)
+	for name := range server.GetServiceInfo() {
+		healthServer.SetServingStatus(name, healthpb.HealthCheckResponse_SERVING)
+	}
+
+	if p.Config.GRPC.ReflectionEnabled {
+		reflection.Register(server)
+	}
+
+	return &Server{Server: server, health: healthServer}
+}
+
+func (s *Server) Listen(port int) error {
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		return fmt.Errorf("grpc listen on port %d: %w", port, err)
+	}
+	s.listener = listener
+	return nil
+}
+
+func (s *Server) Addr() net.Addr {
+	if s.listener == nil {
+		return nil
+	}
+	return s.listener.Addr()
+}
+
+func (s *Server) Serve() error {
+	return s.Server.Serve(s.listener)
+}
+
+func (s *Server) Shutdown(ctx context.Context) {
+	s.health.Shutdown()
+
+	stopped := make(chan struct{})
+	go func() {
+		s.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		s.Stop()
+		<-stopped
+	}
+}
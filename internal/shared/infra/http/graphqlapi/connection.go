@@ -0,0 +1,73 @@
+package graphqlapi
+
+import (
+	"encoding/base64"
+	"strings"
+
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+
+	"github.com/graphql-go/graphql"
+)
+
+const cursorPrefix = "cursor:"
+
+type Connection struct {
+	Edges    []Edge   `json:"edges"`
+	PageInfo PageInfo `json:"pageInfo"`
+}
+
+type Edge struct {
+	Cursor string `json:"cursor"`
+	Node   any    `json:"node"`
+}
+
+type PageInfo struct {
+	HasNextPage bool    `json:"hasNextPage"`
+	EndCursor   *string `json:"endCursor"`
+}
+
+var pageInfoType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "PageInfo",
+	Fields: graphql.Fields{
+		"hasNextPage": &graphql.Field{Type: graphql.NewNonNull(graphql.Boolean)},
+		"endCursor":   &graphql.Field{Type: graphql.String},
+	},
+})
+
+// ConnectionType builds the Relay-style <Node>Connection and <Node>Edge types
+// resolved from a Connection value.
+func ConnectionType(node *graphql.Object) *graphql.Object {
+	edge := graphql.NewObject(graphql.ObjectConfig{
+		Name: node.Name() + "Edge",
+		Fields: graphql.Fields{
+			"cursor": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"node":   &graphql.Field{Type: graphql.NewNonNull(node)},
+		},
+	})
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: node.Name() + "Connection",
+		Fields: graphql.Fields{
+			"edges":    &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(edge)))},
+			"pageInfo": &graphql.Field{Type: graphql.NewNonNull(pageInfoType)},
+		},
+	})
+}
+
+func ConnectionArgs(defaultFirst int) graphql.FieldConfigArgument {
+	return graphql.FieldConfigArgument{
+		paginationArgument: &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: defaultFirst},
+		"after":            &graphql.ArgumentConfig{Type: graphql.String},
+	}
+}
+
+func EncodeCursor(value string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(cursorPrefix + value))
+}
+
+func DecodeCursor(cursor string) (string, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil || !strings.HasPrefix(string(decoded), cursorPrefix) {
+		return "", exceptions.NewBadRequestException("Invalid cursor", nil)
+	}
+	return strings.TrimPrefix(string(decoded), cursorPrefix), nil
+}
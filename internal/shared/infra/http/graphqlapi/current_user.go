@@ -0,0 +1,29 @@
+package graphqlapi
+
+import (
+	"context"
+
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/infra/http/middleware"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type currentUserContextKey struct{}
+
+func withCurrentUser(ctx context.Context, c *fiber.Ctx) context.Context {
+	if userID, ok := middleware.CurrentUserID(c); ok {
+		return context.WithValue(ctx, currentUserContextKey{}, userID)
+	}
+	return ctx
+}
+
+// RequireCurrentUserID returns the user of the request's session, for the
+// resolvers that need one.
+func RequireCurrentUserID(ctx context.Context) (uint, error) {
+	userID, ok := ctx.Value(currentUserContextKey{}).(uint)
+	if !ok || userID == 0 {
+		return 0, exceptions.NewUnauthorizedException("Authentication required", nil)
+	}
+	return userID, nil
+}
@@ -0,0 +1,120 @@
+package graphqlapi
+
+import (
+	"errors"
+
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+	"golang_boilerplate_module/internal/shared/infra/observability"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+)
+
+const (
+	codePersistedQueryNotFound = "PERSISTED_QUERY_NOT_FOUND"
+	codePersistedQueryRequired = "PERSISTED_QUERY_REQUIRED"
+)
+
+var exceptionStatuses = map[exceptions.ExceptionCode]int{
+	exceptions.CodeBadRequest:         400,
+	exceptions.CodeUnauthorized:       401,
+	exceptions.CodeForbidden:          403,
+	exceptions.CodeNotFound:           404,
+	exceptions.CodeUnprocessable:      422,
+	exceptions.CodeInternal:           500,
+	exceptions.CodeTooManyRequests:    429,
+	exceptions.CodeServiceUnavailable: 503,
+}
+
+type queryError struct {
+	message    string
+	extensions map[string]any
+	cause      error
+}
+
+func (e *queryError) Error() string {
+	return e.message
+}
+
+func (e *queryError) Extensions() map[string]any {
+	return e.extensions
+}
+
+func (e *queryError) Unwrap() error {
+	return e.cause
+}
+
+func newQueryError(message, code string) *queryError {
+	return &queryError{message: message, extensions: map[string]any{"code": code}}
+}
+
+func translate(err error) *queryError {
+	var translated *queryError
+	if errors.As(err, &translated) {
+		return translated
+	}
+
+	var domainErr *exceptions.DomainError
+	if !errors.As(err, &domainErr) {
+		domainErr = exceptions.NewInternalException(map[string]any{
+			"error": err.Error(),
+		})
+	}
+
+	extensions := map[string]any{
+		"code":   string(domainErr.Code),
+		"status": exceptionStatuses[domainErr.Code],
+	}
+	if !domainErr.Reportable {
+		for key, value := range domainErr.Metadata {
+			extensions[key] = value
+		}
+	}
+	return &queryError{message: domainErr.Message, extensions: extensions, cause: domainErr}
+}
+
+func formatErrors(errs ...error) []gqlerrors.FormattedError {
+	formatted := make([]gqlerrors.FormattedError, 0, len(errs))
+	for _, err := range errs {
+		formatted = append(formatted, gqlerrors.FormatError(&gqlerrors.Error{
+			Message:       err.Error(),
+			OriginalError: err,
+		}))
+	}
+	return formatted
+}
+
+func translateErrors(resolve graphql.FieldResolveFn, logger providers.LoggerProvider) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (any, error) {
+		result, err := resolve(p)
+		if err != nil {
+			return nil, reportable(p, translate(err), logger)
+		}
+		if thunk, ok := result.(func() (any, error)); ok {
+			return func() (any, error) {
+				value, err := thunk()
+				if err != nil {
+					// graphql-go drops the extensions of errors returned from thunks, but
+					// keeps a located error raised from them intact.
+					queryErr := reportable(p, translate(err), logger)
+					panic(gqlerrors.NewErrorWithPath(queryErr.Error(), graphql.FieldASTsToNodeASTs(p.Info.FieldASTs), "", nil, []int{}, p.Info.Path.AsArray(), queryErr))
+				}
+				return value, nil
+			}, nil
+		}
+		return result, nil
+	}
+}
+
+func reportable(p graphql.ResolveParams, err *queryError, logger providers.LoggerProvider) *queryError {
+	var domainErr *exceptions.DomainError
+	if errors.As(err, &domainErr) && domainErr.Reportable {
+		observability.LoggerWithTrace(p.Context, logger).Error(domainErr.Message,
+			"code", domainErr.Code,
+			"metadata", domainErr.Metadata,
+			"graphqlField", p.Info.FieldName,
+		)
+	}
+	return err
+}
@@ -0,0 +1,193 @@
+package graphqlapi
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"golang_boilerplate_module/internal/config"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+	"golang_boilerplate_module/internal/shared/infra/http/middleware"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const Path = "/graphql"
+
+var tracer = otel.Tracer("graphql")
+
+type Request struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+	Extensions    struct {
+		PersistedQuery *struct {
+			Version    int    `json:"version"`
+			SHA256Hash string `json:"sha256Hash"`
+		} `json:"persistedQuery"`
+	} `json:"extensions"`
+}
+
+type Handler struct {
+	cfg       config.GraphQLConfig
+	schema    *graphql.Schema
+	persisted *PersistedQueries
+	logger    providers.LoggerProvider
+}
+
+func RegisterRoutes(app *fiber.App, cfg *config.Config, schema *graphql.Schema, persisted *PersistedQueries, logger providers.LoggerProvider) {
+	if !cfg.GraphQL.Enabled {
+		return
+	}
+
+	handler := &Handler{cfg: cfg.GraphQL, schema: schema, persisted: persisted, logger: logger}
+	app.Get(Path, handler.Serve)
+	app.Post(Path, handler.Serve)
+}
+
+func (h *Handler) Serve(c *fiber.Ctx) error {
+	req, err := parseRequest(c)
+	if err != nil {
+		return err
+	}
+
+	ctx, span := tracer.Start(c.UserContext(), "GraphQL")
+	defer span.End()
+
+	log := middleware.LoggerFromLocals(c, h.logger).With("handler", "GraphQL")
+
+	query, err := h.resolveQuery(req)
+	if err != nil {
+		return c.JSON(graphql.Result{Errors: formatErrors(err)})
+	}
+
+	doc, err := parser.Parse(parser.ParseParams{Source: query})
+	if err != nil {
+		return c.JSON(graphql.Result{Errors: gqlerrors.FormatErrors(err)})
+	}
+	if validation := graphql.ValidateDocument(h.schema, doc, nil); !validation.IsValid {
+		return c.JSON(graphql.Result{Errors: validation.Errors})
+	}
+
+	operation, err := findOperation(doc, req.OperationName)
+	if err != nil {
+		return c.JSON(graphql.Result{Errors: formatErrors(translate(err))})
+	}
+
+	operationName := ""
+	if operation.Name != nil {
+		operationName = operation.Name.Value
+	}
+	span.SetName("GraphQL " + operation.Operation + " " + operationName)
+	span.SetAttributes(
+		attribute.String("graphql.operation.type", operation.Operation),
+		attribute.String("graphql.operation.name", operationName),
+	)
+
+	if c.Method() == fiber.MethodGet && operation.Operation != ast.OperationTypeQuery {
+		err := exceptions.NewBadRequestException(fmt.Sprintf("Operation type %s must be sent with POST", operation.Operation), nil)
+		return c.JSON(graphql.Result{Errors: formatErrors(translate(err))})
+	}
+
+	cost := analyzeCost(h.schema, doc, operation, req.Variables)
+	span.SetAttributes(
+		attribute.Int("graphql.document.depth", cost.depth),
+		attribute.Int("graphql.document.complexity", cost.complexity),
+	)
+	if err := h.checkLimits(cost); err != nil {
+		log.Warn("graphql operation rejected", "operation", operationName, "reason", err.Error())
+		return c.JSON(graphql.Result{Errors: formatErrors(translate(err))})
+	}
+
+	result := graphql.Execute(graphql.ExecuteParams{
+		Schema:        *h.schema,
+		AST:           doc,
+		OperationName: req.OperationName,
+		Args:          req.Variables,
+		Context:       withCurrentUser(withLoaders(ctx), c),
+	})
+	span.SetAttributes(attribute.Int("graphql.errors", len(result.Errors)))
+
+	return c.JSON(result)
+}
+
+func parseRequest(c *fiber.Ctx) (Request, error) {
+	var req Request
+	if c.Method() == fiber.MethodPost {
+		if err := json.Unmarshal(c.Body(), &req); err != nil {
+			return req, exceptions.NewBadRequestException("Invalid GraphQL request body", nil)
+		}
+		return req, nil
+	}
+
+	req.Query = c.Query("query")
+	req.OperationName = c.Query("operationName")
+	if variables := c.Query("variables"); variables != "" {
+		if err := json.Unmarshal([]byte(variables), &req.Variables); err != nil {
+			return req, exceptions.NewBadRequestException("Invalid GraphQL variables", nil)
+		}
+	}
+	if extensions := c.Query("extensions"); extensions != "" {
+		if err := json.Unmarshal([]byte(extensions), &req.Extensions); err != nil {
+			return req, exceptions.NewBadRequestException("Invalid GraphQL extensions", nil)
+		}
+	}
+	return req, nil
+}
+
+func (h *Handler) resolveQuery(req Request) (string, error) {
+	var hash string
+	if persisted := req.Extensions.PersistedQuery; persisted != nil {
+		hash = persisted.SHA256Hash
+	}
+
+	if h.cfg.PersistedQueriesOnly && (hash == "" || !h.persisted.Allowed(hash)) {
+		return "", newQueryError("Only persisted queries are allowed", codePersistedQueryRequired)
+	}
+
+	if hash == "" {
+		if req.Query == "" {
+			return "", translate(exceptions.NewBadRequestException("Query is required", nil))
+		}
+		return req.Query, nil
+	}
+
+	if req.Query == "" {
+		query, ok := h.persisted.Get(hash)
+		if !ok {
+			return "", newQueryError("PersistedQueryNotFound", codePersistedQueryNotFound)
+		}
+		return query, nil
+	}
+
+	if QueryHash(req.Query) != hash {
+		return "", translate(exceptions.NewBadRequestException("Provided sha256Hash does not match the query", nil))
+	}
+	h.persisted.Register(hash, req.Query)
+	return req.Query, nil
+}
+
+func (h *Handler) checkLimits(cost queryCost) error {
+	if cost.introspection && !h.cfg.IntrospectionEnabled {
+		return exceptions.NewForbiddenException("GraphQL introspection is disabled", nil)
+	}
+	if h.cfg.MaxDepth > 0 && cost.depth > h.cfg.MaxDepth {
+		return exceptions.NewBadRequestException(
+			fmt.Sprintf("Query depth %d exceeds the maximum of %d", cost.depth, h.cfg.MaxDepth),
+			map[string]any{"depth": cost.depth, "maxDepth": h.cfg.MaxDepth},
+		)
+	}
+	if h.cfg.MaxComplexity > 0 && cost.complexity > h.cfg.MaxComplexity {
+		return exceptions.NewBadRequestException(
+			fmt.Sprintf("Query complexity %d exceeds the maximum of %d", cost.complexity, h.cfg.MaxComplexity),
+			map[string]any{"complexity": cost.complexity, "maxComplexity": h.cfg.MaxComplexity},
+		)
+	}
+	return nil
+}
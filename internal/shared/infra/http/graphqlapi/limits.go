@@ -0,0 +1,183 @@
+package graphqlapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+const paginationArgument = "first"
+
+type queryCost struct {
+	depth         int
+	complexity    int
+	introspection bool
+}
+
+type costAnalyzer struct {
+	schema    *graphql.Schema
+	fragments map[string]*ast.FragmentDefinition
+	variables map[string]any
+	cost      queryCost
+}
+
+func findOperation(doc *ast.Document, name string) (*ast.OperationDefinition, error) {
+	var found *ast.OperationDefinition
+	for _, definition := range doc.Definitions {
+		operation, ok := definition.(*ast.OperationDefinition)
+		if !ok {
+			continue
+		}
+		if name == "" {
+			if found != nil {
+				return nil, exceptions.NewBadRequestException("Must provide operation name if query contains multiple operations", nil)
+			}
+			found = operation
+			continue
+		}
+		if operation.Name != nil && operation.Name.Value == name {
+			return operation, nil
+		}
+	}
+	if found == nil {
+		return nil, exceptions.NewBadRequestException(fmt.Sprintf("Unknown operation named %q", name), nil)
+	}
+	return found, nil
+}
+
+// analyzeCost measures the nesting depth and the estimated number of resolved
+// fields of an operation. Every field costs 1; a field taking a `first`
+// argument multiplies the cost of its selection by that page size.
+func analyzeCost(schema *graphql.Schema, doc *ast.Document, operation *ast.OperationDefinition, variables map[string]any) queryCost {
+	analyzer := &costAnalyzer{
+		schema:    schema,
+		fragments: make(map[string]*ast.FragmentDefinition),
+		variables: variables,
+	}
+	for _, definition := range doc.Definitions {
+		if fragment, ok := definition.(*ast.FragmentDefinition); ok {
+			analyzer.fragments[fragment.Name.Value] = fragment
+		}
+	}
+
+	var root graphql.Type = schema.QueryType()
+	if operation.Operation == ast.OperationTypeMutation {
+		root = schema.MutationType()
+	}
+
+	analyzer.cost.depth, analyzer.cost.complexity = analyzer.selectionSet(operation.SelectionSet, root)
+	return analyzer.cost
+}
+
+func (a *costAnalyzer) selectionSet(set *ast.SelectionSet, parent graphql.Type) (depth, complexity int) {
+	if set == nil {
+		return 0, 0
+	}
+	for _, selection := range set.Selections {
+		var d, c int
+		switch node := selection.(type) {
+		case *ast.Field:
+			d, c = a.field(node, parent)
+		case *ast.InlineFragment:
+			typ := parent
+			if node.TypeCondition != nil {
+				typ = a.schema.Type(node.TypeCondition.Name.Value)
+			}
+			d, c = a.selectionSet(node.SelectionSet, typ)
+		case *ast.FragmentSpread:
+			fragment, ok := a.fragments[node.Name.Value]
+			if !ok {
+				continue
+			}
+			d, c = a.selectionSet(fragment.SelectionSet, a.schema.Type(fragment.TypeCondition.Name.Value))
+		}
+		depth = max(depth, d)
+		complexity += c
+	}
+	return depth, complexity
+}
+
+func (a *costAnalyzer) field(node *ast.Field, parent graphql.Type) (depth, complexity int) {
+	name := node.Name.Value
+	if name == "__typename" {
+		return 0, 0
+	}
+	if strings.HasPrefix(name, "__") {
+		a.cost.introspection = true
+	}
+
+	definition := fieldDefinition(parent, name)
+	if definition == nil {
+		return 1, 1
+	}
+
+	child, _ := graphql.GetNamed(definition.Type).(graphql.Type)
+	childDepth, childComplexity := a.selectionSet(node.SelectionSet, child)
+	return childDepth + 1, 1 + a.multiplier(node, definition)*childComplexity
+}
+
+func (a *costAnalyzer) multiplier(node *ast.Field, definition *graphql.FieldDefinition) int {
+	for _, argument := range node.Arguments {
+		if argument.Name.Value == paginationArgument {
+			if n, ok := a.intValue(argument.Value); ok {
+				return max(n, 1)
+			}
+		}
+	}
+	for _, argument := range definition.Args {
+		if argument.Name() == paginationArgument {
+			if n, ok := toInt(argument.DefaultValue); ok {
+				return max(n, 1)
+			}
+		}
+	}
+	return 1
+}
+
+func (a *costAnalyzer) intValue(value ast.Value) (int, bool) {
+	switch typed := value.(type) {
+	case *ast.IntValue:
+		n, err := strconv.Atoi(typed.Value)
+		return n, err == nil
+	case *ast.Variable:
+		return toInt(a.variables[typed.Name.Value])
+	}
+	return 0, false
+}
+
+func toInt(value any) (int, bool) {
+	switch typed := value.(type) {
+	case int:
+		return typed, true
+	case float64:
+		return int(typed), true
+	case json.Number:
+		n, err := typed.Int64()
+		return int(n), err == nil
+	}
+	return 0, false
+}
+
+// fieldDefinition finds the field on parent, including the __schema and
+// __type meta fields, so introspection is measured like any other query.
+func fieldDefinition(parent graphql.Type, name string) *graphql.FieldDefinition {
+	switch name {
+	case graphql.SchemaMetaFieldDef.Name:
+		return graphql.SchemaMetaFieldDef
+	case graphql.TypeMetaFieldDef.Name:
+		return graphql.TypeMetaFieldDef
+	}
+	switch typed := parent.(type) {
+	case *graphql.Object:
+		return typed.Fields()[name]
+	case *graphql.Interface:
+		return typed.Fields()[name]
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package graphqlapi
+
+import (
+	"context"
+	"sync"
+)
+
+type BatchFunc[K comparable, V any] func(ctx context.Context, keys []K) (map[K]V, error)
+
+// Loader collects the keys requested while one level of the query is being
+// resolved and fetches them with a single batch call once the first result is
+// needed. Results are cached for the rest of the request, and keys missing
+// from the batch result resolve to null.
+type Loader[K comparable, V any] struct {
+	batch   BatchFunc[K, V]
+	mu      sync.Mutex
+	pending []K
+	results map[K]*loadResult[V]
+}
+
+type loadResult[V any] struct {
+	value V
+	found bool
+	err   error
+	done  bool
+}
+
+type loadersContextKey struct{}
+
+type loaders struct {
+	mu     sync.Mutex
+	byName map[string]any
+}
+
+func withLoaders(ctx context.Context) context.Context {
+	return context.WithValue(ctx, loadersContextKey{}, &loaders{byName: make(map[string]any)})
+}
+
+// LoaderFor returns the request-scoped loader registered under name, creating
+// it on first use. Outside a GraphQL request every call gets a fresh loader.
+func LoaderFor[K comparable, V any](ctx context.Context, name string, batch BatchFunc[K, V]) *Loader[K, V] {
+	registry, ok := ctx.Value(loadersContextKey{}).(*loaders)
+	if !ok {
+		return newLoader(batch)
+	}
+
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	if loader, ok := registry.byName[name].(*Loader[K, V]); ok {
+		return loader
+	}
+	loader := newLoader(batch)
+	registry.byName[name] = loader
+	return loader
+}
+
+func newLoader[K comparable, V any](batch BatchFunc[K, V]) *Loader[K, V] {
+	return &Loader[K, V]{batch: batch, results: make(map[K]*loadResult[V])}
+}
+
+func (l *Loader[K, V]) Load(ctx context.Context, key K) func() (any, error) {
+	l.mu.Lock()
+	if _, ok := l.results[key]; !ok {
+		l.results[key] = &loadResult[V]{}
+		l.pending = append(l.pending, key)
+	}
+	l.mu.Unlock()
+
+	return func() (any, error) {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+
+		result := l.results[key]
+		if !result.done {
+			l.dispatch(ctx)
+		}
+		if result.err != nil || !result.found {
+			return nil, result.err
+		}
+		return result.value, nil
+	}
+}
+
+func (l *Loader[K, V]) dispatch(ctx context.Context) {
+	keys := l.pending
+	l.pending = nil
+
+	values, err := l.batch(ctx, keys)
+	for _, key := range keys {
+		result := l.results[key]
+		result.done = true
+		if err != nil {
+			result.err = err
+			continue
+		}
+		result.value, result.found = values[key]
+	}
+}
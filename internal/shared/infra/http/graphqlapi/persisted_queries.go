@@ -0,0 +1,99 @@
+package graphqlapi
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"golang_boilerplate_module/internal/config"
+)
+
+type PersistedQueries struct {
+	mu       sync.Mutex
+	capacity int
+	allowed  map[string]string
+	cached   map[string]*list.Element
+	order    *list.List
+}
+
+type cachedQuery struct {
+	hash  string
+	query string
+}
+
+func NewPersistedQueries(cfg *config.Config) (*PersistedQueries, error) {
+	store := &PersistedQueries{
+		capacity: cfg.GraphQL.PersistedQueryCacheSize,
+		allowed:  make(map[string]string),
+		cached:   make(map[string]*list.Element),
+		order:    list.New(),
+	}
+
+	if cfg.GraphQL.PersistedQueriesDir == "" {
+		return store, nil
+	}
+
+	files, err := filepath.Glob(filepath.Join(cfg.GraphQL.PersistedQueriesDir, "*.graphql"))
+	if err != nil {
+		return nil, fmt.Errorf("list persisted queries: %w", err)
+	}
+	for _, file := range files {
+		query, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("read persisted query %s: %w", filepath.Base(file), err)
+		}
+		store.allowed[QueryHash(string(query))] = string(query)
+	}
+	return store, nil
+}
+
+func QueryHash(query string) string {
+	sum := sha256.Sum256([]byte(query))
+	return hex.EncodeToString(sum[:])
+}
+
+func (s *PersistedQueries) Get(hash string) (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if query, ok := s.allowed[hash]; ok {
+		return query, true
+	}
+	if element, ok := s.cached[hash]; ok {
+		s.order.MoveToFront(element)
+		return element.Value.(*cachedQuery).query, true
+	}
+	return "", false
+}
+
+func (s *PersistedQueries) Allowed(hash string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, ok := s.allowed[hash]
+	return ok
+}
+
+func (s *PersistedQueries) Register(hash, query string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.allowed[hash]; ok || s.capacity <= 0 {
+		return
+	}
+	if element, ok := s.cached[hash]; ok {
+		s.order.MoveToFront(element)
+		return
+	}
+
+	s.cached[hash] = s.order.PushFront(&cachedQuery{hash: hash, query: query})
+	for s.order.Len() > s.capacity {
+		oldest := s.order.Back()
+		s.order.Remove(oldest)
+		delete(s.cached, oldest.Value.(*cachedQuery).hash)
+	}
+}
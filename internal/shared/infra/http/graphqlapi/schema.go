@@ -0,0 +1,78 @@
+package graphqlapi
+
+import (
+	"fmt"
+	"strings"
+
+	"golang_boilerplate_module/internal/shared/domain/providers"
+
+	"github.com/graphql-go/graphql"
+	"go.uber.org/fx"
+)
+
+const ContributorsGroup = `group:"graphql_contributors"`
+
+type Contributor interface {
+	QueryFields() graphql.Fields
+	MutationFields() graphql.Fields
+}
+
+type SchemaParams struct {
+	fx.In
+
+	Contributors []Contributor `group:"graphql_contributors"`
+	Logger       providers.LoggerProvider
+}
+
+func NewSchema(p SchemaParams) (*graphql.Schema, error) {
+	queries := graphql.Fields{}
+	mutations := graphql.Fields{}
+	for _, contributor := range p.Contributors {
+		if err := mergeFields(queries, contributor.QueryFields()); err != nil {
+			return nil, err
+		}
+		if err := mergeFields(mutations, contributor.MutationFields()); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(queries) == 0 {
+		queries["_empty"] = &graphql.Field{Type: graphql.Boolean, Description: "Placeholder for a schema without query fields"}
+	}
+
+	config := graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{Name: "Query", Fields: queries}),
+	}
+	if len(mutations) > 0 {
+		config.Mutation = graphql.NewObject(graphql.ObjectConfig{Name: "Mutation", Fields: mutations})
+	}
+
+	schema, err := graphql.NewSchema(config)
+	if err != nil {
+		return nil, fmt.Errorf("build graphql schema: %w", err)
+	}
+
+	for name, named := range schema.TypeMap() {
+		object, ok := named.(*graphql.Object)
+		if !ok || strings.HasPrefix(name, "__") {
+			continue
+		}
+		for _, field := range object.Fields() {
+			if field.Resolve != nil {
+				field.Resolve = translateErrors(field.Resolve, p.Logger)
+			}
+		}
+	}
+
+	return &schema, nil
+}
+
+func mergeFields(into, fields graphql.Fields) error {
+	for name, field := range fields {
+		if _, exists := into[name]; exists {
+			return fmt.Errorf("graphql field %q is contributed twice", name)
+		}
+		into[name] = field
+	}
+	return nil
+}
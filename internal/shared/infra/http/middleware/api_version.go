@@ -0,0 +1,112 @@
+package middleware
+
+import (
+	"fmt"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang_boilerplate_module/internal/config"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+
+	"github.com/gofiber/fiber/v2"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	oteltrace "go.opentelemetry.io/otel/trace"
+)
+
+const apiPrefix = "/api"
+
+var apiVersionRequests metric.Int64Counter
+
+func init() {
+	meter := otel.Meter("http")
+
+	var err error
+	apiVersionRequests, err = meter.Int64Counter(
+		"http.server.api_version.requests",
+		metric.WithDescription("Requests served per API version and route"),
+		metric.WithUnit("{request}"),
+	)
+	if err != nil {
+		panic("failed to create apiVersionRequests counter: " + err.Error())
+	}
+}
+
+func APIVersion(cfg *config.Config, rootLogger providers.LoggerProvider) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		rest := strings.TrimPrefix(c.Path(), apiPrefix)
+		segment, _, _ := strings.Cut(strings.TrimPrefix(rest, "/"), "/")
+
+		name := segment
+		if isVersionSegment(segment) {
+			if _, ok := cfg.API.Version(name); !ok {
+				return exceptions.NewNotFoundException(fmt.Sprintf("API version %s is not supported", name), nil)
+			}
+		} else {
+			name = versionFromAccept(c.Get(fiber.HeaderAccept))
+			if name == "" {
+				name = cfg.API.DefaultVersion
+			}
+			if _, ok := cfg.API.Version(name); !ok {
+				return exceptions.NewBadRequestException(fmt.Sprintf("API version %s is not supported", name), nil)
+			}
+			c.Vary(fiber.HeaderAccept)
+			c.Path(apiPrefix + "/" + name + rest)
+		}
+
+		version, _ := cfg.API.Version(name)
+		oteltrace.SpanFromContext(c.UserContext()).SetAttributes(attribute.String("api.version", name))
+		c.Locals(loggerLocalsKey, LoggerFromLocals(c, rootLogger).With("apiVersion", name))
+		c.Set("API-Version", name)
+		if !version.DeprecatedAt.IsZero() {
+			c.Set("Deprecation", "@"+strconv.FormatInt(version.DeprecatedAt.Unix(), 10))
+			if !version.SunsetAt.IsZero() {
+				c.Set("Sunset", version.SunsetAt.UTC().Format(http.TimeFormat))
+			}
+			if version.DeprecationURL != "" {
+				c.Append(fiber.HeaderLink, fmt.Sprintf(`<%s>; rel="deprecation"`, version.DeprecationURL))
+			}
+		}
+
+		err := c.Next()
+
+		deprecated := !version.DeprecatedAt.IsZero() && !time.Now().Before(version.DeprecatedAt)
+		apiVersionRequests.Add(c.UserContext(), 1, metric.WithAttributes(
+			attribute.String("api.version", name),
+			attribute.Bool("api.version.deprecated", deprecated),
+			attribute.String("http.request.method", c.Method()),
+			attribute.String("http.route", c.Route().Path),
+		))
+
+		return err
+	}
+}
+
+func isVersionSegment(segment string) bool {
+	if len(segment) < 2 || segment[0] != 'v' {
+		return false
+	}
+	_, err := strconv.Atoi(segment[1:])
+	return err == nil
+}
+
+func versionFromAccept(accept string) string {
+	for _, mediaRange := range strings.Split(accept, ",") {
+		_, params, err := mime.ParseMediaType(strings.TrimSpace(mediaRange))
+		if err != nil {
+			continue
+		}
+		if version := params["version"]; version != "" {
+			if !strings.HasPrefix(version, "v") {
+				version = "v" + version
+			}
+			return version
+		}
+	}
+	return ""
+}
@@ -0,0 +1,19 @@
+package middleware
+
+import (
+	"strings"
+
+	"golang_boilerplate_module/internal/config"
+
+	"github.com/gofiber/fiber/v2"
+	fibercors "github.com/gofiber/fiber/v2/middleware/cors"
+)
+
+// CORS applies http.cors_allowed_origins, following reloads.
+func CORS(cfg *config.Config, reloader *config.Reloader) fiber.Handler {
+	return Reloadable(cfg, reloader, func(cfg *config.Config) fiber.Handler {
+		return fibercors.New(fibercors.Config{
+			AllowOrigins: strings.Join(cfg.HTTP.CORSAllowedOrigins, ","),
+		})
+	}, "http.cors_allowed_origins")
+}
@@ -0,0 +1,26 @@
+package middleware
+
+import (
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const currentUserLocalsKey = "currentUserId"
+
+func SetCurrentUserID(c *fiber.Ctx, userID uint) {
+	c.Locals(currentUserLocalsKey, userID)
+}
+
+func CurrentUserID(c *fiber.Ctx) (uint, bool) {
+	userID, ok := c.Locals(currentUserLocalsKey).(uint)
+	return userID, ok && userID != 0
+}
+
+func RequireCurrentUserID(c *fiber.Ctx) (uint, error) {
+	userID, ok := CurrentUserID(c)
+	if !ok {
+		return 0, exceptions.NewUnauthorizedException("Authentication required", nil)
+	}
+	return userID, nil
+}
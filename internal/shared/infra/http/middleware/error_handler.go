@@ -21,6 +21,7 @@ var exceptionHTTPMap = map[exceptions.ExceptionCode]httpMapping{
 	exceptions.CodeNotFound:           {404, "Not Found"},
 	exceptions.CodeUnprocessable:      {422, "Unprocessable Entity"},
 	exceptions.CodeInternal:           {500, "Internal Server Error"},
+	exceptions.CodeTooManyRequests:    {429, "Too Many Requests"},
 	exceptions.CodeServiceUnavailable: {503, "Service Unavailable"},
 }
 
@@ -0,0 +1,27 @@
+package middleware
+
+import (
+	"golang_boilerplate_module/internal/config"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/limiter"
+)
+
+// RateLimit allows http.rate_limit requests per client IP in each
+// http.rate_limit_window, following reloads. Counters live in this process
+// and are shared by every path the handler is mounted on.
+func RateLimit(cfg *config.Config, reloader *config.Reloader) fiber.Handler {
+	return Reloadable(cfg, reloader, func(cfg *config.Config) fiber.Handler {
+		if cfg.HTTP.RateLimit == 0 {
+			return func(c *fiber.Ctx) error { return c.Next() }
+		}
+		return limiter.New(limiter.Config{
+			Max:        cfg.HTTP.RateLimit,
+			Expiration: cfg.HTTP.RateLimitWindow,
+			LimitReached: func(c *fiber.Ctx) error {
+				return exceptions.NewTooManyRequestsException("", nil)
+			},
+		})
+	}, "http.rate_limit", "http.rate_limit_window")
+}
@@ -0,0 +1,16 @@
+package middleware
+
+import (
+	"golang_boilerplate_module/internal/shared/infra/persistence"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// ReadYourWrites makes each request a read-your-writes scope: once it writes,
+// its reads stop going to the read replicas for a while.
+func ReadYourWrites() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		c.SetUserContext(persistence.TrackWrites(c.UserContext()))
+		return c.Next()
+	}
+}
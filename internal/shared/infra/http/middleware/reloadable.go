@@ -0,0 +1,26 @@
+package middleware
+
+import (
+	"sync/atomic"
+
+	"golang_boilerplate_module/internal/config"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Reloadable serves the handler build makes from the current configuration
+// and rebuilds it when a reload changes any of keys. State the handler keeps,
+// such as rate limit counters, starts over.
+func Reloadable(cfg *config.Config, reloader *config.Reloader, build func(*config.Config) fiber.Handler, keys ...string) fiber.Handler {
+	var handler atomic.Pointer[fiber.Handler]
+	store := func(cfg *config.Config) {
+		h := build(cfg)
+		handler.Store(&h)
+	}
+	store(cfg)
+	reloader.Subscribe(store, keys...)
+
+	return func(c *fiber.Ctx) error {
+		return (*handler.Load())(c)
+	}
+}
@@ -0,0 +1,112 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"errors"
+	"time"
+
+	"golang_boilerplate_module/internal/config"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+
+	"github.com/gofiber/fiber/v2"
+	"go.opentelemetry.io/otel/attribute"
+	oteltrace "go.opentelemetry.io/otel/trace"
+)
+
+const currentSessionLocalsKey = "currentSession"
+
+func Session(cfg *config.Config, sessions providers.SessionAuthenticator, rootLogger providers.LoggerProvider) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		token := c.Cookies(cfg.Sessions.CookieName)
+		if token == "" {
+			return c.Next()
+		}
+
+		ctx := c.UserContext()
+		session, err := sessions.AuthenticateSession(ctx, token)
+		if err != nil {
+			var domainErr *exceptions.DomainError
+			if errors.As(err, &domainErr) && domainErr.Code == exceptions.CodeUnauthorized {
+				ClearSessionCookies(c, cfg)
+			}
+			return err
+		}
+
+		c.Locals(currentSessionLocalsKey, session)
+		SetCurrentUserID(c, session.UserID)
+
+		oteltrace.SpanFromContext(ctx).SetAttributes(attribute.Int("user.id", int(session.UserID)))
+		c.Locals(loggerLocalsKey, LoggerFromLocals(c, rootLogger).With("userId", session.UserID))
+
+		if session.Refreshed {
+			SetSessionCookies(c, cfg, token, session.CSRFToken, session.ExpiresAt)
+		}
+
+		return c.Next()
+	}
+}
+
+func CSRF(cfg *config.Config) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		switch c.Method() {
+		case fiber.MethodGet, fiber.MethodHead, fiber.MethodOptions, fiber.MethodTrace:
+			return c.Next()
+		}
+
+		session, ok := CurrentSession(c)
+		if !ok {
+			return c.Next()
+		}
+
+		provided := c.Get(cfg.Sessions.CSRFHeader)
+		if provided == "" || subtle.ConstantTimeCompare([]byte(provided), []byte(session.CSRFToken)) != 1 {
+			return exceptions.NewForbiddenException("Missing or invalid CSRF token", nil)
+		}
+
+		return c.Next()
+	}
+}
+
+func CurrentSession(c *fiber.Ctx) (providers.AuthenticatedSession, bool) {
+	session, ok := c.Locals(currentSessionLocalsKey).(providers.AuthenticatedSession)
+	return session, ok
+}
+
+func SetSessionCookies(c *fiber.Ctx, cfg *config.Config, token, csrfToken string, expiresAt time.Time) {
+	c.Cookie(&fiber.Cookie{
+		Name:     cfg.Sessions.CookieName,
+		Value:    token,
+		Path:     "/",
+		Domain:   cfg.Sessions.CookieDomain,
+		Expires:  expiresAt,
+		Secure:   cfg.Sessions.CookieSecure,
+		HTTPOnly: true,
+		SameSite: cfg.Sessions.CookieSameSite,
+	})
+	c.Cookie(&fiber.Cookie{
+		Name:     cfg.Sessions.CSRFCookieName,
+		Value:    csrfToken,
+		Path:     "/",
+		Domain:   cfg.Sessions.CookieDomain,
+		Expires:  expiresAt,
+		Secure:   cfg.Sessions.CookieSecure,
+		HTTPOnly: false,
+		SameSite: cfg.Sessions.CookieSameSite,
+	})
+}
+
+func ClearSessionCookies(c *fiber.Ctx, cfg *config.Config) {
+	for _, name := range []string{cfg.Sessions.CookieName, cfg.Sessions.CSRFCookieName} {
+		c.Cookie(&fiber.Cookie{
+			Name:     name,
+			Value:    "",
+			Path:     "/",
+			Domain:   cfg.Sessions.CookieDomain,
+			Expires:  time.Unix(0, 0),
+			Secure:   cfg.Sessions.CookieSecure,
+			HTTPOnly: name == cfg.Sessions.CookieName,
+			SameSite: cfg.Sessions.CookieSameSite,
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package middleware
+
+import (
+	"errors"
+	"strings"
+
+	"golang_boilerplate_module/internal/config"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+	"golang_boilerplate_module/internal/shared/domain/tenancy"
+
+	"github.com/gofiber/fiber/v2"
+	"go.opentelemetry.io/otel/attribute"
+	oteltrace "go.opentelemetry.io/otel/trace"
+)
+
+func Tenant(cfg *config.Config, lookup tenancy.TenantLookup, rootLogger providers.LoggerProvider) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		ref, source := resolveTenantRef(c, cfg.Tenancy)
+		if ref == (tenancy.TenantRef{}) {
+			if cfg.Tenancy.DefaultTenant == "" {
+				return exceptions.NewBadRequestException("Tenant could not be resolved", nil)
+			}
+			ref, source = tenancy.TenantRef{ID: cfg.Tenancy.DefaultTenant}, "default"
+		}
+
+		ctx := c.UserContext()
+		tenantID, err := lookup.FindActiveTenantID(ctx, ref)
+		if err != nil {
+			var domainErr *exceptions.DomainError
+			if errors.As(err, &domainErr) && domainErr.Code == exceptions.CodeNotFound {
+				return exceptions.NewNotFoundException("Tenant not found", nil)
+			}
+			return err
+		}
+		// A session belongs to one tenant; a header or subdomain cannot
+		// move it to another.
+		if session, ok := CurrentSession(c); ok && session.TenantID != tenantID {
+			return exceptions.NewForbiddenException("Tenant does not match the session", nil)
+		}
+
+		ctx = tenancy.WithTenantID(ctx, tenantID)
+		c.SetUserContext(ctx)
+
+		oteltrace.SpanFromContext(ctx).SetAttributes(
+			attribute.String("tenant.id", tenantID),
+			attribute.String("tenant.source", source),
+		)
+		c.Locals(loggerLocalsKey, LoggerFromLocals(c, rootLogger).With("tenantId", tenantID))
+
+		return c.Next()
+	}
+}
+
+func resolveTenantRef(c *fiber.Ctx, cfg config.TenancyConfig) (tenancy.TenantRef, string) {
+	for _, resolver := range cfg.Resolvers {
+		switch resolver {
+		case "session":
+			if session, ok := CurrentSession(c); ok {
+				return tenancy.TenantRef{ID: session.TenantID}, resolver
+			}
+		case "header":
+			if id := strings.TrimSpace(c.Get(cfg.Header)); id != "" {
+				return tenancy.TenantRef{ID: id}, resolver
+			}
+		case "subdomain":
+			if cfg.BaseDomain == "" {
+				continue
+			}
+			host := strings.ToLower(c.Hostname())
+			if sub, ok := strings.CutSuffix(host, "."+cfg.BaseDomain); ok && sub != "" && !strings.Contains(sub, ".") {
+				return tenancy.TenantRef{Subdomain: sub}, resolver
+			}
+		}
+	}
+	return tenancy.TenantRef{}, ""
+}
@@ -0,0 +1,58 @@
+package openapi
+
+import (
+	"bytes"
+	"embed"
+	"net/http"
+
+	"golang_boilerplate_module/internal/config"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/filesystem"
+)
+
+const (
+	SpecPath   = "/openapi.json"
+	DocsPath   = "/docs"
+	RedocPath  = "/docs/redoc"
+	AssetsPath = "/docs/assets"
+)
+
+var (
+	//go:embed ui/swagger.html
+	swaggerPage []byte
+
+	//go:embed ui/redoc.html
+	redocPage []byte
+
+	// vendor holds the pinned Swagger UI and Redoc assets (make openapi/ui).
+	//go:embed ui/vendor
+	vendor embed.FS
+)
+
+func RegisterDocsRoutes(app *fiber.App, spec *Spec, cfg *config.Config) {
+	if !cfg.OpenAPI.DocsEnabled {
+		return
+	}
+
+	app.Get(SpecPath, func(c *fiber.Ctx) error {
+		body, err := spec.JSON()
+		if err != nil {
+			return err
+		}
+		c.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSONCharsetUTF8)
+		return c.Send(body)
+	})
+	app.Use(AssetsPath, filesystem.New(filesystem.Config{Root: http.FS(vendor), PathPrefix: "ui/vendor", MaxAge: 86400}))
+	app.Get(DocsPath, uiHandler(swaggerPage))
+	app.Get(RedocPath, uiHandler(redocPage))
+}
+
+func uiHandler(page []byte) fiber.Handler {
+	rendered := bytes.ReplaceAll(page, []byte("{{SPEC_URL}}"), []byte(SpecPath))
+	rendered = bytes.ReplaceAll(rendered, []byte("{{ASSETS_URL}}"), []byte(AssetsPath))
+	return func(c *fiber.Ctx) error {
+		c.Set(fiber.HeaderContentType, fiber.MIMETextHTMLCharsetUTF8)
+		return c.Send(rendered)
+	}
+}
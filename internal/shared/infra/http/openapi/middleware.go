@@ -0,0 +1,96 @@
+package openapi
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+
+	"golang_boilerplate_module/internal/config"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+	"golang_boilerplate_module/internal/shared/infra/http/middleware"
+
+	"github.com/gofiber/fiber/v2"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+var contractViolations metric.Int64Counter
+
+func init() {
+	meter := otel.Meter("http")
+
+	var err error
+	contractViolations, err = meter.Int64Counter(
+		"http.server.contract.violations",
+		metric.WithDescription("Requests and responses that did not match the OpenAPI contract"),
+		metric.WithUnit("{violation}"),
+	)
+	if err != nil {
+		panic("failed to create contractViolations counter: " + err.Error())
+	}
+}
+
+func Validation(cfg *config.Config, validator *Validator, rootLogger providers.LoggerProvider) fiber.Handler {
+	validateRequests := cfg.OpenAPI.ValidateRequests
+	validateResponses := cfg.OpenAPI.ValidateResponses
+	if !validateRequests && !validateResponses {
+		return func(c *fiber.Ctx) error {
+			return c.Next()
+		}
+	}
+
+	return func(c *fiber.Ctx) error {
+		method, path := c.Method(), c.Path()
+
+		if validateRequests {
+			query, _ := url.ParseQuery(string(c.Request().URI().QueryString()))
+			err := validator.ValidateRequest(method, path, query, c.Get(fiber.HeaderContentType), c.Body())
+
+			var contractErr *ContractError
+			if errors.As(err, &contractErr) {
+				recordViolation(c, contractErr)
+				message := "Request does not match the API contract: " + strings.Join(contractErr.Violations, "; ")
+				return exceptions.NewBadRequestException(message, map[string]any{
+					"violations": contractErr.Violations,
+				})
+			}
+		}
+
+		if !validateResponses {
+			return c.Next()
+		}
+
+		if err := c.Next(); err != nil {
+			if handlerErr := c.App().Config().ErrorHandler(c, err); handlerErr != nil {
+				return handlerErr
+			}
+		}
+
+		response := c.Response()
+		if response.IsBodyStream() {
+			return nil
+		}
+		err := validator.ValidateResponse(method, path, response.StatusCode(), string(response.Header.ContentType()), response.Body())
+
+		var contractErr *ContractError
+		if errors.As(err, &contractErr) {
+			recordViolation(c, contractErr)
+			middleware.LoggerFromLocals(c, rootLogger).Error("Response does not match the API contract",
+				"operation", contractErr.Operation,
+				"status", contractErr.Status,
+				"violations", contractErr.Violations,
+			)
+		}
+		return nil
+	}
+}
+
+func recordViolation(c *fiber.Ctx, err *ContractError) {
+	contractViolations.Add(c.UserContext(), 1, metric.WithAttributes(
+		attribute.String("http.request.method", err.Method),
+		attribute.String("http.route", err.Path),
+		attribute.String("contract.direction", err.Direction),
+	))
+}
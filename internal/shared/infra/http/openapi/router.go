@@ -0,0 +1,287 @@
+package openapi
+
+import (
+	"net/http"
+	"reflect"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"golang_boilerplate_module/internal/shared/infra/http/middleware"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	fiberJSON       = fiber.MIMEApplicationJSON
+	eventStreamMIME = "text/event-stream"
+)
+
+type Router struct {
+	router   fiber.Router
+	spec     *Spec
+	prefix   string
+	defaults []RouteOption
+	disabled bool
+}
+
+func NewRouter(app *fiber.App, spec *Spec) *Router {
+	return &Router{router: app, spec: spec}
+}
+
+func (r *Router) Spec() *Spec {
+	return r.spec
+}
+
+func (r *Router) Group(prefix string, defaults ...RouteOption) *Router {
+	return &Router{
+		router:   r.router.Group(prefix),
+		spec:     r.spec,
+		prefix:   r.prefix + prefix,
+		defaults: append(append([]RouteOption(nil), r.defaults...), defaults...),
+		disabled: r.disabled,
+	}
+}
+
+func (r *Router) Version(name string, defaults ...RouteOption) *Router {
+	group := r.Group("/api/"+name, defaults...)
+
+	version, ok := r.spec.api.Version(name)
+	if !ok {
+		group.disabled = true
+	} else if !version.DeprecatedAt.IsZero() {
+		group.defaults = append(group.defaults, Deprecated())
+	}
+	return group
+}
+
+func (r *Router) Get(path string, handler fiber.Handler, options ...RouteOption) {
+	r.Add(fiber.MethodGet, path, handler, options...)
+}
+
+func (r *Router) Post(path string, handler fiber.Handler, options ...RouteOption) {
+	r.Add(fiber.MethodPost, path, handler, options...)
+}
+
+func (r *Router) Put(path string, handler fiber.Handler, options ...RouteOption) {
+	r.Add(fiber.MethodPut, path, handler, options...)
+}
+
+func (r *Router) Patch(path string, handler fiber.Handler, options ...RouteOption) {
+	r.Add(fiber.MethodPatch, path, handler, options...)
+}
+
+func (r *Router) Delete(path string, handler fiber.Handler, options ...RouteOption) {
+	r.Add(fiber.MethodDelete, path, handler, options...)
+}
+
+func (r *Router) Add(method, path string, handler fiber.Handler, options ...RouteOption) {
+	if r.disabled {
+		return
+	}
+	all := append([]RouteOption{OperationID(handlerOperationID(handler))}, r.defaults...)
+	operation := r.spec.add(method, r.prefix+path, append(all, options...))
+
+	if len(operation.Security) > 0 {
+		r.router.Add(method, path, requireSession, handler)
+		return
+	}
+	r.router.Add(method, path, handler)
+}
+
+// requireSession rejects the requests to Authenticated routes that have no
+// session, before the handler runs.
+func requireSession(c *fiber.Ctx) error {
+	if _, err := middleware.RequireCurrentUserID(c); err != nil {
+		return err
+	}
+	return c.Next()
+}
+
+type operationBuilder struct {
+	spec      *Spec
+	operation *Operation
+}
+
+func (b *operationBuilder) hasParameter(name, in string) bool {
+	for _, parameter := range b.operation.Parameters {
+		if parameter.Name == name && parameter.In == in {
+			return true
+		}
+	}
+	return false
+}
+
+func (b *operationBuilder) hasSuccessResponse() bool {
+	for status := range b.operation.Responses {
+		if code, err := strconv.Atoi(status); err == nil && code < 400 {
+			return true
+		}
+	}
+	return false
+}
+
+type RouteOption func(*operationBuilder)
+
+func OperationID(id string) RouteOption {
+	return func(b *operationBuilder) {
+		b.operation.OperationID = id
+	}
+}
+
+func Summary(summary string) RouteOption {
+	return func(b *operationBuilder) {
+		b.operation.Summary = summary
+	}
+}
+
+func Description(description string) RouteOption {
+	return func(b *operationBuilder) {
+		b.operation.Description = description
+	}
+}
+
+func Tags(tags ...string) RouteOption {
+	return func(b *operationBuilder) {
+		b.operation.Tags = append(b.operation.Tags, tags...)
+	}
+}
+
+func Deprecated() RouteOption {
+	return func(b *operationBuilder) {
+		b.operation.Deprecated = true
+	}
+}
+
+// Authenticated requires a session, in the document and on the route.
+func Authenticated() RouteOption {
+	return func(b *operationBuilder) {
+		b.operation.Security = []map[string][]string{{sessionSecurityKey: {}}}
+		b.operation.Responses["401"] = b.spec.errorResponse(http.StatusUnauthorized)
+	}
+}
+
+func PathParam(name, schemaType, description string) RouteOption {
+	return param("path", name, schemaType, description, true)
+}
+
+func QueryParam(name, schemaType, description string) RouteOption {
+	return param("query", name, schemaType, description, false)
+}
+
+func param(in, name, schemaType, description string, required bool) RouteOption {
+	return func(b *operationBuilder) {
+		schema := &Schema{Type: schemaType}
+		if schemaType == "integer" {
+			schema.Minimum = float(0)
+		}
+		b.operation.Parameters = append(b.operation.Parameters, Parameter{
+			Name:        name,
+			In:          in,
+			Description: description,
+			Required:    required,
+			Schema:      schema,
+		})
+	}
+}
+
+func Body[T any]() RouteOption {
+	return func(b *operationBuilder) {
+		b.operation.RequestBody = &RequestBody{
+			Required: true,
+			Content: map[string]MediaType{
+				fiberJSON: {Schema: b.spec.schemas.schemaFor(reflectType[T]())},
+			},
+		}
+	}
+}
+
+func OptionalBody[T any]() RouteOption {
+	return func(b *operationBuilder) {
+		Body[T]()(b)
+		b.operation.RequestBody.Required = false
+	}
+}
+
+func Returns[T any](status int) RouteOption {
+	return func(b *operationBuilder) {
+		b.operation.Responses[strconv.Itoa(status)] = &Response{
+			Description: http.StatusText(status),
+			Content: map[string]MediaType{
+				fiberJSON: {Schema: b.spec.schemas.schemaFor(reflectType[T]())},
+			},
+		}
+	}
+}
+
+func EventStream[T any](description string) RouteOption {
+	return func(b *operationBuilder) {
+		b.operation.Responses[strconv.Itoa(http.StatusOK)] = &Response{
+			Description: description,
+			Content: map[string]MediaType{
+				eventStreamMIME: {Schema: b.spec.schemas.schemaFor(reflectType[T]())},
+			},
+		}
+	}
+}
+
+func WebSocket(description string) RouteOption {
+	return func(b *operationBuilder) {
+		b.operation.Responses[strconv.Itoa(http.StatusSwitchingProtocols)] = &Response{
+			Description: description,
+		}
+	}
+}
+
+func NoContent() RouteOption {
+	return func(b *operationBuilder) {
+		b.operation.Responses[strconv.Itoa(http.StatusNoContent)] = &Response{
+			Description: http.StatusText(http.StatusNoContent),
+		}
+	}
+}
+
+func Redirect(description string) RouteOption {
+	return func(b *operationBuilder) {
+		b.operation.Responses[strconv.Itoa(http.StatusFound)] = &Response{
+			Description: description,
+			Headers: map[string]Header{
+				fiber.HeaderLocation: {Schema: &Schema{Type: "string"}},
+			},
+		}
+	}
+}
+
+func Errors(statuses ...int) RouteOption {
+	return func(b *operationBuilder) {
+		for _, status := range statuses {
+			b.operation.Responses[strconv.Itoa(status)] = b.spec.errorResponse(status)
+		}
+	}
+}
+
+func reflectType[T any]() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
+
+func handlerOperationID(handler fiber.Handler) string {
+	name := runtime.FuncForPC(reflect.ValueOf(handler).Pointer()).Name()
+	name = strings.TrimSuffix(name[strings.LastIndex(name, "/")+1:], "-fm")
+
+	parts := strings.Split(name, ".")
+	method := parts[len(parts)-1]
+	if len(parts) < 3 {
+		return lowerFirst(method)
+	}
+
+	receiver := strings.Trim(parts[len(parts)-2], "(*)")
+	receiver = strings.TrimSuffix(receiver, "Controller")
+	return lowerFirst(receiver) + method
+}
+
+func lowerFirst(s string) string {
+	if s == "" || strings.ToUpper(s) == s {
+		return strings.ToLower(s)
+	}
+	return strings.ToLower(s[:1]) + s[1:]
+}
@@ -0,0 +1,227 @@
+package openapi
+
+import (
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+var (
+	timeType       = reflect.TypeOf(time.Time{})
+	durationType   = reflect.TypeOf(time.Duration(0))
+	rawMessageType = reflect.TypeOf(json.RawMessage{})
+)
+
+type schemaRegistry struct {
+	schemas map[string]*Schema
+	names   map[reflect.Type]string
+	owners  map[string]reflect.Type
+}
+
+func newSchemaRegistry() *schemaRegistry {
+	return &schemaRegistry{
+		schemas: make(map[string]*Schema),
+		names:   make(map[reflect.Type]string),
+		owners:  make(map[string]reflect.Type),
+	}
+}
+
+func (r *schemaRegistry) schemaFor(t reflect.Type) *Schema {
+	nullable := false
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+		nullable = true
+	}
+
+	schema := r.baseSchema(t)
+	if kind, ok := schema.Type.(string); ok && nullable {
+		schema.Type = []string{kind, "null"}
+	}
+	return schema
+}
+
+func (r *schemaRegistry) baseSchema(t reflect.Type) *Schema {
+	switch t {
+	case timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case durationType:
+		return &Schema{Type: "integer", Format: "int64", Description: "Duration in nanoseconds"}
+	case rawMessageType:
+		return &Schema{}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer", Minimum: float(0)}
+	case reflect.Float32:
+		return &Schema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: r.schemaFor(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: r.schemaFor(t.Elem())}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return r.structSchema(t)
+		}
+		return &Schema{Ref: "#/components/schemas/" + r.register(t)}
+	}
+
+	return &Schema{}
+}
+
+func (r *schemaRegistry) register(t reflect.Type) string {
+	if name, ok := r.names[t]; ok {
+		return name
+	}
+
+	name := schemaName(t)
+	if owner, taken := r.owners[name]; taken && owner != t {
+		name = exportedName(t.PkgPath()[strings.LastIndex(t.PkgPath(), "/")+1:]) + name
+	}
+
+	r.names[t] = name
+	r.owners[name] = t
+	r.schemas[name] = &Schema{}
+	*r.schemas[name] = *r.structSchema(t)
+	return name
+}
+
+func (r *schemaRegistry) structSchema(t reflect.Type) *Schema {
+	schema := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+	r.collectFields(t, schema)
+	return schema
+}
+
+func (r *schemaRegistry) collectFields(t reflect.Type, schema *Schema) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				r.collectFields(embedded, schema)
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		property := r.schemaFor(field.Type)
+		if doc := field.Tag.Get("doc"); doc != "" {
+			property.Description = doc
+		}
+		if example := field.Tag.Get("example"); example != "" {
+			property.Examples = []any{example}
+		}
+		if applyValidation(property, field.Tag.Get("validate")) {
+			schema.Required = append(schema.Required, name)
+		}
+
+		schema.Properties[name] = property
+	}
+}
+
+func applyValidation(schema *Schema, tag string) bool {
+	required := false
+	for _, rule := range strings.Split(tag, ",") {
+		key, value, _ := strings.Cut(strings.TrimSpace(rule), "=")
+		switch key {
+		case "required":
+			required = true
+		case "email":
+			schema.Format = "email"
+		case "url":
+			schema.Format = "uri"
+		case "uuid":
+			schema.Format = "uuid"
+		case "oneof":
+			for _, option := range strings.Fields(value) {
+				schema.Enum = append(schema.Enum, option)
+			}
+		case "min", "max", "len":
+			n, err := strconv.Atoi(value)
+			if err != nil {
+				continue
+			}
+			applyBound(schema, key, n)
+		}
+	}
+	return required
+}
+
+func applyBound(schema *Schema, key string, n int) {
+	kind, _ := schema.Type.(string)
+	switch kind {
+	case "string":
+		if key != "max" {
+			schema.MinLength = &n
+		}
+		if key != "min" {
+			schema.MaxLength = &n
+		}
+	case "array":
+		if key != "max" {
+			schema.MinItems = &n
+		}
+		if key != "min" {
+			schema.MaxItems = &n
+		}
+	case "integer", "number":
+		if key != "max" {
+			schema.Minimum = float(float64(n))
+		}
+		if key != "min" {
+			schema.Maximum = float(float64(n))
+		}
+	}
+}
+
+func schemaName(t reflect.Type) string {
+	name := t.Name()
+	if i := strings.Index(name, "["); i >= 0 {
+		name = name[:i]
+	}
+	return exportedName(name)
+}
+
+func exportedName(name string) string {
+	if name == "" {
+		return name
+	}
+	runes := []rune(name)
+	runes[0] = unicode.ToUpper(runes[0])
+	return string(runes)
+}
+
+func float(v float64) *float64 {
+	return &v
+}
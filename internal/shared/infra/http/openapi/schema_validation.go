@@ -0,0 +1,206 @@
+package openapi
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const schemaRefPrefix = "#/components/schemas/"
+
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+func (v *Validator) validateValue(schema *Schema, value any, at string, violations []string) []string {
+	if schema == nil {
+		return violations
+	}
+	if schema.Ref != "" {
+		resolved, ok := v.doc.Components.Schemas[strings.TrimPrefix(schema.Ref, schemaRefPrefix)]
+		if !ok {
+			return append(violations, fmt.Sprintf("%s: unresolved schema %s", at, schema.Ref))
+		}
+		return v.validateValue(resolved, value, at, violations)
+	}
+
+	kind := jsonType(value)
+	if allowed := schemaTypes(schema); len(allowed) > 0 && !typeAllowed(allowed, kind, value) {
+		return append(violations, fmt.Sprintf("%s: expected %s, got %s", at, strings.Join(allowed, " or "), kind))
+	}
+
+	if len(schema.Enum) > 0 && !inEnum(schema.Enum, value) {
+		violations = append(violations, fmt.Sprintf("%s: must be one of %v", at, schema.Enum))
+	}
+
+	switch typed := value.(type) {
+	case string:
+		violations = validateString(schema, typed, at, violations)
+	case json.Number:
+		violations = validateNumber(schema, typed, at, violations)
+	case []any:
+		violations = v.validateArray(schema, typed, at, violations)
+	case map[string]any:
+		violations = v.validateObject(schema, typed, at, violations)
+	}
+	return violations
+}
+
+func validateString(schema *Schema, value, at string, violations []string) []string {
+	length := utf8.RuneCountInString(value)
+	if schema.MinLength != nil && length < *schema.MinLength {
+		violations = append(violations, fmt.Sprintf("%s: must be at least %d characters", at, *schema.MinLength))
+	}
+	if schema.MaxLength != nil && length > *schema.MaxLength {
+		violations = append(violations, fmt.Sprintf("%s: must be at most %d characters", at, *schema.MaxLength))
+	}
+	if schema.Pattern != "" {
+		if pattern, err := regexp.Compile(schema.Pattern); err == nil && !pattern.MatchString(value) {
+			violations = append(violations, fmt.Sprintf("%s: must match %s", at, schema.Pattern))
+		}
+	}
+	if !validFormat(schema.Format, value) {
+		violations = append(violations, fmt.Sprintf("%s: must be a valid %s", at, schema.Format))
+	}
+	return violations
+}
+
+func validFormat(format, value string) bool {
+	switch format {
+	case "date-time":
+		_, err := time.Parse(time.RFC3339Nano, value)
+		return err == nil
+	case "email":
+		address, err := mail.ParseAddress(value)
+		return err == nil && address.Address == value
+	case "uuid":
+		return uuidPattern.MatchString(value)
+	case "uri":
+		parsed, err := url.Parse(value)
+		return err == nil && parsed.Scheme != ""
+	case "byte":
+		_, err := base64.StdEncoding.DecodeString(value)
+		return err == nil
+	}
+	return true
+}
+
+func validateNumber(schema *Schema, value json.Number, at string, violations []string) []string {
+	n, err := value.Float64()
+	if err != nil {
+		return append(violations, fmt.Sprintf("%s: invalid number %s", at, value))
+	}
+	if schema.Minimum != nil && n < *schema.Minimum {
+		violations = append(violations, fmt.Sprintf("%s: must be >= %v", at, *schema.Minimum))
+	}
+	if schema.Maximum != nil && n > *schema.Maximum {
+		violations = append(violations, fmt.Sprintf("%s: must be <= %v", at, *schema.Maximum))
+	}
+	if schema.Format == "int32" && (n < math.MinInt32 || n > math.MaxInt32) {
+		violations = append(violations, fmt.Sprintf("%s: must fit in int32", at))
+	}
+	return violations
+}
+
+func (v *Validator) validateArray(schema *Schema, items []any, at string, violations []string) []string {
+	if schema.MinItems != nil && len(items) < *schema.MinItems {
+		violations = append(violations, fmt.Sprintf("%s: must have at least %d items", at, *schema.MinItems))
+	}
+	if schema.MaxItems != nil && len(items) > *schema.MaxItems {
+		violations = append(violations, fmt.Sprintf("%s: must have at most %d items", at, *schema.MaxItems))
+	}
+	for i, item := range items {
+		violations = v.validateValue(schema.Items, item, fmt.Sprintf("%s[%d]", at, i), violations)
+	}
+	return violations
+}
+
+func (v *Validator) validateObject(schema *Schema, object map[string]any, at string, violations []string) []string {
+	for _, name := range schema.Required {
+		if _, ok := object[name]; !ok {
+			violations = append(violations, fmt.Sprintf("%s.%s: is required", at, name))
+		}
+	}
+
+	names := make([]string, 0, len(object))
+	for name := range object {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		property, ok := schema.Properties[name]
+		if !ok {
+			property = schema.AdditionalProperties
+		}
+		violations = v.validateValue(property, object[name], at+"."+name, violations)
+	}
+	return violations
+}
+
+func schemaTypes(schema *Schema) []string {
+	switch kind := schema.Type.(type) {
+	case string:
+		return []string{kind}
+	case []string:
+		return kind
+	}
+	return nil
+}
+
+func typeAllowed(allowed []string, kind string, value any) bool {
+	for _, candidate := range allowed {
+		if candidate == kind || candidate == "number" && kind == "integer" {
+			return true
+		}
+		if candidate == "integer" && kind == "number" && isIntegral(value) {
+			return true
+		}
+	}
+	return false
+}
+
+func jsonType(value any) string {
+	switch typed := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case json.Number:
+		if strings.ContainsAny(typed.String(), ".eE") {
+			return "number"
+		}
+		return "integer"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+func isIntegral(value any) bool {
+	number, ok := value.(json.Number)
+	if !ok {
+		return false
+	}
+	n, err := number.Float64()
+	return err == nil && n == math.Trunc(n)
+}
+
+func inEnum(enum []any, value any) bool {
+	for _, option := range enum {
+		if fmt.Sprint(option) == fmt.Sprint(value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,205 @@
+package openapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"golang_boilerplate_module/internal/config"
+)
+
+const (
+	openAPIVersion     = "3.1.0"
+	errorSchemaName    = "ErrorResponse"
+	sessionSecurityKey = "sessionCookie"
+)
+
+type errorResponse struct {
+	Status  int    `json:"status" doc:"HTTP status code" validate:"required"`
+	Error   string `json:"error" doc:"HTTP status text" validate:"required"`
+	Message string `json:"message" doc:"Human readable description of the failure" validate:"required"`
+}
+
+type route struct {
+	method    string
+	path      string
+	operation *Operation
+}
+
+type prefixErrors struct {
+	prefix   string
+	statuses []int
+}
+
+type Spec struct {
+	mu           sync.Mutex
+	info         Info
+	routes       []route
+	tags         map[string]string
+	schemas      *schemaRegistry
+	cookie       string
+	api          config.APIConfig
+	prefixErrors []prefixErrors
+}
+
+func NewSpec(cfg *config.Config) *Spec {
+	spec := &Spec{
+		info: Info{
+			Title:   cfg.App.ServiceName,
+			Version: cfg.App.Version,
+		},
+		tags:    make(map[string]string),
+		schemas: newSchemaRegistry(),
+		cookie:  cfg.Sessions.CookieName,
+		api:     cfg.API,
+	}
+	spec.schemas.register(reflectType[errorResponse]())
+	return spec
+}
+
+func (s *Spec) DescribeTag(name, description string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tags[name] = description
+}
+
+func (s *Spec) DescribeErrors(prefix string, statuses ...int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.prefixErrors = append(s.prefixErrors, prefixErrors{prefix: prefix, statuses: statuses})
+}
+
+func (s *Spec) add(method, path string, options []RouteOption) *Operation {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	op := &operationBuilder{
+		spec:      s,
+		operation: &Operation{Responses: make(map[string]*Response)},
+	}
+	for _, option := range options {
+		option(op)
+	}
+
+	openAPIPath, pathParams := convertPath(path)
+	for _, name := range pathParams {
+		if !op.hasParameter(name, "path") {
+			op.operation.Parameters = append(op.operation.Parameters, Parameter{
+				Name:     name,
+				In:       "path",
+				Required: true,
+				Schema:   &Schema{Type: "string"},
+			})
+		}
+	}
+	for _, tag := range op.operation.Tags {
+		if _, ok := s.tags[tag]; !ok {
+			s.tags[tag] = ""
+		}
+	}
+	for _, described := range s.prefixErrors {
+		if path != described.prefix && !strings.HasPrefix(path, described.prefix+"/") {
+			continue
+		}
+		for _, status := range described.statuses {
+			if _, ok := op.operation.Responses[strconv.Itoa(status)]; !ok {
+				op.operation.Responses[strconv.Itoa(status)] = s.errorResponse(status)
+			}
+		}
+	}
+	if _, ok := op.operation.Responses["400"]; !ok && (op.operation.RequestBody != nil || len(op.operation.Parameters) > 0) {
+		op.operation.Responses["400"] = s.errorResponse(http.StatusBadRequest)
+	}
+	if !op.hasSuccessResponse() {
+		op.operation.Responses["200"] = &Response{Description: http.StatusText(http.StatusOK)}
+	}
+	if _, ok := op.operation.Responses["500"]; !ok {
+		op.operation.Responses["500"] = s.errorResponse(http.StatusInternalServerError)
+	}
+
+	s.routes = append(s.routes, route{method: strings.ToLower(method), path: openAPIPath, operation: op.operation})
+	return op.operation
+}
+
+func (s *Spec) errorResponse(status int) *Response {
+	return &Response{
+		Description: http.StatusText(status),
+		Content: map[string]MediaType{
+			fiberJSON: {Schema: &Schema{Ref: "#/components/schemas/" + errorSchemaName}},
+		},
+	}
+}
+
+func (s *Spec) Document() *Document {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	doc := &Document{
+		OpenAPI: openAPIVersion,
+		Info:    s.info,
+		Paths:   make(map[string]*PathItem),
+		Components: Components{
+			Schemas: s.schemas.schemas,
+			SecuritySchemes: map[string]*SecurityScheme{
+				sessionSecurityKey: {
+					Type:        "apiKey",
+					In:          "cookie",
+					Name:        s.cookie,
+					Description: "Session cookie issued at login. Unsafe methods must also send the CSRF token header.",
+				},
+			},
+		},
+	}
+
+	seen := make(map[string]int)
+	for _, r := range s.routes {
+		item, ok := doc.Paths[r.path]
+		if !ok {
+			item = &PathItem{}
+			doc.Paths[r.path] = item
+		}
+
+		operation := *r.operation
+		seen[operation.OperationID]++
+		if n := seen[operation.OperationID]; n > 1 {
+			operation.OperationID += strconv.Itoa(n)
+		}
+		(*item)[r.method] = &operation
+	}
+
+	names := make([]string, 0, len(s.tags))
+	for name := range s.tags {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		doc.Tags = append(doc.Tags, Tag{Name: name, Description: s.tags[name]})
+	}
+
+	return doc
+}
+
+func (s *Spec) JSON() ([]byte, error) {
+	return json.MarshalIndent(s.Document(), "", "  ")
+}
+
+func convertPath(path string) (string, []string) {
+	segments := strings.Split(path, "/")
+	var params []string
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, ":") {
+			name := strings.TrimSuffix(segment[1:], "?")
+			params = append(params, name)
+			segments[i] = "{" + name + "}"
+		}
+	}
+
+	converted := strings.Join(segments, "/")
+	if len(converted) > 1 {
+		converted = strings.TrimSuffix(converted, "/")
+	}
+	return converted, params
+}
@@ -0,0 +1,252 @@
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	DirectionRequest  = "request"
+	DirectionResponse = "response"
+)
+
+type ContractError struct {
+	Direction  string
+	Method     string
+	Path       string
+	Operation  string
+	Status     int
+	Violations []string
+}
+
+func (e *ContractError) Error() string {
+	return fmt.Sprintf("%s does not match the API contract of %s: %s",
+		e.Direction, e.Operation, strings.Join(e.Violations, "; "))
+}
+
+type compiledRoute struct {
+	method    string
+	path      string
+	segments  []string
+	literals  int
+	operation *Operation
+}
+
+type Validator struct {
+	spec   *Spec
+	once   sync.Once
+	doc    *Document
+	routes []compiledRoute
+}
+
+func NewValidator(spec *Spec) *Validator {
+	return &Validator{spec: spec}
+}
+
+func (v *Validator) compile() {
+	v.doc = v.spec.Document()
+	for path, item := range v.doc.Paths {
+		segments := strings.Split(strings.Trim(path, "/"), "/")
+		literals := 0
+		for _, segment := range segments {
+			if !isTemplate(segment) {
+				literals++
+			}
+		}
+		for method, operation := range *item {
+			v.routes = append(v.routes, compiledRoute{
+				method:    method,
+				path:      path,
+				segments:  segments,
+				literals:  literals,
+				operation: operation,
+			})
+		}
+	}
+	sort.SliceStable(v.routes, func(i, j int) bool {
+		return v.routes[i].literals > v.routes[j].literals
+	})
+}
+
+func (v *Validator) match(method, path string) (*compiledRoute, map[string]string) {
+	v.once.Do(v.compile)
+
+	method = strings.ToLower(method)
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i := range v.routes {
+		route := &v.routes[i]
+		if route.method != method || len(route.segments) != len(segments) {
+			continue
+		}
+		params, ok := matchSegments(route.segments, segments)
+		if ok {
+			return route, params
+		}
+	}
+	return nil, nil
+}
+
+func matchSegments(template, segments []string) (map[string]string, bool) {
+	params := make(map[string]string)
+	for i, segment := range template {
+		if isTemplate(segment) {
+			if segments[i] == "" {
+				return nil, false
+			}
+			value, err := url.PathUnescape(segments[i])
+			if err != nil {
+				value = segments[i]
+			}
+			params[segment[1:len(segment)-1]] = value
+			continue
+		}
+		if segment != segments[i] {
+			return nil, false
+		}
+	}
+	return params, true
+}
+
+func isTemplate(segment string) bool {
+	return strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}")
+}
+
+func (v *Validator) ValidateRequest(method, path string, query url.Values, contentType string, body []byte) error {
+	route, params := v.match(method, path)
+	if route == nil {
+		return nil
+	}
+
+	var violations []string
+	for _, parameter := range route.operation.Parameters {
+		var value string
+		var present bool
+		switch parameter.In {
+		case "path":
+			value, present = params[parameter.Name]
+		case "query":
+			present = query.Has(parameter.Name)
+			value = query.Get(parameter.Name)
+		default:
+			continue
+		}
+
+		at := parameter.In + " parameter " + parameter.Name
+		if !present {
+			if parameter.Required {
+				violations = append(violations, at+": is required")
+			}
+			continue
+		}
+		violations = v.validateParameter(parameter.Schema, value, at, violations)
+	}
+
+	if requestBody := route.operation.RequestBody; requestBody != nil {
+		if len(bytes.TrimSpace(body)) == 0 {
+			if requestBody.Required {
+				violations = append(violations, "body: is required")
+			}
+		} else {
+			violations = v.validateContent(requestBody.Content, contentType, body, "body", violations)
+		}
+	}
+
+	return v.contractError(DirectionRequest, route, 0, violations)
+}
+
+func (v *Validator) ValidateResponse(method, path string, status int, contentType string, body []byte) error {
+	route, _ := v.match(method, path)
+	if route == nil {
+		return nil
+	}
+
+	response, ok := route.operation.Responses[strconv.Itoa(status)]
+	if !ok {
+		response, ok = route.operation.Responses["default"]
+	}
+	if !ok {
+		return v.contractError(DirectionResponse, route, status, []string{
+			fmt.Sprintf("status %d is not documented", status),
+		})
+	}
+
+	var violations []string
+	if len(response.Content) > 0 {
+		violations = v.validateContent(response.Content, contentType, body, "response body", violations)
+	}
+	return v.contractError(DirectionResponse, route, status, violations)
+}
+
+func (v *Validator) CheckResponse(req *http.Request, resp *http.Response) error {
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("read response body: %w", err)
+	}
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	return v.ValidateResponse(req.Method, req.URL.Path, resp.StatusCode, resp.Header.Get("Content-Type"), body)
+}
+
+func (v *Validator) validateParameter(schema *Schema, raw, at string, violations []string) []string {
+	var value any = raw
+	if kind, _ := schema.Type.(string); kind == "integer" || kind == "number" {
+		if _, err := strconv.ParseFloat(raw, 64); err != nil {
+			return append(violations, fmt.Sprintf("%s: expected %s, got %q", at, kind, raw))
+		}
+		value = json.Number(raw)
+	} else if kind == "boolean" {
+		parsed, err := strconv.ParseBool(raw)
+		if err != nil {
+			return append(violations, fmt.Sprintf("%s: expected boolean, got %q", at, raw))
+		}
+		value = parsed
+	}
+	return v.validateValue(schema, value, at, violations)
+}
+
+func (v *Validator) validateContent(content map[string]MediaType, contentType string, body []byte, at string, violations []string) []string {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return append(violations, fmt.Sprintf("%s: missing or invalid content type %q", at, contentType))
+	}
+
+	media, ok := content[mediaType]
+	if !ok {
+		return append(violations, fmt.Sprintf("%s: content type %q is not documented", at, mediaType))
+	}
+	if media.Schema == nil || mediaType != fiberJSON {
+		return violations
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return append(violations, fmt.Sprintf("%s: invalid JSON: %v", at, err))
+	}
+	return v.validateValue(media.Schema, value, at, violations)
+}
+
+func (v *Validator) contractError(direction string, route *compiledRoute, status int, violations []string) error {
+	if len(violations) == 0 {
+		return nil
+	}
+	return &ContractError{
+		Direction:  direction,
+		Method:     strings.ToUpper(route.method),
+		Path:       route.path,
+		Operation:  route.operation.OperationID,
+		Status:     status,
+		Violations: violations,
+	}
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 
 	"golang_boilerplate_module/internal/config"
+	"golang_boilerplate_module/internal/shared/domain/bus"
 	"golang_boilerplate_module/internal/shared/domain/providers"
+	"golang_boilerplate_module/internal/shared/infra/observability"
 	"golang_boilerplate_module/internal/shared/infra/persistence"
 	zaplogger "golang_boilerplate_module/internal/shared/infra/providers/logger"
 	"golang_boilerplate_module/internal/shared/infra/telemetry"
@@ -20,16 +22,39 @@ var Module = fx.Module("shared",
 			zaplogger.NewZapLoggerProvider,
 			fx.As(new(providers.LoggerProvider)),
 		),
+		NewBus,
 	),
 	fx.Invoke(registerOTELLifecycle),
+	fx.Invoke(registerSecretRenewal),
+	fx.Invoke(registerConfigReload),
+	fx.Invoke(registerReplicaMonitor),
 )
 
-func registerOTELLifecycle(lc fx.Lifecycle, cfg *config.Config, logger providers.LoggerProvider, db *gorm.DB) {
+// NewBus configures the pipeline every command and query goes through, the
+// first behavior being the outermost.
+func NewBus(cfg *config.Config, logger providers.LoggerProvider, db *gorm.DB) *bus.Bus {
+	return bus.New(
+		observability.TracingBehavior(),
+		observability.MetricsBehavior(),
+		observability.LoggingBehavior(logger),
+		bus.Validation(),
+		bus.Authorization(),
+		bus.Retry(cfg.Bus.QueryAttempts, cfg.Bus.RetryBackoff),
+		persistence.TransactionBehavior(db),
+	)
+}
+
+func registerOTELLifecycle(lc fx.Lifecycle, cfg *config.Config, reloader *config.Reloader, logger providers.LoggerProvider, db *gorm.DB) {
 	var shutdownFn telemetry.ShutdownFunc
 
+	sampler := telemetry.NewRatioSampler(cfg.Otel.SamplerRatio)
+	reloader.Subscribe(func(cfg *config.Config) {
+		sampler.SetRatio(cfg.Otel.SamplerRatio)
+	}, "otel.sampler_ratio")
+
 	lc.Append(fx.Hook{
 		OnStart: func(ctx context.Context) error {
-			fn, err := telemetry.SetupOTel(cfg)
+			fn, err := telemetry.SetupOTel(cfg, sampler)
 			if err != nil {
 				return err
 			}
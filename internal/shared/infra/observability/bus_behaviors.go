@@ -0,0 +1,108 @@
+package observability
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"golang_boilerplate_module/internal/shared/domain/bus"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+)
+
+var (
+	busTracer   = otel.Tracer("bus")
+	busDuration metric.Float64Histogram
+)
+
+func init() {
+	var err error
+	busDuration, err = otel.Meter("bus").Float64Histogram(
+		"usecase.duration",
+		metric.WithDescription("Duration of commands and queries dispatched through the bus"),
+		metric.WithUnit("s"),
+		metric.WithExplicitBucketBoundaries(0.001, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10),
+	)
+	if err != nil {
+		panic("failed to create busDuration histogram: " + err.Error())
+	}
+}
+
+// TracingBehavior opens a span per request and records the error it returns.
+func TracingBehavior() bus.Behavior {
+	return func(ctx context.Context, req bus.Request, next bus.Next) (any, error) {
+		ctx, span := busTracer.Start(ctx, req.Name+"."+string(req.Kind))
+		defer span.End()
+
+		span.SetAttributes(
+			attribute.String("usecase.name", req.Name),
+			attribute.String("usecase.kind", string(req.Kind)),
+		)
+
+		result, err := next(ctx)
+		if err != nil {
+			RecordError(span, err)
+			return nil, err
+		}
+		span.SetStatus(codes.Ok, "")
+		return result, nil
+	}
+}
+
+// LoggingBehavior hands the handler a logger carrying the trace and use case,
+// and logs failures at a level that matches who caused them.
+func LoggingBehavior(logger providers.LoggerProvider) bus.Behavior {
+	return func(ctx context.Context, req bus.Request, next bus.Next) (any, error) {
+		log := LoggerWithTrace(ctx, logger).With("usecase", req.Name)
+		start := time.Now()
+
+		result, err := next(bus.WithLogger(ctx, log))
+		elapsed := time.Since(start)
+		switch {
+		case err == nil:
+			log.Debug(string(req.Kind)+" handled", "duration", elapsed.String())
+		case clientError(err):
+			log.Warn(string(req.Kind)+" rejected", "error", err.Error(), "duration", elapsed.String())
+		default:
+			log.Error(string(req.Kind)+" failed", "error", err.Error(), "duration", elapsed.String())
+		}
+		return result, err
+	}
+}
+
+// MetricsBehavior records the duration and outcome of every request.
+func MetricsBehavior() bus.Behavior {
+	return func(ctx context.Context, req bus.Request, next bus.Next) (any, error) {
+		start := time.Now()
+		result, err := next(ctx)
+
+		outcome := "ok"
+		if err != nil {
+			outcome = "INTERNAL"
+			var domainErr *exceptions.DomainError
+			if errors.As(err, &domainErr) {
+				outcome = string(domainErr.Code)
+			}
+		}
+		busDuration.Record(ctx, time.Since(start).Seconds(), metric.WithAttributes(
+			attribute.String("usecase.name", req.Name),
+			attribute.String("usecase.kind", string(req.Kind)),
+			attribute.String("usecase.outcome", outcome),
+		))
+		return result, err
+	}
+}
+
+func clientError(err error) bool {
+	var domainErr *exceptions.DomainError
+	if !errors.As(err, &domainErr) {
+		return false
+	}
+	status, ok := domainToHTTPStatus[domainErr.Code]
+	return ok && status < 500
+}
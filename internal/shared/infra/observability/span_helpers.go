@@ -6,6 +6,7 @@ import (
 
 	"golang_boilerplate_module/internal/shared/domain/exceptions"
 	"golang_boilerplate_module/internal/shared/domain/providers"
+	"golang_boilerplate_module/internal/shared/domain/tenancy"
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
@@ -19,6 +20,7 @@ var domainToHTTPStatus = map[exceptions.ExceptionCode]int{
 	exceptions.CodeNotFound:           404,
 	exceptions.CodeUnprocessable:      422,
 	exceptions.CodeInternal:           500,
+	exceptions.CodeTooManyRequests:    429,
 	exceptions.CodeServiceUnavailable: 503,
 }
 
@@ -50,6 +52,10 @@ func RecordError(span oteltrace.Span, err error) {
 }
 
 func LoggerWithTrace(ctx context.Context, logger providers.LoggerProvider) providers.LoggerProvider {
+	if tenantID, ok := tenancy.TenantIDFromContext(ctx); ok {
+		logger = logger.With("tenantId", tenantID)
+	}
+
 	span := oteltrace.SpanFromContext(ctx)
 	if spanCtx := span.SpanContext(); spanCtx.IsValid() {
 		return logger.With(
@@ -1,46 +1,152 @@
 package persistence
 
 import (
+	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"strconv"
+	"time"
 
 	"golang_boilerplate_module/internal/config"
+	"golang_boilerplate_module/internal/shared/domain/providers"
 
+	"github.com/glebarez/sqlite"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
-	"gorm.io/gorm/logger"
 	"gorm.io/plugin/opentelemetry/tracing"
 )
 
-func NewDB(cfg *config.Config) (*gorm.DB, error) {
-	gormLogger := logger.Default.LogMode(logger.Silent)
+// NewDB opens the connection pool and waits for the database, retrying with
+// backoff so the application survives a database that starts after it.
+func NewDB(cfg *config.Config, reloader *config.Reloader, log providers.LoggerProvider) (*gorm.DB, error) {
+	var (
+		sqlDB     *sql.DB
+		dialector gorm.Dialector
+		err       error
+	)
+	switch cfg.Database.Dialect() {
+	case config.DialectSQLite:
+		sqlDB, err = openSQLitePool(cfg.Database)
+		dialector = sqlite.Dialector{Conn: sqlDB}
+	default:
+		sqlDB, err = openPool(cfg.Database)
+		dialector = postgres.New(postgres.Config{Conn: sqlDB})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse database URL: %w", err)
+	}
 
-	db, err := gorm.Open(postgres.Open(cfg.Database.URL), &gorm.Config{
-		Logger: gormLogger,
+	gormLogger := NewGormLogger(cfg, reloader, log)
+	db, err := gorm.Open(dialector, &gorm.Config{
+		Logger:               gormLogger,
+		DisableAutomaticPing: true,
 	})
 	if err != nil {
+		_ = sqlDB.Close()
 		return nil, fmt.Errorf("failed to open database connection: %w", err)
 	}
 
-	sqlDB, err := db.DB()
-	if err != nil {
-		return nil, fmt.Errorf("failed to get underlying sql.DB: %w", err)
+	if err := connect(sqlDB, cfg.Database, log); err != nil {
+		_ = sqlDB.Close()
+		return nil, err
 	}
 
-	sqlDB.SetMaxOpenConns(cfg.Database.MaxConnections)
-	sqlDB.SetMaxIdleConns(cfg.Database.MaxConnections / 2)
-
-	if err := sqlDB.Ping(); err != nil {
-		return nil, fmt.Errorf("database ping failed: %w", err)
+	registration, err := registerPoolMetrics(sqlDB, "primary")
+	if err != nil {
+		_ = sqlDB.Close()
+		return nil, fmt.Errorf("failed to register pool metrics: %w", err)
+	}
+	if err := db.Use(&poolMetrics{registration: registration}); err != nil {
+		_ = registration.Unregister()
+		_ = sqlDB.Close()
+		return nil, fmt.Errorf("failed to register pool metrics plugin: %w", err)
 	}
 
+	// From here on CloseDB releases the pool and what is registered on it.
 	if err := db.Use(tracing.NewPlugin()); err != nil {
+		_ = CloseDB(db)
 		return nil, fmt.Errorf("failed to register OTEL tracing plugin: %w", err)
 	}
 
+	if err := db.Use(&TenancyPlugin{RowLevelSecurity: cfg.Tenancy.RowLevelSecurity}); err != nil {
+		_ = CloseDB(db)
+		return nil, fmt.Errorf("failed to register tenancy plugin: %w", err)
+	}
+
+	if len(cfg.Database.ReplicaURLs) > 0 {
+		replicas, err := openReplicas(cfg, gormLogger, log)
+		if err != nil {
+			_ = CloseDB(db)
+			return nil, err
+		}
+		if err := db.Use(replicas); err != nil {
+			replicas.Close()
+			_ = CloseDB(db)
+			return nil, fmt.Errorf("failed to register replicas plugin: %w", err)
+		}
+	}
+
 	return db, nil
 }
 
+// openPool applies the pool limits and the session settings every
+// connection starts with. No connection is made until the pool is used.
+func openPool(cfg config.DatabaseConfig) (*sql.DB, error) {
+	connConfig, err := pgx.ParseConfig(cfg.URL)
+	if err != nil {
+		return nil, err
+	}
+	connConfig.ConnectTimeout = cfg.ConnectTimeout
+	if cfg.ApplicationName != "" {
+		connConfig.RuntimeParams["application_name"] = cfg.ApplicationName
+	}
+	if cfg.StatementTimeout > 0 {
+		connConfig.RuntimeParams["statement_timeout"] = strconv.FormatInt(cfg.StatementTimeout.Milliseconds(), 10)
+	}
+	if cfg.LockTimeout > 0 {
+		connConfig.RuntimeParams["lock_timeout"] = strconv.FormatInt(cfg.LockTimeout.Milliseconds(), 10)
+	}
+
+	sqlDB := stdlib.OpenDB(*connConfig)
+	sqlDB.SetMaxOpenConns(cfg.MaxConnections)
+	sqlDB.SetMaxIdleConns(cfg.MaxIdleConnections)
+	sqlDB.SetConnMaxLifetime(cfg.ConnMaxLifetime)
+	sqlDB.SetConnMaxIdleTime(cfg.ConnMaxIdleTime)
+	return sqlDB, nil
+}
+
+func connect(sqlDB *sql.DB, cfg config.DatabaseConfig, log providers.LoggerProvider) error {
+	delay := cfg.ConnectBackoff
+	for attempt := 1; ; attempt++ {
+		ctx, cancel := context.WithTimeout(context.Background(), cfg.ConnectTimeout)
+		err := sqlDB.PingContext(ctx)
+		cancel()
+		if err == nil {
+			return nil
+		}
+		if attempt >= cfg.ConnectAttempts {
+			return fmt.Errorf("database ping failed after %d attempts: %w", attempt, err)
+		}
+
+		log.Warn("database not reachable, retrying", "attempt", attempt, "error", err.Error(), "retryIn", delay.String())
+		time.Sleep(delay)
+		delay *= 2
+	}
+}
+
+// NewOfflineDB opens the database without connecting, for tools that build
+// the application graph but never run a query. SQLite, which the dialect
+// queries for its version, gets an in-memory database instead of the file.
+func NewOfflineDB(cfg *config.Config) (*gorm.DB, error) {
+	if cfg.Database.Dialect() == config.DialectSQLite {
+		return gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{DisableAutomaticPing: true})
+	}
+	return gorm.Open(postgres.Open(cfg.Database.URL), &gorm.Config{DisableAutomaticPing: true})
+}
+
 func CloseDB(db *gorm.DB) error {
 	var sqlDB *sql.DB
 	var err error
@@ -48,6 +154,9 @@ func CloseDB(db *gorm.DB) error {
 	if sqlDB, err = db.DB(); err != nil {
 		return err
 	}
+	if replicas := ReplicasOf(db); replicas != nil {
+		replicas.Close()
+	}
 
-	return sqlDB.Close()
-}
\ No newline at end of file
+	return errors.Join(unregisterPoolMetrics(db), sqlDB.Close())
+}
@@ -0,0 +1,128 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"golang_boilerplate_module/internal/config"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+	"golang_boilerplate_module/internal/shared/infra/observability"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+var slowQueries metric.Int64Counter
+
+func init() {
+	var err error
+	slowQueries, err = otel.Meter("db").Int64Counter(
+		"db.client.slow_queries",
+		metric.WithDescription("Queries that took longer than the slow query threshold"),
+		metric.WithUnit("{query}"),
+	)
+	if err != nil {
+		panic("failed to create slowQueries counter: " + err.Error())
+	}
+}
+
+// GormLogger writes failed and slow queries to the application logger, with
+// the trace of the request that ran them. The level comes from LOG_LEVEL, so
+// GORM's own log mode is ignored.
+type GormLogger struct {
+	logger    providers.LoggerProvider
+	system    string
+	threshold atomic.Int64
+	logSQL    atomic.Bool
+}
+
+var _ logger.Interface = (*GormLogger)(nil)
+
+// dbSystemNames maps the dialects to the OpenTelemetry db.system.name values.
+var dbSystemNames = map[string]string{
+	config.DialectPostgres: "postgresql",
+	config.DialectSQLite:   "sqlite",
+}
+
+// NewGormLogger builds the logger with a slow query threshold and SQL
+// logging that follow configuration reloads.
+func NewGormLogger(cfg *config.Config, reloader *config.Reloader, log providers.LoggerProvider) *GormLogger {
+	l := &GormLogger{logger: log.With("component", "gorm"), system: dbSystemNames[cfg.Database.Dialect()]}
+	l.apply(cfg)
+	reloader.Subscribe(l.apply, "database.slow_query_threshold", "database.log_sql")
+	return l
+}
+
+func (l *GormLogger) apply(cfg *config.Config) {
+	l.threshold.Store(int64(cfg.Database.SlowQueryThreshold))
+	l.logSQL.Store(cfg.Database.LogSQL)
+}
+
+func (l *GormLogger) LogMode(logger.LogLevel) logger.Interface {
+	return l
+}
+
+func (l *GormLogger) Info(ctx context.Context, msg string, data ...any) {
+	observability.LoggerWithTrace(ctx, l.logger).Info(fmt.Sprintf(msg, data...))
+}
+
+func (l *GormLogger) Warn(ctx context.Context, msg string, data ...any) {
+	observability.LoggerWithTrace(ctx, l.logger).Warn(fmt.Sprintf(msg, data...))
+}
+
+func (l *GormLogger) Error(ctx context.Context, msg string, data ...any) {
+	observability.LoggerWithTrace(ctx, l.logger).Error(fmt.Sprintf(msg, data...))
+}
+
+// ParamsFilter drops the parameters before GORM renders the SQL, so the
+// logged statement keeps its placeholders and never carries user data.
+func (l *GormLogger) ParamsFilter(_ context.Context, sql string, _ ...any) (string, []any) {
+	return sql, nil
+}
+
+// Trace logs the query when it failed or ran past the slow query threshold.
+// A missing record is an answer rather than a failure and is not logged.
+func (l *GormLogger) Trace(ctx context.Context, begin time.Time, fc func() (string, int64), err error) {
+	elapsed := time.Since(begin)
+	threshold := time.Duration(l.threshold.Load())
+	failed := err != nil && !errors.Is(err, gorm.ErrRecordNotFound)
+	slow := threshold > 0 && elapsed > threshold
+	if !failed && !slow {
+		return
+	}
+
+	sql, rows := fc()
+	operation := operationName(sql)
+	fields := []any{"operation", operation, "durationMs", float64(elapsed.Microseconds()) / 1000, "rows", rows}
+	if l.logSQL.Load() {
+		fields = append(fields, "sql", sql)
+	}
+	log := observability.LoggerWithTrace(ctx, l.logger)
+
+	if failed {
+		log.Error("database query failed", append(fields, "error", err.Error())...)
+	}
+	if slow {
+		slowQueries.Add(ctx, 1, metric.WithAttributes(
+			attribute.String("db.system.name", l.system),
+			attribute.String("db.operation.name", operation),
+		))
+		log.Warn("slow database query", append(fields, "thresholdMs", threshold.Milliseconds())...)
+	}
+}
+
+// operationName is the statement's leading keyword, such as SELECT.
+func operationName(sql string) string {
+	fields := strings.Fields(sql)
+	if len(fields) == 0 {
+		return "UNKNOWN"
+	}
+	return strings.ToUpper(fields[0])
+}
@@ -0,0 +1,358 @@
+package persistence
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang_boilerplate_module/internal/config"
+
+	"gorm.io/gorm"
+)
+
+// migrationsLockID serializes concurrent migrators through a Postgres
+// advisory lock.
+const migrationsLockID = 7_305_183_204
+
+var versionedScript = regexp.MustCompile(`^V(\d+)__(.+)\.sql$`)
+
+// Migration is a versioned script and, once applied, its schema history row.
+type Migration struct {
+	Version     int       `json:"version"`
+	Description string    `json:"description"`
+	Script      string    `json:"script"`
+	Checksum    int32     `json:"checksum"`
+	Applied     bool      `json:"applied"`
+	InstalledOn time.Time `json:"installed_on,omitzero"`
+}
+
+// installedBy is the installed_by of the schema history rows; SQLite has no
+// database user.
+var installedBy = map[string]string{
+	config.DialectPostgres: "current_user",
+	config.DialectSQLite:   "''",
+}
+
+// Migrator applies the versioned scripts of a migrations filesystem and
+// records them in flyway_schema_history with Flyway's checksums, so the CLI
+// and the flyway image can take turns on the same database.
+type Migrator struct {
+	db      *gorm.DB
+	scripts fs.FS
+}
+
+// NewMigrator takes the PostgreSQL scripts from the root of scripts and
+// those of another dialect from the directory named after it.
+func NewMigrator(db *gorm.DB, scripts fs.FS) *Migrator {
+	if dialect := db.Dialector.Name(); dialect != config.DialectPostgres {
+		if sub, err := fs.Sub(scripts, dialect); err == nil {
+			scripts = sub
+		}
+	}
+	return &Migrator{db: db, scripts: scripts}
+}
+
+// Status lists every known migration in version order.
+func (m *Migrator) Status(ctx context.Context) ([]Migration, error) {
+	var migrations []Migration
+	err := m.locked(ctx, func(conn *sql.Conn) error {
+		var err error
+		migrations, err = m.resolve(ctx, conn)
+		return err
+	})
+	return migrations, err
+}
+
+// Up applies the pending migrations, each in its own transaction, and
+// returns the ones it applied.
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	var applied []Migration
+	err := m.locked(ctx, func(conn *sql.Conn) error {
+		migrations, err := m.resolve(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for _, migration := range migrations {
+			if migration.Applied {
+				continue
+			}
+			if err := m.apply(ctx, conn, migration); err != nil {
+				return err
+			}
+			applied = append(applied, migration)
+		}
+		return nil
+	})
+	return applied, err
+}
+
+// Down reverts the latest steps applied migrations with their undo scripts
+// and returns the ones it reverted.
+func (m *Migrator) Down(ctx context.Context, steps int) ([]Migration, error) {
+	var reverted []Migration
+	err := m.locked(ctx, func(conn *sql.Conn) error {
+		migrations, err := m.resolve(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for i := len(migrations) - 1; i >= 0 && len(reverted) < steps; i-- {
+			if !migrations[i].Applied {
+				continue
+			}
+			if err := m.revert(ctx, conn, migrations[i]); err != nil {
+				return err
+			}
+			reverted = append(reverted, migrations[i])
+		}
+		return nil
+	})
+	return reverted, err
+}
+
+func (m *Migrator) locked(ctx context.Context, fn func(conn *sql.Conn) error) error {
+	sqlDB, err := m.db.DB()
+	if err != nil {
+		return err
+	}
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	// Migrations and the wait for another migrator may take longer than the
+	// pool's timeouts allow; RESET gives the connection its own back.
+	if Supports(m.db, SessionTimeouts) {
+		if _, err := conn.ExecContext(ctx, "SET statement_timeout = 0; SET lock_timeout = 0"); err != nil {
+			return fmt.Errorf("disable timeouts: %w", err)
+		}
+		defer conn.ExecContext(context.WithoutCancel(ctx), "RESET statement_timeout; RESET lock_timeout")
+	}
+
+	// Without advisory locks, a migrator racing another one fails on the
+	// version the other applied first; SQLite serializes the writes anyway.
+	if Supports(m.db, AdvisoryLocks) {
+		if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", migrationsLockID); err != nil {
+			return fmt.Errorf("acquire migrations lock: %w", err)
+		}
+		defer conn.ExecContext(context.WithoutCancel(ctx), "SELECT pg_advisory_unlock($1)", migrationsLockID)
+	}
+
+	// SQLite changes tables by rebuilding them, which enforced foreign keys
+	// would block; each script is checked against them before it commits.
+	if m.db.Dialector.Name() == config.DialectSQLite {
+		if _, err := conn.ExecContext(ctx, "PRAGMA foreign_keys = OFF"); err != nil {
+			return fmt.Errorf("disable foreign keys: %w", err)
+		}
+		defer conn.ExecContext(context.WithoutCancel(ctx), "PRAGMA foreign_keys = ON")
+	}
+
+	if _, err := conn.ExecContext(ctx, createSchemaHistory); err != nil {
+		return fmt.Errorf("create schema history: %w", err)
+	}
+	return fn(conn)
+}
+
+// resolve matches the scripts against the schema history and fails, like
+// flyway validate, on anything that would make the two disagree.
+func (m *Migrator) resolve(ctx context.Context, conn *sql.Conn) ([]Migration, error) {
+	files, err := fs.Glob(m.scripts, "V*.sql")
+	if err != nil {
+		return nil, err
+	}
+
+	migrations := make([]Migration, 0, len(files))
+	byVersion := make(map[int]int, len(files))
+	for _, file := range files {
+		match := versionedScript.FindStringSubmatch(file)
+		if match == nil {
+			return nil, fmt.Errorf("migration %s: name must look like V<version>__<description>.sql", file)
+		}
+		version, _ := strconv.Atoi(match[1])
+		if other, dup := byVersion[version]; dup {
+			return nil, fmt.Errorf("migrations %s and %s share version %d", migrations[other].Script, file, version)
+		}
+		script, err := fs.ReadFile(m.scripts, file)
+		if err != nil {
+			return nil, err
+		}
+		byVersion[version] = len(migrations)
+		migrations = append(migrations, Migration{
+			Version:     version,
+			Description: strings.ReplaceAll(match[2], "_", " "),
+			Script:      file,
+			Checksum:    flywayChecksum(script),
+		})
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	for i, migration := range migrations {
+		byVersion[migration.Version] = i
+	}
+
+	rows, err := conn.QueryContext(ctx,
+		`SELECT version, checksum, installed_on, success FROM flyway_schema_history
+		 WHERE version IS NOT NULL AND type = 'SQL' ORDER BY installed_rank`)
+	if err != nil {
+		return nil, fmt.Errorf("read schema history: %w", err)
+	}
+	defer rows.Close()
+
+	latest := 0
+	for rows.Next() {
+		var (
+			version     string
+			checksum    sql.NullInt32
+			installedOn time.Time
+			success     bool
+		)
+		if err := rows.Scan(&version, &checksum, &installedOn, &success); err != nil {
+			return nil, err
+		}
+		if !success {
+			return nil, fmt.Errorf("migration V%s failed previously; fix the schema and remove its schema history row", version)
+		}
+		n, err := strconv.Atoi(version)
+		i, ok := byVersion[n]
+		if err != nil || !ok {
+			return nil, fmt.Errorf("applied migration V%s is not among the scripts", version)
+		}
+		if checksum.Int32 != migrations[i].Checksum {
+			return nil, fmt.Errorf("migration %s was changed after it was applied", migrations[i].Script)
+		}
+		migrations[i].Applied = true
+		migrations[i].InstalledOn = installedOn
+		latest = max(latest, n)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, migration := range migrations {
+		if !migration.Applied && migration.Version < latest {
+			return nil, fmt.Errorf("migration %s is older than the latest applied version V%d", migration.Script, latest)
+		}
+	}
+	return migrations, nil
+}
+
+func (m *Migrator) apply(ctx context.Context, conn *sql.Conn, migration Migration) error {
+	script, err := fs.ReadFile(m.scripts, migration.Script)
+	if err != nil {
+		return err
+	}
+
+	return inTx(ctx, conn, func(tx *sql.Tx) error {
+		started := time.Now()
+		if _, err := tx.ExecContext(ctx, string(script)); err != nil {
+			return fmt.Errorf("apply %s: %w", migration.Script, err)
+		}
+		if err := m.checkForeignKeys(ctx, tx); err != nil {
+			return fmt.Errorf("apply %s: %w", migration.Script, err)
+		}
+		_, err := tx.ExecContext(ctx,
+			`INSERT INTO flyway_schema_history
+			 (installed_rank, version, description, type, script, checksum, installed_by, execution_time, success)
+			 SELECT COALESCE(MAX(installed_rank), 0) + 1, $1, $2, 'SQL', $3, $4, `+installedBy[m.db.Dialector.Name()]+`, $5, TRUE
+			 FROM flyway_schema_history`,
+			strconv.Itoa(migration.Version), migration.Description, migration.Script,
+			migration.Checksum, time.Since(started).Milliseconds(),
+		)
+		return err
+	})
+}
+
+func (m *Migrator) revert(ctx context.Context, conn *sql.Conn, migration Migration) error {
+	undo := path.Join("undo", strings.TrimPrefix(migration.Script, "V"))
+	script, err := fs.ReadFile(m.scripts, undo)
+	if err != nil {
+		return fmt.Errorf("migration %s has no undo script %s", migration.Script, undo)
+	}
+
+	return inTx(ctx, conn, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, string(script)); err != nil {
+			return fmt.Errorf("revert %s: %w", migration.Script, err)
+		}
+		if err := m.checkForeignKeys(ctx, tx); err != nil {
+			return fmt.Errorf("revert %s: %w", migration.Script, err)
+		}
+		_, err := tx.ExecContext(ctx,
+			`DELETE FROM flyway_schema_history WHERE version = $1 AND type = 'SQL'`,
+			strconv.Itoa(migration.Version),
+		)
+		return err
+	})
+}
+
+// checkForeignKeys fails on the rows a SQLite script left without the row
+// they reference.
+func (m *Migrator) checkForeignKeys(ctx context.Context, tx *sql.Tx) error {
+	if m.db.Dialector.Name() != config.DialectSQLite {
+		return nil
+	}
+	var table, parent string
+	var rowID sql.NullInt64
+	var constraint int
+	err := tx.QueryRowContext(ctx, "PRAGMA foreign_key_check").Scan(&table, &rowID, &parent, &constraint)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return fmt.Errorf("rows of %s reference missing rows of %s", table, parent)
+}
+
+func inTx(ctx context.Context, conn *sql.Conn, fn func(tx *sql.Tx) error) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// flywayChecksum is the CRC32 Flyway stores for a script: the lines without
+// their terminators, after stripping a byte order mark.
+func flywayChecksum(script []byte) int32 {
+	script = bytes.TrimPrefix(script, []byte("\xef\xbb\xbf"))
+	lines := bytes.Split(script, []byte("\n"))
+	if len(lines[len(lines)-1]) == 0 {
+		lines = lines[:len(lines)-1]
+	}
+
+	crc := crc32.NewIEEE()
+	for _, line := range lines {
+		crc.Write(bytes.TrimSuffix(line, []byte("\r")))
+	}
+	return int32(crc.Sum32())
+}
+
+const createSchemaHistory = `
+CREATE TABLE IF NOT EXISTS flyway_schema_history (
+    installed_rank INTEGER       NOT NULL,
+    version        VARCHAR(50),
+    description    VARCHAR(200)  NOT NULL,
+    type           VARCHAR(20)   NOT NULL,
+    script         VARCHAR(1000) NOT NULL,
+    checksum       INTEGER,
+    installed_by   VARCHAR(100)  NOT NULL,
+    installed_on   TIMESTAMP     NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    execution_time INTEGER       NOT NULL,
+    success        BOOLEAN       NOT NULL,
+    CONSTRAINT flyway_schema_history_pk PRIMARY KEY (installed_rank)
+);
+CREATE INDEX IF NOT EXISTS flyway_schema_history_s_idx ON flyway_schema_history (success);
+`
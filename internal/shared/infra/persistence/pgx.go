@@ -0,0 +1,62 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
+	"gorm.io/gorm"
+)
+
+// WithPgx runs fn on the pgx connection of the transaction carried by ctx, or
+// else on one borrowed from db's pool. pgx caches the statements it prepares
+// on each connection, so repeated queries skip planning. A successful call
+// counts as a write for the read-your-writes window.
+func WithPgx(ctx context.Context, db *gorm.DB, fn func(conn *pgx.Conn) error) error {
+	if err := withPgx(ctx, db, fn); err != nil {
+		return err
+	}
+	if tracker, ok := ctx.Value(writeTrackerKey{}).(*writeTracker); ok {
+		tracker.last.Store(time.Now().UnixNano())
+	}
+	return nil
+}
+
+// WithPgxRead is WithPgx for work that only reads, which may run on a read
+// replica.
+func WithPgxRead(ctx context.Context, db *gorm.DB, fn func(conn *pgx.Conn) error) error {
+	if replicas := ReplicasOf(db); replicas != nil && !InTransaction(ctx) {
+		db = replicas.route(ctx, db)
+	}
+	return withPgx(ctx, db, fn)
+}
+
+func withPgx(ctx context.Context, db *gorm.DB, fn func(conn *pgx.Conn) error) error {
+	if conn, ok := ctx.Value(transactionConnContextKey{}).(*sql.Conn); ok {
+		return rawPgx(conn, fn)
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
+	}
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	return rawPgx(conn, fn)
+}
+
+func rawPgx(conn *sql.Conn, fn func(conn *pgx.Conn) error) error {
+	return conn.Raw(func(driverConn any) error {
+		stdlibConn, ok := driverConn.(*stdlib.Conn)
+		if !ok {
+			return fmt.Errorf("database driver %T is not pgx", driverConn)
+		}
+		return fn(stdlibConn.Conn())
+	})
+}
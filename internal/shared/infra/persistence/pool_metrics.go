@@ -0,0 +1,118 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"gorm.io/gorm"
+)
+
+const PoolMetricsPluginName = "pool_metrics"
+
+var (
+	poolMeter        = otel.Meter("db")
+	poolConnections  metric.Int64ObservableGauge
+	poolMax          metric.Int64ObservableGauge
+	poolWaitCount    metric.Int64ObservableGauge
+	poolWaitDuration metric.Float64ObservableGauge
+	replicaLag       metric.Float64ObservableGauge
+)
+
+func init() {
+	var err error
+	poolConnections, err = poolMeter.Int64ObservableGauge(
+		"db.client.connection.count",
+		metric.WithDescription("Connections of the pool, by state"),
+		metric.WithUnit("{connection}"),
+	)
+	if err != nil {
+		panic("failed to create poolConnections gauge: " + err.Error())
+	}
+
+	poolMax, err = poolMeter.Int64ObservableGauge(
+		"db.client.connection.max",
+		metric.WithDescription("Maximum open connections of the pool"),
+		metric.WithUnit("{connection}"),
+	)
+	if err != nil {
+		panic("failed to create poolMax gauge: " + err.Error())
+	}
+
+	poolWaitCount, err = poolMeter.Int64ObservableGauge(
+		"db.client.connection.wait_count",
+		metric.WithDescription("Total connections waited for since the pool opened"),
+		metric.WithUnit("{wait}"),
+	)
+	if err != nil {
+		panic("failed to create poolWaitCount gauge: " + err.Error())
+	}
+
+	poolWaitDuration, err = poolMeter.Float64ObservableGauge(
+		"db.client.connection.wait_duration",
+		metric.WithDescription("Total time spent waiting for a connection since the pool opened"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		panic("failed to create poolWaitDuration gauge: " + err.Error())
+	}
+
+	replicaLag, err = poolMeter.Float64ObservableGauge(
+		"db.client.replica.lag",
+		metric.WithDescription("Replication lag of a read replica at its last check"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		panic("failed to create replicaLag gauge: " + err.Error())
+	}
+}
+
+// registerPoolMetrics reports the sql.DBStats of a pool on every collection,
+// told apart from other pools by db.client.connection.pool.name.
+func registerPoolMetrics(sqlDB *sql.DB, pool string) (metric.Registration, error) {
+	poolAttr := attribute.String("db.client.connection.pool.name", pool)
+	used := metric.WithAttributes(poolAttr, attribute.String("db.client.connection.state", "used"))
+	idle := metric.WithAttributes(poolAttr, attribute.String("db.client.connection.state", "idle"))
+	attrs := metric.WithAttributes(poolAttr)
+
+	return poolMeter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		stats := sqlDB.Stats()
+		o.ObserveInt64(poolConnections, int64(stats.InUse), used)
+		o.ObserveInt64(poolConnections, int64(stats.Idle), idle)
+		o.ObserveInt64(poolMax, int64(stats.MaxOpenConnections), attrs)
+		o.ObserveInt64(poolWaitCount, stats.WaitCount, attrs)
+		o.ObserveFloat64(poolWaitDuration, stats.WaitDuration.Seconds(), attrs)
+		return nil
+	}, poolConnections, poolMax, poolWaitCount, poolWaitDuration)
+}
+
+func registerReplicaMetrics(rep *replica) (metric.Registration, error) {
+	attrs := metric.WithAttributes(attribute.String("db.client.connection.pool.name", rep.name))
+	return poolMeter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		o.ObserveFloat64(replicaLag, rep.status.Load().Lag.Seconds(), attrs)
+		return nil
+	}, replicaLag)
+}
+
+// poolMetrics keeps the primary's metric callback on the database so
+// CloseDB can unregister it; a closed pool would otherwise keep reporting.
+type poolMetrics struct {
+	registration metric.Registration
+}
+
+func (p *poolMetrics) Name() string {
+	return PoolMetricsPluginName
+}
+
+func (p *poolMetrics) Initialize(*gorm.DB) error {
+	return nil
+}
+
+func unregisterPoolMetrics(db *gorm.DB) error {
+	if metrics, ok := db.Config.Plugins[PoolMetricsPluginName].(*poolMetrics); ok {
+		return metrics.registration.Unregister()
+	}
+	return nil
+}
@@ -0,0 +1,278 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"golang_boilerplate_module/internal/config"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+	"gorm.io/plugin/opentelemetry/tracing"
+)
+
+const ReplicasPluginName = "replicas"
+
+// replicationLag is zero on a primary and on a replica that replayed all it
+// received, so an idle primary does not look like lag.
+const replicationLag = `SELECT CASE
+	WHEN NOT pg_is_in_recovery() OR pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+	ELSE COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0)
+END::float8`
+
+// ReplicaStatus is the outcome of the last check of a read replica.
+type ReplicaStatus struct {
+	Name      string
+	Healthy   bool
+	Lag       time.Duration
+	Error     string
+	CheckedAt time.Time
+}
+
+type replica struct {
+	name    string
+	db      *gorm.DB
+	sqlDB   *sql.DB
+	status  atomic.Pointer[ReplicaStatus]
+	metrics []metric.Registration
+}
+
+// Replicas routes reads to the read replicas. It is registered on the
+// primary, which repositories keep holding, and consulted by ReadConn.
+type Replicas struct {
+	replicas []*replica
+	next     atomic.Uint64
+	window   time.Duration
+	maxLag   time.Duration
+	interval time.Duration
+	logger   providers.LoggerProvider
+}
+
+func openReplicas(cfg *config.Config, gormLogger logger.Interface, log providers.LoggerProvider) (*Replicas, error) {
+	r := &Replicas{
+		window:   cfg.Database.ReadYourWritesWindow,
+		maxLag:   cfg.Database.ReplicaMaxLag,
+		interval: cfg.Database.ReplicaCheckInterval,
+		logger:   log.With("component", "replicas"),
+	}
+	for i, url := range cfg.Database.ReplicaURLs {
+		rep, err := openReplica(cfg, url, fmt.Sprintf("replica-%d", i+1), gormLogger)
+		if err != nil {
+			r.Close()
+			return nil, err
+		}
+		r.replicas = append(r.replicas, rep)
+	}
+	return r, nil
+}
+
+// openReplica opens a pool like the primary's, left out of rotation until
+// its first check.
+func openReplica(cfg *config.Config, url, name string, gormLogger logger.Interface) (*replica, error) {
+	poolCfg := cfg.Database
+	poolCfg.URL = strings.TrimSpace(url)
+	sqlDB, err := openPool(poolCfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse URL of %s: %w", name, err)
+	}
+
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{
+		Logger:               gormLogger,
+		DisableAutomaticPing: true,
+	})
+	if err != nil {
+		_ = sqlDB.Close()
+		return nil, fmt.Errorf("failed to open %s: %w", name, err)
+	}
+
+	poolName := attribute.String("db.client.connection.pool.name", name)
+	if err := db.Use(tracing.NewPlugin(tracing.WithAttributes(poolName))); err != nil {
+		_ = sqlDB.Close()
+		return nil, fmt.Errorf("failed to register OTEL tracing plugin on %s: %w", name, err)
+	}
+	if err := db.Use(&TenancyPlugin{RowLevelSecurity: cfg.Tenancy.RowLevelSecurity}); err != nil {
+		_ = sqlDB.Close()
+		return nil, fmt.Errorf("failed to register tenancy plugin on %s: %w", name, err)
+	}
+
+	rep := &replica{name: name, db: db, sqlDB: sqlDB}
+	rep.status.Store(&ReplicaStatus{Name: name, Error: "not checked yet"})
+
+	poolRegistration, err := registerPoolMetrics(sqlDB, name)
+	if err != nil {
+		rep.close()
+		return nil, fmt.Errorf("failed to register pool metrics of %s: %w", name, err)
+	}
+	rep.metrics = append(rep.metrics, poolRegistration)
+	lagRegistration, err := registerReplicaMetrics(rep)
+	if err != nil {
+		rep.close()
+		return nil, fmt.Errorf("failed to register lag metric of %s: %w", name, err)
+	}
+	rep.metrics = append(rep.metrics, lagRegistration)
+	return rep, nil
+}
+
+// ReplicasOf returns the replicas registered on db, or nil when reads are
+// not routed.
+func ReplicasOf(db *gorm.DB) *Replicas {
+	replicas, _ := db.Config.Plugins[ReplicasPluginName].(*Replicas)
+	return replicas
+}
+
+func (r *Replicas) Name() string {
+	return ReplicasPluginName
+}
+
+// Initialize records the writes made on the primary for the
+// read-your-writes window.
+func (r *Replicas) Initialize(db *gorm.DB) error {
+	if err := db.Callback().Create().After("gorm:create").Register("replicas:track_write", trackWrite); err != nil {
+		return err
+	}
+	if err := db.Callback().Update().After("gorm:update").Register("replicas:track_write", trackWrite); err != nil {
+		return err
+	}
+	if err := db.Callback().Delete().After("gorm:delete").Register("replicas:track_write", trackWrite); err != nil {
+		return err
+	}
+	return db.Callback().Raw().After("gorm:raw").Register("replicas:track_write", trackWrite)
+}
+
+// route picks the next healthy replica, or the primary right after a write
+// made with ctx and when no replica is healthy.
+func (r *Replicas) route(ctx context.Context, primary *gorm.DB) *gorm.DB {
+	if tracker, ok := ctx.Value(writeTrackerKey{}).(*writeTracker); ok {
+		if last := tracker.last.Load(); last != 0 && time.Since(time.Unix(0, last)) < r.window {
+			return primary
+		}
+	}
+
+	n := uint64(len(r.replicas))
+	start := r.next.Add(1)
+	for i := range n {
+		rep := r.replicas[(start+i)%n]
+		if rep.status.Load().Healthy {
+			return rep.db
+		}
+	}
+	return primary
+}
+
+// Status lists the last check of every replica.
+func (r *Replicas) Status() []ReplicaStatus {
+	statuses := make([]ReplicaStatus, 0, len(r.replicas))
+	for _, rep := range r.replicas {
+		statuses = append(statuses, *rep.status.Load())
+	}
+	return statuses
+}
+
+// CheckAll checks every replica, taking unreachable and lagging ones out of
+// rotation and putting recovered ones back.
+func (r *Replicas) CheckAll(ctx context.Context) {
+	for _, rep := range r.replicas {
+		r.check(ctx, rep)
+	}
+}
+
+// Monitor runs CheckAll at each interval until ctx is done.
+func (r *Replicas) Monitor(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		r.CheckAll(ctx)
+	}
+}
+
+func (r *Replicas) check(ctx context.Context, rep *replica) {
+	checkCtx, cancel := context.WithTimeout(ctx, r.interval)
+	defer cancel()
+
+	var lagSeconds float64
+	err := rep.sqlDB.QueryRowContext(checkCtx, replicationLag).Scan(&lagSeconds)
+	if ctx.Err() != nil {
+		return
+	}
+
+	status := &ReplicaStatus{
+		Name:      rep.name,
+		Lag:       time.Duration(lagSeconds * float64(time.Second)),
+		CheckedAt: time.Now(),
+	}
+	switch {
+	case err != nil:
+		status.Error = err.Error()
+	case r.maxLag > 0 && status.Lag > r.maxLag:
+		status.Error = fmt.Sprintf("replication lag %s exceeds %s", status.Lag.Round(time.Millisecond), r.maxLag)
+	default:
+		status.Healthy = true
+	}
+
+	previous := rep.status.Swap(status)
+	switch {
+	case !status.Healthy && (previous.Healthy || previous.CheckedAt.IsZero()):
+		r.logger.Warn("read replica taken out of rotation", "replica", rep.name, "error", status.Error)
+	case !previous.Healthy && status.Healthy:
+		r.logger.Info("read replica in rotation", "replica", rep.name, "lag", status.Lag.String())
+	}
+}
+
+func (r *Replicas) Close() {
+	for _, rep := range r.replicas {
+		rep.close()
+	}
+}
+
+func (rep *replica) close() {
+	for _, registration := range rep.metrics {
+		_ = registration.Unregister()
+	}
+	_ = rep.sqlDB.Close()
+}
+
+type writeTrackerKey struct{}
+
+type writeTracker struct {
+	last atomic.Int64
+}
+
+// TrackWrites starts a read-your-writes scope, normally one per request:
+// reads made with the returned context go to the primary for a while after
+// a write made with it.
+func TrackWrites(ctx context.Context) context.Context {
+	if _, ok := ctx.Value(writeTrackerKey{}).(*writeTracker); ok {
+		return ctx
+	}
+	return context.WithValue(ctx, writeTrackerKey{}, &writeTracker{})
+}
+
+// trackWrite ignores failed statements and raw queries that only read, such
+// as the set_config of tenant sessions.
+func trackWrite(db *gorm.DB) {
+	if db.Error != nil || db.Statement.Context == nil {
+		return
+	}
+	tracker, ok := db.Statement.Context.Value(writeTrackerKey{}).(*writeTracker)
+	if !ok {
+		return
+	}
+	if operationName(db.Statement.SQL.String()) == "SELECT" {
+		return
+	}
+	tracker.last.Store(time.Now().UnixNano())
+}
@@ -15,6 +15,8 @@ import (
 
 var dbTracer = otel.Tracer("shared.persistence")
 
+const addManyBatchSize = 500
+
 type GORMGenericRepository[T any, ID comparable] struct {
 	db         *gorm.DB
 	entityName string
@@ -37,16 +39,50 @@ func (r *GORMGenericRepository[T, ID]) Add(ctx context.Context, entity *T) (*T,
 		attribute.String("db.model", r.entityName),
 	)
 
-	if err := r.db.WithContext(ctx).Create(entity).Error; err != nil {
+	err := WithTenantSession(ctx, r.db, func(tx *gorm.DB) error {
+		return tx.Create(entity).Error
+	})
+	if err != nil {
 		span.SetStatus(codes.Error, err.Error())
 		span.RecordError(err)
-		return nil, exceptions.NewInternalException(map[string]any{"error": err.Error()})
+		return nil, DatabaseError(err)
 	}
 
 	span.SetStatus(codes.Ok, "inserted")
 	return entity, nil
 }
 
+// AddMany inserts the entities in batched INSERTs.
+func (r *GORMGenericRepository[T, ID]) AddMany(ctx context.Context, entities []T) (int64, error) {
+	ctx, span := dbTracer.Start(ctx, r.entityName+".AddMany")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.operation", "INSERT"),
+		attribute.String("db.model", r.entityName),
+		attribute.Int("db.batch_size", len(entities)),
+	)
+
+	if len(entities) == 0 {
+		return 0, nil
+	}
+
+	var inserted int64
+	err := WithTenantSession(ctx, r.db, func(tx *gorm.DB) error {
+		result := tx.CreateInBatches(entities, addManyBatchSize)
+		inserted = result.RowsAffected
+		return result.Error
+	})
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+		return 0, DatabaseError(err)
+	}
+
+	span.SetStatus(codes.Ok, "inserted")
+	return inserted, nil
+}
+
 func (r *GORMGenericRepository[T, ID]) GetByID(ctx context.Context, id ID) (*T, error) {
 	ctx, span := dbTracer.Start(ctx, r.entityName+".GetByID")
 	defer span.End()
@@ -57,7 +93,9 @@ func (r *GORMGenericRepository[T, ID]) GetByID(ctx context.Context, id ID) (*T,
 	)
 
 	var entity T
-	err := r.db.WithContext(ctx).First(&entity, "id = ?", id).Error
+	err := WithTenantReadSession(ctx, r.db, func(tx *gorm.DB) error {
+		return tx.First(&entity, "id = ?", id).Error
+	})
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		span.SetStatus(codes.Error, "not found")
 		return nil, exceptions.NewNotFoundException("", nil)
@@ -65,13 +103,41 @@ func (r *GORMGenericRepository[T, ID]) GetByID(ctx context.Context, id ID) (*T,
 	if err != nil {
 		span.SetStatus(codes.Error, err.Error())
 		span.RecordError(err)
-		return nil, exceptions.NewInternalException(map[string]any{"error": err.Error()})
+		return nil, DatabaseError(err)
 	}
 
 	span.SetStatus(codes.Ok, "found")
 	return &entity, nil
 }
 
+func (r *GORMGenericRepository[T, ID]) GetByIDs(ctx context.Context, ids []ID) ([]T, error) {
+	ctx, span := dbTracer.Start(ctx, r.entityName+".GetByIDs")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.operation", "SELECT"),
+		attribute.String("db.model", r.entityName),
+		attribute.Int("db.batch_size", len(ids)),
+	)
+
+	var entities []T
+	if len(ids) == 0 {
+		return entities, nil
+	}
+
+	err := WithTenantReadSession(ctx, r.db, func(tx *gorm.DB) error {
+		return tx.Where("id IN ?", ids).Find(&entities).Error
+	})
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+		return nil, DatabaseError(err)
+	}
+
+	span.SetStatus(codes.Ok, "found")
+	return entities, nil
+}
+
 func (r *GORMGenericRepository[T, ID]) UpdateByID(ctx context.Context, id ID, updates map[string]any) (*T, error) {
 	ctx, span := dbTracer.Start(ctx, r.entityName+".UpdateByID")
 	defer span.End()
@@ -82,19 +148,20 @@ func (r *GORMGenericRepository[T, ID]) UpdateByID(ctx context.Context, id ID, up
 	)
 
 	var entity T
-	if err := r.db.WithContext(ctx).First(&entity, "id = ?", id).Error; err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			span.SetStatus(codes.Error, "not found")
-			return nil, exceptions.NewNotFoundException("", nil)
+	err := WithTenantSession(ctx, r.db, func(tx *gorm.DB) error {
+		if err := tx.First(&entity, "id = ?", id).Error; err != nil {
+			return err
 		}
-		span.SetStatus(codes.Error, err.Error())
-		span.RecordError(err)
-		return nil, exceptions.NewInternalException(map[string]any{"error": err.Error()})
+		return tx.Model(&entity).Updates(updates).Error
+	})
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		span.SetStatus(codes.Error, "not found")
+		return nil, exceptions.NewNotFoundException("", nil)
 	}
-	if err := r.db.WithContext(ctx).Model(&entity).Updates(updates).Error; err != nil {
+	if err != nil {
 		span.SetStatus(codes.Error, err.Error())
 		span.RecordError(err)
-		return nil, exceptions.NewInternalException(map[string]any{"error": err.Error()})
+		return nil, DatabaseError(err)
 	}
 
 	span.SetStatus(codes.Ok, "updated")
@@ -111,10 +178,13 @@ func (r *GORMGenericRepository[T, ID]) DeleteByID(ctx context.Context, id ID) er
 	)
 
 	var entity T
-	if err := r.db.WithContext(ctx).Delete(&entity, "id = ?", id).Error; err != nil {
+	err := WithTenantSession(ctx, r.db, func(tx *gorm.DB) error {
+		return tx.Delete(&entity, "id = ?", id).Error
+	})
+	if err != nil {
 		span.SetStatus(codes.Error, err.Error())
 		span.RecordError(err)
-		return exceptions.NewInternalException(map[string]any{"error": err.Error()})
+		return DatabaseError(err)
 	}
 
 	span.SetStatus(codes.Ok, "deleted")
@@ -131,10 +201,13 @@ func (r *GORMGenericRepository[T, ID]) DeleteAll(ctx context.Context) error {
 	)
 
 	var entity T
-	if err := r.db.WithContext(ctx).Where("1 = 1").Delete(&entity).Error; err != nil {
+	err := WithTenantSession(ctx, r.db, func(tx *gorm.DB) error {
+		return tx.Where("1 = 1").Delete(&entity).Error
+	})
+	if err != nil {
 		span.SetStatus(codes.Error, err.Error())
 		span.RecordError(err)
-		return exceptions.NewInternalException(map[string]any{"error": err.Error()})
+		return DatabaseError(err)
 	}
 
 	span.SetStatus(codes.Ok, "deleted all")
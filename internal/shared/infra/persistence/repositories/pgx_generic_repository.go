@@ -0,0 +1,449 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// PgxGenericRepository implements the generic repository on pgx, without
+// GORM's per-call reflection. The table and its columns come from the GORM
+// schema of T, parsed once, so both implementations read the same model;
+// fields need types pgx encodes, since GORM serializers are not applied.
+type PgxGenericRepository[T any, ID comparable] struct {
+	db         *gorm.DB
+	entityName string
+	schema     *schema.Schema
+	table      string
+	columns    string
+	primaryKey string
+	tenant     *schema.Field
+}
+
+func NewPgxGenericRepository[T any, ID comparable](db *gorm.DB) *PgxGenericRepository[T, ID] {
+	var zero T
+	stmt := &gorm.Statement{DB: db}
+	if err := stmt.Parse(&zero); err != nil {
+		panic(fmt.Sprintf("failed to parse the schema of %T: %v", zero, err))
+	}
+	if stmt.Schema.PrioritizedPrimaryField == nil {
+		panic(fmt.Sprintf("%T has no primary key", zero))
+	}
+
+	columns := make([]string, 0, len(stmt.Schema.DBNames))
+	for _, name := range stmt.Schema.DBNames {
+		columns = append(columns, quoteIdentifier(name))
+	}
+	r := &PgxGenericRepository[T, ID]{
+		db:         db,
+		entityName: fmt.Sprintf("%T", zero),
+		schema:     stmt.Schema,
+		table:      tableIdentifier(stmt.Schema.Table).Sanitize(),
+		columns:    strings.Join(columns, ", "),
+		primaryKey: quoteIdentifier(stmt.Schema.PrioritizedPrimaryField.DBName),
+	}
+	if field := stmt.Schema.LookUpField("TenantID"); field != nil && field.DBName != "" {
+		r.tenant = field
+	}
+	return r
+}
+
+func (r *PgxGenericRepository[T, ID]) Add(ctx context.Context, entity *T) (*T, error) {
+	ctx, span := dbTracer.Start(ctx, r.entityName+".Add")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.operation", "INSERT"),
+		attribute.String("db.model", r.entityName),
+	)
+
+	err := r.prepareCreate(ctx, entity)
+	if err == nil {
+		err = WithPgxTenantSession(ctx, r.db, func(q PgxQuerier) error {
+			names, values := r.insertValues(ctx, entity)
+			placeholders := make([]string, len(values))
+			for i := range values {
+				placeholders[i] = "$" + strconv.Itoa(i+1)
+			}
+			sql := "INSERT INTO " + r.table + " (" + strings.Join(names, ", ") + ") VALUES (" +
+				strings.Join(placeholders, ", ") + ") RETURNING " + r.columns
+			rows, err := q.Query(ctx, sql, values...)
+			if err != nil {
+				return err
+			}
+			inserted, err := pgx.CollectExactlyOneRow(rows, r.scan)
+			if err != nil {
+				return err
+			}
+			*entity = inserted
+			return nil
+		})
+	}
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+		return nil, DatabaseError(err)
+	}
+
+	span.SetStatus(codes.Ok, "inserted")
+	return entity, nil
+}
+
+// AddMany inserts the entities with COPY, which is much faster than INSERTs
+// for large batches but does not read back generated columns such as ids.
+func (r *PgxGenericRepository[T, ID]) AddMany(ctx context.Context, entities []T) (int64, error) {
+	ctx, span := dbTracer.Start(ctx, r.entityName+".AddMany")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.operation", "COPY"),
+		attribute.String("db.model", r.entityName),
+		attribute.Int("db.batch_size", len(entities)),
+	)
+
+	if len(entities) == 0 {
+		return 0, nil
+	}
+
+	var names []string
+	var fields []*schema.Field
+	for _, name := range r.schema.DBNames {
+		field := r.schema.FieldsByDBName[name]
+		if field.PrimaryKey && field.HasDefaultValue {
+			continue
+		}
+		names = append(names, name)
+		fields = append(fields, field)
+	}
+
+	rows := make([][]any, len(entities))
+	for i := range entities {
+		if err := r.prepareCreate(ctx, &entities[i]); err != nil {
+			span.SetStatus(codes.Error, err.Error())
+			span.RecordError(err)
+			return 0, DatabaseError(err)
+		}
+		value := reflect.ValueOf(&entities[i]).Elem()
+		rows[i] = make([]any, len(fields))
+		for j, field := range fields {
+			rows[i][j], _ = field.ValueOf(ctx, value)
+		}
+	}
+
+	var copied int64
+	err := WithPgxTenantSession(ctx, r.db, func(q PgxQuerier) error {
+		var err error
+		copied, err = q.CopyFrom(ctx, tableIdentifier(r.schema.Table), names, pgx.CopyFromRows(rows))
+		return err
+	})
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+		return 0, DatabaseError(err)
+	}
+
+	span.SetStatus(codes.Ok, "inserted")
+	return copied, nil
+}
+
+func (r *PgxGenericRepository[T, ID]) GetByID(ctx context.Context, id ID) (*T, error) {
+	ctx, span := dbTracer.Start(ctx, r.entityName+".GetByID")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.operation", "SELECT"),
+		attribute.String("db.model", r.entityName),
+	)
+
+	entities, err := r.Select(ctx, r.primaryKey+" = $1", "LIMIT 1", id)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+		return nil, DatabaseError(err)
+	}
+	if len(entities) == 0 {
+		span.SetStatus(codes.Error, "not found")
+		return nil, exceptions.NewNotFoundException("", nil)
+	}
+
+	span.SetStatus(codes.Ok, "found")
+	return &entities[0], nil
+}
+
+func (r *PgxGenericRepository[T, ID]) GetByIDs(ctx context.Context, ids []ID) ([]T, error) {
+	ctx, span := dbTracer.Start(ctx, r.entityName+".GetByIDs")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.operation", "SELECT"),
+		attribute.String("db.model", r.entityName),
+		attribute.Int("db.batch_size", len(ids)),
+	)
+
+	var entities []T
+	if len(ids) == 0 {
+		return entities, nil
+	}
+
+	entities, err := r.Select(ctx, r.primaryKey+" = ANY($1)", "", ids)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+		return nil, DatabaseError(err)
+	}
+
+	span.SetStatus(codes.Ok, "found")
+	return entities, nil
+}
+
+// UpdateByID takes columns or field names as keys, like GORM's Updates, and
+// refreshes the update timestamps.
+func (r *PgxGenericRepository[T, ID]) UpdateByID(ctx context.Context, id ID, updates map[string]any) (*T, error) {
+	ctx, span := dbTracer.Start(ctx, r.entityName+".UpdateByID")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.operation", "UPDATE"),
+		attribute.String("db.model", r.entityName),
+	)
+
+	assignments := make(map[string]any, len(updates)+1)
+	for key, value := range updates {
+		column := key
+		if field := r.schema.LookUpField(key); field != nil && field.DBName != "" {
+			column = field.DBName
+		}
+		assignments[column] = value
+	}
+	if _, scoped, _ := pgxTenantScope(ctx); scoped && r.tenant != nil {
+		delete(assignments, r.tenant.DBName)
+	}
+	now := r.db.NowFunc()
+	for _, field := range r.schema.Fields {
+		if _, set := assignments[field.DBName]; field.AutoUpdateTime > 0 && field.DBName != "" && !set {
+			assignments[field.DBName] = timestamp(field, now)
+		}
+	}
+
+	columns := make([]string, 0, len(assignments))
+	for column := range assignments {
+		columns = append(columns, column)
+	}
+	slices.Sort(columns)
+	set := make([]string, len(columns))
+	args := make([]any, 0, len(columns)+2)
+	for i, column := range columns {
+		args = append(args, assignments[column])
+		set[i] = quoteIdentifier(column) + " = $" + strconv.Itoa(len(args))
+	}
+	args = append(args, id)
+	where := r.primaryKey + " = $" + strconv.Itoa(len(args))
+
+	var entity T
+	err := WithPgxTenantSession(ctx, r.db, func(q PgxQuerier) error {
+		where, args, err := r.scoped(ctx, where, args)
+		if err != nil {
+			return err
+		}
+		rows, err := q.Query(ctx, "UPDATE "+r.table+" SET "+strings.Join(set, ", ")+" WHERE "+where+" RETURNING "+r.columns, args...)
+		if err != nil {
+			return err
+		}
+		entity, err = pgx.CollectExactlyOneRow(rows, r.scan)
+		return err
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		span.SetStatus(codes.Error, "not found")
+		return nil, exceptions.NewNotFoundException("", nil)
+	}
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+		return nil, DatabaseError(err)
+	}
+
+	span.SetStatus(codes.Ok, "updated")
+	return &entity, nil
+}
+
+func (r *PgxGenericRepository[T, ID]) DeleteByID(ctx context.Context, id ID) error {
+	ctx, span := dbTracer.Start(ctx, r.entityName+".DeleteByID")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.operation", "DELETE"),
+		attribute.String("db.model", r.entityName),
+	)
+
+	if err := r.delete(ctx, r.primaryKey+" = $1", id); err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+		return DatabaseError(err)
+	}
+
+	span.SetStatus(codes.Ok, "deleted")
+	return nil
+}
+
+func (r *PgxGenericRepository[T, ID]) DeleteAll(ctx context.Context) error {
+	ctx, span := dbTracer.Start(ctx, r.entityName+".DeleteAll")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.operation", "DELETE_ALL"),
+		attribute.String("db.model", r.entityName),
+	)
+
+	if err := r.delete(ctx, "TRUE"); err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+		return DatabaseError(err)
+	}
+
+	span.SetStatus(codes.Ok, "deleted all")
+	return nil
+}
+
+// Select reads the entities matching where, a condition on numbered
+// parameters such as "email = $1", in the tenant of ctx. suffix follows the
+// condition, as in "ORDER BY id LIMIT $2". Errors are returned as they are,
+// for the caller to map.
+func (r *PgxGenericRepository[T, ID]) Select(ctx context.Context, where, suffix string, args ...any) ([]T, error) {
+	var entities []T
+	err := WithPgxTenantReadSession(ctx, r.db, func(q PgxQuerier) error {
+		where, args, err := r.scoped(ctx, where, args)
+		if err != nil {
+			return err
+		}
+		rows, err := q.Query(ctx, strings.TrimSpace("SELECT "+r.columns+" FROM "+r.table+" WHERE "+where+" "+suffix), args...)
+		if err != nil {
+			return err
+		}
+		entities, err = pgx.CollectRows(rows, r.scan)
+		return err
+	})
+	return entities, err
+}
+
+func (r *PgxGenericRepository[T, ID]) delete(ctx context.Context, where string, args ...any) error {
+	return WithPgxTenantSession(ctx, r.db, func(q PgxQuerier) error {
+		where, args, err := r.scoped(ctx, where, args)
+		if err != nil {
+			return err
+		}
+		_, err = q.Exec(ctx, "DELETE FROM "+r.table+" WHERE "+where, args...)
+		return err
+	})
+}
+
+// scoped adds the tenant of ctx to the condition when T belongs to tenants.
+func (r *PgxGenericRepository[T, ID]) scoped(ctx context.Context, where string, args []any) (string, []any, error) {
+	if r.tenant == nil {
+		return where, args, nil
+	}
+	tenantID, ok, err := pgxTenantScope(ctx)
+	if err != nil || !ok {
+		return where, args, err
+	}
+	args = append(slices.Clip(args), tenantID)
+	return "(" + where + ") AND " + quoteIdentifier(r.tenant.DBName) + " = $" + strconv.Itoa(len(args)), args, nil
+}
+
+// prepareCreate assigns the tenant and the creation timestamps, as GORM's
+// callbacks do.
+func (r *PgxGenericRepository[T, ID]) prepareCreate(ctx context.Context, entity *T) error {
+	value := reflect.ValueOf(entity).Elem()
+	if r.tenant != nil {
+		tenantID, ok, err := pgxTenantScope(ctx)
+		if err != nil {
+			return err
+		}
+		if ok {
+			if err := r.tenant.Set(ctx, value, tenantID); err != nil {
+				return err
+			}
+		}
+	}
+
+	now := r.db.NowFunc()
+	for _, field := range r.schema.Fields {
+		if field.AutoCreateTime == 0 && field.AutoUpdateTime == 0 {
+			continue
+		}
+		if _, isZero := field.ValueOf(ctx, value); isZero {
+			if err := field.Set(ctx, value, now); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// insertValues leaves out zero fields the database has a default for, such
+// as an auto-increment id.
+func (r *PgxGenericRepository[T, ID]) insertValues(ctx context.Context, entity *T) ([]string, []any) {
+	value := reflect.ValueOf(entity).Elem()
+	names := make([]string, 0, len(r.schema.DBNames))
+	values := make([]any, 0, len(r.schema.DBNames))
+	for _, name := range r.schema.DBNames {
+		field := r.schema.FieldsByDBName[name]
+		fieldValue, isZero := field.ValueOf(ctx, value)
+		if isZero && field.HasDefaultValue {
+			continue
+		}
+		names = append(names, quoteIdentifier(name))
+		values = append(values, fieldValue)
+	}
+	return names, values
+}
+
+// scan reads a row into the fields its columns belong to.
+func (r *PgxGenericRepository[T, ID]) scan(row pgx.CollectableRow) (T, error) {
+	var entity T
+	value := reflect.ValueOf(&entity).Elem()
+	descriptions := row.FieldDescriptions()
+	targets := make([]any, len(descriptions))
+	for i, description := range descriptions {
+		field, ok := r.schema.FieldsByDBName[description.Name]
+		if !ok {
+			return entity, fmt.Errorf("column %s does not belong to %s", description.Name, r.entityName)
+		}
+		targets[i] = field.ReflectValueOf(context.Background(), value).Addr().Interface()
+	}
+	return entity, row.Scan(targets...)
+}
+
+func timestamp(field *schema.Field, now time.Time) any {
+	switch field.AutoUpdateTime {
+	case schema.UnixNanosecond:
+		return now.UnixNano()
+	case schema.UnixMillisecond:
+		return now.UnixMilli()
+	case schema.UnixSecond:
+		return now.Unix()
+	default:
+		return now
+	}
+}
+
+// tableIdentifier splits a schema-qualified table name.
+func tableIdentifier(table string) pgx.Identifier {
+	return pgx.Identifier(strings.Split(table, "."))
+}
+
+func quoteIdentifier(name string) string {
+	return pgx.Identifier{name}.Sanitize()
+}
@@ -0,0 +1,72 @@
+package repositories
+
+import (
+	"context"
+
+	"golang_boilerplate_module/internal/shared/domain/tenancy"
+	"golang_boilerplate_module/internal/shared/infra/persistence"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+)
+
+const setCurrentTenant = "SELECT set_config('app.current_tenant', $1, true)"
+
+// PgxQuerier is what pgx.Conn and pgx.Tx have in common for repositories.
+type PgxQuerier interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+	CopyFrom(ctx context.Context, tableName pgx.Identifier, columnNames []string, rowSrc pgx.CopyFromSource) (int64, error)
+}
+
+// WithPgxTenantSession is WithTenantSession for repositories built on pgx.
+func WithPgxTenantSession(ctx context.Context, db *gorm.DB, fn func(q PgxQuerier) error) error {
+	return persistence.WithPgx(ctx, db, func(conn *pgx.Conn) error {
+		return withPgxTenant(ctx, db, conn, fn)
+	})
+}
+
+// WithPgxTenantReadSession is WithPgxTenantSession for work that only reads,
+// which may run on a read replica.
+func WithPgxTenantReadSession(ctx context.Context, db *gorm.DB, fn func(q PgxQuerier) error) error {
+	return persistence.WithPgxRead(ctx, db, func(conn *pgx.Conn) error {
+		return withPgxTenant(ctx, db, conn, fn)
+	})
+}
+
+// withPgxTenant sets the tenant for row level security in a transaction of
+// its own, or in the one carried by ctx, which pgx must not begin again.
+func withPgxTenant(ctx context.Context, db *gorm.DB, conn *pgx.Conn, fn func(q PgxQuerier) error) error {
+	tenantID, ok := tenancy.TenantIDFromContext(ctx)
+	if !ok || !persistence.RowLevelSecurityEnabled(db) {
+		return fn(conn)
+	}
+
+	if persistence.InTransaction(ctx) {
+		if _, err := conn.Exec(ctx, setCurrentTenant, tenantID); err != nil {
+			return err
+		}
+		return fn(conn)
+	}
+	return pgx.BeginFunc(ctx, conn, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, setCurrentTenant, tenantID); err != nil {
+			return err
+		}
+		return fn(tx)
+	})
+}
+
+// pgxTenantScope is the tenant rows are scoped to, mirroring the tenancy
+// plugin: none in system scope, and an error when ctx has no tenant.
+func pgxTenantScope(ctx context.Context) (string, bool, error) {
+	if tenancy.IsSystemScope(ctx) {
+		return "", false, nil
+	}
+	tenantID, ok := tenancy.TenantIDFromContext(ctx)
+	if !ok {
+		return "", false, tenancy.ErrTenantRequired
+	}
+	return tenantID, true, nil
+}
@@ -0,0 +1,65 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/domain/tenancy"
+	"golang_boilerplate_module/internal/shared/infra/persistence"
+
+	"gorm.io/gorm"
+)
+
+func WithTenantSession(ctx context.Context, db *gorm.DB, fn func(tx *gorm.DB) error) error {
+	return withTenant(ctx, persistence.Conn(ctx, db), fn)
+}
+
+// WithTenantReadSession is WithTenantSession for work that only reads, which
+// may run on a read replica.
+func WithTenantReadSession(ctx context.Context, db *gorm.DB, fn func(tx *gorm.DB) error) error {
+	return withTenant(ctx, persistence.ReadConn(ctx, db), fn)
+}
+
+func withTenant(ctx context.Context, db *gorm.DB, fn func(tx *gorm.DB) error) error {
+	tenantID, ok := tenancy.TenantIDFromContext(ctx)
+	if !ok || !persistence.RowLevelSecurityEnabled(db) {
+		return fn(db.WithContext(ctx))
+	}
+
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("SELECT set_config('app.current_tenant', ?, true)", tenantID).Error; err != nil {
+			return err
+		}
+		return fn(tx)
+	})
+}
+
+// WithSystemSession runs fn across every tenant, for the jobs and listeners
+// that are not scoped to one. With row-level security it sets
+// `app.system_scope` for the transaction, which only the policies that grant
+// such a bypass (user_events) honour.
+func WithSystemSession(ctx context.Context, db *gorm.DB, fn func(tx *gorm.DB) error) error {
+	ctx = tenancy.WithSystemScope(ctx)
+	db = persistence.Conn(ctx, db)
+	if !persistence.RowLevelSecurityEnabled(db) {
+		return fn(db.WithContext(ctx))
+	}
+
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("SELECT set_config('app.system_scope', 'on', true)").Error; err != nil {
+			return err
+		}
+		return fn(tx)
+	})
+}
+
+// DatabaseError maps a failed statement to a domain error. A statement run
+// without a tenant is a request the tenant middleware did not scope, so it
+// is refused rather than reported as a server fault.
+func DatabaseError(err error) *exceptions.DomainError {
+	if errors.Is(err, tenancy.ErrTenantRequired) {
+		return exceptions.NewBadRequestException("Tenant could not be resolved", nil)
+	}
+	return exceptions.NewInternalException(map[string]any{"error": err.Error()})
+}
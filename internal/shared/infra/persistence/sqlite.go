@@ -0,0 +1,94 @@
+package persistence
+
+import (
+	"database/sql"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"sync/atomic"
+
+	"golang_boilerplate_module/internal/config"
+
+	"gorm.io/gorm"
+)
+
+// Capability is a database feature that not every dialect has.
+type Capability string
+
+const (
+	// ListenNotify is LISTEN/NOTIFY, which other processes' changes arrive by.
+	ListenNotify Capability = "listen_notify"
+	// AdvisoryLocks are the pg_advisory_lock family.
+	AdvisoryLocks Capability = "advisory_locks"
+	// RowLevelSecurity is policies on app.current_tenant.
+	RowLevelSecurity Capability = "row_level_security"
+	// SessionTimeouts are statement_timeout and lock_timeout.
+	SessionTimeouts Capability = "session_timeouts"
+)
+
+var capabilities = map[string][]Capability{
+	config.DialectPostgres: {ListenNotify, AdvisoryLocks, RowLevelSecurity, SessionTimeouts},
+	config.DialectSQLite:   nil,
+}
+
+// Supports tells whether the database behind db has the capability.
+func Supports(db *gorm.DB, capability Capability) bool {
+	return slices.Contains(capabilities[db.Dialector.Name()], capability)
+}
+
+var memoryDatabases atomic.Uint64
+
+// sqliteDSN turns sqlite://<path>[?params] into a DSN of the pure Go driver,
+// with foreign keys on and lock_timeout as the busy timeout. Each
+// sqlite://:memory: is a database of its own, shared by the pool.
+func sqliteDSN(cfg config.DatabaseConfig) (dsn string, memory bool, err error) {
+	rest := strings.TrimPrefix(strings.TrimPrefix(cfg.URL, config.DialectSQLite+":"), "//")
+	path, rawQuery, _ := strings.Cut(rest, "?")
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return "", false, err
+	}
+	if path == "" {
+		return "", false, fmt.Errorf("sqlite URL has no path")
+	}
+
+	memory = path == ":memory:"
+	if memory {
+		path = fmt.Sprintf("memory-%d", memoryDatabases.Add(1))
+		query.Set("mode", "memory")
+		query.Set("cache", "shared")
+	} else {
+		query.Add("_pragma", "journal_mode(WAL)")
+	}
+	query.Add("_pragma", "foreign_keys(1)")
+	query.Add("_pragma", fmt.Sprintf("busy_timeout(%d)", cfg.LockTimeout.Milliseconds()))
+	if !query.Has("_txlock") {
+		// Writers take the lock up front instead of failing to upgrade.
+		query.Set("_txlock", "immediate")
+	}
+	return "file:" + path + "?" + query.Encode(), memory, nil
+}
+
+// openSQLitePool is openPool for SQLite. An in-memory database lives as long
+// as a connection to it, so its pool never closes one.
+func openSQLitePool(cfg config.DatabaseConfig) (*sql.DB, error) {
+	dsn, memory, err := sqliteDSN(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	sqlDB, err := sql.Open(config.DialectSQLite, dsn)
+	if err != nil {
+		return nil, err
+	}
+	sqlDB.SetMaxOpenConns(cfg.MaxConnections)
+	if memory {
+		sqlDB.SetMaxIdleConns(cfg.MaxConnections)
+		return sqlDB, nil
+	}
+	sqlDB.SetMaxIdleConns(cfg.MaxIdleConnections)
+	sqlDB.SetConnMaxLifetime(cfg.ConnMaxLifetime)
+	sqlDB.SetConnMaxIdleTime(cfg.ConnMaxIdleTime)
+	return sqlDB, nil
+}
@@ -0,0 +1,108 @@
+package persistence
+
+import (
+	"reflect"
+
+	"golang_boilerplate_module/internal/shared/domain/tenancy"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+)
+
+const (
+	TenancyPluginName = "tenancy"
+	tenantFieldName   = "TenantID"
+)
+
+type TenancyPlugin struct {
+	RowLevelSecurity bool
+}
+
+func (p *TenancyPlugin) Name() string {
+	return TenancyPluginName
+}
+
+func (p *TenancyPlugin) Initialize(db *gorm.DB) error {
+	if err := db.Callback().Create().Before("gorm:create").Register("tenancy:assign", assignTenant); err != nil {
+		return err
+	}
+	if err := db.Callback().Query().Before("gorm:query").Register("tenancy:scope", scopeTenant); err != nil {
+		return err
+	}
+	if err := db.Callback().Update().Before("gorm:update").Register("tenancy:scope", scopeTenant); err != nil {
+		return err
+	}
+	if err := db.Callback().Delete().Before("gorm:delete").Register("tenancy:scope", scopeTenant); err != nil {
+		return err
+	}
+	return db.Callback().Row().Before("gorm:row").Register("tenancy:scope", scopeTenant)
+}
+
+func RowLevelSecurityEnabled(db *gorm.DB) bool {
+	plugin, ok := db.Config.Plugins[TenancyPluginName].(*TenancyPlugin)
+	return ok && plugin.RowLevelSecurity
+}
+
+func tenantField(db *gorm.DB) *schema.Field {
+	if db.Statement.Schema == nil {
+		return nil
+	}
+	return db.Statement.Schema.LookUpField(tenantFieldName)
+}
+
+func statementTenant(db *gorm.DB) (string, bool) {
+	ctx := db.Statement.Context
+	if tenancy.IsSystemScope(ctx) {
+		return "", false
+	}
+	tenantID, ok := tenancy.TenantIDFromContext(ctx)
+	if !ok {
+		_ = db.AddError(tenancy.ErrTenantRequired)
+		return "", false
+	}
+	return tenantID, true
+}
+
+func assignTenant(db *gorm.DB) {
+	field := tenantField(db)
+	if field == nil || db.Error != nil {
+		return
+	}
+
+	tenantID, ok := statementTenant(db)
+	if !ok {
+		return
+	}
+
+	rv := db.Statement.ReflectValue
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < rv.Len(); i++ {
+			_ = field.Set(db.Statement.Context, reflect.Indirect(rv.Index(i)), tenantID)
+		}
+	case reflect.Struct:
+		_ = field.Set(db.Statement.Context, rv, tenantID)
+	}
+}
+
+func scopeTenant(db *gorm.DB) {
+	field := tenantField(db)
+	if field == nil || db.Error != nil {
+		return
+	}
+
+	tenantID, ok := statementTenant(db)
+	if !ok {
+		return
+	}
+
+	if updates, isMap := db.Statement.Dest.(map[string]any); isMap {
+		delete(updates, field.DBName)
+		delete(updates, field.Name)
+	}
+
+	db.Statement.AddClause(clause.Where{Exprs: []clause.Expression{
+		clause.Eq{Column: clause.Column{Table: clause.CurrentTable, Name: field.DBName}, Value: tenantID},
+	}})
+}
@@ -0,0 +1,75 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+
+	"golang_boilerplate_module/internal/shared/domain/bus"
+
+	"gorm.io/gorm"
+)
+
+type transactionContextKey struct{}
+
+type transactionConnContextKey struct{}
+
+// WithTransaction makes repositories called with ctx run on tx.
+func WithTransaction(ctx context.Context, tx *gorm.DB) context.Context {
+	return context.WithValue(ctx, transactionContextKey{}, tx)
+}
+
+// Conn returns the transaction carried by ctx, or db when there is none.
+func Conn(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(transactionContextKey{}).(*gorm.DB); ok {
+		return tx
+	}
+	return db
+}
+
+// ReadConn returns the connection for a read-only query: the transaction
+// carried by ctx, or else a read replica when db routes reads to replicas.
+func ReadConn(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(transactionContextKey{}).(*gorm.DB); ok {
+		return tx
+	}
+	if replicas := ReplicasOf(db); replicas != nil {
+		return replicas.route(ctx, db)
+	}
+	return db
+}
+
+// InTransaction reports whether ctx carries a transaction.
+func InTransaction(ctx context.Context) bool {
+	_, ok := ctx.Value(transactionContextKey{}).(*gorm.DB)
+	return ok
+}
+
+// TransactionBehavior runs transactional commands inside a database
+// transaction that is committed when the handler succeeds. The transaction
+// holds a dedicated connection, which pgx repositories share through ctx.
+func TransactionBehavior(db *gorm.DB) bus.Behavior {
+	return func(ctx context.Context, req bus.Request, next bus.Next) (any, error) {
+		if req.Kind != bus.KindCommand || !req.Transactional {
+			return next(ctx)
+		}
+
+		var result any
+		run := func(tx *gorm.DB) error {
+			var err error
+			result, err = next(WithTransaction(ctx, tx))
+			return err
+		}
+		if InTransaction(ctx) {
+			err := Conn(ctx, db).WithContext(ctx).Transaction(run)
+			return result, err
+		}
+
+		err := db.WithContext(ctx).Connection(func(conn *gorm.DB) error {
+			if sqlConn, ok := conn.Statement.ConnPool.(*sql.Conn); ok {
+				ctx = context.WithValue(ctx, transactionConnContextKey{}, sqlConn)
+			}
+			return conn.Transaction(run)
+		})
+		return result, err
+	}
+}
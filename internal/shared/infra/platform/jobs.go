@@ -0,0 +1,76 @@
+package platform
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"golang_boilerplate_module/internal/shared/domain/providers"
+
+	"go.uber.org/fx"
+)
+
+const JobsGroup = `group:"platform_jobs"`
+
+// Job is a background task the scheduler runs every Interval until shutdown.
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context) error
+}
+
+type JobsParams struct {
+	fx.In
+
+	Lifecycle fx.Lifecycle
+	Jobs      []Job `group:"platform_jobs"`
+	Logger    providers.LoggerProvider
+}
+
+func RegisterJobs(p JobsParams) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var running sync.WaitGroup
+
+	p.Lifecycle.Append(fx.Hook{
+		OnStart: func(_ context.Context) error {
+			for _, job := range p.Jobs {
+				running.Add(1)
+				go func(job Job) {
+					defer running.Done()
+					runJob(ctx, job, p.Logger)
+				}(job)
+				p.Logger.Info("Job started", "job", job.Name, "interval", job.Interval.String())
+			}
+			return nil
+		},
+		OnStop: func(stopCtx context.Context) error {
+			cancel()
+			done := make(chan struct{})
+			go func() {
+				running.Wait()
+				close(done)
+			}()
+			select {
+			case <-done:
+			case <-stopCtx.Done():
+			}
+			return nil
+		},
+	})
+}
+
+func runJob(ctx context.Context, job Job, logger providers.LoggerProvider) {
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := job.Run(ctx); err != nil {
+				logger.Error("job run failed", "job", job.Name, "error", err.Error())
+			}
+		}
+	}
+}
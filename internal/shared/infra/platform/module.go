@@ -0,0 +1,86 @@
+package platform
+
+import (
+	"fmt"
+
+	"golang_boilerplate_module/internal/config"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+
+	"go.uber.org/fx"
+)
+
+// Module declares what a business module contributes to the platform.
+type Module struct {
+	Name        string
+	Description string
+	// Required modules cannot be disabled.
+	Required  bool
+	DependsOn []string
+	// ConfigPrefix is the prefix of the environment variables the module reads.
+	ConfigPrefix string
+	// Config points to the zero value of the module's settings struct.
+	Config any
+	// Migrations names the scripts in migrations/ that the module owns.
+	Migrations []string
+
+	Providers     []any
+	Routes        []any
+	EventHandlers []any
+	// HealthChecks are constructors of readiness checks.
+	HealthChecks []any
+	// Jobs are constructors of Job, run by the platform scheduler.
+	Jobs []any
+	// Seeders are constructors of Seeder, run by the seed command.
+	Seeders []any
+}
+
+type ModuleInfo struct {
+	Name         string   `json:"name" validate:"required"`
+	Description  string   `json:"description" validate:"required"`
+	Enabled      bool     `json:"enabled" validate:"required"`
+	Required     bool     `json:"required" validate:"required"`
+	DependsOn    []string `json:"depends_on" validate:"required"`
+	ConfigPrefix string   `json:"config_prefix,omitempty"`
+	Migrations   []string `json:"migrations" validate:"required"`
+}
+
+func (m Module) info(enabled bool) ModuleInfo {
+	return ModuleInfo{
+		Name:         m.Name,
+		Description:  m.Description,
+		Enabled:      enabled,
+		Required:     m.Required,
+		DependsOn:    append([]string{}, m.DependsOn...),
+		ConfigPrefix: m.ConfigPrefix,
+		Migrations:   append([]string{}, m.Migrations...),
+	}
+}
+
+func (m Module) options(cfg *config.Config) fx.Option {
+	options := []fx.Option{fx.Provide(m.Providers...)}
+	if m.Config != nil {
+		section, ok := cfg.Section(m.Name)
+		if !ok {
+			return fx.Error(fmt.Errorf("module %q: configuration section was not loaded", m.Name))
+		}
+		options = append(options, fx.Supply(section))
+	}
+	for _, constructor := range m.HealthChecks {
+		options = append(options, fx.Provide(fx.Annotate(
+			constructor,
+			fx.As(new(providers.HealthChecker)),
+			fx.ResultTags(providers.HealthCheckersGroup),
+		)))
+	}
+	for _, constructor := range m.Jobs {
+		options = append(options, fx.Provide(fx.Annotate(constructor, fx.ResultTags(JobsGroup))))
+	}
+	for _, constructor := range m.Seeders {
+		options = append(options, fx.Provide(fx.Annotate(constructor, fx.ResultTags(SeedersGroup))))
+	}
+	options = append(options,
+		fx.Invoke(m.Routes...),
+		fx.Invoke(m.EventHandlers...),
+	)
+	return fx.Module(m.Name, options...)
+}
@@ -0,0 +1,161 @@
+package platform
+
+import (
+	"fmt"
+	"io/fs"
+	"path"
+	"strings"
+
+	"golang_boilerplate_module/internal/config"
+	"golang_boilerplate_module/migrations"
+
+	"go.uber.org/fx"
+)
+
+// Registry holds the modules the application is built from and decides,
+// from MODULES_DISABLED, which of them are wired into the fx graph.
+type Registry struct {
+	cfg     *config.Config
+	modules []Module
+	enabled map[string]bool
+}
+
+func NewRegistry(cfg *config.Config, modules ...Module) (*Registry, error) {
+	r := &Registry{cfg: cfg, modules: modules, enabled: make(map[string]bool, len(modules))}
+
+	for _, m := range modules {
+		if _, dup := r.enabled[m.Name]; dup {
+			return nil, fmt.Errorf("module %q is registered twice", m.Name)
+		}
+		r.enabled[m.Name] = true
+	}
+
+	for _, name := range cfg.Modules.Disabled {
+		m, ok := r.find(name)
+		if !ok {
+			return nil, fmt.Errorf("MODULES_DISABLED: unknown module %q", name)
+		}
+		if m.Required {
+			return nil, fmt.Errorf("MODULES_DISABLED: module %q is required and cannot be disabled", name)
+		}
+		r.enabled[name] = false
+	}
+
+	for _, m := range modules {
+		for _, dependency := range m.DependsOn {
+			enabled, ok := r.enabled[dependency]
+			if !ok {
+				return nil, fmt.Errorf("module %q depends on unknown module %q", m.Name, dependency)
+			}
+			if r.enabled[m.Name] && !enabled {
+				return nil, fmt.Errorf("module %q depends on disabled module %q", m.Name, dependency)
+			}
+		}
+	}
+
+	if err := r.checkConfig(); err != nil {
+		return nil, err
+	}
+	if err := r.checkMigrations(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Options wires the enabled modules and the services that collect their
+// contributions.
+func (r *Registry) Options() fx.Option {
+	options := []fx.Option{fx.Supply(r)}
+	for _, m := range r.modules {
+		if r.enabled[m.Name] {
+			options = append(options, m.options(r.cfg))
+		}
+	}
+	options = append(options,
+		fx.Invoke(RegisterJobs),
+		fx.Invoke(RegisterRoutes),
+	)
+	return fx.Options(options...)
+}
+
+func (r *Registry) Modules() []ModuleInfo {
+	infos := make([]ModuleInfo, 0, len(r.modules))
+	for _, m := range r.modules {
+		infos = append(infos, m.info(r.enabled[m.Name]))
+	}
+	return infos
+}
+
+func (r *Registry) Enabled(name string) bool {
+	return r.enabled[name]
+}
+
+func (r *Registry) find(name string) (Module, bool) {
+	for _, m := range r.modules {
+		if m.Name == name {
+			return m, true
+		}
+	}
+	return Module{}, false
+}
+
+// Sections returns the configuration sections the modules declare, with zero
+// values, for describing them.
+func (r *Registry) Sections() []config.Section {
+	var sections []config.Section
+	for _, m := range r.modules {
+		if m.Config != nil {
+			sections = append(sections, config.Section{Key: m.Name, Value: m.Config})
+		}
+	}
+	return sections
+}
+
+// checkConfig ensures every environment variable of a module section carries
+// the module's ConfigPrefix.
+func (r *Registry) checkConfig() error {
+	for _, section := range r.Sections() {
+		m, _ := r.find(section.Key)
+		for _, setting := range config.Describe(section) {
+			if setting.Env != "" && (m.ConfigPrefix == "" || !strings.HasPrefix(setting.Env, m.ConfigPrefix)) {
+				return fmt.Errorf("module %q: setting %s reads %s, outside its config prefix %q", m.Name, setting.Key, setting.Env, m.ConfigPrefix)
+			}
+		}
+	}
+	return nil
+}
+
+// migrationDirs hold the PostgreSQL scripts and those of the other dialects.
+var migrationDirs = []string{".", config.DialectSQLite}
+
+// checkMigrations ensures every versioned script, in every dialect, belongs
+// to exactly one module.
+func (r *Registry) checkMigrations() error {
+	owners := make(map[string]string)
+	for _, m := range r.modules {
+		for _, file := range m.Migrations {
+			if owner, ok := owners[file]; ok {
+				return fmt.Errorf("migration %s is claimed by modules %q and %q", file, owner, m.Name)
+			}
+			for _, dir := range migrationDirs {
+				if _, err := fs.Stat(migrations.FS, path.Join(dir, file)); err != nil {
+					return fmt.Errorf("module %q declares missing migration %s", m.Name, path.Join(dir, file))
+				}
+			}
+			owners[file] = m.Name
+		}
+	}
+
+	for _, dir := range migrationDirs {
+		files, err := fs.Glob(migrations.FS, path.Join(dir, "V*.sql"))
+		if err != nil {
+			return err
+		}
+		for _, file := range files {
+			if _, ok := owners[path.Base(file)]; !ok {
+				return fmt.Errorf("migration %s is not declared by any module", file)
+			}
+		}
+	}
+	return nil
+}
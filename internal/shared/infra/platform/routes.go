@@ -0,0 +1,20 @@
+package platform
+
+import (
+	"golang_boilerplate_module/internal/shared/infra/http/openapi"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const ModulesPath = "/modules"
+
+func RegisterRoutes(router *openapi.Router, registry *Registry) {
+	platform := router.Group("", openapi.Tags("Platform"))
+	platform.Get(ModulesPath, func(c *fiber.Ctx) error {
+		return c.JSON(registry.Modules())
+	},
+		openapi.OperationID("listModules"),
+		openapi.Summary("List the application modules and whether they are enabled"),
+		openapi.Returns[[]ModuleInfo](fiber.StatusOK),
+	)
+}
@@ -0,0 +1,25 @@
+package platform
+
+import (
+	"context"
+
+	"go.uber.org/fx"
+)
+
+const SeedersGroup = `group:"platform_seeders"`
+
+// Seeder loads a fixture file into a development database, idempotently.
+type Seeder struct {
+	Name string
+	// Fixture is the file, relative to the fixtures directory, the seeder
+	// reads; seeders whose fixture is missing are skipped.
+	Fixture string
+	// Seed returns how many records it created.
+	Seed func(ctx context.Context, fixture []byte) (int, error)
+}
+
+type SeedersParams struct {
+	fx.In
+
+	Seeders []Seeder `group:"platform_seeders"`
+}
@@ -0,0 +1,89 @@
+package logger
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"sync/atomic"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+const redactedValue = "REDACTED"
+
+// redactor replaces secret values with REDACTED. The values can be swapped
+// while entries are being written.
+type redactor struct {
+	replacer atomic.Pointer[strings.Replacer]
+}
+
+func newRedactor(secrets []string) *redactor {
+	r := &redactor{}
+	r.set(secrets)
+	return r
+}
+
+func (r *redactor) set(secrets []string) {
+	if len(secrets) == 0 {
+		r.replacer.Store(nil)
+		return
+	}
+	// Longer values first, so a secret containing another is replaced whole.
+	secrets = slices.SortedFunc(slices.Values(secrets), func(a, b string) int {
+		return cmp.Compare(len(b), len(a))
+	})
+	pairs := make([]string, 0, 2*len(secrets))
+	for _, secret := range secrets {
+		pairs = append(pairs, secret, redactedValue)
+	}
+	r.replacer.Store(strings.NewReplacer(pairs...))
+}
+
+func (r *redactor) replace(text string) string {
+	if replacer := r.replacer.Load(); replacer != nil {
+		return replacer.Replace(text)
+	}
+	return text
+}
+
+// redactingCore redacts messages and string, error and stringer fields
+// before any encoder sees them.
+type redactingCore struct {
+	zapcore.Core
+	redactor *redactor
+}
+
+func (c *redactingCore) With(fields []zapcore.Field) zapcore.Core {
+	return &redactingCore{Core: c.Core.With(c.redact(fields)), redactor: c.redactor}
+}
+
+func (c *redactingCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(entry.Level) {
+		return checked.AddCore(entry, c)
+	}
+	return checked
+}
+
+func (c *redactingCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	entry.Message = c.redactor.replace(entry.Message)
+	return c.Core.Write(entry, c.redact(fields))
+}
+
+func (c *redactingCore) redact(fields []zapcore.Field) []zapcore.Field {
+	redacted := make([]zapcore.Field, len(fields))
+	for i, field := range fields {
+		redacted[i] = field
+		switch field.Type {
+		case zapcore.StringType:
+			redacted[i].String = c.redactor.replace(field.String)
+		case zapcore.ErrorType, zapcore.StringerType:
+			text := fmt.Sprint(field.Interface)
+			if replaced := c.redactor.replace(text); replaced != text {
+				redacted[i] = zap.String(field.Key, replaced)
+			}
+		}
+	}
+	return redacted
+}
@@ -14,11 +14,17 @@ type ZapLoggerProvider struct {
 	logger *zap.SugaredLogger
 }
 
-func NewZapLoggerProvider(cfg *config.Config) (providers.LoggerProvider, error) {
-	level, err := zapcore.ParseLevel(cfg.Logger.Level)
-	if err != nil {
-		level = zapcore.ErrorLevel
-	}
+// NewZapLoggerProvider builds the logger with a level and a set of redacted
+// secrets that follow configuration reloads.
+func NewZapLoggerProvider(cfg *config.Config, reloader *config.Reloader) (providers.LoggerProvider, error) {
+	level := zap.NewAtomicLevelAt(parseLevel(cfg.Logger.Level))
+	redactor := newRedactor(cfg.SecretValues())
+	reloader.Subscribe(func(cfg *config.Config) {
+		level.SetLevel(parseLevel(cfg.Logger.Level))
+	}, "logger.level")
+	reloader.Subscribe(func(cfg *config.Config) {
+		redactor.set(cfg.SecretValues())
+	})
 
 	var zapCfg zap.Config
 	if cfg.App.Env == "development" {
@@ -26,7 +32,7 @@ func NewZapLoggerProvider(cfg *config.Config) (providers.LoggerProvider, error)
 	} else {
 		zapCfg = zap.NewProductionConfig()
 	}
-	zapCfg.Level = zap.NewAtomicLevelAt(level)
+	zapCfg.Level = level
 	zapCfg.InitialFields = map[string]any{
 		"service": cfg.App.ServiceName,
 		"version": cfg.App.Version,
@@ -43,7 +49,7 @@ func NewZapLoggerProvider(cfg *config.Config) (providers.LoggerProvider, error)
 	)
 
 	teeLogger := zap.New(
-		zapcore.NewTee(base.Core(), otelCore),
+		&redactingCore{Core: zapcore.NewTee(base.Core(), otelCore), redactor: redactor},
 		zap.WithCaller(true),
 		zap.AddCallerSkip(1),
 	).With(
@@ -54,6 +60,14 @@ func NewZapLoggerProvider(cfg *config.Config) (providers.LoggerProvider, error)
 	return &ZapLoggerProvider{logger: teeLogger.Sugar()}, nil
 }
 
+func parseLevel(text string) zapcore.Level {
+	level, err := zapcore.ParseLevel(text)
+	if err != nil {
+		return zapcore.ErrorLevel
+	}
+	return level
+}
+
 func (z *ZapLoggerProvider) Info(msg string, fields ...any) {
 	z.logger.Infow(msg, fields...)
 }
@@ -0,0 +1,77 @@
+package infra
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"golang_boilerplate_module/internal/config"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+
+	"go.uber.org/fx"
+)
+
+// registerConfigReload reloads the configuration on SIGHUP and whenever the
+// config file changes, checked every app.config_watch_interval.
+func registerConfigReload(lc fx.Lifecycle, cfg *config.Config, reloader *config.Reloader, logger providers.LoggerProvider) {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	lc.Append(fx.Hook{
+		OnStart: func(_ context.Context) error {
+			go func() {
+				defer close(done)
+				watchConfig(ctx, cfg.App.ConfigWatchInterval, reloader, logger)
+			}()
+			return nil
+		},
+		OnStop: func(_ context.Context) error {
+			cancel()
+			<-done
+			return nil
+		},
+	})
+}
+
+func watchConfig(ctx context.Context, interval time.Duration, reloader *config.Reloader, logger providers.LoggerProvider) {
+	hangup := make(chan os.Signal, 1)
+	signal.Notify(hangup, syscall.SIGHUP)
+	defer signal.Stop(hangup)
+
+	var tick <-chan time.Time
+	if interval > 0 {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hangup:
+			reloadConfig(reloader, logger, "signal")
+		case <-tick:
+			if reloader.Modified() {
+				reloadConfig(reloader, logger, "file")
+			}
+		}
+	}
+}
+
+func reloadConfig(reloader *config.Reloader, logger providers.LoggerProvider, trigger string) {
+	result, err := reloader.Reload()
+	if err != nil {
+		logger.Error("Configuration reload rejected; keeping the running configuration", "trigger", trigger, "error", err)
+		return
+	}
+	if len(result.Changed) == 0 {
+		logger.Info("Configuration reloaded without changes", "trigger", trigger)
+		return
+	}
+	logger.Info("Configuration reloaded", "trigger", trigger, "changed", result.Changed)
+	if len(result.Restart) > 0 {
+		logger.Warn("Configuration changes need a restart to take effect", "settings", result.Restart)
+	}
+}
@@ -0,0 +1,37 @@
+package infra
+
+import (
+	"context"
+
+	"golang_boilerplate_module/internal/shared/infra/persistence"
+
+	"go.uber.org/fx"
+	"gorm.io/gorm"
+)
+
+// registerReplicaMonitor checks the read replicas once before the
+// application starts serving and then while it runs.
+func registerReplicaMonitor(lc fx.Lifecycle, db *gorm.DB) {
+	replicas := persistence.ReplicasOf(db)
+	if replicas == nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	lc.Append(fx.Hook{
+		OnStart: func(startCtx context.Context) error {
+			replicas.CheckAll(startCtx)
+			go func() {
+				defer close(done)
+				replicas.Monitor(ctx)
+			}()
+			return nil
+		},
+		OnStop: func(_ context.Context) error {
+			cancel()
+			<-done
+			return nil
+		},
+	})
+}
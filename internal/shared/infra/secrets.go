@@ -0,0 +1,76 @@
+package infra
+
+import (
+	"context"
+	"time"
+
+	"golang_boilerplate_module/internal/config"
+	"golang_boilerplate_module/internal/config/secrets"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+
+	"go.uber.org/fx"
+)
+
+// registerSecretRenewal keeps the lease of the secrets the configuration was
+// loaded from alive while the application runs.
+func registerSecretRenewal(lc fx.Lifecycle, cfg *config.Config, logger providers.LoggerProvider) {
+	store, ok := cfg.SecretStore().(secrets.Leased)
+	if !ok || store.Lease() == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	lc.Append(fx.Hook{
+		OnStart: func(_ context.Context) error {
+			go func() {
+				defer close(done)
+				renewSecretLease(ctx, store, logger)
+			}()
+			return nil
+		},
+		OnStop: func(_ context.Context) error {
+			cancel()
+			<-done
+			return nil
+		},
+	})
+}
+
+// renewSecretLease renews the lease when two thirds of it have passed and
+// retries failures until it expires.
+func renewSecretLease(ctx context.Context, store secrets.Leased, logger providers.LoggerProvider) {
+	lease := store.Lease()
+	expiry := time.Now().Add(lease)
+	wait := lease * 2 / 3
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(wait):
+		}
+
+		lease, err := store.Renew(ctx)
+		if err == nil && lease == 0 {
+			logger.Error("Secret lease cannot be extended further; restart to load new secrets", "store", store.Name())
+			return
+		}
+		if err == nil {
+			logger.Debug("Secret lease renewed", "store", store.Name(), "lease", lease.String())
+			expiry = time.Now().Add(lease)
+			wait = lease * 2 / 3
+			continue
+		}
+		if ctx.Err() != nil {
+			return
+		}
+
+		remaining := time.Until(expiry)
+		if remaining <= 0 {
+			logger.Error("Secret lease expired; restart to load new secrets", "store", store.Name(), "error", err)
+			return
+		}
+		logger.Warn("Secret lease renewal failed", "store", store.Name(), "error", err, "expiresIn", remaining.String())
+		wait = max(remaining/3, time.Second)
+	}
+}
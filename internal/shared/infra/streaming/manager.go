@@ -0,0 +1,178 @@
+package streaming
+
+import (
+	"context"
+	"sync"
+
+	"golang_boilerplate_module/internal/config"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/fx"
+)
+
+const (
+	TransportSSE       = "sse"
+	TransportWebSocket = "websocket"
+	TransportGRPC      = "grpc"
+)
+
+const (
+	DisconnectClient       = "client"
+	DisconnectSlowConsumer = "slow_consumer"
+	DisconnectShutdown     = "shutdown"
+	DisconnectError        = "error"
+)
+
+var (
+	streamConnections metric.Int64UpDownCounter
+	streamDisconnects metric.Int64Counter
+	streamMessages    metric.Int64Counter
+)
+
+func init() {
+	meter := otel.Meter("streams")
+
+	var err error
+	streamConnections, err = meter.Int64UpDownCounter(
+		"streams.connections",
+		metric.WithDescription("Number of open change stream connections"),
+		metric.WithUnit("{connection}"),
+	)
+	if err != nil {
+		panic("failed to create streamConnections counter: " + err.Error())
+	}
+
+	streamDisconnects, err = meter.Int64Counter(
+		"streams.disconnects",
+		metric.WithDescription("Change stream connections closed, by reason"),
+		metric.WithUnit("{connection}"),
+	)
+	if err != nil {
+		panic("failed to create streamDisconnects counter: " + err.Error())
+	}
+
+	streamMessages, err = meter.Int64Counter(
+		"streams.messages",
+		metric.WithDescription("Messages delivered to change stream connections"),
+		metric.WithUnit("{message}"),
+	)
+	if err != nil {
+		panic("failed to create streamMessages counter: " + err.Error())
+	}
+}
+
+// Manager tracks the open change streams so they can be counted and closed
+// before the servers drain their connections on shutdown.
+type Manager struct {
+	cfg config.StreamsConfig
+
+	mu     sync.Mutex
+	closed bool
+	conns  map[*Conn]struct{}
+}
+
+func NewManager(cfg *config.Config) *Manager {
+	return &Manager{cfg: cfg.Streams, conns: make(map[*Conn]struct{})}
+}
+
+func RegisterShutdown(lc fx.Lifecycle, manager *Manager) {
+	lc.Append(fx.Hook{
+		OnStop: func(_ context.Context) error {
+			manager.Close()
+			return nil
+		},
+	})
+}
+
+func (m *Manager) Config() config.StreamsConfig {
+	return m.cfg
+}
+
+// Open registers a stream. Its context is canceled when the stream is closed
+// or the manager shuts down.
+func (m *Manager) Open(ctx context.Context, name, transport string) (*Conn, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.closed {
+		return nil, exceptions.NewServiceUnavailableException("Server is shutting down", nil)
+	}
+
+	streamCtx, cancel := context.WithCancel(ctx)
+	conn := &Conn{
+		ctx:     streamCtx,
+		parent:  ctx,
+		cancel:  cancel,
+		manager: m,
+		attrs: []attribute.KeyValue{
+			attribute.String("stream.name", name),
+			attribute.String("stream.transport", transport),
+		},
+	}
+	m.conns[conn] = struct{}{}
+	streamConnections.Add(ctx, 1, metric.WithAttributes(conn.attrs...))
+	return conn, nil
+}
+
+func (m *Manager) Close() {
+	m.mu.Lock()
+	m.closed = true
+	conns := make([]*Conn, 0, len(m.conns))
+	for conn := range m.conns {
+		conns = append(conns, conn)
+	}
+	m.mu.Unlock()
+
+	for _, conn := range conns {
+		conn.cancel()
+	}
+}
+
+type Conn struct {
+	ctx     context.Context
+	parent  context.Context
+	cancel  context.CancelFunc
+	manager *Manager
+	attrs   []attribute.KeyValue
+	once    sync.Once
+}
+
+func (c *Conn) Context() context.Context {
+	return c.ctx
+}
+
+// Close ends the stream and records why; later calls are no-ops.
+func (c *Conn) Close(reason string) {
+	c.once.Do(func() {
+		c.cancel()
+
+		c.manager.mu.Lock()
+		delete(c.manager.conns, c)
+		c.manager.mu.Unlock()
+
+		streamConnections.Add(context.Background(), -1, metric.WithAttributes(c.attrs...))
+		streamDisconnects.Add(context.Background(), 1, metric.WithAttributes(
+			append(c.attrs, attribute.String("stream.disconnect.reason", reason))...,
+		))
+	})
+}
+
+// EndReason explains why the event source of the stream stopped: the client
+// or the server went away, or the subscriber fell too far behind.
+func (c *Conn) EndReason() string {
+	switch {
+	case c.parent.Err() != nil:
+		return DisconnectClient
+	case c.ctx.Err() != nil:
+		return DisconnectShutdown
+	default:
+		return DisconnectSlowConsumer
+	}
+}
+
+func (c *Conn) Delivered() {
+	streamMessages.Add(context.Background(), 1, metric.WithAttributes(c.attrs...))
+}
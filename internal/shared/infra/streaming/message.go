@@ -0,0 +1,9 @@
+package streaming
+
+// Message is one event sent to a change stream. ID is what clients send back
+// to resume (Last-Event-ID for SSE, lastEventId for WebSocket).
+type Message struct {
+	ID    string `json:"id"`
+	Event string `json:"event"`
+	Data  any    `json:"data"`
+}
@@ -0,0 +1,78 @@
+package streaming
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	eventStreamContentType = "text/event-stream"
+	sseRetry               = 3 * time.Second
+)
+
+// ServeSSE streams events as Server-Sent Events until the client disconnects,
+// the events channel closes or the manager shuts down. conn is closed when the
+// stream ends.
+func ServeSSE[T any](c *fiber.Ctx, conn *Conn, events <-chan T, encode func(T) Message) error {
+	heartbeat := conn.manager.cfg.HeartbeatInterval
+
+	c.Set(fiber.HeaderContentType, eventStreamContentType)
+	c.Set(fiber.HeaderCacheControl, "no-cache")
+	c.Set(fiber.HeaderConnection, "keep-alive")
+	c.Set("X-Accel-Buffering", "no")
+
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		reason := DisconnectClient
+		defer func() { conn.Close(reason) }()
+
+		fmt.Fprintf(w, "retry: %d\n\n", sseRetry.Milliseconds())
+		if err := w.Flush(); err != nil {
+			return
+		}
+
+		ticker := time.NewTicker(heartbeat)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-conn.Context().Done():
+				reason = conn.EndReason()
+				return
+			case event, ok := <-events:
+				if !ok {
+					reason = conn.EndReason()
+					return
+				}
+				if err := writeEvent(w, encode(event)); err != nil {
+					return
+				}
+				conn.Delivered()
+			case <-ticker.C:
+				w.WriteString(": heartbeat\n\n")
+				if err := w.Flush(); err != nil {
+					return
+				}
+			}
+		}
+	})
+	return nil
+}
+
+func writeEvent(w *bufio.Writer, message Message) error {
+	data, err := json.Marshal(message.Data)
+	if err != nil {
+		return err
+	}
+	if message.ID != "" {
+		fmt.Fprintf(w, "id: %s\n", message.ID)
+	}
+	if message.Event != "" {
+		fmt.Fprintf(w, "event: %s\n", message.Event)
+	}
+	fmt.Fprintf(w, "data: %s\n\n", data)
+	return w.Flush()
+}
@@ -0,0 +1,91 @@
+package streaming
+
+import (
+	"time"
+
+	"github.com/gofiber/contrib/websocket"
+	"github.com/gofiber/fiber/v2"
+)
+
+const maxClientMessageSize = 512
+
+// UpgradeWebSocket upgrades the request and streams events as JSON messages
+// until the client disconnects, the events channel closes or the manager
+// shuts down. conn is closed when the stream ends.
+func UpgradeWebSocket[T any](c *fiber.Ctx, conn *Conn, events <-chan T, encode func(T) Message) error {
+	cfg := conn.manager.cfg
+
+	handler := websocket.New(func(ws *websocket.Conn) {
+		reason := DisconnectClient
+		defer func() { conn.Close(reason) }()
+
+		closed := make(chan struct{})
+		go readUntilClosed(ws, cfg.HeartbeatInterval, closed)
+
+		ticker := time.NewTicker(cfg.HeartbeatInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-closed:
+				return
+			case <-conn.Context().Done():
+				reason = conn.EndReason()
+				closeWebSocket(ws, reason, cfg.WriteTimeout)
+				return
+			case event, ok := <-events:
+				if !ok {
+					reason = conn.EndReason()
+					closeWebSocket(ws, reason, cfg.WriteTimeout)
+					return
+				}
+				ws.SetWriteDeadline(time.Now().Add(cfg.WriteTimeout))
+				if err := ws.WriteJSON(encode(event)); err != nil {
+					select {
+					case <-closed:
+					default:
+						reason = DisconnectSlowConsumer
+					}
+					return
+				}
+				conn.Delivered()
+			case <-ticker.C:
+				if err := ws.WriteControl(websocket.PingMessage, nil, time.Now().Add(cfg.WriteTimeout)); err != nil {
+					return
+				}
+			}
+		}
+	}, websocket.Config{Origins: cfg.AllowedOrigins})
+
+	if err := handler(c); err != nil {
+		conn.Close(DisconnectError)
+		return err
+	}
+	return nil
+}
+
+// readUntilClosed discards client messages and answers pings; it closes
+// closed once the client goes away or misses two heartbeats.
+func readUntilClosed(ws *websocket.Conn, heartbeat time.Duration, closed chan<- struct{}) {
+	defer close(closed)
+
+	ws.SetReadLimit(maxClientMessageSize)
+	ws.SetReadDeadline(time.Now().Add(2 * heartbeat))
+	ws.SetPongHandler(func(string) error {
+		return ws.SetReadDeadline(time.Now().Add(2 * heartbeat))
+	})
+
+	for {
+		if _, _, err := ws.ReadMessage(); err != nil {
+			return
+		}
+	}
+}
+
+func closeWebSocket(ws *websocket.Conn, reason string, timeout time.Duration) {
+	code, text := websocket.CloseGoingAway, "server shutting down"
+	if reason == DisconnectSlowConsumer {
+		code, text = websocket.CloseTryAgainLater, "consumer too slow, resume from the last event"
+	}
+	ws.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, text), time.Now().Add(timeout))
+}
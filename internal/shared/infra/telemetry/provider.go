@@ -22,7 +22,7 @@ import (
 
 type ShutdownFunc func(ctx context.Context) error
 
-func SetupOTel(cfg *config.Config) (ShutdownFunc, error) {
+func SetupOTel(cfg *config.Config, sampler sdktrace.Sampler) (ShutdownFunc, error) {
 	if cfg.Otel.Endpoint == "" {
 		
 		return func(_ context.Context) error { return nil }, nil
@@ -53,7 +53,7 @@ func SetupOTel(cfg *config.Config) (ShutdownFunc, error) {
 		sdktrace.WithResource(res),
 		sdktrace.WithSpanProcessor(SpanEnricher{}),
 		sdktrace.WithSpanProcessor(sdktrace.NewBatchSpanProcessor(traceExporter)),
-		sdktrace.WithSampler(sdktrace.AlwaysSample()),
+		sdktrace.WithSampler(sampler),
 	)
 	otel.SetTracerProvider(tp)
 	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
@@ -0,0 +1,33 @@
+package telemetry
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// RatioSampler samples that share of root traces and follows the parent's
+// decision for the rest. The ratio can change while spans are being started.
+type RatioSampler struct {
+	sampler atomic.Pointer[sdktrace.Sampler]
+}
+
+func NewRatioSampler(ratio float64) *RatioSampler {
+	s := &RatioSampler{}
+	s.SetRatio(ratio)
+	return s
+}
+
+func (s *RatioSampler) SetRatio(ratio float64) {
+	sampler := sdktrace.ParentBased(sdktrace.TraceIDRatioBased(ratio))
+	s.sampler.Store(&sampler)
+}
+
+func (s *RatioSampler) ShouldSample(p sdktrace.SamplingParameters) sdktrace.SamplingResult {
+	return (*s.sampler.Load()).ShouldSample(p)
+}
+
+func (s *RatioSampler) Description() string {
+	return fmt.Sprintf("Reloadable{%s}", (*s.sampler.Load()).Description())
+}
@@ -3,6 +3,8 @@ package telemetry
 import (
 	"context"
 
+	"golang_boilerplate_module/internal/shared/domain/tenancy"
+
 	"go.opentelemetry.io/otel/attribute"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 )
@@ -17,6 +19,9 @@ func (s SpanEnricher) OnStart(parent context.Context, span sdktrace.ReadWriteSpa
 	if reqID, ok := parent.Value(RequestIDContextKey).(string); ok && reqID != "" {
 		span.SetAttributes(attribute.String("http.request.header.x_request_id", reqID))
 	}
+	if tenantID, ok := tenancy.TenantIDFromContext(parent); ok {
+		span.SetAttributes(attribute.String("tenant.id", tenantID))
+	}
 }
 
 func (s SpanEnricher) OnEnd(_ sdktrace.ReadOnlySpan) {}
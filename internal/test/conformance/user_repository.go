@@ -0,0 +1,345 @@
+// Package conformance holds the behaviour every implementation of a
+// repository must share. Each implementation's tests run the same suite.
+package conformance
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+
+	"golang_boilerplate_module/internal/modules/users/usersdomain"
+	"golang_boilerplate_module/internal/modules/users/usersdomain/usersrepo"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/domain/tenancy"
+)
+
+// Tenants the suite writes to. Both must exist where tenants are a foreign
+// key.
+const (
+	Tenant      = "default"
+	OtherTenant = "conformance"
+)
+
+// UserRepository is a user repository that also inserts in bulk.
+type UserRepository interface {
+	usersrepo.UserRepository
+	AddMany(ctx context.Context, users []usersdomain.User) (int64, error)
+}
+
+// RunUserRepository runs the suite. newRepo returns a repository whose users
+// table is empty, and is called once per case.
+func RunUserRepository(t *testing.T, newRepo func(t *testing.T) UserRepository) {
+	ctx := tenancy.WithTenantID(context.Background(), Tenant)
+	other := tenancy.WithTenantID(context.Background(), OtherTenant)
+
+	t.Run("Add assigns the id, tenant and timestamps", func(t *testing.T) {
+		repo := newRepo(t)
+		before := time.Now()
+
+		added, err := repo.Add(ctx, &usersdomain.User{Name: "Ada", Email: "ada@example.com", TenantID: OtherTenant})
+		if err != nil {
+			t.Fatalf("add: %v", err)
+		}
+		if added.ID == 0 || added.TenantID != Tenant {
+			t.Fatalf("expected an id and the tenant of the context, got %+v", added)
+		}
+		if !recent(added.CreatedAt, before) || !recent(added.UpdatedAt, before) {
+			t.Fatalf("expected creation timestamps, got %+v", added)
+		}
+
+		found, err := repo.GetByID(ctx, added.ID)
+		if err != nil {
+			t.Fatalf("get: %v", err)
+		}
+		if !sameUser(*found, *added) {
+			t.Fatalf("expected %+v, got %+v", added, found)
+		}
+
+		second, err := repo.Add(ctx, &usersdomain.User{Name: "Grace", Email: "grace@example.com"})
+		if err != nil {
+			t.Fatalf("add: %v", err)
+		}
+		if second.ID <= added.ID {
+			t.Fatalf("expected increasing ids, got %d after %d", second.ID, added.ID)
+		}
+	})
+
+	t.Run("emails are unique per tenant", func(t *testing.T) {
+		repo := newRepo(t)
+		mustAdd(t, repo, ctx, "Ada", "ada@example.com")
+
+		if _, err := repo.Add(ctx, &usersdomain.User{Name: "Other Ada", Email: "ada@example.com"}); !hasCode(err, exceptions.CodeInternal) {
+			t.Fatalf("expected an internal error for a duplicate email, got %v", err)
+		}
+		if _, err := repo.Add(other, &usersdomain.User{Name: "Ada", Email: "ada@example.com"}); err != nil {
+			t.Fatalf("expected the email to be free in another tenant: %v", err)
+		}
+	})
+
+	t.Run("missing users are not found", func(t *testing.T) {
+		repo := newRepo(t)
+
+		_, err := repo.GetByID(ctx, 4242)
+		if !reflect.DeepEqual(err, exceptions.NewNotFoundException("", nil)) {
+			t.Fatalf("expected not found from GetByID, got %#v", err)
+		}
+		_, err = repo.UpdateByID(ctx, 4242, map[string]any{"name": "Nobody"})
+		if !reflect.DeepEqual(err, exceptions.NewNotFoundException("", nil)) {
+			t.Fatalf("expected not found from UpdateByID, got %#v", err)
+		}
+		_, err = repo.GetByEmail(ctx, "nobody@example.com")
+		if !reflect.DeepEqual(err, exceptions.NewNotFoundException("User not found", nil)) {
+			t.Fatalf("expected not found from GetByEmail, got %#v", err)
+		}
+		if err := repo.DeleteByID(ctx, 4242); err != nil {
+			t.Fatalf("expected deleting a missing user to succeed, got %v", err)
+		}
+	})
+
+	t.Run("GetByEmail finds the user", func(t *testing.T) {
+		repo := newRepo(t)
+		added := mustAdd(t, repo, ctx, "Ada", "ada@example.com")
+		mustAdd(t, repo, ctx, "Grace", "grace@example.com")
+
+		found, err := repo.GetByEmail(ctx, "ada@example.com")
+		if err != nil {
+			t.Fatalf("get by email: %v", err)
+		}
+		if !sameUser(*found, *added) {
+			t.Fatalf("expected %+v, got %+v", added, found)
+		}
+	})
+
+	t.Run("GetByIDs returns the users that exist", func(t *testing.T) {
+		repo := newRepo(t)
+		ada := mustAdd(t, repo, ctx, "Ada", "ada@example.com")
+		mustAdd(t, repo, ctx, "Grace", "grace@example.com")
+		linus := mustAdd(t, repo, ctx, "Linus", "linus@example.com")
+
+		users, err := repo.GetByIDs(ctx, []uint{linus.ID, 4242, ada.ID})
+		if err != nil {
+			t.Fatalf("get by ids: %v", err)
+		}
+		if got := sortedIDs(users); !slices.Equal(got, []uint{ada.ID, linus.ID}) {
+			t.Fatalf("expected users %d and %d, got %v", ada.ID, linus.ID, got)
+		}
+
+		users, err = repo.GetByIDs(ctx, nil)
+		if err != nil || len(users) != 0 {
+			t.Fatalf("expected no users for no ids, got %v, %v", users, err)
+		}
+	})
+
+	t.Run("List pages by id", func(t *testing.T) {
+		repo := newRepo(t)
+		var ids []uint
+		for _, email := range []string{"a@example.com", "b@example.com", "c@example.com"} {
+			ids = append(ids, mustAdd(t, repo, ctx, "User", email).ID)
+		}
+
+		page, err := repo.List(ctx, 0, 2)
+		if err != nil {
+			t.Fatalf("list: %v", err)
+		}
+		if got := userIDs(page); !slices.Equal(got, ids[:2]) {
+			t.Fatalf("expected the first page %v, got %v", ids[:2], got)
+		}
+		page, err = repo.List(ctx, ids[1], 2)
+		if err != nil {
+			t.Fatalf("list: %v", err)
+		}
+		if got := userIDs(page); !slices.Equal(got, ids[2:]) {
+			t.Fatalf("expected the last page %v, got %v", ids[2:], got)
+		}
+	})
+
+	t.Run("UpdateByID changes the fields and the update timestamp", func(t *testing.T) {
+		repo := newRepo(t)
+		added := mustAdd(t, repo, ctx, "Ada", "ada@example.com")
+		before := time.Now()
+
+		updated, err := repo.UpdateByID(ctx, added.ID, map[string]any{"name": "Ada Lovelace", "tenant_id": OtherTenant})
+		if err != nil {
+			t.Fatalf("update: %v", err)
+		}
+		if updated.ID != added.ID || updated.Name != "Ada Lovelace" || updated.Email != added.Email || updated.TenantID != Tenant {
+			t.Fatalf("unexpected update %+v", updated)
+		}
+		if !recent(updated.UpdatedAt, before) || !sameTime(updated.CreatedAt, added.CreatedAt) {
+			t.Fatalf("expected only the update timestamp to change, got %+v after %+v", updated, added)
+		}
+
+		found, err := repo.GetByID(ctx, added.ID)
+		if err != nil {
+			t.Fatalf("get: %v", err)
+		}
+		if !sameUser(*found, *updated) {
+			t.Fatalf("expected %+v, got %+v", updated, found)
+		}
+	})
+
+	t.Run("DeleteByID removes the user", func(t *testing.T) {
+		repo := newRepo(t)
+		ada := mustAdd(t, repo, ctx, "Ada", "ada@example.com")
+		grace := mustAdd(t, repo, ctx, "Grace", "grace@example.com")
+
+		if err := repo.DeleteByID(ctx, ada.ID); err != nil {
+			t.Fatalf("delete: %v", err)
+		}
+		if _, err := repo.GetByID(ctx, ada.ID); !hasCode(err, exceptions.CodeNotFound) {
+			t.Fatalf("expected the deleted user to be gone, got %v", err)
+		}
+		if _, err := repo.GetByID(ctx, grace.ID); err != nil {
+			t.Fatalf("expected the other user to remain: %v", err)
+		}
+	})
+
+	t.Run("AddMany inserts every user", func(t *testing.T) {
+		repo := newRepo(t)
+		before := time.Now()
+
+		inserted, err := repo.AddMany(ctx, []usersdomain.User{
+			{Name: "Ada", Email: "ada@example.com"},
+			{Name: "Grace", Email: "grace@example.com"},
+			{Name: "Linus", Email: "linus@example.com"},
+		})
+		if err != nil {
+			t.Fatalf("add many: %v", err)
+		}
+		if inserted != 3 {
+			t.Fatalf("expected 3 users inserted, got %d", inserted)
+		}
+
+		users, err := repo.List(ctx, 0, 10)
+		if err != nil {
+			t.Fatalf("list: %v", err)
+		}
+		if len(users) != 3 {
+			t.Fatalf("expected 3 users, got %+v", users)
+		}
+		for _, user := range users {
+			if user.ID == 0 || user.TenantID != Tenant || !recent(user.CreatedAt, before) || !recent(user.UpdatedAt, before) {
+				t.Fatalf("expected an id, the tenant and timestamps, got %+v", user)
+			}
+		}
+
+		if _, err := repo.AddMany(ctx, []usersdomain.User{{Name: "Ada", Email: "ada@example.com"}}); err == nil {
+			t.Fatal("expected a duplicate email to fail the batch")
+		}
+	})
+
+	t.Run("tenants only see their own users", func(t *testing.T) {
+		repo := newRepo(t)
+		ada := mustAdd(t, repo, ctx, "Ada", "ada@example.com")
+		grace := mustAdd(t, repo, other, "Grace", "grace@example.com")
+
+		if _, err := repo.GetByID(other, ada.ID); !hasCode(err, exceptions.CodeNotFound) {
+			t.Fatalf("expected another tenant's user to be hidden, got %v", err)
+		}
+		if _, err := repo.GetByEmail(other, "ada@example.com"); !hasCode(err, exceptions.CodeNotFound) {
+			t.Fatalf("expected another tenant's email to be hidden, got %v", err)
+		}
+		if _, err := repo.UpdateByID(other, ada.ID, map[string]any{"name": "Mallory"}); !hasCode(err, exceptions.CodeNotFound) {
+			t.Fatalf("expected another tenant's user not to be updated, got %v", err)
+		}
+		users, err := repo.GetByIDs(other, []uint{ada.ID, grace.ID})
+		if err != nil {
+			t.Fatalf("get by ids: %v", err)
+		}
+		if got := userIDs(users); !slices.Equal(got, []uint{grace.ID}) {
+			t.Fatalf("expected only the tenant's user, got %v", got)
+		}
+		page, err := repo.List(other, 0, 10)
+		if err != nil {
+			t.Fatalf("list: %v", err)
+		}
+		if got := userIDs(page); !slices.Equal(got, []uint{grace.ID}) {
+			t.Fatalf("expected only the tenant's user, got %v", got)
+		}
+
+		if err := repo.DeleteByID(other, ada.ID); err != nil {
+			t.Fatalf("delete: %v", err)
+		}
+		if err := repo.DeleteAll(other); err != nil {
+			t.Fatalf("delete all: %v", err)
+		}
+		if _, err := repo.GetByID(ctx, ada.ID); err != nil {
+			t.Fatalf("expected another tenant's deletes to leave the user: %v", err)
+		}
+		if _, err := repo.GetByID(other, grace.ID); !hasCode(err, exceptions.CodeNotFound) {
+			t.Fatalf("expected DeleteAll to remove the tenant's users, got %v", err)
+		}
+
+		system := tenancy.WithSystemScope(context.Background())
+		page, err = repo.List(system, 0, 10)
+		if err != nil {
+			t.Fatalf("list in system scope: %v", err)
+		}
+		if got := userIDs(page); !slices.Equal(got, []uint{ada.ID}) {
+			t.Fatalf("expected the system scope to see every tenant, got %v", got)
+		}
+	})
+
+	t.Run("calls without a tenant are refused", func(t *testing.T) {
+		repo := newRepo(t)
+		background := context.Background()
+
+		if _, err := repo.Add(background, &usersdomain.User{Name: "Ada", Email: "ada@example.com"}); !hasCode(err, exceptions.CodeBadRequest) {
+			t.Fatalf("expected Add without a tenant to be refused, got %v", err)
+		}
+		if _, err := repo.GetByID(background, 1); !hasCode(err, exceptions.CodeBadRequest) {
+			t.Fatalf("expected GetByID without a tenant to be refused, got %v", err)
+		}
+		if _, err := repo.List(background, 0, 10); !hasCode(err, exceptions.CodeBadRequest) {
+			t.Fatalf("expected List without a tenant to be refused, got %v", err)
+		}
+		if err := repo.DeleteAll(background); !hasCode(err, exceptions.CodeBadRequest) {
+			t.Fatalf("expected DeleteAll without a tenant to be refused, got %v", err)
+		}
+	})
+}
+
+func mustAdd(t *testing.T, repo UserRepository, ctx context.Context, name, email string) *usersdomain.User {
+	t.Helper()
+	user, err := repo.Add(ctx, &usersdomain.User{Name: name, Email: email})
+	if err != nil {
+		t.Fatalf("add %s: %v", email, err)
+	}
+	return user
+}
+
+func hasCode(err error, code exceptions.ExceptionCode) bool {
+	var domainErr *exceptions.DomainError
+	return errors.As(err, &domainErr) && domainErr.Code == code
+}
+
+// recent allows for the database keeping microseconds.
+func recent(at, since time.Time) bool {
+	return !at.Before(since.Add(-time.Millisecond)) && !at.After(time.Now().Add(time.Millisecond))
+}
+
+func sameTime(a, b time.Time) bool {
+	return a.Sub(b).Abs() < time.Millisecond
+}
+
+func sameUser(a, b usersdomain.User) bool {
+	return a.ID == b.ID && a.TenantID == b.TenantID && a.Name == b.Name && a.Email == b.Email &&
+		sameTime(a.CreatedAt, b.CreatedAt) && sameTime(a.UpdatedAt, b.UpdatedAt)
+}
+
+func userIDs(users []usersdomain.User) []uint {
+	ids := make([]uint, 0, len(users))
+	for _, user := range users {
+		ids = append(ids, user.ID)
+	}
+	return ids
+}
+
+func sortedIDs(users []usersdomain.User) []uint {
+	ids := userIDs(users)
+	slices.Sort(ids)
+	return ids
+}
@@ -0,0 +1,323 @@
+// Package inmemory holds repositories that keep their rows in memory, for
+// unit tests and demos that should not need a database. They behave like the
+// GORM repositories: the same ids, tenants, timestamps, unique indexes and
+// errors, checked by the conformance suite.
+package inmemory
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"sync"
+	"time"
+
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/domain/tenancy"
+	"golang_boilerplate_module/internal/shared/infra/persistence/repositories"
+
+	"gorm.io/gorm/schema"
+)
+
+type uniqueIndex struct {
+	name   string
+	fields []*schema.Field
+}
+
+// GenericRepository implements the generic repository on a slice. The
+// primary key, tenant, timestamps and unique indexes come from the GORM
+// schema of T, so the model needs no changes.
+type GenericRepository[T any, ID comparable] struct {
+	schema     *schema.Schema
+	primaryKey *schema.Field
+	tenant     *schema.Field
+	unique     []uniqueIndex
+
+	mu     sync.RWMutex
+	rows   []T
+	lastID int64
+}
+
+func NewGenericRepository[T any, ID comparable]() *GenericRepository[T, ID] {
+	var zero T
+	s, err := schema.Parse(&zero, &sync.Map{}, schema.NamingStrategy{})
+	if err != nil {
+		panic(fmt.Sprintf("failed to parse the schema of %T: %v", zero, err))
+	}
+	if s.PrioritizedPrimaryField == nil {
+		panic(fmt.Sprintf("%T has no primary key", zero))
+	}
+
+	r := &GenericRepository[T, ID]{
+		schema:     s,
+		primaryKey: s.PrioritizedPrimaryField,
+		unique:     []uniqueIndex{{name: s.Table + "_pkey", fields: []*schema.Field{s.PrioritizedPrimaryField}}},
+	}
+	if field := s.LookUpField("TenantID"); field != nil && field.DBName != "" {
+		r.tenant = field
+	}
+	for _, field := range s.Fields {
+		if field.Unique {
+			r.unique = append(r.unique, uniqueIndex{name: "uni_" + s.Table + "_" + field.DBName, fields: []*schema.Field{field}})
+		}
+	}
+	for _, index := range s.ParseIndexes() {
+		if index.Class != "UNIQUE" {
+			continue
+		}
+		fields := make([]*schema.Field, 0, len(index.Fields))
+		for _, option := range index.Fields {
+			fields = append(fields, option.Field)
+		}
+		r.unique = append(r.unique, uniqueIndex{name: index.Name, fields: fields})
+	}
+	return r
+}
+
+func (r *GenericRepository[T, ID]) Add(ctx context.Context, entity *T) (*T, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.insert(ctx, []*T{entity}); err != nil {
+		return nil, repositories.DatabaseError(err)
+	}
+	return entity, nil
+}
+
+// AddMany inserts every entity or, if one of them fails, none.
+func (r *GenericRepository[T, ID]) AddMany(ctx context.Context, entities []T) (int64, error) {
+	if len(entities) == 0 {
+		return 0, nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	pointers := make([]*T, len(entities))
+	for i := range entities {
+		pointers[i] = &entities[i]
+	}
+	if err := r.insert(ctx, pointers); err != nil {
+		return 0, repositories.DatabaseError(err)
+	}
+	return int64(len(entities)), nil
+}
+
+func (r *GenericRepository[T, ID]) GetByID(ctx context.Context, id ID) (*T, error) {
+	entities, err := r.Select(ctx, func(entity T) bool { return r.idOf(ctx, entity) == id })
+	if err != nil {
+		return nil, repositories.DatabaseError(err)
+	}
+	if len(entities) == 0 {
+		return nil, exceptions.NewNotFoundException("", nil)
+	}
+	return &entities[0], nil
+}
+
+func (r *GenericRepository[T, ID]) GetByIDs(ctx context.Context, ids []ID) ([]T, error) {
+	var entities []T
+	if len(ids) == 0 {
+		return entities, nil
+	}
+
+	entities, err := r.Select(ctx, func(entity T) bool { return slices.Contains(ids, r.idOf(ctx, entity)) })
+	if err != nil {
+		return nil, repositories.DatabaseError(err)
+	}
+	return entities, nil
+}
+
+// UpdateByID takes columns or field names as keys, like GORM's Updates, and
+// refreshes the update timestamps.
+func (r *GenericRepository[T, ID]) UpdateByID(ctx context.Context, id ID, updates map[string]any) (*T, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	tenantID, scoped, err := r.scope(ctx)
+	if err != nil {
+		return nil, repositories.DatabaseError(err)
+	}
+	i := slices.IndexFunc(r.rows, func(entity T) bool {
+		return r.idOf(ctx, entity) == id && r.visible(ctx, entity, tenantID, scoped)
+	})
+	if i < 0 {
+		return nil, exceptions.NewNotFoundException("", nil)
+	}
+
+	entity := r.rows[i]
+	if err := r.assign(ctx, &entity, updates, scoped); err != nil {
+		return nil, repositories.DatabaseError(err)
+	}
+	if err := r.checkUnique(ctx, entity, r.rows, i); err != nil {
+		return nil, repositories.DatabaseError(err)
+	}
+	r.rows[i] = entity
+	return &entity, nil
+}
+
+// DeleteByID succeeds when there is nothing to delete, as a DELETE does.
+func (r *GenericRepository[T, ID]) DeleteByID(ctx context.Context, id ID) error {
+	return r.delete(ctx, func(entity T) bool { return r.idOf(ctx, entity) == id })
+}
+
+func (r *GenericRepository[T, ID]) DeleteAll(ctx context.Context) error {
+	return r.delete(ctx, func(T) bool { return true })
+}
+
+// Select returns copies of the entities of the tenant of ctx that match, in
+// the order they were added. Errors are returned as they are, for the caller
+// to map.
+func (r *GenericRepository[T, ID]) Select(ctx context.Context, match func(entity T) bool) ([]T, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	tenantID, scoped, err := r.scope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var entities []T
+	for _, entity := range r.rows {
+		if r.visible(ctx, entity, tenantID, scoped) && match(entity) {
+			entities = append(entities, entity)
+		}
+	}
+	return entities, nil
+}
+
+func (r *GenericRepository[T, ID]) delete(ctx context.Context, match func(entity T) bool) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	tenantID, scoped, err := r.scope(ctx)
+	if err != nil {
+		return repositories.DatabaseError(err)
+	}
+	r.rows = slices.DeleteFunc(r.rows, func(entity T) bool {
+		return r.visible(ctx, entity, tenantID, scoped) && match(entity)
+	})
+	return nil
+}
+
+// insert assigns the tenant, timestamps and ids, as GORM's callbacks and the
+// database do, and stores the entities if no unique index is violated. Ids
+// handed out to a failed insert are not reused, like a sequence's.
+func (r *GenericRepository[T, ID]) insert(ctx context.Context, entities []*T) error {
+	tenantID, scoped, err := r.scope(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	rows := slices.Clone(r.rows)
+	for _, entity := range entities {
+		value := reflect.ValueOf(entity).Elem()
+		if scoped {
+			if err := r.tenant.Set(ctx, value, tenantID); err != nil {
+				return err
+			}
+		}
+		for _, field := range r.schema.Fields {
+			if field.AutoCreateTime == 0 && field.AutoUpdateTime == 0 {
+				continue
+			}
+			if _, isZero := field.ValueOf(ctx, value); isZero {
+				if err := field.Set(ctx, value, now); err != nil {
+					return err
+				}
+			}
+		}
+		if _, isZero := r.primaryKey.ValueOf(ctx, value); isZero && r.primaryKey.HasDefaultValue {
+			r.lastID++
+			if err := r.primaryKey.Set(ctx, value, r.lastID); err != nil {
+				return err
+			}
+		}
+
+		if err := r.checkUnique(ctx, *entity, rows, -1); err != nil {
+			return err
+		}
+		rows = append(rows, *entity)
+	}
+	r.rows = rows
+	return nil
+}
+
+func (r *GenericRepository[T, ID]) assign(ctx context.Context, entity *T, updates map[string]any, scoped bool) error {
+	value := reflect.ValueOf(entity).Elem()
+	assigned := make(map[*schema.Field]bool, len(updates))
+	for key, update := range updates {
+		field := r.schema.LookUpField(key)
+		if field == nil || field.DBName == "" {
+			return fmt.Errorf("column %q of relation %q does not exist", key, r.schema.Table)
+		}
+		assigned[field] = true
+		if scoped && field == r.tenant {
+			continue
+		}
+		if err := field.Set(ctx, value, update); err != nil {
+			return err
+		}
+	}
+
+	now := time.Now()
+	for _, field := range r.schema.Fields {
+		if field.AutoUpdateTime > 0 && field.DBName != "" && !assigned[field] {
+			if err := field.Set(ctx, value, now); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// checkUnique reports the first unique index entity shares with one of rows
+// other than the one at skip, with the error PostgreSQL would give.
+func (r *GenericRepository[T, ID]) checkUnique(ctx context.Context, entity T, rows []T, skip int) error {
+	value := reflect.ValueOf(&entity).Elem()
+	for _, index := range r.unique {
+		for i := range rows {
+			if i != skip && r.sameKey(ctx, index, value, reflect.ValueOf(&rows[i]).Elem()) {
+				return fmt.Errorf("duplicate key value violates unique constraint %q", index.name)
+			}
+		}
+	}
+	return nil
+}
+
+func (r *GenericRepository[T, ID]) sameKey(ctx context.Context, index uniqueIndex, a, b reflect.Value) bool {
+	for _, field := range index.fields {
+		valueA, _ := field.ValueOf(ctx, a)
+		valueB, _ := field.ValueOf(ctx, b)
+		if !reflect.DeepEqual(valueA, valueB) {
+			return false
+		}
+	}
+	return true
+}
+
+// scope tells which tenant ctx reads and writes, as the tenancy plugin does:
+// none in the system scope, and an error without a tenant.
+func (r *GenericRepository[T, ID]) scope(ctx context.Context) (string, bool, error) {
+	if r.tenant == nil || tenancy.IsSystemScope(ctx) {
+		return "", false, nil
+	}
+	tenantID, ok := tenancy.TenantIDFromContext(ctx)
+	if !ok {
+		return "", false, tenancy.ErrTenantRequired
+	}
+	return tenantID, true, nil
+}
+
+func (r *GenericRepository[T, ID]) visible(ctx context.Context, entity T, tenantID string, scoped bool) bool {
+	if !scoped {
+		return true
+	}
+	value, _ := r.tenant.ValueOf(ctx, reflect.ValueOf(&entity).Elem())
+	return value == tenantID
+}
+
+func (r *GenericRepository[T, ID]) idOf(ctx context.Context, entity T) ID {
+	value, _ := r.primaryKey.ValueOf(ctx, reflect.ValueOf(&entity).Elem())
+	id, _ := value.(ID)
+	return id
+}
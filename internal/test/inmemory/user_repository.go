@@ -0,0 +1,43 @@
+package inmemory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"golang_boilerplate_module/internal/modules/users/usersdomain"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/infra/persistence/repositories"
+)
+
+type UserRepository struct {
+	*GenericRepository[usersdomain.User, uint]
+}
+
+func NewUserRepository() *UserRepository {
+	return &UserRepository{GenericRepository: NewGenericRepository[usersdomain.User, uint]()}
+}
+
+func (r *UserRepository) GetByEmail(ctx context.Context, email string) (*usersdomain.User, error) {
+	users, err := r.Select(ctx, func(user usersdomain.User) bool { return user.Email == email })
+	if err != nil {
+		return nil, repositories.DatabaseError(err)
+	}
+	if len(users) == 0 {
+		return nil, exceptions.NewNotFoundException("User not found", nil)
+	}
+	return &users[0], nil
+}
+
+func (r *UserRepository) List(ctx context.Context, afterID uint, limit int) ([]usersdomain.User, error) {
+	users, err := r.Select(ctx, func(user usersdomain.User) bool { return user.ID > afterID })
+	if err != nil {
+		return nil, repositories.DatabaseError(err)
+	}
+
+	slices.SortFunc(users, func(a, b usersdomain.User) int { return cmp.Compare(a.ID, b.ID) })
+	if limit >= 0 && len(users) > limit {
+		users = users[:limit]
+	}
+	return users, nil
+}
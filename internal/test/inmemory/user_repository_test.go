@@ -0,0 +1,49 @@
+package inmemory_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"golang_boilerplate_module/internal/modules/users/usersdomain"
+	"golang_boilerplate_module/internal/shared/domain/tenancy"
+	"golang_boilerplate_module/internal/test/conformance"
+	"golang_boilerplate_module/internal/test/inmemory"
+)
+
+func TestUserRepository_Conformance(t *testing.T) {
+	conformance.RunUserRepository(t, func(t *testing.T) conformance.UserRepository {
+		return inmemory.NewUserRepository()
+	})
+}
+
+func TestUserRepository_ConcurrentAdds(t *testing.T) {
+	repo := inmemory.NewUserRepository()
+	ctx := tenancy.WithTenantID(context.Background(), conformance.Tenant)
+
+	const writers = 50
+	var wg sync.WaitGroup
+	for i := range writers {
+		wg.Go(func() {
+			email := fmt.Sprintf("user-%d@example.com", i)
+			if _, err := repo.Add(ctx, &usersdomain.User{Name: "User", Email: email}); err != nil {
+				t.Errorf("add %s: %v", email, err)
+			}
+		})
+	}
+	wg.Wait()
+
+	users, err := repo.List(ctx, 0, writers+1)
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if len(users) != writers {
+		t.Fatalf("expected %d users, got %d", writers, len(users))
+	}
+	for i, user := range users {
+		if user.ID != uint(i+1) {
+			t.Fatalf("expected ids 1 to %d without gaps, got %d at %d", writers, user.ID, i)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package integration
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"golang_boilerplate_module/internal/bootstrap"
+	"golang_boilerplate_module/internal/config"
+)
+
+func TestConfigReload_AppliesRateLimitWithoutRestart(t *testing.T) {
+	t.Setenv("HTTP_RATE_LIMIT", "2")
+	result, err := reloader.Reload()
+	if err != nil {
+		t.Fatalf("reload: %v", err)
+	}
+	t.Cleanup(func() {
+		os.Unsetenv("HTTP_RATE_LIMIT")
+		if _, err := reloader.Reload(); err != nil {
+			t.Errorf("restore: %v", err)
+		}
+	})
+	if !slices.Contains(result.Changed, "http.rate_limit") || len(result.Restart) != 0 {
+		t.Fatalf("expected http.rate_limit to change without a restart, got %+v", result)
+	}
+
+	var statuses []int
+	for range 3 {
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/users", nil)
+		req.Header.Set(testUserIDHeader, "1")
+		resp, err := request(req)
+		if err != nil {
+			t.Fatalf("request: %v", err)
+		}
+		resp.Body.Close()
+		statuses = append(statuses, resp.StatusCode)
+	}
+	if statuses[2] != http.StatusTooManyRequests {
+		t.Fatalf("expected the third request to be limited, got %v", statuses)
+	}
+}
+
+func TestConfigReload_RejectsInvalidFileAndFlagsRestartSettings(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	write := func(content string) {
+		t.Helper()
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatalf("write config: %v", err)
+		}
+	}
+	t.Setenv("LOG_LEVEL", "")
+	t.Setenv("PORT", "")
+	write("logger:\n  level: error\n")
+
+	cfg, err := bootstrap.Config(config.WithFile(path))
+	if err != nil {
+		t.Fatalf("config: %v", err)
+	}
+	r := config.NewReloader(cfg)
+	var levels []string
+	r.Subscribe(func(cfg *config.Config) { levels = append(levels, cfg.Logger.Level) }, "logger.level")
+
+	write("logger:\n  level: verbose\n")
+	if _, err := r.Reload(); err == nil {
+		t.Fatal("expected an invalid log level to be rejected")
+	}
+	if r.Current() != cfg || len(levels) != 0 {
+		t.Fatal("expected a rejected reload to keep the running configuration")
+	}
+
+	write("logger:\n  level: debug\napp:\n  port: 4000\n")
+	result, err := r.Reload()
+	if err != nil {
+		t.Fatalf("reload: %v", err)
+	}
+	if !slices.Equal(levels, []string{"debug"}) {
+		t.Fatalf("expected the subscriber to see debug, got %v", levels)
+	}
+	if !slices.Equal(result.Restart, []string{"app.port"}) {
+		t.Fatalf("expected app.port to need a restart, got %v", result.Restart)
+	}
+	if cfg.Logger.Level != "error" || r.Current().Logger.Level != "debug" {
+		t.Fatal("expected the startup configuration to stay untouched")
+	}
+}
@@ -0,0 +1,72 @@
+package integration
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"golang_boilerplate_module/internal/shared/infra/http/openapi"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const contractDriftPath = "/test/contract-drift"
+
+type contractDriftOutput struct {
+	ID   uint   `json:"id" validate:"required"`
+	Name string `json:"name" validate:"required"`
+}
+
+func registerContractDriftRoute(router *openapi.Router) {
+	router.Get(contractDriftPath, func(c *fiber.Ctx) error {
+		return c.JSON(fiber.Map{"id": "not-a-number"})
+	}, openapi.Returns[contractDriftOutput](fiber.StatusOK))
+}
+
+func TestContract_RejectsRequestOutsideTheContract(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodPost, "/api/v1/users",
+		bytes.NewBufferString(`{"name":"Ana","email":"not-an-email"}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := request(req)
+	if err != nil {
+		t.Fatalf("request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d", resp.StatusCode)
+	}
+
+	var body map[string]any
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if message, _ := body["message"].(string); !strings.Contains(message, "body.email") {
+		t.Fatalf("expected the violation to name body.email, got %q", message)
+	}
+}
+
+func TestContract_ReportsResponseDrift(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodGet, contractDriftPath, nil)
+	resp, err := fiberApp.Test(req, 10_000)
+	if err != nil {
+		t.Fatalf("request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected the drifting response to be delivered with 200, got %d", resp.StatusCode)
+	}
+
+	var contractErr *openapi.ContractError
+	if err := contract.CheckResponse(req, resp); !errors.As(err, &contractErr) {
+		t.Fatalf("expected a contract error, got %v", err)
+	}
+	if len(contractErr.Violations) != 2 {
+		t.Fatalf("expected the wrong id type and the missing name, got %v", contractErr.Violations)
+	}
+}
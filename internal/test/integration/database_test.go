@@ -0,0 +1,80 @@
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"golang_boilerplate_module/internal/shared/infra/persistence"
+)
+
+const testStatementTimeout = "15s"
+
+func TestDatabase_ConnectionsStartWithSessionSettings(t *testing.T) {
+	requirePostgres(t)
+	var statementTimeout, applicationName string
+	if err := appDB.Raw("SHOW statement_timeout").Scan(&statementTimeout).Error; err != nil {
+		t.Fatalf("show statement_timeout: %v", err)
+	}
+	if statementTimeout != testStatementTimeout {
+		t.Fatalf("expected statement_timeout %s, got %s", testStatementTimeout, statementTimeout)
+	}
+	if err := appDB.Raw("SHOW application_name").Scan(&applicationName).Error; err != nil {
+		t.Fatalf("show application_name: %v", err)
+	}
+	if applicationName != "boilerplate-api-test" {
+		t.Fatalf("expected the service name as application_name, got %q", applicationName)
+	}
+}
+
+func TestDatabase_ReadsGoToReplicasUntilTheRequestWrites(t *testing.T) {
+	requirePostgres(t)
+	ctx := persistence.TrackWrites(context.Background())
+	if persistence.ReadConn(ctx, appDB) == appDB {
+		t.Fatal("expected reads to go to the replica")
+	}
+
+	if err := appDB.WithContext(ctx).Exec("SELECT 1").Error; err != nil {
+		t.Fatalf("select: %v", err)
+	}
+	if persistence.ReadConn(ctx, appDB) == appDB {
+		t.Fatal("expected a raw SELECT not to count as a write")
+	}
+
+	if err := appDB.WithContext(ctx).Exec("UPDATE feature_flags SET enabled = enabled WHERE FALSE").Error; err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	if persistence.ReadConn(ctx, appDB) != appDB {
+		t.Fatal("expected reads after a write to go to the primary")
+	}
+	if persistence.ReadConn(context.Background(), appDB) == appDB {
+		t.Fatal("expected other requests to keep reading from the replica")
+	}
+}
+
+func TestReadyz_ReportsReplicaLag(t *testing.T) {
+	requirePostgres(t)
+	req, _ := http.NewRequest(http.MethodGet, "/readyz", nil)
+	resp, err := request(req)
+	if err != nil {
+		t.Fatalf("request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	var body struct {
+		Components map[string]struct {
+			Status  string                    `json:"status"`
+			Details map[string]map[string]any `json:"details"`
+		} `json:"components"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+
+	replicas := body.Components["database_replicas"]
+	replica, ok := replicas.Details["replica-1"]
+	if replicas.Status != "healthy" || !ok || replica["status"] != "healthy" || replica["lag_seconds"] != 0.0 {
+		t.Fatalf("unexpected replica health %+v", replicas)
+	}
+}
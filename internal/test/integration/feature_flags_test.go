@@ -0,0 +1,187 @@
+package integration
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"golang_boilerplate_module/internal/modules/featureflags/application/featureflagsusecases"
+	"golang_boilerplate_module/internal/modules/featureflags/infra/featureflagspersistence"
+	"golang_boilerplate_module/internal/shared/domain/providers"
+)
+
+const testFlagAdminID = "1"
+
+func truncateFeatureFlags(t *testing.T) {
+	t.Helper()
+	truncate(t, "feature_flags")
+}
+
+func flagRequest(t *testing.T, method, path, userID string, body any) *http.Response {
+	t.Helper()
+	var payload bytes.Buffer
+	if body != nil {
+		_ = json.NewEncoder(&payload).Encode(body)
+	}
+	req, _ := http.NewRequest(method, "/api/v1/feature-flags"+path, &payload)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(testUserIDHeader, userID)
+	resp, err := request(req)
+	if err != nil {
+		t.Fatalf("%s %s: %v", method, path, err)
+	}
+	return resp
+}
+
+// eventually polls until cond holds, for changes that reach the cache
+// through NOTIFY.
+func eventually(t *testing.T, message string, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal(message)
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+}
+
+func TestFeatureFlags_AdminsManageFlags(t *testing.T) {
+	truncateFeatureFlags(t)
+
+	definition := map[string]any{
+		"enabled": true,
+		"rules": []map[string]any{{
+			"conditions": []map[string]any{{"attribute": "tenant_id", "operator": "in", "values": []string{"acme"}}},
+			"serve":      map[string]any{"variant": "on"},
+		}},
+		"default": map[string]any{"variant": "off"},
+	}
+
+	resp := flagRequest(t, http.MethodPut, "/new-checkout", "2", definition)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusForbidden {
+		t.Fatalf("expected 403 for a non-administrator, got %d", resp.StatusCode)
+	}
+
+	resp = flagRequest(t, http.MethodPut, "/new-checkout", testFlagAdminID, definition)
+	var saved featureflagsusecases.FlagOutput
+	_ = json.NewDecoder(resp.Body).Decode(&saved)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+	if saved.Kind != "boolean" || len(saved.Variants) != 2 || saved.UpdatedBy == nil || *saved.UpdatedBy != 1 {
+		t.Fatalf("unexpected flag %+v", saved)
+	}
+
+	ctx := context.Background()
+	acme := providers.FlagSubject{UserID: "10", TenantID: "acme"}
+	if !featureFlags.Bool(ctx, "new-checkout", acme, false) {
+		t.Fatal("expected the tenant rule to switch the flag on for acme")
+	}
+	if featureFlags.Bool(ctx, "new-checkout", providers.FlagSubject{UserID: "10", TenantID: "globex"}, true) {
+		t.Fatal("expected the default to switch the flag off for other tenants")
+	}
+
+	resp = flagRequest(t, http.MethodPatch, "/new-checkout", testFlagAdminID, map[string]any{"enabled": false})
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+	if featureFlags.Bool(ctx, "new-checkout", acme, true) {
+		t.Fatal("expected the disabled flag to be off")
+	}
+
+	resp = flagRequest(t, http.MethodGet, "/evaluations", "2", nil)
+	var evaluations []featureflagsusecases.EvaluationOutput
+	_ = json.NewDecoder(resp.Body).Decode(&evaluations)
+	resp.Body.Close()
+	if len(evaluations) != 1 || evaluations[0].Variant != "off" || evaluations[0].Reason != "disabled" {
+		t.Fatalf("unexpected evaluations %+v", evaluations)
+	}
+
+	resp = flagRequest(t, http.MethodDelete, "/new-checkout", testFlagAdminID, nil)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d", resp.StatusCode)
+	}
+	resp = flagRequest(t, http.MethodGet, "/new-checkout", testFlagAdminID, nil)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected 404 after delete, got %d", resp.StatusCode)
+	}
+}
+
+func TestFeatureFlags_ChangesFromOtherReplicasReachTheCache(t *testing.T) {
+	requirePostgres(t)
+	truncateFeatureFlags(t)
+
+	resp := flagRequest(t, http.MethodPut, "/beta", testFlagAdminID, map[string]any{"enabled": false})
+	resp.Body.Close()
+
+	ctx := context.Background()
+	subject := providers.FlagSubject{UserID: "3"}
+	if featureFlags.Bool(ctx, "beta", subject, true) {
+		t.Fatal("expected the flag to start off")
+	}
+
+	// Another replica writes to the shared table; only NOTIFY tells this one.
+	db := openTestDB(t)
+	if _, err := db.Exec("UPDATE feature_flags SET enabled = TRUE WHERE key = 'beta'"); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+
+	eventually(t, "expected the change to reach the cache", func() bool {
+		return featureFlags.Bool(ctx, "beta", subject, false)
+	})
+}
+
+func TestFeatureFlags_FileStoreIsReadOnlyAndWatched(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "flags.yaml")
+	write := func(content string) {
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatalf("write flags: %v", err)
+		}
+	}
+	write("flags:\n  - key: theme\n    kind: multivariate\n    enabled: true\n    variants: [light, dark]\n    default:\n      rollout:\n        - {variant: light, weight: 50}\n        - {variant: dark, weight: 50}\n")
+
+	store := featureflagspersistence.NewFileFlagStore(path, 10*time.Millisecond)
+	flags, err := store.List(t.Context())
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if len(flags) != 1 || flags[0].OffVariant != "light" || len(flags[0].Default.Rollout) != 2 {
+		t.Fatalf("unexpected flags %+v", flags)
+	}
+	if _, err := store.Save(t.Context(), &flags[0]); err == nil {
+		t.Fatal("expected the file store to reject changes")
+	}
+
+	changed := make(chan struct{}, 1)
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	go store.Watch(ctx, func(string) {
+		select {
+		case changed <- struct{}{}:
+		default:
+		}
+	})
+	time.Sleep(50 * time.Millisecond)
+	write("flags:\n  - key: theme\n    kind: multivariate\n    variants: [light, dark, neon]\n")
+	select {
+	case <-changed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the change to the file to be reported")
+	}
+
+	write("flags:\n  - key: theme\n    kind: multivariate\n    variants: [light]\n")
+	if _, err := store.List(t.Context()); err == nil {
+		t.Fatal("expected an invalid flag in the file to be rejected")
+	}
+}
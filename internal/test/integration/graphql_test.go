@@ -0,0 +1,296 @@
+package integration
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"golang_boilerplate_module/internal/shared/infra/http/graphqlapi"
+)
+
+type graphQLResponse struct {
+	Data   map[string]json.RawMessage `json:"data"`
+	Errors []struct {
+		Message    string         `json:"message"`
+		Path       []any          `json:"path"`
+		Extensions map[string]any `json:"extensions"`
+	} `json:"errors"`
+}
+
+type graphQLUser struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+const testUsersAdminID = 900001
+
+func postGraphQL(t *testing.T, payload map[string]any) graphQLResponse {
+	t.Helper()
+	return postGraphQLAs(t, 0, payload)
+}
+
+// postGraphQLAs sends the operation with a session of actorID, if not 0.
+func postGraphQLAs(t *testing.T, actorID uint, payload map[string]any) graphQLResponse {
+	t.Helper()
+	body, _ := json.Marshal(payload)
+	req, _ := http.NewRequest(http.MethodPost, graphqlapi.Path, bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	if actorID != 0 {
+		req.Header.Set(testUserIDHeader, fmt.Sprint(actorID))
+	}
+	return doGraphQL(t, req)
+}
+
+func doGraphQL(t *testing.T, req *http.Request) graphQLResponse {
+	t.Helper()
+	resp, err := request(req)
+	if err != nil {
+		t.Fatalf("request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+
+	var result graphQLResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	return result
+}
+
+func createGraphQLUser(t *testing.T, name, email string) graphQLUser {
+	t.Helper()
+	result := postGraphQL(t, map[string]any{
+		"query":     `mutation($input: CreateUserInput!) { createUser(input: $input) { id name email } }`,
+		"variables": map[string]any{"input": map[string]any{"name": name, "email": email}},
+	})
+	if len(result.Errors) > 0 {
+		t.Fatalf("createUser: %v", result.Errors)
+	}
+
+	var user graphQLUser
+	if err := json.Unmarshal(result.Data["createUser"], &user); err != nil {
+		t.Fatalf("decode createUser: %v", err)
+	}
+	return user
+}
+
+func TestGraphQL_UserLifecycle(t *testing.T) {
+	t.Cleanup(func() { truncateUsers(t) })
+
+	created := createGraphQLUser(t, "Ana", "ana@example.com")
+	if created.ID == "" || created.Email != "ana@example.com" {
+		t.Fatalf("unexpected created user %+v", created)
+	}
+	actorID, _ := strconv.ParseUint(created.ID, 10, 64)
+
+	result := postGraphQLAs(t, uint(actorID), map[string]any{
+		"query":     `mutation($id: ID!) { updateUser(id: $id, input: {name: "Ana Maria"}) { name email } }`,
+		"variables": map[string]any{"id": created.ID},
+	})
+	if len(result.Errors) > 0 {
+		t.Fatalf("updateUser: %v", result.Errors)
+	}
+	var updated graphQLUser
+	json.Unmarshal(result.Data["updateUser"], &updated)
+	if updated.Name != "Ana Maria" || updated.Email != "ana@example.com" {
+		t.Fatalf("unexpected updated user %+v", updated)
+	}
+
+	result = postGraphQLAs(t, uint(actorID), map[string]any{
+		"query":     `mutation($id: ID!) { deleteUser(id: $id) }`,
+		"variables": map[string]any{"id": created.ID},
+	})
+	if len(result.Errors) > 0 || string(result.Data["deleteUser"]) != "true" {
+		t.Fatalf("deleteUser: %s %v", result.Data["deleteUser"], result.Errors)
+	}
+
+	result = postGraphQL(t, map[string]any{
+		"query":     `query($id: ID!) { user(id: $id) { id } }`,
+		"variables": map[string]any{"id": created.ID},
+	})
+	if len(result.Errors) > 0 || string(result.Data["user"]) != "null" {
+		t.Fatalf("expected a null user after delete, got %s %v", result.Data["user"], result.Errors)
+	}
+}
+
+func TestGraphQL_MutationsRequireTheUserOrAnAdmin(t *testing.T) {
+	t.Cleanup(func() { truncateUsers(t) })
+
+	target := createGraphQLUser(t, "Target", "target@example.com")
+	other := createGraphQLUser(t, "Other", "other@example.com")
+	otherID, _ := strconv.ParseUint(other.ID, 10, 64)
+
+	mutations := map[string]string{
+		"updateUser": `mutation($id: ID!) { updateUser(id: $id, input: {email: "stolen@example.com"}) { id } }`,
+		"deleteUser": `mutation($id: ID!) { deleteUser(id: $id) }`,
+	}
+	for name, query := range mutations {
+		payload := map[string]any{"query": query, "variables": map[string]any{"id": target.ID}}
+
+		result := postGraphQL(t, payload)
+		if len(result.Errors) != 1 || result.Errors[0].Extensions["code"] != "UNAUTHORIZED" {
+			t.Fatalf("%s without a session: expected UNAUTHORIZED, got %v", name, result.Errors)
+		}
+
+		result = postGraphQLAs(t, uint(otherID), payload)
+		if len(result.Errors) != 1 || result.Errors[0].Extensions["code"] != "FORBIDDEN" {
+			t.Fatalf("%s by another user: expected FORBIDDEN, got %v", name, result.Errors)
+		}
+	}
+
+	result := postGraphQL(t, map[string]any{
+		"query":     `query($id: ID!) { user(id: $id) { email } }`,
+		"variables": map[string]any{"id": target.ID},
+	})
+	var unchanged graphQLUser
+	json.Unmarshal(result.Data["user"], &unchanged)
+	if unchanged.Email != "target@example.com" {
+		t.Fatalf("expected the user to be left unchanged, got %+v %v", unchanged, result.Errors)
+	}
+
+	result = postGraphQLAs(t, testUsersAdminID, map[string]any{
+		"query":     mutations["deleteUser"],
+		"variables": map[string]any{"id": target.ID},
+	})
+	if len(result.Errors) > 0 || string(result.Data["deleteUser"]) != "true" {
+		t.Fatalf("deleteUser by an admin: %s %v", result.Data["deleteUser"], result.Errors)
+	}
+}
+
+func TestGraphQL_BatchesUserLookups(t *testing.T) {
+	t.Cleanup(func() { truncateUsers(t) })
+
+	first := createGraphQLUser(t, "First", "first@example.com")
+	second := createGraphQLUser(t, "Second", "second@example.com")
+
+	result := postGraphQL(t, map[string]any{
+		"query": fmt.Sprintf(`{ a: user(id: "%s") { name } b: user(id: "%s") { name } }`, first.ID, second.ID),
+	})
+	if len(result.Errors) > 0 {
+		t.Fatalf("query: %v", result.Errors)
+	}
+
+	var a, b graphQLUser
+	json.Unmarshal(result.Data["a"], &a)
+	json.Unmarshal(result.Data["b"], &b)
+	if a.Name != "First" || b.Name != "Second" {
+		t.Fatalf("unexpected users %+v %+v", a, b)
+	}
+}
+
+func TestGraphQL_UsersConnectionPaginates(t *testing.T) {
+	t.Cleanup(func() { truncateUsers(t) })
+
+	for i := range 3 {
+		createGraphQLUser(t, "Page", fmt.Sprintf("page%d@example.com", i))
+	}
+
+	const query = `query($after: String) {
+		users(first: 2, after: $after) { edges { node { id } } pageInfo { hasNextPage endCursor } }
+	}`
+	type page struct {
+		Edges    []struct{ Node graphQLUser } `json:"edges"`
+		PageInfo struct {
+			HasNextPage bool    `json:"hasNextPage"`
+			EndCursor   *string `json:"endCursor"`
+		} `json:"pageInfo"`
+	}
+
+	var firstPage page
+	result := postGraphQL(t, map[string]any{"query": query})
+	json.Unmarshal(result.Data["users"], &firstPage)
+	if len(firstPage.Edges) != 2 || !firstPage.PageInfo.HasNextPage || firstPage.PageInfo.EndCursor == nil {
+		t.Fatalf("expected 2 users and a next page, got %+v %v", firstPage, result.Errors)
+	}
+
+	var secondPage page
+	result = postGraphQL(t, map[string]any{"query": query, "variables": map[string]any{"after": *firstPage.PageInfo.EndCursor}})
+	json.Unmarshal(result.Data["users"], &secondPage)
+	if len(secondPage.Edges) != 1 || secondPage.PageInfo.HasNextPage {
+		t.Fatalf("expected the last user and no next page, got %+v %v", secondPage, result.Errors)
+	}
+}
+
+func TestGraphQL_TranslatesDomainErrorsToExtensions(t *testing.T) {
+	t.Cleanup(func() { truncateUsers(t) })
+
+	result := postGraphQLAs(t, testUsersAdminID, map[string]any{
+		"query": `mutation { updateUser(id: "999999", input: {name: "Ghost"}) { id } }`,
+	})
+	if len(result.Errors) != 1 {
+		t.Fatalf("expected one error, got %v", result.Errors)
+	}
+	if code := result.Errors[0].Extensions["code"]; code != "NOT_FOUND" {
+		t.Fatalf("expected NOT_FOUND, got %v", code)
+	}
+	if status := result.Errors[0].Extensions["status"]; status != float64(http.StatusNotFound) {
+		t.Fatalf("expected status 404, got %v", status)
+	}
+
+	createGraphQLUser(t, "Dup", "dup@example.com")
+	result = postGraphQL(t, map[string]any{
+		"query": `mutation { createUser(input: {name: "Dup", email: "dup@example.com"}) { id } }`,
+	})
+	if len(result.Errors) != 1 || result.Errors[0].Extensions["code"] != "UNPROCESSABLE" {
+		t.Fatalf("expected UNPROCESSABLE, got %v", result.Errors)
+	}
+}
+
+func TestGraphQL_EnforcesDepthAndComplexityLimits(t *testing.T) {
+	result := postGraphQL(t, map[string]any{
+		"query": `{ __schema { types { fields { type { name } } } } }`,
+	})
+	if len(result.Errors) != 1 || result.Errors[0].Extensions["maxDepth"] != float64(4) {
+		t.Fatalf("expected a depth violation, got %v", result.Errors)
+	}
+
+	result = postGraphQL(t, map[string]any{
+		"query": `{ users(first: 100) { edges { node { id name email } } } }`,
+	})
+	if len(result.Errors) != 1 || result.Errors[0].Extensions["maxComplexity"] != float64(200) {
+		t.Fatalf("expected a complexity violation, got %v", result.Errors)
+	}
+	if result.Data["users"] != nil {
+		t.Fatal("expected the query not to run")
+	}
+}
+
+func TestGraphQL_AutomaticPersistedQueries(t *testing.T) {
+	const query = `{ users(first: 1) { pageInfo { hasNextPage } } }`
+	extensions := map[string]any{
+		"persistedQuery": map[string]any{"version": 1, "sha256Hash": graphqlapi.QueryHash(query)},
+	}
+
+	result := postGraphQL(t, map[string]any{"extensions": extensions})
+	if len(result.Errors) != 1 || result.Errors[0].Extensions["code"] != "PERSISTED_QUERY_NOT_FOUND" {
+		t.Fatalf("expected PERSISTED_QUERY_NOT_FOUND, got %v", result.Errors)
+	}
+
+	result = postGraphQL(t, map[string]any{"query": query, "extensions": extensions})
+	if len(result.Errors) > 0 {
+		t.Fatalf("register: %v", result.Errors)
+	}
+
+	result = postGraphQL(t, map[string]any{"extensions": extensions})
+	if len(result.Errors) > 0 || result.Data["users"] == nil {
+		t.Fatalf("expected the persisted query to run, got %v", result.Errors)
+	}
+}
+
+func TestGraphQL_RejectsMutationsOverGET(t *testing.T) {
+	query := url.Values{"query": {`mutation { deleteUser(id: "1") }`}}
+	req, _ := http.NewRequest(http.MethodGet, graphqlapi.Path+"?"+query.Encode(), nil)
+
+	result := doGraphQL(t, req)
+	if len(result.Errors) != 1 || result.Errors[0].Extensions["code"] != "BAD_REQUEST" {
+		t.Fatalf("expected BAD_REQUEST, got %v", result.Errors)
+	}
+}
@@ -0,0 +1,237 @@
+package integration
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"golang_boilerplate_module/internal/modules/users/infra/usersgrpc/userspb"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func dialGRPC(t *testing.T) *grpc.ClientConn {
+	t.Helper()
+	conn, err := grpc.NewClient(grpcServer.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("dial grpc: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func grpcContext(t *testing.T) context.Context {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+	return ctx
+}
+
+// grpcContextAs authenticates the calls with a session of userID.
+func grpcContextAs(t *testing.T, userID uint) context.Context {
+	t.Helper()
+	return metadata.AppendToOutgoingContext(grpcContext(t), "authorization", "Bearer "+openSession(t, userID).Token)
+}
+
+func TestGRPC_HealthIsServing(t *testing.T) {
+	client := healthpb.NewHealthClient(dialGRPC(t))
+
+	resp, err := client.Check(grpcContext(t), &healthpb.HealthCheckRequest{Service: "users.v1.UserService"})
+	if err != nil {
+		t.Fatalf("health check: %v", err)
+	}
+	if resp.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+		t.Fatalf("expected SERVING, got %s", resp.GetStatus())
+	}
+}
+
+func TestGRPC_UserLifecycle(t *testing.T) {
+	t.Cleanup(func() { truncateUsers(t) })
+
+	client := userspb.NewUserServiceClient(dialGRPC(t))
+	ctx := grpcContext(t)
+
+	created, err := client.CreateUser(ctx, &userspb.CreateUserRequest{Name: "Ana", Email: "ana@example.com"})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	id := created.GetUser().GetId()
+	if id == 0 {
+		t.Fatal("expected non-zero ID")
+	}
+
+	ownerCtx := grpcContextAs(t, uint(id))
+	name := "Ana Maria"
+	updated, err := client.UpdateUser(ownerCtx, &userspb.UpdateUserRequest{Id: id, Name: &name})
+	if err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	if updated.GetUser().GetName() != name || updated.GetUser().GetEmail() != "ana@example.com" {
+		t.Fatalf("unexpected updated user %v", updated.GetUser())
+	}
+
+	got, err := client.GetUser(ctx, &userspb.GetUserRequest{Id: id})
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if got.GetUser().GetName() != name {
+		t.Fatalf("expected name=%q, got %q", name, got.GetUser().GetName())
+	}
+
+	if _, err := client.DeleteUser(ownerCtx, &userspb.DeleteUserRequest{Id: id}); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+
+	_, err = client.GetUser(ctx, &userspb.GetUserRequest{Id: id})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("expected NotFound after delete, got %v", err)
+	}
+}
+
+func TestGRPC_ChangesRequireTheUserOrAnAdmin(t *testing.T) {
+	t.Cleanup(func() { truncateUsers(t) })
+
+	client := userspb.NewUserServiceClient(dialGRPC(t))
+	ctx := grpcContext(t)
+
+	id := uint64(createUser(t, "Bruno", "bruno@example.com"))
+	otherCtx := grpcContextAs(t, createUser(t, "Clara", "clara@example.com"))
+	name := "Renamed"
+
+	_, err := client.UpdateUser(ctx, &userspb.UpdateUserRequest{Id: id, Name: &name})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("expected Unauthenticated for an anonymous update, got %v", err)
+	}
+	_, err = client.DeleteUser(ctx, &userspb.DeleteUserRequest{Id: id})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("expected Unauthenticated for an anonymous delete, got %v", err)
+	}
+
+	badCtx := metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer not-a-session")
+	_, err = client.DeleteUser(badCtx, &userspb.DeleteUserRequest{Id: id})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("expected Unauthenticated for an unknown session, got %v", err)
+	}
+
+	_, err = client.UpdateUser(otherCtx, &userspb.UpdateUserRequest{Id: id, Name: &name})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected PermissionDenied updating another user, got %v", err)
+	}
+	_, err = client.DeleteUser(otherCtx, &userspb.DeleteUserRequest{Id: id})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected PermissionDenied deleting another user, got %v", err)
+	}
+
+	got, err := client.GetUser(ctx, &userspb.GetUserRequest{Id: id})
+	if err != nil || got.GetUser().GetName() != "Bruno" {
+		t.Fatalf("expected the user to be unchanged, got %v %v", got.GetUser(), err)
+	}
+}
+
+func TestGRPC_WatchUsersRequiresASession(t *testing.T) {
+	client := userspb.NewUserServiceClient(dialGRPC(t))
+
+	stream, err := client.WatchUsers(grpcContext(t), &userspb.WatchUsersRequest{})
+	if err == nil {
+		_, err = stream.Recv()
+	}
+	if status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("expected Unauthenticated, got %v", err)
+	}
+}
+
+func TestGRPC_ListUsersPaginates(t *testing.T) {
+	t.Cleanup(func() { truncateUsers(t) })
+
+	client := userspb.NewUserServiceClient(dialGRPC(t))
+	ctx := grpcContext(t)
+
+	for i := range 3 {
+		email := fmt.Sprintf("page%d@example.com", i)
+		if _, err := client.CreateUser(ctx, &userspb.CreateUserRequest{Name: "Page", Email: email}); err != nil {
+			t.Fatalf("create %d: %v", i, err)
+		}
+	}
+
+	first, err := client.ListUsers(ctx, &userspb.ListUsersRequest{PageSize: 2})
+	if err != nil {
+		t.Fatalf("list first page: %v", err)
+	}
+	if len(first.GetUsers()) != 2 || first.GetNextPageToken() == "" {
+		t.Fatalf("expected 2 users and a next page token, got %v", first)
+	}
+
+	second, err := client.ListUsers(ctx, &userspb.ListUsersRequest{PageSize: 2, PageToken: first.GetNextPageToken()})
+	if err != nil {
+		t.Fatalf("list second page: %v", err)
+	}
+	if len(second.GetUsers()) != 1 || second.GetNextPageToken() != "" {
+		t.Fatalf("expected the last user and no token, got %v", second)
+	}
+}
+
+func TestGRPC_MapsDomainErrorsToStatusCodes(t *testing.T) {
+	t.Cleanup(func() { truncateUsers(t) })
+
+	client := userspb.NewUserServiceClient(dialGRPC(t))
+	ctx := grpcContext(t)
+
+	_, err := client.CreateUser(ctx, &userspb.CreateUserRequest{Name: "", Email: "x@example.com"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument, got %v", err)
+	}
+
+	if _, err := client.CreateUser(ctx, &userspb.CreateUserRequest{Name: "Dup", Email: "dup@example.com"}); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	_, err = client.CreateUser(ctx, &userspb.CreateUserRequest{Name: "Dup", Email: "dup@example.com"})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition, got %v", err)
+	}
+
+	_, err = client.ListUsers(ctx, &userspb.ListUsersRequest{PageToken: "not-a-token"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for a bad page token, got %v", err)
+	}
+}
+
+func TestGRPC_WatchUsersStreamsChanges(t *testing.T) {
+	t.Cleanup(func() { truncateUsers(t) })
+
+	client := userspb.NewUserServiceClient(dialGRPC(t))
+	ctx := grpcContext(t)
+	watcherCtx := grpcContextAs(t, createUser(t, "Watcher", "watcher@example.com"))
+
+	watchCtx, stopWatching := context.WithCancel(watcherCtx)
+	defer stopWatching()
+
+	stream, err := client.WatchUsers(watchCtx, &userspb.WatchUsersRequest{})
+	if err != nil {
+		t.Fatalf("watch: %v", err)
+	}
+	if _, err := stream.Header(); err != nil {
+		t.Fatalf("watch header: %v", err)
+	}
+
+	created, err := client.CreateUser(ctx, &userspb.CreateUserRequest{Name: "Watched", Email: "watched@example.com"})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	event, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("recv: %v", err)
+	}
+	if event.GetType() != userspb.UserEventType_USER_EVENT_TYPE_CREATED {
+		t.Fatalf("expected a created event, got %s", event.GetType())
+	}
+	if event.GetUser().GetId() != created.GetUser().GetId() {
+		t.Fatalf("expected user %d, got %d", created.GetUser().GetId(), event.GetUser().GetId())
+	}
+}
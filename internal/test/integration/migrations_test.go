@@ -0,0 +1,55 @@
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"golang_boilerplate_module/internal/shared/infra/persistence"
+	"golang_boilerplate_module/migrations"
+)
+
+func TestMigrations_DownAndUpAgain(t *testing.T) {
+	db, err := openGorm()
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	defer persistence.CloseDB(db)
+
+	ctx := context.Background()
+	migrator := persistence.NewMigrator(db, migrations.FS)
+
+	status, err := migrator.Status(ctx)
+	if err != nil {
+		t.Fatalf("status: %v", err)
+	}
+	latest := status[len(status)-1]
+	for _, migration := range status {
+		if !migration.Applied {
+			t.Fatalf("expected %s to be applied", migration.Script)
+		}
+	}
+
+	reverted, err := migrator.Down(ctx, 1)
+	if err != nil {
+		t.Fatalf("down: %v", err)
+	}
+	if len(reverted) != 1 || reverted[0].Version != latest.Version {
+		t.Fatalf("expected V%d to be reverted, got %+v", latest.Version, reverted)
+	}
+
+	status, err = migrator.Status(ctx)
+	if err != nil {
+		t.Fatalf("status: %v", err)
+	}
+	if status[len(status)-1].Applied {
+		t.Fatalf("expected %s to be pending after down", latest.Script)
+	}
+
+	applied, err := migrator.Up(ctx)
+	if err != nil {
+		t.Fatalf("up: %v", err)
+	}
+	if len(applied) != 1 || applied[0].Version != latest.Version {
+		t.Fatalf("expected only V%d to be applied again, got %+v", latest.Version, applied)
+	}
+}
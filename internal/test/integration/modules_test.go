@@ -0,0 +1,51 @@
+package integration
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"golang_boilerplate_module/internal/shared/infra/platform"
+)
+
+func TestModules_ListsRegisteredModules(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodGet, platform.ModulesPath, nil)
+	resp, err := request(req)
+	if err != nil {
+		t.Fatalf("request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+
+	var modules []platform.ModuleInfo
+	if err := json.NewDecoder(resp.Body).Decode(&modules); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+
+	byName := make(map[string]platform.ModuleInfo, len(modules))
+	for _, m := range modules {
+		byName[m.Name] = m
+	}
+	for _, name := range []string{"health", "tenants", "sessions", "identity", "users", "organizations", "privacy", "featureflags"} {
+		m, ok := byName[name]
+		if !ok {
+			t.Fatalf("expected module %q to be listed", name)
+		}
+		if !m.Enabled {
+			t.Fatalf("expected module %q to be enabled", name)
+		}
+	}
+
+	if !byName["sessions"].Required {
+		t.Fatal("expected sessions to be a required module")
+	}
+	if len(byName["users"].Migrations) != 2 {
+		t.Fatalf("expected users to own 2 migrations, got %v", byName["users"].Migrations)
+	}
+	if deps := byName["identity"].DependsOn; len(deps) != 2 {
+		t.Fatalf("expected identity to depend on users and sessions, got %v", deps)
+	}
+}
@@ -0,0 +1,314 @@
+package integration
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+)
+
+const mockOIDCClientID = "integration-client"
+
+var oidcProvider *mockOIDCProvider
+
+type mockOIDCUser struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+}
+
+type mockOIDCGrant struct {
+	user          mockOIDCUser
+	nonce         string
+	codeChallenge string
+}
+
+type mockOIDCProvider struct {
+	*httptest.Server
+	key *rsa.PrivateKey
+
+	mu     sync.Mutex
+	next   mockOIDCUser
+	grants map[string]mockOIDCGrant
+}
+
+func startMockOIDCProvider() *mockOIDCProvider {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		panic("generate oidc key: " + err.Error())
+	}
+
+	p := &mockOIDCProvider{key: key, grants: make(map[string]mockOIDCGrant)}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", p.discovery)
+	mux.HandleFunc("/jwks", p.jwks)
+	mux.HandleFunc("/authorize", p.authorize)
+	mux.HandleFunc("/token", p.token)
+	p.Server = httptest.NewServer(mux)
+	return p
+}
+
+func (p *mockOIDCProvider) signInAs(user mockOIDCUser) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.next = user
+}
+
+func (p *mockOIDCProvider) discovery(w http.ResponseWriter, _ *http.Request) {
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"issuer":                                p.URL,
+		"authorization_endpoint":                p.URL + "/authorize",
+		"token_endpoint":                        p.URL + "/token",
+		"jwks_uri":                              p.URL + "/jwks",
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+	})
+}
+
+func (p *mockOIDCProvider) jwks(w http.ResponseWriter, _ *http.Request) {
+	_ = json.NewEncoder(w).Encode(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{{
+		Key:       &p.key.PublicKey,
+		KeyID:     "integration",
+		Algorithm: string(jose.RS256),
+		Use:       "sig",
+	}}})
+}
+
+func (p *mockOIDCProvider) authorize(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	if query.Get("client_id") != mockOIDCClientID || query.Get("code_challenge_method") != "S256" {
+		http.Error(w, "invalid request", http.StatusBadRequest)
+		return
+	}
+
+	raw := make([]byte, 16)
+	_, _ = rand.Read(raw)
+	code := base64.RawURLEncoding.EncodeToString(raw)
+
+	p.mu.Lock()
+	p.grants[code] = mockOIDCGrant{
+		user:          p.next,
+		nonce:         query.Get("nonce"),
+		codeChallenge: query.Get("code_challenge"),
+	}
+	p.mu.Unlock()
+
+	redirect, _ := url.Parse(query.Get("redirect_uri"))
+	values := redirect.Query()
+	values.Set("code", code)
+	values.Set("state", query.Get("state"))
+	redirect.RawQuery = values.Encode()
+	http.Redirect(w, r, redirect.String(), http.StatusFound)
+}
+
+func (p *mockOIDCProvider) token(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "invalid form", http.StatusBadRequest)
+		return
+	}
+
+	p.mu.Lock()
+	grant, ok := p.grants[r.PostForm.Get("code")]
+	delete(p.grants, r.PostForm.Get("code"))
+	p.mu.Unlock()
+
+	challenge := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+	if !ok || base64.RawURLEncoding.EncodeToString(challenge[:]) != grant.codeChallenge {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"error":"invalid_grant"}`))
+		return
+	}
+
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.RS256, Key: jose.JSONWebKey{Key: p.key, KeyID: "integration"}},
+		(&jose.SignerOptions{}).WithType("JWT"),
+	)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	now := time.Now()
+	payload, _ := json.Marshal(map[string]any{
+		"iss":            p.URL,
+		"sub":            grant.user.Subject,
+		"aud":            mockOIDCClientID,
+		"iat":            now.Unix(),
+		"exp":            now.Add(5 * time.Minute).Unix(),
+		"nonce":          grant.nonce,
+		"email":          grant.user.Email,
+		"email_verified": grant.user.EmailVerified,
+		"name":           grant.user.Name,
+	})
+	signed, err := signer.Sign(payload)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	idToken, _ := signed.CompactSerialize()
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"access_token": "access-token",
+		"token_type":   "Bearer",
+		"expires_in":   300,
+		"id_token":     idToken,
+	})
+}
+
+func responseCookie(resp *http.Response, name string) *http.Cookie {
+	for _, cookie := range resp.Cookies() {
+		if cookie.Name == name {
+			return cookie
+		}
+	}
+	return nil
+}
+
+func oidcLogin(t *testing.T, user mockOIDCUser) *http.Response {
+	t.Helper()
+
+	oidcProvider.signInAs(user)
+
+	loginReq, _ := http.NewRequest(http.MethodGet, "/api/auth/oidc/mock/login?redirect_to=/welcome", nil)
+	loginResp, err := request(loginReq)
+	if err != nil {
+		t.Fatalf("login: %v", err)
+	}
+	loginResp.Body.Close()
+	if loginResp.StatusCode != http.StatusFound {
+		t.Fatalf("expected 302 from login, got %d", loginResp.StatusCode)
+	}
+
+	stateCookie := responseCookie(loginResp, "oidc_state")
+	if stateCookie == nil || !stateCookie.HttpOnly {
+		t.Fatalf("expected an HttpOnly oidc_state cookie")
+	}
+
+	client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }}
+	authorizeResp, err := client.Get(loginResp.Header.Get("Location"))
+	if err != nil {
+		t.Fatalf("authorize: %v", err)
+	}
+	authorizeResp.Body.Close()
+	if authorizeResp.StatusCode != http.StatusFound {
+		t.Fatalf("expected 302 from authorize, got %d", authorizeResp.StatusCode)
+	}
+
+	callback, _ := url.Parse(authorizeResp.Header.Get("Location"))
+	callbackReq, _ := http.NewRequest(http.MethodGet, callback.RequestURI(), nil)
+	callbackReq.AddCookie(stateCookie)
+	callbackResp, err := request(callbackReq)
+	if err != nil {
+		t.Fatalf("callback: %v", err)
+	}
+	return callbackResp
+}
+
+func TestOIDC_LoginProvisionsUserAndOpensSession(t *testing.T) {
+	t.Cleanup(func() {
+		truncateIdentities(t)
+		truncateSessions(t)
+		truncateUsers(t)
+	})
+
+	resp := oidcLogin(t, mockOIDCUser{Subject: "sub-nina", Email: "nina@example.com", EmailVerified: true, Name: "Nina"})
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusFound {
+		t.Fatalf("expected 302 from callback, got %d", resp.StatusCode)
+	}
+	if location := resp.Header.Get("Location"); location != "/welcome" {
+		t.Fatalf("expected redirect to /welcome, got %q", location)
+	}
+
+	sessionCookie := responseCookie(resp, "session_id")
+	if sessionCookie == nil || sessionCookie.Value == "" {
+		t.Fatalf("expected a session cookie")
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/sessions", nil)
+	req.AddCookie(sessionCookie)
+	sessionsResp, err := request(req)
+	if err != nil {
+		t.Fatalf("list sessions: %v", err)
+	}
+	defer sessionsResp.Body.Close()
+	if sessionsResp.StatusCode != http.StatusOK {
+		t.Fatalf("expected the new session to authenticate, got %d", sessionsResp.StatusCode)
+	}
+}
+
+func TestOIDC_LoginLinksExistingUserByEmail(t *testing.T) {
+	t.Cleanup(func() {
+		truncateIdentities(t)
+		truncateSessions(t)
+		truncateUsers(t)
+	})
+
+	userID := createUser(t, "Otto", "otto@example.com")
+
+	for range 2 {
+		resp := oidcLogin(t, mockOIDCUser{Subject: "sub-otto", Email: "otto@example.com", EmailVerified: true})
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusFound {
+			t.Fatalf("expected 302 from callback, got %d", resp.StatusCode)
+		}
+	}
+
+	exportResp := privacyRequest(t, http.MethodGet, fmt.Sprintf("/api/v1/privacy/users/%d/export", userID), userID, "")
+	defer exportResp.Body.Close()
+
+	var archive struct {
+		Modules struct {
+			Identity []struct {
+				Provider string `json:"provider"`
+				Subject  string `json:"subject"`
+			} `json:"identity"`
+		} `json:"modules"`
+	}
+	if err := json.NewDecoder(exportResp.Body).Decode(&archive); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if len(archive.Modules.Identity) != 1 || archive.Modules.Identity[0].Subject != "sub-otto" {
+		t.Fatalf("expected one linked identity for the existing user, got %+v", archive.Modules.Identity)
+	}
+}
+
+func TestOIDC_CallbackRejectsMissingStateCookie(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodGet, "/api/auth/oidc/mock/callback?state=forged&code=abc", nil)
+	resp, err := request(req)
+	if err != nil {
+		t.Fatalf("callback: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected 401, got %d", resp.StatusCode)
+	}
+}
+
+func TestOIDC_UnknownProvider(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodGet, "/api/auth/oidc/unknown/login", nil)
+	resp, err := request(req)
+	if err != nil {
+		t.Fatalf("login: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected 404, got %d", resp.StatusCode)
+	}
+}
@@ -0,0 +1,163 @@
+package integration
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+var pathParam = regexp.MustCompile(`\{[^}]+\}`)
+
+func TestOpenAPI_DocumentDescribesRoutes(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodGet, "/openapi.json", nil)
+	resp, err := request(req)
+	if err != nil {
+		t.Fatalf("request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+
+	var doc struct {
+		OpenAPI string `json:"openapi"`
+		Paths   map[string]map[string]struct {
+			OperationID string `json:"operationId"`
+		} `json:"paths"`
+		Components struct {
+			Schemas map[string]struct {
+				Required []string `json:"required"`
+			} `json:"schemas"`
+		} `json:"components"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+
+	if doc.OpenAPI != "3.1.0" {
+		t.Fatalf("expected openapi=3.1.0, got %q", doc.OpenAPI)
+	}
+	if _, ok := doc.Paths["/api/v1/users/{id}"]["get"]; !ok {
+		t.Fatalf("expected GET /api/v1/users/{id} to be documented")
+	}
+	if _, ok := doc.Paths["/api/v1/users"]["post"]; !ok {
+		t.Fatalf("expected POST /api/v1/users to be documented")
+	}
+	if required := doc.Components.Schemas["CreateUserInput"].Required; len(required) != 2 {
+		t.Fatalf("expected name and email to be required, got %v", required)
+	}
+	if _, ok := doc.Components.Schemas["ErrorResponse"]; !ok {
+		t.Fatalf("expected the error response schema")
+	}
+}
+
+func TestOpenAPI_ServesDocsUI(t *testing.T) {
+	for _, path := range []string{"/docs", "/docs/redoc"} {
+		req, _ := http.NewRequest(http.MethodGet, path, nil)
+		resp, err := request(req)
+		if err != nil {
+			t.Fatalf("request %s: %v", path, err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("%s: expected 200, got %d", path, resp.StatusCode)
+		}
+		if ct := resp.Header.Get("Content-Type"); !strings.HasPrefix(ct, "text/html") {
+			t.Fatalf("%s: expected text/html, got %q", path, ct)
+		}
+		if strings.Contains(string(body), "https://") {
+			t.Fatalf("%s: expected the UI assets to be served locally, got %s", path, body)
+		}
+	}
+}
+
+func TestOpenAPI_ServesPinnedUIAssets(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodGet, "/docs/assets/VERSIONS", nil)
+	resp, err := request(req)
+	if err != nil {
+		t.Fatalf("request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	for _, pkg := range []string{"swagger-ui-dist ", "redoc "} {
+		if !strings.Contains(string(body), pkg) {
+			t.Fatalf("expected a pinned %sversion, got %q", pkg, body)
+		}
+	}
+
+	// The pages load these; a build that skipped make openapi/ui lacks them.
+	for _, asset := range []string{"swagger-ui-bundle.js", "redoc.standalone.js"} {
+		req, _ := http.NewRequest(http.MethodGet, "/docs/assets/"+asset, nil)
+		resp, err := request(req)
+		if err != nil {
+			t.Fatalf("request %s: %v", asset, err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("%s: expected 200, got %d", asset, resp.StatusCode)
+		}
+		if ct := resp.Header.Get("Content-Type"); !strings.Contains(ct, "javascript") {
+			t.Fatalf("%s: expected JavaScript, got %q", asset, ct)
+		}
+		if len(body) < 1024 {
+			t.Fatalf("%s: expected the bundle, got %d bytes", asset, len(body))
+		}
+	}
+}
+
+func TestOpenAPI_AuthenticatedRoutesRequireASession(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodGet, "/openapi.json", nil)
+	resp, err := request(req)
+	if err != nil {
+		t.Fatalf("request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	var doc struct {
+		Paths map[string]map[string]struct {
+			Security    []map[string][]string `json:"security"`
+			RequestBody *struct {
+				Required bool `json:"required"`
+			} `json:"requestBody"`
+		} `json:"paths"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+
+	checked := 0
+	for path, operations := range doc.Paths {
+		for method, operation := range operations {
+			// A missing required body fails request validation first.
+			if len(operation.Security) == 0 || operation.RequestBody != nil && operation.RequestBody.Required {
+				continue
+			}
+			target := pathParam.ReplaceAllString(path, "1")
+			req, _ := http.NewRequest(strings.ToUpper(method), target, nil)
+			resp, err := request(req)
+			if err != nil {
+				t.Fatalf("%s %s: %v", method, target, err)
+			}
+			resp.Body.Close()
+			if resp.StatusCode != http.StatusUnauthorized {
+				t.Fatalf("%s %s: expected 401 without a session, got %d", method, target, resp.StatusCode)
+			}
+			checked++
+		}
+	}
+	if checked == 0 {
+		t.Fatal("expected authenticated routes in the document")
+	}
+}
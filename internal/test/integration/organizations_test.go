@@ -0,0 +1,189 @@
+package integration
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func organizationRequest(t *testing.T, method, path string, actorID uint, body string) *http.Response {
+	t.Helper()
+
+	req, _ := http.NewRequest(method, path, bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	if actorID != 0 {
+		req.Header.Set(testUserIDHeader, fmt.Sprint(actorID))
+	}
+
+	resp, err := request(req)
+	if err != nil {
+		t.Fatalf("%s %s: %v", method, path, err)
+	}
+	return resp
+}
+
+func createOrganization(t *testing.T, actorID uint, name string) uint {
+	t.Helper()
+
+	resp := organizationRequest(t, http.MethodPost, "/api/v1/organizations", actorID, fmt.Sprintf(`{"name":%q}`, name))
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("create organization: expected 201, got %d", resp.StatusCode)
+	}
+
+	var created struct {
+		ID   uint   `json:"id"`
+		Role string `json:"role"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&created); err != nil {
+		t.Fatalf("decode create organization: %v", err)
+	}
+	if created.Role != "owner" {
+		t.Fatalf("expected creator role=owner, got %q", created.Role)
+	}
+	return created.ID
+}
+
+func TestOrganizations_RequiresAuthentication(t *testing.T) {
+	resp := organizationRequest(t, http.MethodPost, "/api/v1/organizations", 0, `{"name":"Acme"}`)
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected 401, got %d", resp.StatusCode)
+	}
+}
+
+func TestOrganizations_InvitationFlow(t *testing.T) {
+	t.Cleanup(func() {
+		truncateOrganizations(t)
+		truncateUsers(t)
+	})
+
+	ownerID := createUser(t, "Olga", "olga@example.com")
+	inviteeID := createUser(t, "Ivan", "ivan@example.com")
+	orgID := createOrganization(t, ownerID, "Acme")
+
+	resp := organizationRequest(t, http.MethodPost, fmt.Sprintf("/api/v1/organizations/%d/invitations", orgID), ownerID,
+		`{"email":"ivan@example.com","role":"admin"}`)
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("invite: expected 201, got %d", resp.StatusCode)
+	}
+
+	var invitation struct {
+		Token string `json:"token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&invitation); err != nil || invitation.Token == "" {
+		t.Fatalf("expected invitation token, err=%v", err)
+	}
+
+	accept := organizationRequest(t, http.MethodPost, "/api/v1/invitations/"+invitation.Token+"/accept", inviteeID, "")
+	defer accept.Body.Close()
+	if accept.StatusCode != http.StatusOK {
+		t.Fatalf("accept: expected 200, got %d", accept.StatusCode)
+	}
+
+	members := organizationRequest(t, http.MethodGet, fmt.Sprintf("/api/v1/organizations/%d/members", orgID), inviteeID, "")
+	defer members.Body.Close()
+
+	var list []struct {
+		UserID uint   `json:"user_id"`
+		Role   string `json:"role"`
+	}
+	if err := json.NewDecoder(members.Body).Decode(&list); err != nil {
+		t.Fatalf("decode members: %v", err)
+	}
+	if len(list) != 2 {
+		t.Fatalf("expected 2 members, got %d", len(list))
+	}
+
+	again := organizationRequest(t, http.MethodPost, "/api/v1/invitations/"+invitation.Token+"/accept", inviteeID, "")
+	defer again.Body.Close()
+	if again.StatusCode != http.StatusUnprocessableEntity {
+		t.Fatalf("reuse token: expected 422, got %d", again.StatusCode)
+	}
+}
+
+func TestOrganizations_ExpiredInvitationDoesNotBlockANewOne(t *testing.T) {
+	t.Cleanup(func() {
+		truncateOrganizations(t)
+		truncateUsers(t)
+	})
+
+	ownerID := createUser(t, "Otavio", "otavio@example.com")
+	orgID := createOrganization(t, ownerID, "Acme")
+	path := fmt.Sprintf("/api/v1/organizations/%d/invitations", orgID)
+
+	first := organizationRequest(t, http.MethodPost, path, ownerID, `{"email":"iris@example.com"}`)
+	first.Body.Close()
+	if first.StatusCode != http.StatusCreated {
+		t.Fatalf("invite: expected 201, got %d", first.StatusCode)
+	}
+
+	if err := appDB.Exec("UPDATE organization_invitations SET expires_at = ? WHERE organization_id = ?",
+		time.Now().Add(-time.Minute), orgID).Error; err != nil {
+		t.Fatalf("expire invitation: %v", err)
+	}
+
+	again := organizationRequest(t, http.MethodPost, path, ownerID, `{"email":"iris@example.com"}`)
+	again.Body.Close()
+	if again.StatusCode != http.StatusCreated {
+		t.Fatalf("invite after expiry: expected 201, got %d", again.StatusCode)
+	}
+}
+
+func TestOrganizations_LastOwnerCannotLeave(t *testing.T) {
+	t.Cleanup(func() {
+		truncateOrganizations(t)
+		truncateUsers(t)
+	})
+
+	ownerID := createUser(t, "Olga", "olga@example.com")
+	orgID := createOrganization(t, ownerID, "Acme")
+
+	resp := organizationRequest(t, http.MethodDelete, fmt.Sprintf("/api/v1/organizations/%d/members/%d", orgID, ownerID), ownerID, "")
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusUnprocessableEntity {
+		t.Fatalf("expected 422, got %d", resp.StatusCode)
+	}
+}
+
+func TestOrganizations_TransferOwnership(t *testing.T) {
+	t.Cleanup(func() {
+		truncateOrganizations(t)
+		truncateUsers(t)
+	})
+
+	ownerID := createUser(t, "Olga", "olga@example.com")
+	memberID := createUser(t, "Mara", "mara@example.com")
+	orgID := createOrganization(t, ownerID, "Acme")
+
+	invite := organizationRequest(t, http.MethodPost, fmt.Sprintf("/api/v1/organizations/%d/invitations", orgID), ownerID,
+		`{"email":"mara@example.com"}`)
+	defer invite.Body.Close()
+	var invitation struct {
+		Token string `json:"token"`
+	}
+	_ = json.NewDecoder(invite.Body).Decode(&invitation)
+
+	accept := organizationRequest(t, http.MethodPost, "/api/v1/invitations/"+invitation.Token+"/accept", memberID, "")
+	accept.Body.Close()
+
+	transfer := organizationRequest(t, http.MethodPost, fmt.Sprintf("/api/v1/organizations/%d/transfer-ownership", orgID), ownerID,
+		fmt.Sprintf(`{"user_id":%d}`, memberID))
+	defer transfer.Body.Close()
+	if transfer.StatusCode != http.StatusOK {
+		t.Fatalf("transfer: expected 200, got %d", transfer.StatusCode)
+	}
+
+	leave := organizationRequest(t, http.MethodDelete, fmt.Sprintf("/api/v1/organizations/%d/members/%d", orgID, ownerID), ownerID, "")
+	defer leave.Body.Close()
+	if leave.StatusCode != http.StatusNoContent {
+		t.Fatalf("former owner leave: expected 204, got %d", leave.StatusCode)
+	}
+}
@@ -0,0 +1,258 @@
+package integration
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"golang_boilerplate_module/internal/modules/privacy/infra/privacypersistence"
+	"golang_boilerplate_module/internal/shared/domain/tenancy"
+)
+
+const testPrivacyAdminID = 900000
+
+func privacyRequest(t *testing.T, method, path string, actorID uint, body string) *http.Response {
+	t.Helper()
+
+	req, _ := http.NewRequest(method, path, bytes.NewBufferString(body))
+	if body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if actorID != 0 {
+		req.Header.Set(testUserIDHeader, fmt.Sprint(actorID))
+	}
+
+	resp, err := request(req)
+	if err != nil {
+		t.Fatalf("%s %s: %v", method, path, err)
+	}
+	return resp
+}
+
+func createUser(t *testing.T, name, email string) uint {
+	t.Helper()
+
+	body := fmt.Sprintf(`{"name":%q,"email":%q}`, name, email)
+	req, _ := http.NewRequest(http.MethodPost, "/api/v1/users", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := request(req)
+	if err != nil {
+		t.Fatalf("create user: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("create user: expected 201, got %d", resp.StatusCode)
+	}
+
+	var created struct {
+		ID uint `json:"id"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&created); err != nil {
+		t.Fatalf("decode create user: %v", err)
+	}
+	return created.ID
+}
+
+func TestExportPersonalData_Success(t *testing.T) {
+	t.Cleanup(func() {
+		truncatePrivacy(t)
+		truncateUsers(t)
+	})
+
+	userID := createUser(t, "Carla", "carla@example.com")
+
+	resp := privacyRequest(t, http.MethodGet, fmt.Sprintf("/api/v1/privacy/users/%d/export", userID), userID, "")
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+	if cd := resp.Header.Get("Content-Disposition"); !strings.Contains(cd, "attachment") {
+		t.Fatalf("expected attachment Content-Disposition, got %q", cd)
+	}
+
+	var archive struct {
+		SubjectID uint `json:"subject_id"`
+		Modules   struct {
+			Users struct {
+				Email string `json:"email"`
+			} `json:"users"`
+		} `json:"modules"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&archive); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+
+	if archive.SubjectID != userID {
+		t.Fatalf("expected subject_id=%d, got %d", userID, archive.SubjectID)
+	}
+	if archive.Modules.Users.Email != "carla@example.com" {
+		t.Fatalf("expected exported email=carla@example.com, got %q", archive.Modules.Users.Email)
+	}
+}
+
+func TestExportPersonalData_UserNotFound(t *testing.T) {
+	resp := privacyRequest(t, http.MethodGet, "/api/v1/privacy/users/999999/export", testPrivacyAdminID, "")
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected 404, got %d", resp.StatusCode)
+	}
+}
+
+func TestPrivacy_OnlyTheUserOrAnAdministrator(t *testing.T) {
+	t.Cleanup(func() {
+		truncatePrivacy(t)
+		truncateUsers(t)
+	})
+
+	userID := createUser(t, "Elisa", "elisa@example.com")
+	otherID := createUser(t, "Fabio", "fabio@example.com")
+	exportPath := fmt.Sprintf("/api/v1/privacy/users/%d/export", userID)
+	erasurePath := fmt.Sprintf("/api/v1/privacy/users/%d/erasure", userID)
+
+	anonymous := privacyRequest(t, http.MethodGet, exportPath, 0, "")
+	anonymous.Body.Close()
+	if anonymous.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected 401 without a session, got %d", anonymous.StatusCode)
+	}
+
+	export := privacyRequest(t, http.MethodGet, exportPath, otherID, "")
+	export.Body.Close()
+	if export.StatusCode != http.StatusForbidden {
+		t.Fatalf("expected 403 exporting another user, got %d", export.StatusCode)
+	}
+
+	erasure := privacyRequest(t, http.MethodPost, erasurePath, otherID, "")
+	erasure.Body.Close()
+	if erasure.StatusCode != http.StatusForbidden {
+		t.Fatalf("expected 403 erasing another user, got %d", erasure.StatusCode)
+	}
+
+	admin := privacyRequest(t, http.MethodGet, exportPath, testPrivacyAdminID, "")
+	admin.Body.Close()
+	if admin.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200 for a privacy administrator, got %d", admin.StatusCode)
+	}
+}
+
+func TestRequestErasure_UserNotFound(t *testing.T) {
+	resp := privacyRequest(t, http.MethodPost, "/api/v1/privacy/users/999999/erasure", testPrivacyAdminID, "")
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected 404, got %d", resp.StatusCode)
+	}
+}
+
+func TestRequestErasure_ThenCancel(t *testing.T) {
+	t.Cleanup(func() {
+		truncatePrivacy(t)
+		truncateUsers(t)
+	})
+
+	userID := createUser(t, "Diego", "diego@example.com")
+
+	erasureResp := privacyRequest(t, http.MethodPost, fmt.Sprintf("/api/v1/privacy/users/%d/erasure", userID), userID,
+		`{"reason":"account closed"}`)
+	defer erasureResp.Body.Close()
+
+	if erasureResp.StatusCode != http.StatusAccepted {
+		t.Fatalf("expected 202, got %d", erasureResp.StatusCode)
+	}
+
+	var erasure struct {
+		ID     uint   `json:"id"`
+		Status string `json:"status"`
+	}
+	if err := json.NewDecoder(erasureResp.Body).Decode(&erasure); err != nil {
+		t.Fatalf("decode erasure: %v", err)
+	}
+	if erasure.Status != "pending" {
+		t.Fatalf("expected status=pending, got %q", erasure.Status)
+	}
+
+	duplicateResp := privacyRequest(t, http.MethodPost, fmt.Sprintf("/api/v1/privacy/users/%d/erasure", userID), userID, "")
+	duplicateResp.Body.Close()
+	if duplicateResp.StatusCode != http.StatusUnprocessableEntity {
+		t.Fatalf("expected 422 for duplicate erasure, got %d", duplicateResp.StatusCode)
+	}
+
+	certResp := privacyRequest(t, http.MethodGet, fmt.Sprintf("/api/v1/privacy/erasures/%d/certificate", erasure.ID), userID, "")
+	certResp.Body.Close()
+	if certResp.StatusCode != http.StatusUnprocessableEntity {
+		t.Fatalf("expected 422 for pending certificate, got %d", certResp.StatusCode)
+	}
+
+	cancelResp := privacyRequest(t, http.MethodPost, fmt.Sprintf("/api/v1/privacy/erasures/%d/cancel", erasure.ID), userID, "")
+	defer cancelResp.Body.Close()
+
+	if cancelResp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", cancelResp.StatusCode)
+	}
+
+	getResp := privacyRequest(t, http.MethodGet, fmt.Sprintf("/api/v1/privacy/erasures/%d", erasure.ID), userID, "")
+	defer getResp.Body.Close()
+
+	var current struct {
+		Status string `json:"status"`
+	}
+	if err := json.NewDecoder(getResp.Body).Decode(&current); err != nil {
+		t.Fatalf("decode get erasure: %v", err)
+	}
+	if current.Status != "cancelled" {
+		t.Fatalf("expected status=cancelled, got %q", current.Status)
+	}
+}
+
+func TestCancelErasure_AfterTheJobClaimedIt(t *testing.T) {
+	t.Cleanup(func() {
+		truncatePrivacy(t)
+		truncateUsers(t)
+	})
+
+	userID := createUser(t, "Elisa", "elisa@example.com")
+
+	erasureResp := privacyRequest(t, http.MethodPost, fmt.Sprintf("/api/v1/privacy/users/%d/erasure", userID), userID, "")
+	defer erasureResp.Body.Close()
+
+	var erasure struct {
+		ID uint `json:"id"`
+	}
+	if err := json.NewDecoder(erasureResp.Body).Decode(&erasure); err != nil {
+		t.Fatalf("decode erasure: %v", err)
+	}
+
+	ctx := tenancy.WithTenantID(context.Background(), "default")
+	now := time.Now().UTC()
+	claimed, err := privacypersistence.NewGORMErasureRequestRepository(appDB).Claim(ctx, erasure.ID, now, now.Add(-time.Hour))
+	if err != nil || !claimed {
+		t.Fatalf("claim: %v %v", claimed, err)
+	}
+
+	cancelResp := privacyRequest(t, http.MethodPost, fmt.Sprintf("/api/v1/privacy/erasures/%d/cancel", erasure.ID), userID, "")
+	cancelResp.Body.Close()
+	if cancelResp.StatusCode != http.StatusUnprocessableEntity {
+		t.Fatalf("expected 422 for a claimed erasure, got %d", cancelResp.StatusCode)
+	}
+
+	getResp := privacyRequest(t, http.MethodGet, fmt.Sprintf("/api/v1/privacy/erasures/%d", erasure.ID), userID, "")
+	defer getResp.Body.Close()
+
+	var current struct {
+		Status string `json:"status"`
+	}
+	if err := json.NewDecoder(getResp.Body).Decode(&current); err != nil {
+		t.Fatalf("decode get erasure: %v", err)
+	}
+	if current.Status != "processing" {
+		t.Fatalf("expected the claim to stand, got status=%q", current.Status)
+	}
+}
@@ -0,0 +1,82 @@
+package integration
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"golang_boilerplate_module/internal/modules/users/infra/userspersistence"
+	"golang_boilerplate_module/internal/modules/users/usersdomain"
+	"golang_boilerplate_module/internal/modules/users/usersdomain/usersrepo"
+	"golang_boilerplate_module/internal/shared/domain/bus"
+	"golang_boilerplate_module/internal/shared/domain/exceptions"
+	"golang_boilerplate_module/internal/shared/domain/tenancy"
+	"golang_boilerplate_module/internal/shared/infra/persistence"
+	"golang_boilerplate_module/internal/test/conformance"
+
+	"gorm.io/gorm"
+)
+
+func createConformanceTenant(t *testing.T) {
+	t.Helper()
+	db := openTestDB(t)
+	if _, err := db.Exec("INSERT INTO tenants (id, name) VALUES ($1, $1) ON CONFLICT (id) DO NOTHING", conformance.OtherTenant); err != nil {
+		t.Fatalf("create tenant: %v", err)
+	}
+}
+
+func TestUserRepositories_Conformance(t *testing.T) {
+	createConformanceTenant(t)
+	t.Cleanup(func() {
+		truncateUsers(t)
+		truncateTenants(t)
+	})
+
+	implementations := []struct {
+		name    string
+		newRepo func(db *gorm.DB) usersrepo.UserRepository
+	}{
+		{"gorm", userspersistence.NewGORMUserRepository},
+		{"pgx", userspersistence.NewPgxUserRepository},
+	}
+	for _, implementation := range implementations {
+		t.Run(implementation.name, func(t *testing.T) {
+			if implementation.name == "pgx" {
+				requirePostgres(t)
+			}
+			conformance.RunUserRepository(t, func(t *testing.T) conformance.UserRepository {
+				truncateUsers(t)
+				return implementation.newRepo(appDB).(conformance.UserRepository)
+			})
+		})
+	}
+}
+
+func TestPgxUserRepository_JoinsTheCommandTransaction(t *testing.T) {
+	requirePostgres(t)
+	truncateUsers(t)
+
+	repo := userspersistence.NewPgxUserRepository(appDB)
+	ctx := tenancy.WithTenantID(context.Background(), conformance.Tenant)
+	errRollback := errors.New("rollback")
+
+	transaction := persistence.TransactionBehavior(appDB)
+	_, err := transaction(ctx, bus.Request{Kind: bus.KindCommand, Transactional: true}, func(ctx context.Context) (any, error) {
+		if _, err := repo.Add(ctx, &usersdomain.User{Name: "Ada", Email: "ada@example.com"}); err != nil {
+			return nil, err
+		}
+		if _, err := repo.GetByEmail(ctx, "ada@example.com"); err != nil {
+			return nil, err
+		}
+		return nil, errRollback
+	})
+	if !errors.Is(err, errRollback) {
+		t.Fatalf("expected the handler's error, got %v", err)
+	}
+
+	_, err = repo.GetByEmail(ctx, "ada@example.com")
+	var domainErr *exceptions.DomainError
+	if !errors.As(err, &domainErr) || domainErr.Code != exceptions.CodeNotFound {
+		t.Fatalf("expected the insert to be rolled back, got %v", err)
+	}
+}
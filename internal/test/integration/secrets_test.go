@@ -0,0 +1,208 @@
+package integration
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"golang_boilerplate_module/internal/bootstrap"
+	"golang_boilerplate_module/internal/config"
+	"golang_boilerplate_module/internal/config/secrets"
+)
+
+const mockVaultToken = "integration-token"
+
+// mockVault serves a KV v2 entry, a leased dynamic secret and lease renewal
+// like the Vault HTTP API.
+type mockVault struct {
+	*httptest.Server
+
+	mu      sync.Mutex
+	renewed []map[string]any
+}
+
+func startMockVault(databaseURL string) *mockVault {
+	v := &mockVault{}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/secret/data/api", func(w http.ResponseWriter, r *http.Request) {
+		v.reply(w, r, map[string]any{
+			"data": map[string]any{
+				"data":     map[string]any{"database": map[string]any{"url": databaseURL}},
+				"metadata": map[string]any{"version": 3},
+			},
+		})
+	})
+	mux.HandleFunc("GET /v1/database/creds/api", func(w http.ResponseWriter, r *http.Request) {
+		v.reply(w, r, map[string]any{
+			"lease_id":       "database/creds/api/abc123",
+			"lease_duration": 60,
+			"renewable":      true,
+			"data":           map[string]any{"database.url": databaseURL},
+		})
+	})
+	mux.HandleFunc("PUT /v1/sys/leases/renew", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		v.mu.Lock()
+		v.renewed = append(v.renewed, body)
+		v.mu.Unlock()
+		v.reply(w, r, map[string]any{"lease_id": body["lease_id"], "lease_duration": 120, "renewable": true})
+	})
+	v.Server = httptest.NewServer(mux)
+	return v
+}
+
+func (v *mockVault) reply(w http.ResponseWriter, r *http.Request, body map[string]any) {
+	w.Header().Set("Content-Type", "application/json")
+	if r.Header.Get("X-Vault-Token") != mockVaultToken {
+		w.WriteHeader(http.StatusForbidden)
+		_ = json.NewEncoder(w).Encode(map[string]any{"errors": []string{"permission denied"}})
+		return
+	}
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func TestSecrets_VaultBackendLoadsKVEntry(t *testing.T) {
+	vault := startMockVault(dbURL)
+	defer vault.Close()
+
+	t.Setenv("DATABASE_URL", "")
+	t.Setenv("SECRETS_BACKEND", "vault")
+	t.Setenv("VAULT_ADDR", vault.URL)
+	t.Setenv("VAULT_TOKEN", mockVaultToken)
+	t.Setenv("VAULT_SECRET_PATH", "secret/data/api")
+
+	cfg, err := bootstrap.Config()
+	if err != nil {
+		t.Fatalf("config: %v", err)
+	}
+	if cfg.Database.URL != dbURL {
+		t.Fatalf("expected the database URL from vault, got %q", cfg.Database.URL)
+	}
+	if origin := cfg.Origin("database.url"); origin != "vault" {
+		t.Fatalf("expected origin vault, got %q", origin)
+	}
+	if !slices.Contains(cfg.SecretValues(), dbURL) {
+		t.Fatal("expected the database URL to be redacted from logs")
+	}
+	// A SQLite URL has no password to mask.
+	for _, setting := range cfg.Settings() {
+		if strings.Contains(setting.Value, mockVaultToken) || (setting.Value == dbURL && !onSQLite()) {
+			t.Fatalf("expected %s to be masked, got %q", setting.Key, setting.Value)
+		}
+	}
+}
+
+func TestSecrets_VaultBackendRejectsInvalidToken(t *testing.T) {
+	vault := startMockVault(dbURL)
+	defer vault.Close()
+
+	t.Setenv("SECRETS_BACKEND", "vault")
+	t.Setenv("VAULT_ADDR", vault.URL)
+	t.Setenv("VAULT_TOKEN", "wrong")
+	t.Setenv("VAULT_SECRET_PATH", "secret/data/api")
+
+	_, err := bootstrap.Config()
+	if err == nil || !strings.Contains(err.Error(), "permission denied") {
+		t.Fatalf("expected permission denied, got %v", err)
+	}
+}
+
+func TestSecrets_VaultLeaseIsRenewed(t *testing.T) {
+	vault := startMockVault(dbURL)
+	defer vault.Close()
+
+	t.Setenv("DATABASE_URL", "")
+	store := secrets.NewVaultStore(secrets.VaultOptions{
+		Address: vault.URL,
+		Token:   mockVaultToken,
+		Path:    "database/creds/api",
+		Timeout: time.Second,
+	})
+
+	cfg, err := bootstrap.Config(config.WithSecretStore(store))
+	if err != nil {
+		t.Fatalf("config: %v", err)
+	}
+	if cfg.Database.URL != dbURL {
+		t.Fatalf("expected the database URL from the leased secret, got %q", cfg.Database.URL)
+	}
+	if lease := store.Lease(); lease != time.Minute {
+		t.Fatalf("expected a 1m lease, got %s", lease)
+	}
+
+	lease, err := store.Renew(t.Context())
+	if err != nil {
+		t.Fatalf("renew: %v", err)
+	}
+	if lease != 2*time.Minute {
+		t.Fatalf("expected the renewed lease to last 2m, got %s", lease)
+	}
+	if len(vault.renewed) != 1 || vault.renewed[0]["lease_id"] != "database/creds/api/abc123" {
+		t.Fatalf("expected one renewal of the lease, got %v", vault.renewed)
+	}
+}
+
+func TestSecrets_FileVariantReadsMountedSecret(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "database_url")
+	if err := os.WriteFile(path, []byte(dbURL+"\n"), 0o600); err != nil {
+		t.Fatalf("write secret: %v", err)
+	}
+
+	t.Setenv("DATABASE_URL", "")
+	t.Setenv("DATABASE_URL_FILE", path)
+
+	cfg, err := bootstrap.Config()
+	if err != nil {
+		t.Fatalf("config: %v", err)
+	}
+	if cfg.Database.URL != dbURL {
+		t.Fatalf("expected the database URL from the file, got %q", cfg.Database.URL)
+	}
+	if origin := cfg.Origin("database.url"); origin != "env-file" {
+		t.Fatalf("expected origin env-file, got %q", origin)
+	}
+}
+
+func TestSecrets_EncryptedFileBackend(t *testing.T) {
+	key, err := secrets.GenerateKey()
+	if err != nil {
+		t.Fatalf("keygen: %v", err)
+	}
+	parsed, _ := secrets.ParseKey(key)
+	sealed, err := secrets.Encrypt([]byte("database:\n  url: "+dbURL+"\n"), parsed)
+	if err != nil {
+		t.Fatalf("encrypt: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "secrets.enc")
+	if err := os.WriteFile(path, sealed, 0o600); err != nil {
+		t.Fatalf("write secrets: %v", err)
+	}
+
+	t.Setenv("DATABASE_URL", "")
+	t.Setenv("SECRETS_BACKEND", "file")
+	t.Setenv("SECRETS_FILE", path)
+	t.Setenv("SECRETS_KEY", key)
+
+	cfg, err := bootstrap.Config()
+	if err != nil {
+		t.Fatalf("config: %v", err)
+	}
+	if cfg.Database.URL != dbURL {
+		t.Fatalf("expected the database URL from the secrets file, got %q", cfg.Database.URL)
+	}
+
+	other, _ := secrets.GenerateKey()
+	t.Setenv("SECRETS_KEY", other)
+	if _, err := bootstrap.Config(); err == nil || !strings.Contains(err.Error(), "wrong key") {
+		t.Fatalf("expected a wrong key error, got %v", err)
+	}
+}
@@ -0,0 +1,151 @@
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"golang_boilerplate_module/internal/modules/sessions/application/sessionsusecases"
+	"golang_boilerplate_module/internal/shared/domain/tenancy"
+)
+
+func openSession(t *testing.T, userID uint) sessionsusecases.CreatedSession {
+	t.Helper()
+
+	ctx := tenancy.WithTenantID(context.Background(), "default")
+	created, err := createSession.Execute(ctx, sessionsusecases.CreateSessionInput{UserID: userID, UserAgent: "integration"})
+	if err != nil {
+		t.Fatalf("create session: %v", err)
+	}
+	return created
+}
+
+func sessionRequest(t *testing.T, method, path string, session sessionsusecases.CreatedSession, csrf string) *http.Response {
+	t.Helper()
+
+	req, _ := http.NewRequest(method, path, nil)
+	req.AddCookie(&http.Cookie{Name: "session_id", Value: session.Token})
+	if csrf != "" {
+		req.Header.Set("X-CSRF-Token", csrf)
+	}
+
+	resp, err := request(req)
+	if err != nil {
+		t.Fatalf("%s %s: %v", method, path, err)
+	}
+	return resp
+}
+
+func TestSessions_CookieAuthenticatesRequests(t *testing.T) {
+	t.Cleanup(func() {
+		truncateSessions(t)
+		truncateUsers(t)
+	})
+
+	userID := createUser(t, "Sara", "sara@example.com")
+	session := openSession(t, userID)
+
+	resp := sessionRequest(t, http.MethodGet, "/api/v1/sessions", session, "")
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+
+	var sessions []struct {
+		ID      string `json:"id"`
+		Current bool   `json:"current"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&sessions); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if len(sessions) != 1 || !sessions[0].Current || sessions[0].ID != session.Session.ID {
+		t.Fatalf("expected the current session to be listed, got %+v", sessions)
+	}
+}
+
+func TestSessions_UnknownCookieIsRejected(t *testing.T) {
+	resp := sessionRequest(t, http.MethodGet, "/api/v1/sessions", sessionsusecases.CreatedSession{Token: "bogus"}, "")
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected 401, got %d", resp.StatusCode)
+	}
+}
+
+func TestSessions_CSRFRequiredOnUnsafeMethods(t *testing.T) {
+	t.Cleanup(func() {
+		truncateSessions(t)
+		truncateUsers(t)
+	})
+
+	userID := createUser(t, "Sara", "sara@example.com")
+	session := openSession(t, userID)
+
+	missing := sessionRequest(t, http.MethodPost, "/api/v1/sessions/revoke-others", session, "")
+	defer missing.Body.Close()
+	if missing.StatusCode != http.StatusForbidden {
+		t.Fatalf("without csrf: expected 403, got %d", missing.StatusCode)
+	}
+
+	wrong := sessionRequest(t, http.MethodPost, "/api/v1/sessions/revoke-others", session, "not-the-token")
+	defer wrong.Body.Close()
+	if wrong.StatusCode != http.StatusForbidden {
+		t.Fatalf("wrong csrf: expected 403, got %d", wrong.StatusCode)
+	}
+
+	ok := sessionRequest(t, http.MethodPost, "/api/v1/sessions/revoke-others", session, session.CSRFToken)
+	defer ok.Body.Close()
+	if ok.StatusCode != http.StatusOK {
+		t.Fatalf("with csrf: expected 200, got %d", ok.StatusCode)
+	}
+}
+
+func TestSessions_LogOutOtherDevices(t *testing.T) {
+	t.Cleanup(func() {
+		truncateSessions(t)
+		truncateUsers(t)
+	})
+
+	userID := createUser(t, "Sara", "sara@example.com")
+	laptop := openSession(t, userID)
+	phone := openSession(t, userID)
+
+	resp := sessionRequest(t, http.MethodPost, "/api/v1/sessions/revoke-others", laptop, laptop.CSRFToken)
+	resp.Body.Close()
+
+	stillIn := sessionRequest(t, http.MethodGet, "/api/v1/sessions", laptop, "")
+	defer stillIn.Body.Close()
+	if stillIn.StatusCode != http.StatusOK {
+		t.Fatalf("current device: expected 200, got %d", stillIn.StatusCode)
+	}
+
+	loggedOut := sessionRequest(t, http.MethodGet, "/api/v1/sessions", phone, "")
+	defer loggedOut.Body.Close()
+	if loggedOut.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("other device: expected 401, got %d", loggedOut.StatusCode)
+	}
+}
+
+func TestSessions_Logout(t *testing.T) {
+	t.Cleanup(func() {
+		truncateSessions(t)
+		truncateUsers(t)
+	})
+
+	userID := createUser(t, "Sara", "sara@example.com")
+	session := openSession(t, userID)
+
+	resp := sessionRequest(t, http.MethodDelete, "/api/v1/sessions/current", session, session.CSRFToken)
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("logout: expected 204, got %d", resp.StatusCode)
+	}
+
+	after := sessionRequest(t, http.MethodGet, "/api/v1/sessions", session, "")
+	defer after.Body.Close()
+	if after.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("after logout: expected 401, got %d", after.StatusCode)
+	}
+}
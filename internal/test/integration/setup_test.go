@@ -8,6 +8,9 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -17,21 +20,125 @@ import (
 	"github.com/testcontainers/testcontainers-go/wait"
 
 	"golang_boilerplate_module/internal/bootstrap"
+	"golang_boilerplate_module/internal/config"
+	"golang_boilerplate_module/internal/modules/sessions/application/sessionsusecases"
 	"golang_boilerplate_module/internal/shared/domain/providers"
+	"golang_boilerplate_module/internal/shared/infra/grpcserver"
+	"golang_boilerplate_module/internal/shared/infra/http/middleware"
+	"golang_boilerplate_module/internal/shared/infra/http/openapi"
+	"golang_boilerplate_module/internal/shared/infra/persistence"
+	"golang_boilerplate_module/migrations"
 
+	gormsqlite "github.com/glebarez/sqlite"
 	"github.com/gofiber/fiber/v2"
 	"go.uber.org/fx"
 	"go.uber.org/fx/fxtest"
+	gormpostgres "gorm.io/driver/postgres"
+	"gorm.io/gorm"
 )
 
+const testUserIDHeader = "X-Test-User-ID"
+
 var (
-	fiberApp *fiber.App
-	dbURL    string
+	fiberApp      *fiber.App
+	dbURL         string
+	createSession *sessionsusecases.CreateSessionUseCase
+	contract      *openapi.Validator
+	grpcServer    *grpcserver.Server
+	reloader      *config.Reloader
+	featureFlags  providers.FeatureFlags
+	appDB         *gorm.DB
 )
 
 func TestMain(m *testing.M) {
 	ctx := context.Background()
 
+	var stopDatabase func()
+	if onSQLite() {
+		dbURL, stopDatabase = createSQLite()
+	} else {
+		dbURL, stopDatabase = startPostgres(ctx)
+	}
+	defer stopDatabase()
+
+	if err := applyMigrations(); err != nil {
+		panic("migrations: " + err.Error())
+	}
+
+	os.Setenv("DATABASE_URL", dbURL)
+	os.Setenv("SERVICE_NAME", "boilerplate-api-test")
+	os.Setenv("PORT", "3000")
+	os.Setenv("APP_ENV", "test")
+	os.Setenv("LOG_LEVEL", "error")
+	os.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "") 
+
+	os.Setenv("GRPC_PORT", "0")
+	os.Setenv("GRAPHQL_MAX_DEPTH", "4")
+	os.Setenv("GRAPHQL_MAX_COMPLEXITY", "200")
+
+	os.Setenv("API_VERSIONS", "v1,v2")
+	os.Setenv("API_DEFAULT_VERSION", "v1")
+	os.Setenv("API_V1_DEPRECATED_AT", testV1DeprecatedAt)
+	os.Setenv("API_V1_SUNSET_AT", testV1SunsetAt)
+
+	oidcProvider = startMockOIDCProvider()
+	defer oidcProvider.Close()
+
+	os.Setenv("TENANCY_RESOLVERS", "header,session")
+	os.Setenv("FEATURE_FLAGS_ADMINS", testFlagAdminID)
+	os.Setenv("PRIVACY_ADMINS", fmt.Sprint(testPrivacyAdminID))
+	os.Setenv("USERS_ADMINS", fmt.Sprint(testUsersAdminID))
+	os.Setenv("TENANCY_ADMINS", fmt.Sprint(testTenantAdminID))
+
+	os.Setenv("DATABASE_STATEMENT_TIMEOUT", testStatementTimeout)
+	if !onSQLite() {
+		// The primary doubles as a replica, so reads take the replica path.
+		os.Setenv("DATABASE_REPLICA_URLS", dbURL)
+	}
+
+	os.Setenv("OIDC_PROVIDERS", "mock")
+	os.Setenv("OIDC_MOCK_ISSUER", oidcProvider.URL)
+	os.Setenv("OIDC_MOCK_CLIENT_ID", mockOIDCClientID)
+	os.Setenv("OIDC_MOCK_CLIENT_SECRET", "secret")
+	os.Setenv("OIDC_MOCK_REDIRECT_URL", "http://localhost:3000/api/auth/oidc/mock/callback")
+
+	app := fxtest.New(
+		&testing.T{},
+		bootstrap.App(),
+		fx.Decorate(withTestAuthentication),
+		fx.Invoke(registerContractDriftRoute),
+		fx.Invoke(registerVersionProbeRoutes),
+		fx.Invoke(func(app *fiber.App, sessions *sessionsusecases.CreateSessionUseCase, validator *openapi.Validator, server *grpcserver.Server, r *config.Reloader, flags providers.FeatureFlags, db *gorm.DB) {
+			fiberApp = app
+			createSession = sessions
+			contract = validator
+			grpcServer = server
+			reloader = r
+			featureFlags = flags
+			appDB = db
+		}),
+	)
+	app.RequireStart()
+	defer app.RequireStop()
+
+	os.Exit(m.Run())
+}
+
+// onSQLite tells whether INTEGRATION_DATABASE=sqlite runs the suite on a
+// SQLite file instead of a PostgreSQL container.
+func onSQLite() bool {
+	return os.Getenv("INTEGRATION_DATABASE") == config.DialectSQLite
+}
+
+// requirePostgres skips tests of what only PostgreSQL has.
+func requirePostgres(t *testing.T) {
+	t.Helper()
+	if onSQLite() {
+		t.Skip("requires PostgreSQL")
+	}
+}
+
+func startPostgres(ctx context.Context) (string, func()) {
 	pgContainer, err := postgres.Run(ctx,
 		"postgres:17-alpine",
 		postgres.WithDatabase("boilerplate"),
@@ -46,68 +153,128 @@ func TestMain(m *testing.M) {
 	if err != nil {
 		panic("start postgres: " + err.Error())
 	}
-	defer func() { _ = pgContainer.Terminate(ctx) }()
 
-	dbURL, err = pgContainer.ConnectionString(ctx, "sslmode=disable")
+	url, err := pgContainer.ConnectionString(ctx, "sslmode=disable")
 	if err != nil {
 		panic("postgres connection string: " + err.Error())
 	}
+	return url, func() { _ = pgContainer.Terminate(ctx) }
+}
 
-	if err := applyMigrations(dbURL); err != nil {
-		panic("migrations: " + err.Error())
+func createSQLite() (string, func()) {
+	dir, err := os.MkdirTemp("", "integration")
+	if err != nil {
+		panic("sqlite directory: " + err.Error())
 	}
+	return "sqlite://" + filepath.Join(dir, "integration.db"), func() { _ = os.RemoveAll(dir) }
+}
 
-	os.Setenv("DATABASE_URL", dbURL)
-	os.Setenv("SERVICE_NAME", "boilerplate-api-test")
-	os.Setenv("PORT", "3000")
-	os.Setenv("APP_ENV", "test")
-	os.Setenv("LOG_LEVEL", "error")
-	os.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "") 
+// sqliteDSN is the driver DSN of the SQLite dbURL.
+func sqliteDSN() string {
+	return "file:" + strings.TrimPrefix(dbURL, "sqlite://") + "?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)"
+}
 
-	app := fxtest.New(
-		&testing.T{},
-		bootstrap.App,
-		fx.Invoke(func(app *fiber.App, _ providers.LoggerProvider) {
-			fiberApp = app
-		}),
-	)
-	app.RequireStart()
-	defer app.RequireStop()
+func withTestAuthentication(app *fiber.App) *fiber.App {
+	app.Use(func(c *fiber.Ctx) error {
+		if userID, err := strconv.ParseUint(c.Get(testUserIDHeader), 10, 64); err == nil {
+			middleware.SetCurrentUserID(c, uint(userID))
+		}
+		return c.Next()
+	})
+	return app
+}
 
-	os.Exit(m.Run())
+// openGorm opens the test database without the application's plugins.
+func openGorm() (*gorm.DB, error) {
+	if onSQLite() {
+		return gorm.Open(gormsqlite.Open(sqliteDSN()), &gorm.Config{})
+	}
+	return gorm.Open(gormpostgres.Open(dbURL), &gorm.Config{})
 }
 
-func applyMigrations(url string) error {
-	db, err := sql.Open("postgres", url)
+func applyMigrations() error {
+	db, err := openGorm()
 	if err != nil {
 		return fmt.Errorf("open: %w", err)
 	}
-	defer db.Close()
-
-	_, err = db.Exec(`
-		CREATE TABLE IF NOT EXISTS users (
-			id         SERIAL PRIMARY KEY,
-			name       VARCHAR(255) NOT NULL,
-			email      VARCHAR(255) NOT NULL UNIQUE,
-			created_at TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
-			updated_at TIMESTAMPTZ  NOT NULL DEFAULT NOW()
-		)
-	`)
+	defer persistence.CloseDB(db)
+
+	_, err = persistence.NewMigrator(db, migrations.FS).Up(context.Background())
 	return err
 }
 
-func truncateUsers(t *testing.T) {
+// openTestDB opens a connection of its own to the test database.
+func openTestDB(t *testing.T) *sql.DB {
 	t.Helper()
-	db, err := sql.Open("postgres", dbURL)
+	driver, dsn := "postgres", dbURL
+	if onSQLite() {
+		driver, dsn = "sqlite", sqliteDSN()
+	}
+	db, err := sql.Open(driver, dsn)
 	if err != nil {
-		t.Fatalf("truncate open: %v", err)
+		t.Fatalf("open: %v", err)
 	}
-	defer db.Close()
-	if _, err := db.Exec("TRUNCATE TABLE users RESTART IDENTITY CASCADE"); err != nil {
+	t.Cleanup(func() { _ = db.Close() })
+	return db
+}
+
+// truncate empties the tables, and the rows referencing theirs, and
+// restarts their ids.
+func truncate(t *testing.T, tables ...string) {
+	t.Helper()
+	db := openTestDB(t)
+	statement := "TRUNCATE TABLE " + strings.Join(tables, ", ") + " RESTART IDENTITY CASCADE"
+	if onSQLite() {
+		statement = ""
+		for _, table := range tables {
+			statement += "DELETE FROM " + table + "; DELETE FROM sqlite_sequence WHERE name = '" + table + "'; "
+		}
+	}
+	if _, err := db.Exec(statement); err != nil {
 		t.Fatalf("truncate: %v", err)
 	}
 }
 
+func truncateUsers(t *testing.T) {
+	t.Helper()
+	truncate(t, "users")
+}
+
 func request(req *http.Request) (*http.Response, error) {
-	return fiberApp.Test(req, 10_000)
-}
\ No newline at end of file
+	resp, err := fiberApp.Test(req, 10_000)
+	if err != nil {
+		return nil, err
+	}
+	if err := contract.CheckResponse(req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func truncatePrivacy(t *testing.T) {
+	t.Helper()
+	truncate(t, "privacy_audit_records", "privacy_erasure_requests")
+}
+
+func truncateTenants(t *testing.T) {
+	t.Helper()
+	db := openTestDB(t)
+	if _, err := db.Exec("DELETE FROM tenants WHERE id <> 'default'"); err != nil {
+		t.Fatalf("truncate: %v", err)
+	}
+}
+
+func truncateOrganizations(t *testing.T) {
+	t.Helper()
+	truncate(t, "organizations")
+}
+
+func truncateSessions(t *testing.T) {
+	t.Helper()
+	truncate(t, "sessions")
+}
+
+func truncateIdentities(t *testing.T) {
+	t.Helper()
+	truncate(t, "external_identities", "oidc_login_states")
+}
@@ -0,0 +1,232 @@
+package integration
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fasthttp/websocket"
+)
+
+type streamEvent struct {
+	ID    uint64
+	Event string
+	Data  struct {
+		Type string `json:"type"`
+		User struct {
+			ID    uint   `json:"id"`
+			Name  string `json:"name"`
+			Email string `json:"email"`
+		} `json:"user"`
+	}
+}
+
+// testStreamUserID is the user the streams are opened as.
+const testStreamUserID = 1
+
+// Streams need a real connection; fiber's app.Test buffers the whole body.
+func streamBaseURL() string {
+	return "127.0.0.1:" + os.Getenv("PORT")
+}
+
+func openUserStream(t *testing.T, query string, lastEventID uint64) *bufio.Reader {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "http://"+streamBaseURL()+"/api/v1/users/stream"+query, nil)
+	req.Header.Set(testUserIDHeader, strconv.Itoa(testStreamUserID))
+	if lastEventID != 0 {
+		req.Header.Set("Last-Event-ID", strconv.FormatUint(lastEventID, 10))
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("open stream: %v", err)
+	}
+	t.Cleanup(func() { resp.Body.Close() })
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+	if ct := resp.Header.Get("Content-Type"); ct != "text/event-stream" {
+		t.Fatalf("expected text/event-stream, got %q", ct)
+	}
+
+	reader := bufio.NewReader(resp.Body)
+	// The retry hint is flushed once the subscription is in place.
+	if line, err := reader.ReadString('\n'); err != nil || !strings.HasPrefix(line, "retry:") {
+		t.Fatalf("expected retry hint, got %q (%v)", line, err)
+	}
+	return reader
+}
+
+func nextStreamEvent(t *testing.T, reader *bufio.Reader) streamEvent {
+	t.Helper()
+
+	result := make(chan streamEvent, 1)
+	failure := make(chan error, 1)
+	go func() {
+		var event streamEvent
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				failure <- err
+				return
+			}
+			line = strings.TrimRight(line, "\n")
+			switch {
+			case strings.HasPrefix(line, "id: "):
+				event.ID, _ = strconv.ParseUint(strings.TrimPrefix(line, "id: "), 10, 64)
+			case strings.HasPrefix(line, "event: "):
+				event.Event = strings.TrimPrefix(line, "event: ")
+			case strings.HasPrefix(line, "data: "):
+				if err := json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &event.Data); err != nil {
+					failure <- err
+					return
+				}
+			case line == "" && event.Event != "":
+				result <- event
+				return
+			}
+		}
+	}()
+
+	select {
+	case event := <-result:
+		return event
+	case err := <-failure:
+		t.Fatalf("read stream: %v", err)
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for a stream event")
+	}
+	return streamEvent{}
+}
+
+func TestUserStream_ReceivesChanges(t *testing.T) {
+	t.Cleanup(func() { truncateUsers(t) })
+
+	stream := openUserStream(t, "", 0)
+	id := createUser(t, "Streamed", "streamed@example.com")
+
+	event := nextStreamEvent(t, stream)
+	if event.Event != "created" || event.Data.Type != "created" {
+		t.Fatalf("expected a created event, got %q", event.Event)
+	}
+	if event.Data.User.ID != id || event.Data.User.Email != "streamed@example.com" {
+		t.Fatalf("unexpected user in event: %+v", event.Data.User)
+	}
+	if event.ID == 0 {
+		t.Fatal("expected the event to carry an ID")
+	}
+}
+
+func TestUserStream_ResumesFromLastEventID(t *testing.T) {
+	t.Cleanup(func() { truncateUsers(t) })
+
+	stream := openUserStream(t, "", 0)
+	createUser(t, "First", "first-resume@example.com")
+	first := nextStreamEvent(t, stream)
+
+	secondID := createUser(t, "Second", "second-resume@example.com")
+	nextStreamEvent(t, stream)
+
+	resumed := openUserStream(t, "", first.ID)
+	event := nextStreamEvent(t, resumed)
+	if event.Data.User.ID != secondID {
+		t.Fatalf("expected replay to start at user %d, got %d", secondID, event.Data.User.ID)
+	}
+}
+
+func TestUserStream_FiltersByType(t *testing.T) {
+	t.Cleanup(func() { truncateUsers(t) })
+
+	stream := openUserStream(t, "?types=updated", 0)
+	id := createUser(t, "Filtered", "filtered@example.com")
+
+	result := postGraphQLAs(t, id, map[string]any{
+		"query":     `mutation($id: ID!) { updateUser(id: $id, input: {name: "Renamed"}) { id } }`,
+		"variables": map[string]any{"id": strconv.FormatUint(uint64(id), 10)},
+	})
+	if len(result.Errors) > 0 {
+		t.Fatalf("update: %v", result.Errors[0].Message)
+	}
+
+	event := nextStreamEvent(t, stream)
+	if event.Event != "updated" || event.Data.User.Name != "Renamed" {
+		t.Fatalf("expected only the updated event, got %q", event.Event)
+	}
+}
+
+func TestUserStream_RejectsInvalidFilters(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/users/stream?types=renamed", nil)
+	req.Header.Set(testUserIDHeader, strconv.Itoa(testStreamUserID))
+	resp, err := request(req)
+	if err != nil {
+		t.Fatalf("request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d", resp.StatusCode)
+	}
+}
+
+func TestUserStreams_RequireASession(t *testing.T) {
+	resp, err := http.Get("http://" + streamBaseURL() + "/api/v1/users/stream")
+	if err != nil {
+		t.Fatalf("open stream: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("stream: expected 401, got %d", resp.StatusCode)
+	}
+
+	conn, resp, err := websocket.DefaultDialer.Dial("ws://"+streamBaseURL()+"/api/v1/users/ws", nil)
+	if err == nil {
+		conn.Close()
+		t.Fatal("websocket: expected the handshake to be refused")
+	}
+	if resp == nil || resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("websocket: expected 401, got %v", resp)
+	}
+}
+
+func TestUserWebSocket_ReceivesChanges(t *testing.T) {
+	t.Cleanup(func() { truncateUsers(t) })
+
+	header := http.Header{testUserIDHeader: {strconv.Itoa(testStreamUserID)}}
+	conn, _, err := websocket.DefaultDialer.Dial("ws://"+streamBaseURL()+"/api/v1/users/ws", header)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	defer conn.Close()
+
+	// Give the server a moment to subscribe before the write.
+	time.Sleep(100 * time.Millisecond)
+	id := createUser(t, "Socket", "socket@example.com")
+
+	_ = conn.SetReadDeadline(time.Now().Add(10 * time.Second))
+	var message struct {
+		ID    string `json:"id"`
+		Event string `json:"event"`
+		Data  struct {
+			User struct {
+				ID uint `json:"id"`
+			} `json:"user"`
+		} `json:"data"`
+	}
+	if err := conn.ReadJSON(&message); err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	if message.Event != "created" || message.Data.User.ID != id {
+		t.Fatalf("unexpected message: %+v", message)
+	}
+}
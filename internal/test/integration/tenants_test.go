@@ -0,0 +1,190 @@
+package integration
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+const testTenantAdminID = 900002
+
+func tenantRequest(t *testing.T, method, path string, actorID uint, body string) *http.Response {
+	t.Helper()
+
+	req, _ := http.NewRequest(method, path, bytes.NewBufferString(body))
+	if body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if actorID != 0 {
+		req.Header.Set(testUserIDHeader, fmt.Sprint(actorID))
+	}
+
+	resp, err := request(req)
+	if err != nil {
+		t.Fatalf("%s %s: %v", method, path, err)
+	}
+	return resp
+}
+
+func createTenant(t *testing.T, id, name string) {
+	t.Helper()
+
+	body := fmt.Sprintf(`{"id":%q,"name":%q}`, id, name)
+	req, _ := http.NewRequest(http.MethodPost, "/api/v1/tenants", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(testUserIDHeader, fmt.Sprint(testTenantAdminID))
+
+	resp, err := request(req)
+	if err != nil {
+		t.Fatalf("create tenant: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("create tenant: expected 201, got %d", resp.StatusCode)
+	}
+}
+
+func TestTenants_RequireAnAdministrator(t *testing.T) {
+	t.Cleanup(func() { truncateTenants(t) })
+
+	const body = `{"id":"umbrella","name":"Umbrella"}`
+	tests := []struct {
+		name    string
+		method  string
+		path    string
+		actorID uint
+		body    string
+		status  int
+	}{
+		{name: "create without a session", method: http.MethodPost, path: "/api/v1/tenants", body: body, status: http.StatusUnauthorized},
+		{name: "create by a non-admin", method: http.MethodPost, path: "/api/v1/tenants", actorID: 1, body: body, status: http.StatusForbidden},
+		{name: "get without a session", method: http.MethodGet, path: "/api/v1/tenants/default", status: http.StatusUnauthorized},
+		{name: "get by a non-admin", method: http.MethodGet, path: "/api/v1/tenants/default", actorID: 1, status: http.StatusForbidden},
+		{name: "get by an admin", method: http.MethodGet, path: "/api/v1/tenants/default", actorID: testTenantAdminID, status: http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := tenantRequest(t, tt.method, tt.path, tt.actorID, tt.body)
+			resp.Body.Close()
+			if resp.StatusCode != tt.status {
+				t.Fatalf("expected %d, got %d", tt.status, resp.StatusCode)
+			}
+		})
+	}
+
+	db := openTestDB(t)
+	var count int
+	if err := db.QueryRow("SELECT COUNT(*) FROM tenants WHERE id = 'umbrella'").Scan(&count); err != nil {
+		t.Fatalf("count: %v", err)
+	}
+	if count != 0 {
+		t.Fatal("expected no tenant to be created without an administrator")
+	}
+}
+
+func TestTenants_EmailUniquePerTenant(t *testing.T) {
+	t.Cleanup(func() {
+		truncateUsers(t)
+		truncateTenants(t)
+	})
+
+	createTenant(t, "acme", "Acme Corp")
+
+	for _, tenant := range []string{"default", "acme"} {
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/users",
+			bytes.NewBufferString(`{"name":"Bia","email":"bia@example.com"}`))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Tenant-ID", tenant)
+
+		resp, err := request(req)
+		if err != nil {
+			t.Fatalf("create user in %s: %v", tenant, err)
+		}
+		resp.Body.Close()
+
+		if resp.StatusCode != http.StatusCreated {
+			t.Fatalf("create user in %s: expected 201, got %d", tenant, resp.StatusCode)
+		}
+	}
+}
+
+func TestTenants_UsersAreIsolated(t *testing.T) {
+	t.Cleanup(func() {
+		truncateUsers(t)
+		truncateTenants(t)
+	})
+
+	createTenant(t, "globex", "Globex")
+	userID := createUser(t, "Caio", "caio@example.com")
+
+	req, _ := http.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/users/%d", userID), nil)
+	req.Header.Set("X-Tenant-ID", "globex")
+
+	resp, err := request(req)
+	if err != nil {
+		t.Fatalf("request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected 404 across tenants, got %d", resp.StatusCode)
+	}
+}
+
+func TestTenants_UnknownTenant(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/users/1", nil)
+	req.Header.Set("X-Tenant-ID", "does-not-exist")
+
+	resp, err := request(req)
+	if err != nil {
+		t.Fatalf("request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected 404, got %d", resp.StatusCode)
+	}
+
+	var body map[string]any
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if body["message"] != "Tenant not found" {
+		t.Fatalf("expected message=Tenant not found, got %v", body["message"])
+	}
+}
+
+func TestTenants_SessionTenantCannotBeOverridden(t *testing.T) {
+	t.Cleanup(func() {
+		truncateSessions(t)
+		truncateUsers(t)
+		truncateTenants(t)
+	})
+
+	createTenant(t, "initech", "Initech")
+	userID := createUser(t, "Rui", "rui@example.com")
+	session := openSession(t, userID)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/sessions", nil)
+	req.AddCookie(&http.Cookie{Name: "session_id", Value: session.Token})
+	req.Header.Set("X-Tenant-ID", "initech")
+
+	resp, err := request(req)
+	if err != nil {
+		t.Fatalf("request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusForbidden {
+		t.Fatalf("expected 403 for a header naming another tenant, got %d", resp.StatusCode)
+	}
+
+	own := sessionRequest(t, http.MethodGet, "/api/v1/sessions", session, "")
+	own.Body.Close()
+	if own.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200 in the session's tenant, got %d", own.StatusCode)
+	}
+}
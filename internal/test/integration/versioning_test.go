@@ -0,0 +1,164 @@
+package integration
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"golang_boilerplate_module/internal/shared/infra/http/openapi"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	testV1DeprecatedAt = "2026-01-01"
+	testV1SunsetAt     = "2099-01-01"
+	versionProbePath   = "/test/version"
+)
+
+type versionProbeOutput struct {
+	Version string `json:"version" validate:"required"`
+}
+
+func registerVersionProbeRoutes(router *openapi.Router) {
+	for _, version := range []string{"v1", "v2"} {
+		router.Version(version).Get(versionProbePath, func(c *fiber.Ctx) error {
+			return c.JSON(versionProbeOutput{Version: version})
+		}, openapi.Returns[versionProbeOutput](fiber.StatusOK))
+	}
+}
+
+func probeVersion(t *testing.T, path, accept string) (*http.Response, string) {
+	t.Helper()
+
+	req, _ := http.NewRequest(http.MethodGet, path, nil)
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	resp, err := request(req)
+	if err != nil {
+		t.Fatalf("request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("%s: expected 200, got %d", path, resp.StatusCode)
+	}
+
+	var body versionProbeOutput
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	return resp, body.Version
+}
+
+func TestAPIVersion_PathPrefixSelectsVersion(t *testing.T) {
+	resp, version := probeVersion(t, "/api/v2"+versionProbePath, "")
+
+	if version != "v2" || resp.Header.Get("API-Version") != "v2" {
+		t.Fatalf("expected v2, got body=%s header=%s", version, resp.Header.Get("API-Version"))
+	}
+	if resp.Header.Get("Deprecation") != "" {
+		t.Fatalf("expected no Deprecation header on the current version")
+	}
+}
+
+func TestAPIVersion_AcceptParameterSelectsVersion(t *testing.T) {
+	resp, version := probeVersion(t, "/api"+versionProbePath, "application/json; version=2")
+
+	if version != "v2" {
+		t.Fatalf("expected v2, got %s", version)
+	}
+	if !strings.Contains(resp.Header.Get("Vary"), "Accept") {
+		t.Fatalf("expected Vary: Accept, got %q", resp.Header.Get("Vary"))
+	}
+}
+
+func TestAPIVersion_UnversionedUsesDefaultAndAnnouncesDeprecation(t *testing.T) {
+	resp, version := probeVersion(t, "/api"+versionProbePath, "")
+
+	if version != "v1" {
+		t.Fatalf("expected the default version v1, got %s", version)
+	}
+
+	deprecatedAt, _ := time.Parse(time.DateOnly, testV1DeprecatedAt)
+	if got, want := resp.Header.Get("Deprecation"), "@"+strconv.FormatInt(deprecatedAt.Unix(), 10); got != want {
+		t.Fatalf("expected Deprecation %q, got %q", want, got)
+	}
+	sunsetAt, _ := time.Parse(time.DateOnly, testV1SunsetAt)
+	if got, want := resp.Header.Get("Sunset"), sunsetAt.Format(http.TimeFormat); got != want {
+		t.Fatalf("expected Sunset %q, got %q", want, got)
+	}
+}
+
+func TestAPIVersion_VersionedUserRoutes(t *testing.T) {
+	t.Cleanup(func() { truncateUsers(t) })
+
+	createReq, _ := http.NewRequest(http.MethodPost, "/api/v1/users",
+		bytes.NewBufferString(`{"name":"Ana","email":"ana.v1@example.com"}`))
+	createReq.Header.Set("Content-Type", "application/json")
+	createResp, err := request(createReq)
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	defer createResp.Body.Close()
+
+	if createResp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected 201, got %d", createResp.StatusCode)
+	}
+	var created struct {
+		ID uint `json:"id"`
+	}
+	if err := json.NewDecoder(createResp.Body).Decode(&created); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+
+	getReq, _ := http.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/users/%d", created.ID), nil)
+	getResp, err := request(getReq)
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	getResp.Body.Close()
+
+	if getResp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", getResp.StatusCode)
+	}
+	for _, resp := range []*http.Response{createResp, getResp} {
+		if resp.Header.Get("API-Version") != "v1" {
+			t.Fatalf("expected API-Version v1, got %q", resp.Header.Get("API-Version"))
+		}
+		if resp.Header.Get("Deprecation") == "" || resp.Header.Get("Sunset") == "" {
+			t.Fatalf("expected the deprecation headers of v1, got Deprecation=%q Sunset=%q", resp.Header.Get("Deprecation"), resp.Header.Get("Sunset"))
+		}
+	}
+}
+
+func TestAPIVersion_UnsupportedVersion(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodGet, "/api/v9/users/1", nil)
+	resp, err := request(req)
+	if err != nil {
+		t.Fatalf("request: %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected 404, got %d", resp.StatusCode)
+	}
+
+	req, _ = http.NewRequest(http.MethodGet, "/api/users/1", nil)
+	req.Header.Set("Accept", "application/json; version=9")
+	resp, err = request(req)
+	if err != nil {
+		t.Fatalf("request: %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d", resp.StatusCode)
+	}
+}
@@ -0,0 +1 @@
+ALTER TABLE privacy_erasure_requests ADD COLUMN IF NOT EXISTS attempts INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,32 @@
+CREATE TABLE IF NOT EXISTS privacy_erasure_requests (
+    id                 SERIAL PRIMARY KEY,
+    user_id            INTEGER      NOT NULL,
+    status             VARCHAR(32)  NOT NULL,
+    reason             TEXT         NOT NULL DEFAULT '',
+    requested_at       TIMESTAMPTZ  NOT NULL,
+    scheduled_for      TIMESTAMPTZ  NOT NULL,
+    cancellable_until  TIMESTAMPTZ  NOT NULL,
+    cancelled_at       TIMESTAMPTZ,
+    completed_at       TIMESTAMPTZ,
+    certificate_digest VARCHAR(64)  NOT NULL DEFAULT '',
+    created_at         TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    updated_at         TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_privacy_erasure_requests_user_id ON privacy_erasure_requests (user_id);
+CREATE INDEX IF NOT EXISTS idx_privacy_erasure_requests_due ON privacy_erasure_requests (status, scheduled_for);
+
+CREATE TABLE IF NOT EXISTS privacy_audit_records (
+    id                 SERIAL PRIMARY KEY,
+    erasure_request_id INTEGER      REFERENCES privacy_erasure_requests (id),
+    user_id            INTEGER      NOT NULL,
+    action             VARCHAR(64)  NOT NULL,
+    module             VARCHAR(128) NOT NULL DEFAULT '',
+    strategy           VARCHAR(32)  NOT NULL DEFAULT '',
+    records_affected   BIGINT       NOT NULL DEFAULT 0,
+    details            TEXT         NOT NULL DEFAULT '',
+    created_at         TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_privacy_audit_records_user_id ON privacy_audit_records (user_id);
+CREATE INDEX IF NOT EXISTS idx_privacy_audit_records_erasure_request_id ON privacy_audit_records (erasure_request_id);
@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS tenants (
+    id         VARCHAR(63)  PRIMARY KEY,
+    name       VARCHAR(255) NOT NULL,
+    subdomain  VARCHAR(63)  UNIQUE,
+    active     BOOLEAN      NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO tenants (id, name) VALUES ('default', 'Default tenant')
+ON CONFLICT (id) DO NOTHING;
+
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS tenant_id VARCHAR(63) NOT NULL DEFAULT 'default' REFERENCES tenants (id);
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_email_key;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_tenant_email ON users (tenant_id, email);
+
+ALTER TABLE privacy_erasure_requests
+    ADD COLUMN IF NOT EXISTS tenant_id VARCHAR(63) NOT NULL DEFAULT 'default' REFERENCES tenants (id);
+CREATE INDEX IF NOT EXISTS idx_privacy_erasure_requests_tenant_id ON privacy_erasure_requests (tenant_id);
+
+ALTER TABLE privacy_audit_records
+    ADD COLUMN IF NOT EXISTS tenant_id VARCHAR(63) NOT NULL DEFAULT 'default' REFERENCES tenants (id);
+CREATE INDEX IF NOT EXISTS idx_privacy_audit_records_tenant_id ON privacy_audit_records (tenant_id);
@@ -0,0 +1,64 @@
+CREATE TABLE IF NOT EXISTS organizations (
+    id         SERIAL       PRIMARY KEY,
+    tenant_id  VARCHAR(63)  NOT NULL REFERENCES tenants (id),
+    name       VARCHAR(255) NOT NULL,
+    created_at TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_organizations_tenant_id ON organizations (tenant_id);
+
+CREATE TABLE IF NOT EXISTS organization_memberships (
+    id              SERIAL      PRIMARY KEY,
+    tenant_id       VARCHAR(63) NOT NULL REFERENCES tenants (id),
+    organization_id INTEGER     NOT NULL REFERENCES organizations (id) ON DELETE CASCADE,
+    user_id         INTEGER     NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    role            VARCHAR(16) NOT NULL CHECK (role IN ('owner', 'admin', 'member')),
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT idx_memberships_org_user UNIQUE (organization_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_organization_memberships_tenant_id ON organization_memberships (tenant_id);
+CREATE INDEX IF NOT EXISTS idx_organization_memberships_user_id ON organization_memberships (user_id);
+
+CREATE TABLE IF NOT EXISTS teams (
+    id              SERIAL       PRIMARY KEY,
+    tenant_id       VARCHAR(63)  NOT NULL REFERENCES tenants (id),
+    organization_id INTEGER      NOT NULL REFERENCES organizations (id) ON DELETE CASCADE,
+    name            VARCHAR(255) NOT NULL,
+    created_at      TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    updated_at      TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_teams_tenant_id ON teams (tenant_id);
+CREATE INDEX IF NOT EXISTS idx_teams_organization_id ON teams (organization_id);
+
+CREATE TABLE IF NOT EXISTS team_members (
+    id         SERIAL      PRIMARY KEY,
+    tenant_id  VARCHAR(63) NOT NULL REFERENCES tenants (id),
+    team_id    INTEGER     NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+    user_id    INTEGER     NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT idx_team_members_team_user UNIQUE (team_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_team_members_tenant_id ON team_members (tenant_id);
+
+CREATE TABLE IF NOT EXISTS organization_invitations (
+    id                 SERIAL       PRIMARY KEY,
+    tenant_id          VARCHAR(63)  NOT NULL REFERENCES tenants (id),
+    organization_id    INTEGER      NOT NULL REFERENCES organizations (id) ON DELETE CASCADE,
+    email              VARCHAR(255) NOT NULL,
+    role               VARCHAR(16)  NOT NULL CHECK (role IN ('admin', 'member')),
+    token_hash         VARCHAR(64)  NOT NULL UNIQUE,
+    status             VARCHAR(16)  NOT NULL,
+    invited_by_user_id INTEGER      NOT NULL,
+    expires_at         TIMESTAMPTZ  NOT NULL,
+    responded_at       TIMESTAMPTZ,
+    created_at         TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    updated_at         TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_organization_invitations_tenant_id ON organization_invitations (tenant_id);
+CREATE INDEX IF NOT EXISTS idx_organization_invitations_org_email ON organization_invitations (organization_id, LOWER(email));
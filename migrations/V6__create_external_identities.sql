@@ -0,0 +1,28 @@
+CREATE TABLE IF NOT EXISTS external_identities (
+    id            SERIAL       PRIMARY KEY,
+    tenant_id     VARCHAR(63)  NOT NULL REFERENCES tenants (id),
+    user_id       INTEGER      NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    provider      VARCHAR(64)  NOT NULL,
+    subject       VARCHAR(255) NOT NULL,
+    email         VARCHAR(255) NOT NULL DEFAULT '',
+    created_at    TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    last_login_at TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_external_identities_provider_subject
+    ON external_identities (tenant_id, provider, subject);
+CREATE INDEX IF NOT EXISTS idx_external_identities_user_id ON external_identities (user_id);
+
+CREATE TABLE IF NOT EXISTS oidc_login_states (
+    id            VARCHAR(64)   PRIMARY KEY,
+    tenant_id     VARCHAR(63)   NOT NULL REFERENCES tenants (id),
+    provider      VARCHAR(64)   NOT NULL,
+    nonce         VARCHAR(64)   NOT NULL,
+    code_verifier VARCHAR(128)  NOT NULL,
+    redirect_to   VARCHAR(2048) NOT NULL DEFAULT '/',
+    expires_at    TIMESTAMPTZ   NOT NULL,
+    created_at    TIMESTAMPTZ   NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_oidc_login_states_tenant_id ON oidc_login_states (tenant_id);
+CREATE INDEX IF NOT EXISTS idx_oidc_login_states_expires_at ON oidc_login_states (expires_at);
@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS user_events (
+    id          BIGSERIAL    PRIMARY KEY,
+    tenant_id   VARCHAR(63)  NOT NULL REFERENCES tenants (id),
+    type        VARCHAR(16)  NOT NULL,
+    user_id     INTEGER      NOT NULL,
+    name        VARCHAR(255) NOT NULL,
+    email       VARCHAR(255) NOT NULL,
+    occurred_at TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_events_tenant_id_id ON user_events (tenant_id, id);
+CREATE INDEX IF NOT EXISTS idx_user_events_occurred_at ON user_events (occurred_at);
+
+-- Every replica LISTENs on user_events; the payload is the inserted row.
+CREATE OR REPLACE FUNCTION notify_user_event() RETURNS TRIGGER AS $$
+BEGIN
+    PERFORM pg_notify('user_events', row_to_json(NEW)::text);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS user_events_notify ON user_events;
+CREATE TRIGGER user_events_notify
+    AFTER INSERT ON user_events
+    FOR EACH ROW EXECUTE FUNCTION notify_user_event();
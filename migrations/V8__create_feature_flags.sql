@@ -0,0 +1,30 @@
+CREATE TABLE IF NOT EXISTS feature_flags (
+    key           VARCHAR(100) PRIMARY KEY,
+    kind          VARCHAR(16)  NOT NULL,
+    description   TEXT         NOT NULL DEFAULT '',
+    enabled       BOOLEAN      NOT NULL DEFAULT FALSE,
+    variants      JSONB        NOT NULL,
+    off_variant   VARCHAR(100) NOT NULL,
+    rules         JSONB        NOT NULL DEFAULT '[]',
+    default_serve JSONB        NOT NULL,
+    updated_by    INTEGER,
+    updated_at    TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
+
+-- Every replica LISTENs on feature_flags to drop its cached flags; the
+-- payload is the key of the changed flag.
+CREATE OR REPLACE FUNCTION notify_feature_flag() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        PERFORM pg_notify('feature_flags', OLD.key);
+    ELSE
+        PERFORM pg_notify('feature_flags', NEW.key);
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS feature_flags_notify ON feature_flags;
+CREATE TRIGGER feature_flags_notify
+    AFTER INSERT OR UPDATE OR DELETE ON feature_flags
+    FOR EACH ROW EXECUTE FUNCTION notify_feature_flag();
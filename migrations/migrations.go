@@ -0,0 +1,16 @@
+// Package migrations embeds the Flyway scripts so the module registry can
+// check that every versioned migration is owned by exactly one module, and
+// so the api binary can apply them without the flyway image.
+//
+// undo/ holds the script that reverts each version, named after it without
+// the V prefix so Flyway never picks it up.
+//
+// sqlite/ holds the same versions, and their undo scripts, written for
+// SQLite. Flyway would find those as well, so make migrate and the compose
+// file hide the directory from the flyway image.
+package migrations
+
+import "embed"
+
+//go:embed V*.sql undo/*.sql sqlite/V*.sql sqlite/undo/*.sql
+var FS embed.FS
@@ -0,0 +1,93 @@
+-- Optional defense in depth for multi-tenancy. Not applied by Flyway (no V prefix).
+-- Apply manually and set TENANCY_ROW_LEVEL_SECURITY=true so repositories run
+-- each statement with `app.current_tenant` set for the current transaction.
+-- Policies only bind roles that do not own the tables (or use FORCE below).
+-- user_events is also read and pruned across tenants by the change listener
+-- and the cleanup job, which set `app.system_scope` for their transaction.
+
+ALTER TABLE users ENABLE ROW LEVEL SECURITY;
+ALTER TABLE privacy_erasure_requests ENABLE ROW LEVEL SECURITY;
+ALTER TABLE privacy_audit_records ENABLE ROW LEVEL SECURITY;
+ALTER TABLE organizations ENABLE ROW LEVEL SECURITY;
+ALTER TABLE organization_memberships ENABLE ROW LEVEL SECURITY;
+ALTER TABLE teams ENABLE ROW LEVEL SECURITY;
+ALTER TABLE team_members ENABLE ROW LEVEL SECURITY;
+ALTER TABLE organization_invitations ENABLE ROW LEVEL SECURITY;
+ALTER TABLE sessions ENABLE ROW LEVEL SECURITY;
+ALTER TABLE external_identities ENABLE ROW LEVEL SECURITY;
+ALTER TABLE oidc_login_states ENABLE ROW LEVEL SECURITY;
+ALTER TABLE user_events ENABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS tenant_isolation ON users;
+CREATE POLICY tenant_isolation ON users
+    USING (tenant_id = current_setting('app.current_tenant', true))
+    WITH CHECK (tenant_id = current_setting('app.current_tenant', true));
+
+DROP POLICY IF EXISTS tenant_isolation ON privacy_erasure_requests;
+CREATE POLICY tenant_isolation ON privacy_erasure_requests
+    USING (tenant_id = current_setting('app.current_tenant', true))
+    WITH CHECK (tenant_id = current_setting('app.current_tenant', true));
+
+DROP POLICY IF EXISTS tenant_isolation ON privacy_audit_records;
+CREATE POLICY tenant_isolation ON privacy_audit_records
+    USING (tenant_id = current_setting('app.current_tenant', true))
+    WITH CHECK (tenant_id = current_setting('app.current_tenant', true));
+
+DROP POLICY IF EXISTS tenant_isolation ON organizations;
+CREATE POLICY tenant_isolation ON organizations
+    USING (tenant_id = current_setting('app.current_tenant', true))
+    WITH CHECK (tenant_id = current_setting('app.current_tenant', true));
+
+DROP POLICY IF EXISTS tenant_isolation ON organization_memberships;
+CREATE POLICY tenant_isolation ON organization_memberships
+    USING (tenant_id = current_setting('app.current_tenant', true))
+    WITH CHECK (tenant_id = current_setting('app.current_tenant', true));
+
+DROP POLICY IF EXISTS tenant_isolation ON teams;
+CREATE POLICY tenant_isolation ON teams
+    USING (tenant_id = current_setting('app.current_tenant', true))
+    WITH CHECK (tenant_id = current_setting('app.current_tenant', true));
+
+DROP POLICY IF EXISTS tenant_isolation ON team_members;
+CREATE POLICY tenant_isolation ON team_members
+    USING (tenant_id = current_setting('app.current_tenant', true))
+    WITH CHECK (tenant_id = current_setting('app.current_tenant', true));
+
+DROP POLICY IF EXISTS tenant_isolation ON organization_invitations;
+CREATE POLICY tenant_isolation ON organization_invitations
+    USING (tenant_id = current_setting('app.current_tenant', true))
+    WITH CHECK (tenant_id = current_setting('app.current_tenant', true));
+
+DROP POLICY IF EXISTS tenant_isolation ON sessions;
+CREATE POLICY tenant_isolation ON sessions
+    USING (tenant_id = current_setting('app.current_tenant', true))
+    WITH CHECK (tenant_id = current_setting('app.current_tenant', true));
+
+DROP POLICY IF EXISTS tenant_isolation ON external_identities;
+CREATE POLICY tenant_isolation ON external_identities
+    USING (tenant_id = current_setting('app.current_tenant', true))
+    WITH CHECK (tenant_id = current_setting('app.current_tenant', true));
+
+DROP POLICY IF EXISTS tenant_isolation ON oidc_login_states;
+CREATE POLICY tenant_isolation ON oidc_login_states
+    USING (tenant_id = current_setting('app.current_tenant', true))
+    WITH CHECK (tenant_id = current_setting('app.current_tenant', true));
+
+DROP POLICY IF EXISTS tenant_isolation ON user_events;
+CREATE POLICY tenant_isolation ON user_events
+    USING (tenant_id = current_setting('app.current_tenant', true)
+        OR current_setting('app.system_scope', true) = 'on')
+    WITH CHECK (tenant_id = current_setting('app.current_tenant', true));
+
+-- ALTER TABLE users FORCE ROW LEVEL SECURITY;
+-- ALTER TABLE privacy_erasure_requests FORCE ROW LEVEL SECURITY;
+-- ALTER TABLE privacy_audit_records FORCE ROW LEVEL SECURITY;
+-- ALTER TABLE organizations FORCE ROW LEVEL SECURITY;
+-- ALTER TABLE organization_memberships FORCE ROW LEVEL SECURITY;
+-- ALTER TABLE teams FORCE ROW LEVEL SECURITY;
+-- ALTER TABLE team_members FORCE ROW LEVEL SECURITY;
+-- ALTER TABLE organization_invitations FORCE ROW LEVEL SECURITY;
+-- ALTER TABLE sessions FORCE ROW LEVEL SECURITY;
+-- ALTER TABLE external_identities FORCE ROW LEVEL SECURITY;
+-- ALTER TABLE oidc_login_states FORCE ROW LEVEL SECURITY;
+-- ALTER TABLE user_events FORCE ROW LEVEL SECURITY;
@@ -0,0 +1 @@
+ALTER TABLE privacy_erasure_requests ADD COLUMN attempts INTEGER NOT NULL DEFAULT 0;
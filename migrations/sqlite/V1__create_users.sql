@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS users (
+    id         INTEGER      PRIMARY KEY AUTOINCREMENT,
+    name       VARCHAR(255) NOT NULL,
+    email      VARCHAR(255) NOT NULL UNIQUE,
+    created_at TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS sessions (
+    id                  VARCHAR(64)  PRIMARY KEY,
+    tenant_id           VARCHAR(63)  NOT NULL REFERENCES tenants (id),
+    user_id             INTEGER      NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    csrf_token          VARCHAR(64)  NOT NULL,
+    user_agent          VARCHAR(512) NOT NULL DEFAULT '',
+    ip_address          VARCHAR(64)  NOT NULL DEFAULT '',
+    created_at          TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_seen_at        TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at          TIMESTAMP    NOT NULL,
+    absolute_expires_at TIMESTAMP    NOT NULL,
+    revoked_at          TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_sessions_tenant_id ON sessions (tenant_id);
+CREATE INDEX IF NOT EXISTS idx_sessions_user_id ON sessions (user_id);
+CREATE INDEX IF NOT EXISTS idx_sessions_expires_at ON sessions (expires_at);
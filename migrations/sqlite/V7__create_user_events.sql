@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS user_events (
+    id          INTEGER      PRIMARY KEY AUTOINCREMENT,
+    tenant_id   VARCHAR(63)  NOT NULL REFERENCES tenants (id),
+    type        VARCHAR(16)  NOT NULL,
+    user_id     INTEGER      NOT NULL,
+    name        VARCHAR(255) NOT NULL,
+    email       VARCHAR(255) NOT NULL,
+    occurred_at TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_events_tenant_id_id ON user_events (tenant_id, id);
+CREATE INDEX IF NOT EXISTS idx_user_events_occurred_at ON user_events (occurred_at);
+
+-- No NOTIFY trigger: without LISTEN the event bus is the in-memory one.
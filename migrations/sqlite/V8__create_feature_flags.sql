@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS feature_flags (
+    key           VARCHAR(100) PRIMARY KEY,
+    kind          VARCHAR(16)  NOT NULL,
+    description   TEXT         NOT NULL DEFAULT '',
+    enabled       BOOLEAN      NOT NULL DEFAULT FALSE,
+    variants      TEXT         NOT NULL,
+    off_variant   VARCHAR(100) NOT NULL,
+    rules         TEXT         NOT NULL DEFAULT '[]',
+    default_serve TEXT         NOT NULL,
+    updated_by    INTEGER,
+    updated_at    TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- No NOTIFY trigger: without LISTEN, the flag store only reports the changes
+-- the process makes itself.
@@ -0,0 +1,3 @@
+ALTER TABLE privacy_erasure_requests ADD COLUMN claimed_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_privacy_erasure_requests_claimed ON privacy_erasure_requests (status, claimed_at);
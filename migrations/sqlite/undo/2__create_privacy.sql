@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS privacy_audit_records;
+DROP TABLE IF EXISTS privacy_erasure_requests;
@@ -0,0 +1,60 @@
+-- The rebuilds of V3, the other way around.
+
+CREATE TABLE privacy_audit_records_rebuilt (
+    id                 INTEGER      PRIMARY KEY AUTOINCREMENT,
+    erasure_request_id INTEGER      REFERENCES privacy_erasure_requests (id),
+    user_id            INTEGER      NOT NULL,
+    action             VARCHAR(64)  NOT NULL,
+    module             VARCHAR(128) NOT NULL DEFAULT '',
+    strategy           VARCHAR(32)  NOT NULL DEFAULT '',
+    records_affected   BIGINT       NOT NULL DEFAULT 0,
+    details            TEXT         NOT NULL DEFAULT '',
+    created_at         TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+INSERT INTO privacy_audit_records_rebuilt
+    (id, erasure_request_id, user_id, action, module, strategy, records_affected, details, created_at)
+SELECT id, erasure_request_id, user_id, action, module, strategy, records_affected, details, created_at
+FROM privacy_audit_records;
+DROP TABLE privacy_audit_records;
+ALTER TABLE privacy_audit_records_rebuilt RENAME TO privacy_audit_records;
+CREATE INDEX IF NOT EXISTS idx_privacy_audit_records_user_id ON privacy_audit_records (user_id);
+CREATE INDEX IF NOT EXISTS idx_privacy_audit_records_erasure_request_id ON privacy_audit_records (erasure_request_id);
+
+CREATE TABLE privacy_erasure_requests_rebuilt (
+    id                 INTEGER      PRIMARY KEY AUTOINCREMENT,
+    user_id            INTEGER      NOT NULL,
+    status             VARCHAR(32)  NOT NULL,
+    reason             TEXT         NOT NULL DEFAULT '',
+    requested_at       TIMESTAMP    NOT NULL,
+    scheduled_for      TIMESTAMP    NOT NULL,
+    cancellable_until  TIMESTAMP    NOT NULL,
+    cancelled_at       TIMESTAMP,
+    completed_at       TIMESTAMP,
+    certificate_digest VARCHAR(64)  NOT NULL DEFAULT '',
+    created_at         TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at         TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+INSERT INTO privacy_erasure_requests_rebuilt
+    (id, user_id, status, reason, requested_at, scheduled_for, cancellable_until,
+     cancelled_at, completed_at, certificate_digest, created_at, updated_at)
+SELECT id, user_id, status, reason, requested_at, scheduled_for, cancellable_until,
+       cancelled_at, completed_at, certificate_digest, created_at, updated_at
+FROM privacy_erasure_requests;
+DROP TABLE privacy_erasure_requests;
+ALTER TABLE privacy_erasure_requests_rebuilt RENAME TO privacy_erasure_requests;
+CREATE INDEX IF NOT EXISTS idx_privacy_erasure_requests_user_id ON privacy_erasure_requests (user_id);
+CREATE INDEX IF NOT EXISTS idx_privacy_erasure_requests_due ON privacy_erasure_requests (status, scheduled_for);
+
+CREATE TABLE users_rebuilt (
+    id         INTEGER      PRIMARY KEY AUTOINCREMENT,
+    name       VARCHAR(255) NOT NULL,
+    email      VARCHAR(255) NOT NULL UNIQUE,
+    created_at TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+INSERT INTO users_rebuilt (id, name, email, created_at, updated_at)
+SELECT id, name, email, created_at, updated_at FROM users;
+DROP TABLE users;
+ALTER TABLE users_rebuilt RENAME TO users;
+
+DROP TABLE IF EXISTS tenants;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_privacy_erasure_requests_claimed;
+ALTER TABLE privacy_erasure_requests DROP COLUMN claimed_at;
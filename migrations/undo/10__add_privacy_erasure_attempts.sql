@@ -0,0 +1 @@
+ALTER TABLE privacy_erasure_requests DROP COLUMN IF EXISTS attempts;
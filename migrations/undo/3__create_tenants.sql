@@ -0,0 +1,8 @@
+ALTER TABLE privacy_audit_records DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE privacy_erasure_requests DROP COLUMN IF EXISTS tenant_id;
+
+DROP INDEX IF EXISTS idx_users_tenant_email;
+ALTER TABLE users DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE users ADD CONSTRAINT users_email_key UNIQUE (email);
+
+DROP TABLE IF EXISTS tenants;
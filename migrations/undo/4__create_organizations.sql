@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS organization_invitations;
+DROP TABLE IF EXISTS team_members;
+DROP TABLE IF EXISTS teams;
+DROP TABLE IF EXISTS organization_memberships;
+DROP TABLE IF EXISTS organizations;
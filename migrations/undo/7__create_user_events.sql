@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS user_events_notify ON user_events;
+DROP FUNCTION IF EXISTS notify_user_event();
+DROP TABLE IF EXISTS user_events;
@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS feature_flags_notify ON feature_flags;
+DROP FUNCTION IF EXISTS notify_feature_flag();
+DROP TABLE IF EXISTS feature_flags;